@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -14,9 +15,10 @@ import (
 )
 
 const (
-	getAccountPath = "/:address"
-	getBalancePath = "/:address/balance"
-	getKeyPath     = "/:address/key/:key"
+	getAccountPath        = "/:address"
+	getAccountAtBlockPath = "/:address/block/:nonce"
+	getBalancePath        = "/:address/balance"
+	getKeyPath            = "/:address/key/:key"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -24,6 +26,7 @@ type FacadeHandler interface {
 	GetBalance(address string) (*big.Int, error)
 	GetValueForKey(address string, key string) (string, error)
 	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccountAtBlock(address string, nonce uint64) (state.UserAccountHandler, error)
 	IsInterfaceNil() bool
 }
 
@@ -39,6 +42,7 @@ type accountResponse struct {
 // Routes defines address related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getAccountPath, GetAccount)
+	router.RegisterHandler(http.MethodGet, getAccountAtBlockPath, GetAccountAtBlock)
 	router.RegisterHandler(http.MethodGet, getBalancePath, GetBalance)
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 }
@@ -105,6 +109,51 @@ func GetAccount(c *gin.Context) {
 	)
 }
 
+// GetAccountAtBlock returns an accountResponse containing information
+//  about the account correlated with provided address, as it was at the provided block nonce
+func GetAccountAtBlock(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), errors.ErrInvalidBlockNonce.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	addr := c.Param("address")
+	acc, err := facade.GetAccountAtBlock(addr, nonce)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"account": accountResponseFromBaseAccount(addr, acc)},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // GetBalance returns the balance for the address parameter
 func GetBalance(c *gin.Context) {
 	facade, ok := getFacade(c)
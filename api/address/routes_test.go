@@ -338,6 +338,84 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, response.Error)
 }
 
+func TestGetAccountAtBlock_InvalidNonceShouldError(t *testing.T) {
+	t.Parallel()
+	facade := mock.Facade{
+		GetAccountAtBlockHandler: func(address string, nonce uint64) (state.UserAccountHandler, error) {
+			assert.Fail(t, "should not have been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/block/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Empty(t, response.Data)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetAccountAtBlock_FailWhenFacadeGetAccountAtBlockFails(t *testing.T) {
+	t.Parallel()
+	returnedError := "i am an error"
+	facade := mock.Facade{
+		GetAccountAtBlockHandler: func(address string, nonce uint64) (state.UserAccountHandler, error) {
+			return nil, errors.New(returnedError)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/test/block/7", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Empty(t, response.Data)
+	assert.True(t, strings.Contains(response.Error, fmt.Sprintf("%s: %s", apiErrors.ErrCouldNotGetAccount.Error(), returnedError)))
+}
+
+func TestGetAccountAtBlock_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+	requestedNonce := uint64(0)
+	facade := mock.Facade{
+		GetAccountAtBlockHandler: func(address string, nonce uint64) (state.UserAccountHandler, error) {
+			requestedNonce = nonce
+			acc, _ := state.NewUserAccount([]byte("1234"))
+			_ = acc.AddToBalance(big.NewInt(100))
+			acc.IncreaseNonce(1)
+
+			return acc, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	reqAddress := "test"
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/block/7", reqAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	mapResponse := response.Data.(map[string]interface{})
+	accountResponse := AccountResponse{}
+
+	mapResponseBytes, _ := json.Marshal(&mapResponse)
+	_ = json.Unmarshal(mapResponseBytes, &accountResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, uint64(7), requestedNonce)
+	assert.Equal(t, accountResponse.Account.Address, reqAddress)
+	assert.Equal(t, accountResponse.Account.Nonce, uint64(1))
+	assert.Equal(t, accountResponse.Account.Balance, "100")
+	assert.Empty(t, response.Error)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -380,6 +458,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"address": {
 				[]config.RouteConfig{
 					{Name: "/:address", Open: true},
+					{Name: "/:address/block/:nonce", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/key/:key", Open: true},
 				},
@@ -17,6 +17,7 @@ import (
 const (
 	getBlockByNoncePath = "/by-nonce/:nonce"
 	getBlockByHashPath  = "/by-hash/:hash"
+	getFinalityPath     = "/finality"
 )
 
 var log = logger.GetOrCreate("api/block")
@@ -25,6 +26,7 @@ var log = logger.GetOrCreate("api/block")
 type BlockService interface {
 	GetBlockByHash(hash string, withTxs bool) (*APIBlock, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*APIBlock, error)
+	GetFinalityInfo(fromIndex uint64) (*APIFinality, error)
 }
 
 // APIBlock represents the structure for block that is returned by api routes
@@ -48,10 +50,29 @@ type APIMiniBlock struct {
 	Transactions       []*transaction.ApiTransactionResult `form:"transactions" json:"transactions,omitempty"`
 }
 
+// APIFinalityEvent represents the structure for a block which became final or has been reverted
+type APIFinalityEvent struct {
+	Index     uint64 `form:"index" json:"index"`
+	Type      string `form:"type" json:"type"`
+	ShardID   uint32 `form:"shardID" json:"shardID"`
+	Nonce     uint64 `form:"nonce" json:"nonce"`
+	Round     uint64 `form:"round" json:"round"`
+	Hash      string `form:"hash" json:"hash"`
+	MetaNonce uint64 `form:"metaNonce" json:"metaNonce"`
+	MetaHash  string `form:"metaHash" json:"metaHash"`
+}
+
+// APIFinality represents the structure for the blocks finality info that is returned by api routes
+type APIFinality struct {
+	LastFinalBlocks []*APIFinalityEvent `form:"lastFinalBlocks" json:"lastFinalBlocks"`
+	Events          []*APIFinalityEvent `form:"events" json:"events"`
+}
+
 // Routes defines block related routes
 func Routes(routes *wrapper.RouterWrapper) {
 	routes.RegisterHandler(http.MethodGet, getBlockByNoncePath, getBlockByNonce)
 	routes.RegisterHandler(http.MethodGet, getBlockByHashPath, getBlockByHash)
+	routes.RegisterHandler(http.MethodGet, getFinalityPath, getFinality)
 }
 
 func getBlockByNonce(c *gin.Context) {
@@ -135,6 +156,45 @@ func getBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+func getFinality(c *gin.Context) {
+	ef, ok := c.MustGet("facade").(BlockService)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return
+	}
+
+	fromIndex, err := getQueryParamFromIndex(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidFinalityIndex.Error()),
+		)
+		return
+	}
+
+	finality, err := ef.GetFinalityInfo(fromIndex)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetFinalityInfo.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"finality": finality}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamFromIndex(c *gin.Context) (uint64, error) {
+	fromIndexStr := c.Request.URL.Query().Get("fromIndex")
+	if fromIndexStr == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(fromIndexStr, 10, 64)
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
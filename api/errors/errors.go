@@ -52,11 +52,41 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetFinalityInfo signals an error happening when trying to fetch the blocks finality info
+var ErrGetFinalityInfo = errors.New("getting finality info failed")
+
+// ErrInvalidFinalityIndex signals an invalid finality event index was provided
+var ErrInvalidFinalityIndex = errors.New("invalid finality event index")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetPeersTraffic signals that an error occurred while getting the peers traffic
+var ErrGetPeersTraffic = errors.New("error getting peers traffic")
+
+// ErrGetKnownPeers signals that an error occurred while getting the known peers
+var ErrGetKnownPeers = errors.New("error getting known peers")
+
+// ErrBanPeer signals that an error occurred while banning a peer
+var ErrBanPeer = errors.New("error banning peer")
+
+// ErrUnbanPeer signals that an error occurred while removing a peer ban
+var ErrUnbanPeer = errors.New("error removing peer ban")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrGetConsensusGroup signals an error happening when trying to compute a past consensus group
+var ErrGetConsensusGroup = errors.New("getting consensus group failed")
+
+// ErrInvalidShardID signals that an invalid shard ID has been provided
+var ErrInvalidShardID = errors.New("invalid shard ID")
+
+// ErrInvalidEpoch signals that an invalid epoch has been provided
+var ErrInvalidEpoch = errors.New("invalid epoch")
+
+// ErrInvalidRound signals that an invalid round has been provided
+var ErrInvalidRound = errors.New("invalid round")
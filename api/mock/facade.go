@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -23,6 +24,7 @@ type Facade struct {
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
 	BalanceHandler             func(string) (*big.Int, error)
 	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
+	GetAccountAtBlockHandler   func(address string, nonce uint64) (state.UserAccountHandler, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
@@ -37,9 +39,14 @@ type Facade struct {
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersTrafficCalled                   func() ([]core.QueryP2PPeerTrafficInfo, error)
+	GetKnownPeersCalled                     func() ([]core.QueryP2PPeerStoreInfo, error)
+	BanPeerCalled                           func(pid string, durationInSec uint32, reason string) error
+	UnbanPeerCalled                         func(pid string) error
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
+	GetConsensusGroupCalled                 func(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error)
 }
 
 // GetThrottlerForEndpoint -
@@ -98,6 +105,11 @@ func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
 }
 
+// GetAccountAtBlock is the mock implementation of a handler's GetAccountAtBlock method
+func (f *Facade) GetAccountAtBlock(address string, nonce uint64) (state.UserAccountHandler, error) {
+	return f.GetAccountAtBlockHandler(address, nonce)
+}
+
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
 func (f *Facade) CreateTransaction(
 	nonce uint64,
@@ -134,6 +146,15 @@ func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRespons
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetConsensusGroup -
+func (f *Facade) GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error) {
+	if f.GetConsensusGroupCalled != nil {
+		return f.GetConsensusGroupCalled(shardID, epoch, round)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	return f.ExecuteSCQueryHandler(query)
@@ -174,6 +195,26 @@ func (f *Facade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetPeersTraffic -
+func (f *Facade) GetPeersTraffic() ([]core.QueryP2PPeerTrafficInfo, error) {
+	return f.GetPeersTrafficCalled()
+}
+
+// GetKnownPeers -
+func (f *Facade) GetKnownPeers() ([]core.QueryP2PPeerStoreInfo, error) {
+	return f.GetKnownPeersCalled()
+}
+
+// BanPeer -
+func (f *Facade) BanPeer(pid string, durationInSec uint32, reason string) error {
+	return f.BanPeerCalled(pid, durationInSec, reason)
+}
+
+// UnbanPeer -
+func (f *Facade) UnbanPeer(pid string) error {
+	return f.UnbanPeerCalled(pid)
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *Facade) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -25,6 +25,10 @@ const (
 	p2pStatusPath       = "/p2pstatus"
 	debugPath           = "/debug"
 	peerInfoPath        = "/peerinfo"
+	peersPath           = "/peers"
+	peerStorePath       = "/peerstore"
+	banPath             = "/ban"
+	unbanPath           = "/unban"
 )
 
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -40,6 +44,10 @@ type FacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersTraffic() ([]core.QueryP2PPeerTrafficInfo, error)
+	GetKnownPeers() ([]core.QueryP2PPeerStoreInfo, error)
+	BanPeer(pid string, durationInSec uint32, reason string) error
+	UnbanPeer(pid string) error
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -51,6 +59,18 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// BanPeerRequest represents the structure on which user input for banning a peer will validate against
+type BanPeerRequest struct {
+	Pid           string `form:"pid" json:"pid"`
+	DurationInSec uint32 `form:"durationInSec" json:"durationInSec"`
+	Reason        string `form:"reason" json:"reason"`
+}
+
+// UnbanPeerRequest represents the structure on which user input for removing a peer ban will validate against
+type UnbanPeerRequest struct {
+	Pid string `form:"pid" json:"pid"`
+}
+
 type statisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
@@ -84,6 +104,10 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, peersPath, PeersTraffic)
+	router.RegisterHandler(http.MethodGet, peerStorePath, PeerStore)
+	router.RegisterHandler(http.MethodPost, banPath, BanPeer)
+	router.RegisterHandler(http.MethodPost, unbanPath, UnbanPeer)
 	// placeholder for custom routes
 }
 
@@ -313,6 +337,155 @@ func PeerInfo(c *gin.Context) {
 	)
 }
 
+// PeersTraffic returns the traffic accounted for each connected peer, together with its shard, peer type and
+// connection direction
+func PeersTraffic(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	peers, err := facade.GetPeersTraffic()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetPeersTraffic.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"peers": peers},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// PeerStore returns the peers known by the node, together with their ban status
+func PeerStore(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	peers, err := facade.GetKnownPeers()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKnownPeers.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"peers": peers},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// BanPeer bans the provided p2p peer ID for the given duration
+func BanPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = BanPeerRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = facade.BanPeer(request.Pid, request.DurationInSec, request.Reason)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrBanPeer.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"status": "banned"},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// UnbanPeer removes the ban of the provided p2p peer ID
+func UnbanPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = UnbanPeerRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = facade.UnbanPeer(request.Pid)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrUnbanPeer.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"status": "unbanned"},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // PrometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func PrometheusMetrics(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -461,6 +461,213 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestPeersTraffic_GetPeersTrafficErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetPeersTrafficCalled: func() ([]core.QueryP2PPeerTrafficInfo, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/peers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestPeersTraffic_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetPeersTrafficCalled: func() ([]core.QueryP2PPeerTrafficInfo, error) {
+			return []core.QueryP2PPeerTrafficInfo{
+				{
+					Pid:                 "pid",
+					ConnectionDirection: "Inbound",
+					Topics:              []core.QueryP2PPeerTopicTraffic{{Topic: "topic", NumMessagesIn: 1}},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/peers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	peers, ok := responseData["peers"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(peers))
+	peer, ok := peers[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "Inbound", peer["direction"])
+	assert.Equal(t, 1, len(peer["topics"].([]interface{})))
+}
+
+func TestPeerStore_GetKnownPeersErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetKnownPeersCalled: func() ([]core.QueryP2PPeerStoreInfo, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/peerstore", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestPeerStore_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetKnownPeersCalled: func() ([]core.QueryP2PPeerStoreInfo, error) {
+			return []core.QueryP2PPeerStoreInfo{{Pid: "pid", IsBanned: true, BanReason: "reason"}}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/peerstore", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	peers, ok := responseData["peers"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 1, len(peers))
+}
+
+func TestBanPeer_BanErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		BanPeerCalled: func(pid string, durationInSec uint32, reason string) error {
+			return expectedErr
+		},
+	}
+
+	jsonStr, _ := json.Marshal(&node.BanPeerRequest{Pid: "pid", DurationInSec: 10})
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/ban", bytes.NewBuffer(jsonStr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestBanPeer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	banRequest := &node.BanPeerRequest{
+		Pid:           "pid",
+		DurationInSec: 10,
+		Reason:        "reason",
+	}
+	wasCalled := false
+	facade := &mock.Facade{
+		BanPeerCalled: func(pid string, durationInSec uint32, reason string) error {
+			wasCalled = true
+			assert.Equal(t, banRequest.Pid, pid)
+			assert.Equal(t, banRequest.DurationInSec, durationInSec)
+			assert.Equal(t, banRequest.Reason, reason)
+			return nil
+		},
+	}
+
+	jsonStr, _ := json.Marshal(banRequest)
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/ban", bytes.NewBuffer(jsonStr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.True(t, wasCalled)
+}
+
+func TestUnbanPeer_UnbanErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		UnbanPeerCalled: func(pid string) error {
+			return expectedErr
+		},
+	}
+
+	jsonStr, _ := json.Marshal(&node.UnbanPeerRequest{Pid: "pid"})
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/unban", bytes.NewBuffer(jsonStr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestUnbanPeer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	unbannedPid := ""
+	facade := &mock.Facade{
+		UnbanPeerCalled: func(pid string) error {
+			unbannedPid = pid
+			return nil
+		},
+	}
+
+	jsonStr, _ := json.Marshal(&node.UnbanPeerRequest{Pid: "pid"})
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/unban", bytes.NewBuffer(jsonStr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "pid", unbannedPid)
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -559,6 +766,10 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/peers", Open: true},
+					{Name: "/peerstore", Open: true},
+					{Name: "/ban", Open: true},
+					{Name: "/unban", Open: true},
 				},
 			},
 		},
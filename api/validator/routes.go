@@ -1,7 +1,9 @@
 package validator
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -10,17 +12,36 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath     = "/statistics"
+	consensusGroupPath = "/consensus-group"
+)
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*APIConsensusGroup, error)
 	IsInterfaceNil() bool
 }
 
+// APIConsensusGroup represents the structure for a past consensus group that is returned by api routes
+type APIConsensusGroup struct {
+	ShardID            uint32   `form:"shardID" json:"shardID"`
+	Epoch              uint32   `form:"epoch" json:"epoch"`
+	Round              uint64   `form:"round" json:"round"`
+	Randomness         string   `form:"randomness" json:"randomness"`
+	PreviousBlockNonce uint64   `form:"previousBlockNonce" json:"previousBlockNonce"`
+	PreviousBlockHash  string   `form:"previousBlockHash" json:"previousBlockHash"`
+	ScheduledLeader    string   `form:"scheduledLeader" json:"scheduledLeader"`
+	Leader             string   `form:"leader" json:"leader"`
+	Validators         []string `form:"validators" json:"validators"`
+	ProposedBlockHash  string   `form:"proposedBlockHash" json:"proposedBlockHash,omitempty"`
+}
+
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	router.RegisterHandler(http.MethodGet, consensusGroupPath, ConsensusGroup)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -82,3 +103,52 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+// ConsensusGroup will return the consensus group and the leader of a shard for a past epoch and round
+func ConsensusGroup(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	shardID, epoch, round, err := getConsensusGroupQueryParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	consensusGroup, err := facade.GetConsensusGroup(shardID, epoch, round)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetConsensusGroup.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"consensusGroup": consensusGroup}, "", shared.ReturnCodeSuccess)
+}
+
+func getConsensusGroupQueryParams(c *gin.Context) (uint32, uint32, uint64, error) {
+	query := c.Request.URL.Query()
+
+	shardID, err := strconv.ParseUint(query.Get("shard"), 10, 32)
+	if err != nil {
+		return 0, 0, 0, errors.ErrInvalidShardID
+	}
+
+	epoch, err := strconv.ParseUint(query.Get("epoch"), 10, 32)
+	if err != nil {
+		return 0, 0, 0, errors.ErrInvalidEpoch
+	}
+
+	round, err := strconv.ParseUint(query.Get("round"), 10, 64)
+	if err != nil {
+		return 0, 0, 0, errors.ErrInvalidRound
+	}
+
+	return uint32(shardID), uint32(epoch), round, nil
+}
@@ -113,6 +113,105 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+type consensusGroupResponseData struct {
+	ConsensusGroup *validator.APIConsensusGroup `json:"consensusGroup"`
+}
+
+type consensusGroupResponse struct {
+	Data  consensusGroupResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func TestConsensusGroup_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetConsensusGroupCalled: func(_ uint32, _ uint32, _ uint64) (*validator.APIConsensusGroup, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	testCases := map[string]error{
+		"/validator/consensus-group?epoch=1&round=10":         apiErrors.ErrInvalidShardID,
+		"/validator/consensus-group?shard=0&epoch=a&round=10": apiErrors.ErrInvalidEpoch,
+		"/validator/consensus-group?shard=0&epoch=1":          apiErrors.ErrInvalidRound,
+	}
+
+	for path, expectedErr := range testCases {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := consensusGroupResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, path)
+		assert.Contains(t, response.Error, expectedErr.Error(), path)
+	}
+}
+
+func TestConsensusGroup_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.Facade{
+		GetConsensusGroupCalled: func(_ uint32, _ uint32, _ uint64) (*validator.APIConsensusGroup, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/consensus-group?shard=0&epoch=1&round=10", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := consensusGroupResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetConsensusGroup.Error())
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestConsensusGroup_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedGroup := &validator.APIConsensusGroup{
+		ShardID:            4294967295,
+		Epoch:              3,
+		Round:              1250,
+		Randomness:         "72616e64",
+		PreviousBlockNonce: 1200,
+		PreviousBlockHash:  "68617368",
+		Leader:             "aa",
+		Validators:         []string{"aa", "bb"},
+	}
+	facade := mock.Facade{
+		GetConsensusGroupCalled: func(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error) {
+			assert.Equal(t, expectedGroup.ShardID, shardID)
+			assert.Equal(t, expectedGroup.Epoch, epoch)
+			assert.Equal(t, expectedGroup.Round, round)
+
+			return expectedGroup, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/consensus-group?shard=4294967295&epoch=3&round=1250", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := consensusGroupResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, expectedGroup, response.Data.ConsensusGroup)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -157,6 +256,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/consensus-group", Open: true},
 				},
 			},
 		},
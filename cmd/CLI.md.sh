@@ -6,6 +6,9 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForPeerTool
+    generateForConsensusReplay
+    generateForRatingSimulator
 }
 
 generateForNode() {
@@ -78,6 +81,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForPeerTool() {
+    HELP="
+# Elrond Peer Tool CLI
+
+The **Elrond Peer Tool** exposes the following Command Line Interface:
+$(code)
+\$ peertool --help
+
+$(./peertool/peertool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./peertool/CLI.md
+}
+
+generateForConsensusReplay() {
+    HELP="
+# Elrond Consensus Replay Tool CLI
+
+The **Elrond Consensus Replay Tool** exposes the following Command Line Interface:
+$(code)
+\$ consensusreplay --help
+
+$(./consensusreplay/consensusreplay --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./consensusreplay/CLI.md
+}
+
+generateForRatingSimulator() {
+    HELP="
+# Elrond Rating Simulator CLI
+
+The **Elrond Rating Simulator** exposes the following Command Line Interface:
+$(code)
+\$ ratingsimulator --help
+
+$(./ratingsimulator/ratingsimulator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./ratingsimulator/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/display"
+	"github.com/urfave/cli"
+)
+
+type config struct {
+	recordsPath string
+	round       int64
+	listRounds  bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// recordsPath defines a flag for setting the records file or the folder holding the records files
+	recordsPath = cli.StringFlag{
+		Name:        "records",
+		Usage:       "The consensus records file or the folder holding the consensus records files written by the node",
+		Value:       "consensus-records",
+		Destination: &argsConfig.recordsPath,
+	}
+
+	// round defines a flag for setting the round to be replayed
+	round = cli.Int64Flag{
+		Name:        "round",
+		Usage:       "The index of the round to be replayed",
+		Value:       -1,
+		Destination: &argsConfig.round,
+	}
+
+	// listRounds is used when only the recorded rounds should be listed
+	listRounds = cli.BoolFlag{
+		Name:        "list",
+		Usage:       "Will list the recorded rounds instead of replaying one",
+		Destination: &argsConfig.listRounds,
+	}
+
+	argsConfig = &config{}
+
+	log    = logger.GetOrCreate("consensusreplay")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond Consensus Replay Tool"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Replay tool used to explain, offline, why a recorded consensus round did or did not reach consensus"
+	cliApp.Flags = []cli.Flag{
+		recordsPath,
+		round,
+		listRounds,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	cliApp.Action = replay
+}
+
+func replay(_ *cli.Context) error {
+	records, err := recorder.ReadRecords(argsConfig.recordsPath)
+	if err != nil {
+		return err
+	}
+
+	if argsConfig.listRounds {
+		displayRecordedRounds(records)
+		return nil
+	}
+
+	recordedRound, err := recorder.GetRecordedRound(records, argsConfig.round)
+	if err != nil {
+		return err
+	}
+
+	report, err := bls.ReplayRound(recordedRound)
+	if err != nil {
+		return err
+	}
+
+	return displayReport(report)
+}
+
+func displayRecordedRounds(records []*recorder.Record) {
+	indexes := recorder.GetRecordedRoundIndexes(records)
+	strIndexes := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		strIndexes = append(strIndexes, fmt.Sprintf("%d", index))
+	}
+
+	fmt.Printf("%d recorded rounds: %s\n", len(indexes), strings.Join(strIndexes, ", "))
+}
+
+func displayReport(report *bls.ReplayReport) error {
+	header := []string{"Since round start", "Subround", "Direction", "Message", "From", "Header hash", "Accepted", "Reason"}
+	lines := make([]*display.LineData, 0, len(report.Timeline))
+	for _, event := range report.Timeline {
+		lines = append(lines, display.NewLineData(false, []string{
+			event.SinceRoundStart.String(),
+			event.Subround,
+			event.Direction,
+			event.MsgType,
+			displayPubKey(event.PubKey),
+			core.GetTrimmedPk(hex.EncodeToString(event.BlockHeaderHash)),
+			fmt.Sprintf("%v", event.Accepted),
+			event.Reason,
+		}))
+	}
+
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		return err
+	}
+
+	missingSigners := make([]string, 0, len(report.MissingSigners))
+	for _, pubKey := range report.MissingSigners {
+		missingSigners = append(missingSigners, displayPubKey(pubKey))
+	}
+
+	fmt.Printf("round %d, duration %s, consensus group size %d\n", report.Round, report.RoundDuration, report.ConsensusGroupSize)
+	fmt.Printf("leader %s, self %s\n", displayPubKey(report.Leader), displayPubKey(report.SelfPubKey))
+	fmt.Printf("subrounds recorded: %v\n", report.SubroundsRecorded)
+	fmt.Println(table)
+	fmt.Printf("block header hash %s received at %s\n", hex.EncodeToString(report.BlockHeaderHash), report.BlockReceivedAt)
+	fmt.Printf("signatures %d/%d, missing signers: %s\n",
+		report.NumSignatures, report.SignatureThreshold, strings.Join(missingSigners, ", "))
+	fmt.Printf("final info received: %v\n", report.FinalInfoReceived)
+	fmt.Printf("consensus reached: %v, %s\n", report.ConsensusReached, report.Reason)
+
+	return nil
+}
+
+func displayPubKey(pubKey []byte) string {
+	return core.GetTrimmedPk(hex.EncodeToString(pubKey))
+}
@@ -3,6 +3,7 @@ package factory
 import (
 	"errors"
 	"math/big"
+	"strings"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -49,6 +50,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	slashDisabled "github.com/ElrondNetwork/elrond-go/process/slash/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -109,6 +112,7 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	EquivocationDetector     consensus.EquivocationDetector
 }
 
 type processComponentsFactoryArgs struct {
@@ -244,12 +248,13 @@ func NewProcessComponentsFactoryArgs(
 // ProcessComponentsFactory creates the process components
 func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, error) {
 	argsHeaderSig := &headerCheck.ArgsHeaderSigVerifier{
-		Marshalizer:       args.coreData.InternalMarshalizer,
-		Hasher:            args.coreData.Hasher,
-		NodesCoordinator:  args.nodesCoordinator,
-		MultiSigVerifier:  args.crypto.MultiSigner,
-		SingleSigVerifier: args.crypto.SingleSigner,
-		KeyGen:            args.crypto.BlockSignKeyGen,
+		Marshalizer:         args.coreData.InternalMarshalizer,
+		Hasher:              args.coreData.Hasher,
+		NodesCoordinator:    args.nodesCoordinator,
+		MultiSigVerifier:    args.crypto.MultiSigner,
+		SingleSigVerifier:   args.crypto.SingleSigner,
+		KeyGen:              args.crypto.BlockSignKeyGen,
+		BackupLeaderEnabled: args.mainConfig.ConsensusBackupLeader.Enabled,
 	}
 	headerSigVerifier, err := headerCheck.NewHeaderSigVerifier(argsHeaderSig)
 	if err != nil {
@@ -368,7 +373,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	bootStr := args.data.Store.GetStorer(dataRetriever.BootstrapUnit)
+	bootStr := args.data.CommitStore.GetStorer(dataRetriever.BootstrapUnit)
 	bootStorer, err := bootstrapStorage.NewBootstrapStorer(args.coreData.InternalMarshalizer, bootStr)
 	if err != nil {
 		return nil, err
@@ -449,6 +454,11 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	equivocationDetector, evidenceProcessorArgs, err := newEquivocationDetector(args, interceptorsContainer)
+	if err != nil {
+		return nil, err
+	}
+
 	var pendingMiniBlocksHandler process.PendingMiniBlocksHandler
 	if args.shardCoordinator.SelfId() == core.MetachainShardId {
 		pendingMiniBlocksHandler, err = pendingMb.NewPendingMiniBlocks()
@@ -478,6 +488,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		headerValidator,
 		blockTracker,
 		pendingMiniBlocksHandler,
+		evidenceProcessorArgs,
 	)
 	if err != nil {
 		return nil, err
@@ -522,9 +533,85 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		EquivocationDetector:     equivocationDetector,
 	}, nil
 }
 
+// newEquivocationDetector creates the detector which looks for validators signing conflicting headers, hooks it on
+// the header interceptors and on the evidence topic and returns the arguments needed by the metachain to slash them
+func newEquivocationDetector(
+	args *processComponentsFactoryArgs,
+	interceptorsContainer process.InterceptorsContainer,
+) (consensus.EquivocationDetector, *slash.ArgsEvidenceProcessor, error) {
+	detectorConfig := args.mainConfig.EquivocationDetector
+	if !detectorConfig.Enabled {
+		return slashDisabled.NewDisabledEquivocationDetector(), nil, nil
+	}
+
+	evidencePool, err := createCache(detectorConfig.EvidencePool)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argsVerifier := slash.ArgsEvidenceVerifier{
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		Hasher:           args.coreData.Hasher,
+		NodesCoordinator: args.nodesCoordinator,
+		KeyGen:           args.crypto.BlockSignKeyGen,
+		SingleSigner:     args.crypto.SingleSigner,
+	}
+	evidenceVerifier, err := slash.NewEvidenceVerifier(argsVerifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argsDetector := slash.ArgsEquivocationDetector{
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		Hasher:           args.coreData.Hasher,
+		NodesCoordinator: args.nodesCoordinator,
+		ShardCoordinator: args.shardCoordinator,
+		EvidenceVerifier: evidenceVerifier,
+		EvidencePool:     evidencePool,
+		Broadcaster:      args.network.NetMessenger,
+		AntifloodHandler: args.network.InputAntifloodHandler,
+		CacheSize:        detectorConfig.SignedHeadersCacheSize,
+	}
+	detector, err := slash.NewEquivocationDetector(argsDetector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interceptorsContainer.Iterate(func(key string, interceptor process.Interceptor) bool {
+		isHeaderTopic := strings.HasPrefix(key, factory.ShardBlocksTopic) || key == factory.MetachainBlocksTopic
+		if isHeaderTopic {
+			interceptor.RegisterHandler(detector.HeaderReceived)
+		}
+		return true
+	})
+
+	messenger := args.network.NetMessenger
+	if !messenger.HasTopic(core.EquivocationEvidenceTopic) {
+		err = messenger.CreateTopic(core.EquivocationEvidenceTopic, true)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	err = messenger.RegisterMessageProcessor(core.EquivocationEvidenceTopic, detector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	evidenceProcessorArgs := &slash.ArgsEvidenceProcessor{
+		Marshalizer:          args.coreData.InternalMarshalizer,
+		Hasher:               args.coreData.Hasher,
+		EvidenceVerifier:     evidenceVerifier,
+		EvidencePool:         evidencePool,
+		MaxEvidencesPerBlock: detectorConfig.MaxEvidencesPerBlock,
+	}
+
+	return detector, evidenceProcessorArgs, nil
+}
+
 func setGenesisHeader(args *processComponentsFactoryArgs, genesisBlocks map[uint32]data.HeaderHandler) error {
 	genesisBlock, ok := genesisBlocks[args.shardCoordinator.SelfId()]
 	if !ok {
@@ -1083,6 +1170,7 @@ func newBlockProcessor(
 	headerValidator process.HeaderConstructionValidator,
 	blockTracker process.BlockTracker,
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
+	evidenceProcessorArgs *slash.ArgsEvidenceProcessor,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1145,6 +1233,7 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			processArgs.version,
 			processArgs.historyRepo,
+			evidenceProcessorArgs,
 		)
 	}
 
@@ -1223,7 +1312,7 @@ func newShardBlockProcessor(
 		core.InternalMarshalizer,
 		core.Hasher,
 		stateComponents.AddressPubkeyConverter,
-		data.Store,
+		data.CommitStore,
 		data.Datapool,
 	)
 	if err != nil {
@@ -1344,7 +1433,7 @@ func newShardBlockProcessor(
 
 	preProcFactory, err := shard.NewPreProcessorsContainerFactory(
 		shardCoordinator,
-		data.Store,
+		data.CommitStore,
 		core.InternalMarshalizer,
 		core.Hasher,
 		data.Datapool,
@@ -1388,6 +1477,11 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	err = txCoordinator.SetTracer(core.Tracer)
+	if err != nil {
+		return nil, err
+	}
+
 	accountsDb := make(map[state.AccountsDbIdentifier]state.AccountsAdapter)
 	accountsDb[state.UserAccountsState] = stateComponents.AccountsAdapter
 
@@ -1397,7 +1491,7 @@ func newShardBlockProcessor(
 		ForkDetector:           forkDetector,
 		Hasher:                 core.Hasher,
 		Marshalizer:            core.InternalMarshalizer,
-		Store:                  data.Store,
+		Store:                  data.CommitStore,
 		ShardCoordinator:       shardCoordinator,
 		NodesCoordinator:       nodesCoordinator,
 		Uint64Converter:        core.Uint64ByteSliceConverter,
@@ -1417,6 +1511,7 @@ func newShardBlockProcessor(
 		Indexer:                indexer,
 		TpsBenchmark:           tpsBenchmark,
 		HistoryRepository:      historyRepository,
+		CommitJournal:          data.CommitJournal,
 	}
 	arguments := block.ArgShardProcessor{
 		ArgBaseProcessor: argumentsBaseProcessor,
@@ -1432,6 +1527,11 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	err = blockProcessor.SetTracer(core.Tracer)
+	if err != nil {
+		return nil, err
+	}
+
 	return blockProcessor, nil
 }
 
@@ -1464,6 +1564,7 @@ func newMetaBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	version string,
 	historyRepository fullHistory.HistoryRepository,
+	evidenceProcessorArgs *slash.ArgsEvidenceProcessor,
 ) (process.BlockProcessor, error) {
 
 	builtInFuncs := builtInFunctions.NewBuiltInFunctionContainer()
@@ -1504,7 +1605,7 @@ func newMetaBlockProcessor(
 		core.InternalMarshalizer,
 		core.Hasher,
 		stateComponents.AddressPubkeyConverter,
-		data.Store,
+		data.CommitStore,
 		data.Datapool,
 	)
 	if err != nil {
@@ -1601,7 +1702,7 @@ func newMetaBlockProcessor(
 
 	preProcFactory, err := metachain.NewPreProcessorsContainerFactory(
 		shardCoordinator,
-		data.Store,
+		data.CommitStore,
 		core.InternalMarshalizer,
 		core.Hasher,
 		data.Datapool,
@@ -1643,6 +1744,11 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	err = txCoordinator.SetTracer(core.Tracer)
+	if err != nil {
+		return nil, err
+	}
+
 	scDataGetter, err := smartContract.NewSCQueryService(vmContainer, economicsData)
 	if err != nil {
 		return nil, err
@@ -1682,23 +1788,35 @@ func newMetaBlockProcessor(
 	}
 
 	argsEpochEconomics := metachainEpochStart.ArgsNewEpochEconomics{
-		Marshalizer:        core.InternalMarshalizer,
-		Hasher:             core.Hasher,
-		Store:              data.Store,
-		ShardCoordinator:   shardCoordinator,
-		RewardsHandler:     economicsData,
-		RoundTime:          rounder,
-		GenesisNonce:       genesisHdr.GetNonce(),
-		GenesisEpoch:       genesisHdr.GetEpoch(),
-		GenesisTotalSupply: economicsData.GenesisTotalSupply(),
+		Marshalizer:              core.InternalMarshalizer,
+		Hasher:                   core.Hasher,
+		Store:                    data.Store,
+		ShardCoordinator:         shardCoordinator,
+		RewardsHandler:           economicsData,
+		GenesisRoundDurationInMs: nodesSetup.GetRoundDuration(),
+		GenesisNonce:             genesisHdr.GetNonce(),
+		GenesisEpoch:             genesisHdr.GetEpoch(),
+		GenesisTotalSupply:       economicsData.GenesisTotalSupply(),
 	}
 	epochEconomics, err := metachainEpochStart.NewEndOfEpochEconomicsDataCreator(argsEpochEconomics)
 	if err != nil {
 		return nil, err
 	}
 
-	rewardsStorage := data.Store.GetStorer(dataRetriever.RewardTransactionUnit)
-	miniBlockStorage := data.Store.GetStorer(dataRetriever.MiniBlockUnit)
+	argsRoundsSchedule := metachainEpochStart.ArgsNewRoundsSchedule{
+		Marshalizer:              core.InternalMarshalizer,
+		Store:                    data.Store,
+		SCQuery:                  scDataGetter,
+		GenesisRoundDurationInMs: nodesSetup.GetRoundDuration(),
+		GenesisEpoch:             genesisHdr.GetEpoch(),
+	}
+	epochRoundsScheduleCreator, err := metachainEpochStart.NewRoundsScheduleCreator(argsRoundsSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsStorage := data.CommitStore.GetStorer(dataRetriever.RewardTransactionUnit)
+	miniBlockStorage := data.CommitStore.GetStorer(dataRetriever.MiniBlockUnit)
 	argsEpochRewards := metachainEpochStart.ArgsNewRewardsCreator{
 		ShardCoordinator:              shardCoordinator,
 		PubkeyConverter:               stateComponents.AddressPubkeyConverter,
@@ -1737,7 +1855,7 @@ func newMetaBlockProcessor(
 		ForkDetector:           forkDetector,
 		Hasher:                 core.Hasher,
 		Marshalizer:            core.InternalMarshalizer,
-		Store:                  data.Store,
+		Store:                  data.CommitStore,
 		ShardCoordinator:       shardCoordinator,
 		NodesCoordinator:       nodesCoordinator,
 		Uint64Converter:        core.Uint64ByteSliceConverter,
@@ -1757,6 +1875,7 @@ func newMetaBlockProcessor(
 		Indexer:                indexer,
 		TpsBenchmark:           tpsBenchmark,
 		HistoryRepository:      historyRepository,
+		CommitJournal:          data.CommitJournal,
 	}
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
@@ -1764,6 +1883,7 @@ func newMetaBlockProcessor(
 		SCToProtocol:                 smartContractToProtocol,
 		PendingMiniBlocksHandler:     pendingMiniBlocksHandler,
 		EpochStartDataCreator:        epochStartDataCreator,
+		EpochRoundsScheduleCreator:   epochRoundsScheduleCreator,
 		EpochEconomics:               epochEconomics,
 		EpochRewardsCreator:          epochRewards,
 		EpochValidatorInfoCreator:    validatorInfoCreator,
@@ -1780,6 +1900,24 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	err = metaProcessor.SetTracer(core.Tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	if evidenceProcessorArgs != nil {
+		evidenceProcessorArgs.SCCallExecutor = scProcessor
+		evidenceProcessor, errCreate := slash.NewEvidenceProcessor(*evidenceProcessorArgs)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		err = metaProcessor.SetEquivocationEvidenceProcessor(evidenceProcessor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return metaProcessor, nil
 }
 
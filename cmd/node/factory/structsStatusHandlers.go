@@ -7,6 +7,7 @@ import (
 	"os"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
@@ -17,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	factoryViews "github.com/ElrondNetwork/elrond-go/statusHandler/factory"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/persister"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/view"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/urfave/cli"
@@ -32,6 +34,7 @@ type ArgStatusHandlers struct {
 	ChanStartViews               chan struct{}
 	ChanLogRewrite               chan struct{}
 	LogFile                      *os.File
+	PrometheusConfig             config.PrometheusConfig
 }
 
 // StatusHandlersInfo is struct that stores all components that are returned when status handlers are created
@@ -41,6 +44,7 @@ type statusHandlersInfo struct {
 	StatusMetrics            external.StatusMetricsHandler
 	PersistentHandler        *persister.PersistentStatusHandler
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	MetricsServer            prometheus.MetricsServer
 }
 
 // NewStatusHandlersFactoryArgs will return arguments for status handlers
@@ -52,6 +56,7 @@ func NewStatusHandlersFactoryArgs(
 	chanStartViews chan struct{},
 	chanLogRewrite chan struct{},
 	logFile *os.File,
+	prometheusConfig config.PrometheusConfig,
 ) (*ArgStatusHandlers, error) {
 	baseErrMessage := "error creating status handler factory arguments"
 	if ctx == nil {
@@ -78,6 +83,7 @@ func NewStatusHandlersFactoryArgs(
 		ChanStartViews:           chanStartViews,
 		ChanLogRewrite:           chanLogRewrite,
 		LogFile:                  logFile,
+		PrometheusConfig:         prometheusConfig,
 	}, nil
 }
 
@@ -136,6 +142,14 @@ func CreateStatusHandlers(arguments *ArgStatusHandlers) (*statusHandlersInfo, er
 	}
 	appStatusHandlers = append(appStatusHandlers, persistentHandler)
 
+	metricsServer, prometheusHandler, err := createMetricsServer(arguments.PrometheusConfig)
+	if err != nil {
+		return nil, err
+	}
+	if prometheusHandler != nil {
+		appStatusHandlers = append(appStatusHandlers, prometheusHandler)
+	}
+
 	if len(appStatusHandlers) > 0 {
 		handler, err = statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
 		if err != nil {
@@ -151,9 +165,28 @@ func CreateStatusHandlers(arguments *ArgStatusHandlers) (*statusHandlersInfo, er
 	statusHandlersInfoObject.UseTermUI = useTermui
 	statusHandlersInfoObject.StatusMetrics = statusMetrics
 	statusHandlersInfoObject.PersistentHandler = persistentHandler
+	statusHandlersInfoObject.MetricsServer = metricsServer
 	return statusHandlersInfoObject, nil
 }
 
+func createMetricsServer(cfg config.PrometheusConfig) (prometheus.MetricsServer, core.AppStatusHandler, error) {
+	if !cfg.Enabled {
+		return prometheus.NewDisabledMetricsServer(), nil, nil
+	}
+
+	prometheusHandler, err := prometheus.NewPrometheusStatusHandler(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metricsServer, err := prometheus.NewMetricsServer(cfg.InterfaceAddress, cfg.Port, prometheusHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metricsServer, prometheusHandler, nil
+}
+
 // UpdateStorerAndMetricsForPersistentHandler will set storer for persistent status handler
 func (shi *statusHandlersInfo) UpdateStorerAndMetricsForPersistentHandler(store storage.Storer) error {
 	err := shi.PersistentHandler.SetStorage(store)
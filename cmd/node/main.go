@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
@@ -32,15 +33,18 @@ import (
 	historyFactory "github.com/ElrondNetwork/elrond-go/core/fullHistory/factory"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersRating"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
@@ -49,16 +53,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/finality"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
@@ -461,7 +469,7 @@ func main() {
 
 func getSuite(config *config.Config) (crypto.Suite, error) {
 	switch config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PbftConsensusType:
 		return mcl.NewSuiteBLS12(), nil
 	default:
 		return nil, errors.New("no consensus provided in config file")
@@ -781,6 +789,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		chanCreateViews,
 		chanLogRewrite,
 		logFile,
+		generalConfig.Prometheus,
 	)
 	if err != nil {
 		return err
@@ -790,9 +799,25 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	if err != nil {
 		return err
 	}
+	err = statusHandlersInfo.MetricsServer.Start()
+	if err != nil {
+		return err
+	}
 
 	coreComponents.StatusHandler = statusHandlersInfo.StatusHandler
 
+	log.Trace("creating tracer")
+	coreComponents.Tracer, err = tracing.CreateTracer(generalConfig.Tracing, workingDir)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("creating peer store")
+	p2pPeerStore, err := createPeerStore(p2pConfig.PeerStore, generalConfig.PeerStoreStorage, pathManager, shardId)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating network components")
 	networkComponentFactory, err := mainFactory.NewNetworkComponentsFactory(
 		*p2pConfig,
@@ -800,6 +825,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		coreComponents.StatusHandler,
 		coreComponents.InternalMarshalizer,
 		syncer,
+		p2pPeerStore,
 	)
 	if err != nil {
 		return err
@@ -869,6 +895,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("creating consensus message recorder")
+	consensusMessageRecorder, err := recorder.CreateMessageRecorder(generalConfig.ConsensusRecorder, workingDir, rounder, syncer)
+	if err != nil {
+		return err
+	}
+
 	importStartHandler, err := trigger.NewImportStartHandler(filepath.Join(workingDir, defaultDBPath), appVersion)
 	if err != nil {
 		return err
@@ -909,6 +941,17 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("replaying the commit journal for bootstrap")
+	argsCommitJournalReplay := mainFactory.ArgsCommitJournalBootstrapReplay{
+		Config:           *generalConfig,
+		PathManager:      pathManager,
+		ShardCoordinator: genesisShardCoordinator,
+	}
+	err = mainFactory.ReplayCommitJournalForBootstrap(argsCommitJournalReplay)
+	if err != nil {
+		return err
+	}
+
 	epochStartBootstrapArgs := bootstrap.ArgsEpochStartBootstrap{
 		PublicKey:                  cryptoParams.PublicKey,
 		Marshalizer:                coreComponents.InternalMarshalizer,
@@ -1002,6 +1045,28 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	log.Trace("applying the rounds schedule of the current epoch")
+	currentEpochStartIdentifier := []byte(core.EpochStartIdentifier(currentEpoch))
+	epochStartMetaBlock, err := process.GetMetaHeaderFromStorage(currentEpochStartIdentifier, coreComponents.InternalMarshalizer, dataComponents.Store)
+	if err == nil {
+		err = rounder.SetSchedule(round.CreateSchedule(epochStartMetaBlock.EpochStart.RoundsSchedule))
+		if err != nil {
+			return err
+		}
+	} else {
+		log.Debug("no epoch start block to read the rounds schedule from", "epoch", currentEpoch, "error", err)
+	}
+
+	epochStartNotifier.RegisterHandler(notifier.NewHandlerForEpochStart(func(_ data.HeaderHandler) {}, func(metaHeader data.HeaderHandler) {
+		metaBlock, ok := metaHeader.(*block.MetaBlock)
+		if !ok {
+			return
+		}
+
+		errSetSchedule := rounder.SetSchedule(round.CreateSchedule(metaBlock.EpochStart.RoundsSchedule))
+		log.LogIfError(errSetSchedule, "epoch", metaBlock.Epoch)
+	}, core.RoundsScheduleOrder))
+
 	healthService.RegisterComponent(dataComponents.Datapool.Transactions())
 	healthService.RegisterComponent(dataComponents.Datapool.UnsignedTransactions())
 	healthService.RegisterComponent(dataComponents.Datapool.RewardTransactions())
@@ -1067,11 +1132,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	log.Trace("creating state components")
 	stateArgs := mainFactory.StateComponentsFactoryArgs{
-		Config:           *generalConfig,
-		ShardCoordinator: shardCoordinator,
-		Core:             coreComponents,
-		PathManager:      pathManager,
-		Tries:            triesComponents,
+		Config:             *generalConfig,
+		ShardCoordinator:   shardCoordinator,
+		Core:               coreComponents,
+		PathManager:        pathManager,
+		Tries:              triesComponents,
+		TrieStorageJournal: dataComponents.TrieStorageJournal,
 	}
 	stateComponentsFactory, err := mainFactory.NewStateComponentsFactory(stateArgs)
 	if err != nil {
@@ -1309,6 +1375,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		chanStopNodeProcess,
 		hardForkTrigger,
 		historyRepository,
+		consensusMessageRecorder,
 	)
 	if err != nil {
 		return err
@@ -1357,6 +1424,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		statusPollingInterval,
 		networkComponents,
 		processComponents,
+		dataComponents,
 		shardCoordinator,
 	)
 	if err != nil {
@@ -1417,7 +1485,17 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(
+			log,
+			healthService,
+			statusHandlersInfo.MetricsServer,
+			coreComponents.Tracer,
+			consensusMessageRecorder,
+			dataComponents,
+			triesComponents,
+			networkComponents,
+			chanCloseComponents,
+		)
 	}()
 
 	select {
@@ -1434,6 +1512,9 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	metricsServer io.Closer,
+	tracer io.Closer,
+	consensusMessageRecorder io.Closer,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1443,6 +1524,18 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing metrics server...")
+	err = metricsServer.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing tracer...")
+	err = tracer.Close()
+	log.LogIfError(err)
+
+	log.Debug("closing consensus message recorder...")
+	err = consensusMessageRecorder.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1944,6 +2037,21 @@ func createElasticIndexer(
 
 	return indexer.NewElasticIndexer(arguments)
 }
+
+func computeHeartbeatCapabilities(generalConfig *config.Config, historyRepository fullHistory.HistoryRepository) uint32 {
+	capabilities := uint32(0)
+
+	isFullArchive := !generalConfig.StoragePruning.Enabled || !generalConfig.StoragePruning.CleanOldEpochsData
+	if isFullArchive {
+		capabilities |= heartbeat.CapabilityFullArchive
+	}
+	if historyRepository.IsEnabled() {
+		capabilities |= heartbeat.CapabilityFullHistory
+	}
+
+	return capabilities
+}
+
 func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sharding.Coordinator) (uint32, error) {
 	if shardCoordinator.SelfId() == core.MetachainShardId {
 		return nodesConfig.MetaChainConsensusGroupSize, nil
@@ -1955,6 +2063,14 @@ func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sh
 	return 0, state.ErrUnknownShardId
 }
 
+func getBackupLeaderTimeFraction(backupLeaderConfig config.ConsensusBackupLeaderConfig) float64 {
+	if !backupLeaderConfig.Enabled {
+		return 0
+	}
+
+	return backupLeaderConfig.TimeFraction
+}
+
 func createHardForkTrigger(
 	config *config.Config,
 	keyGen crypto.KeyGenerator,
@@ -2027,6 +2143,7 @@ func createHardForkTrigger(
 		ChainID:                  coreData.ChainID,
 		Rounder:                  process.Rounder,
 		GenesisNodesSetupHandler: nodesSetup,
+		AppStatusHandler:         coreData.StatusHandler,
 	}
 	hardForkExportFactory, err := exportFactory.NewExportHandlerFactory(argsExporter)
 	if err != nil {
@@ -2083,6 +2200,7 @@ func createNode(
 	chanStopNodeProcess chan endProcess.ArgEndProcess,
 	hardForkTrigger node.HardforkTrigger,
 	historyRepository fullHistory.HistoryRepository,
+	consensusMessageRecorder consensus.MessageRecorder,
 ) (*node.Node, error) {
 	var err error
 	var consensusGroupSize uint32
@@ -2140,6 +2258,23 @@ func createNode(
 		return nil, err
 	}
 
+	peerHonestyScoreProvider, ok := peerHonestyHandler.(p2p.PeerHonestyScoreProvider)
+	if ok {
+		err = network.PeerStore.SetPeerHonestyScoreProvider(peerHonestyScoreProvider)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	finalityTracker, err := finality.NewFinalityTracker(finality.ArgFinalityTracker{
+		BlockTracker:     process.BlockTracker,
+		ShardCoordinator: shardCoordinator,
+		HistorySize:      int(config.FinalityTracker.HistorySize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *node.Node
 	nd, err = node.NewNode(
 		node.WithMessenger(network.NetMessenger),
@@ -2152,6 +2287,7 @@ func createNode(
 		node.WithAddressPubkeyConverter(stateComponents.AddressPubkeyConverter),
 		node.WithValidatorPubkeyConverter(stateComponents.ValidatorPubkeyConverter),
 		node.WithAccountsAdapter(stateComponents.AccountsAdapter),
+		node.WithArchiveAccountsAdapter(stateComponents.ArchiveAccountsAdapter),
 		node.WithBlockChain(data.Blkc),
 		node.WithDataStore(data.Store),
 		node.WithRoundDuration(nodesConfig.RoundDuration),
@@ -2173,14 +2309,18 @@ func createNode(
 		node.WithInterceptorsContainer(process.InterceptorsContainer),
 		node.WithResolversFinder(process.ResolversFinder),
 		node.WithConsensusType(config.Consensus.Type),
+		node.WithBackupLeaderTimeFraction(getBackupLeaderTimeFraction(config.ConsensusBackupLeader)),
+		node.WithAdaptiveSubroundsConfig(config.RoundTiming.AdaptiveSubrounds),
 		node.WithTxSingleSigner(crypto.TxSingleSigner),
 		node.WithBootstrapRoundIndex(bootstrapRoundIndex),
 		node.WithAppStatusHandler(coreData.StatusHandler),
 		node.WithIndexer(indexer),
+		node.WithTracer(coreData.Tracer),
 		node.WithEpochStartTrigger(process.EpochStartTrigger),
 		node.WithEpochStartEventNotifier(epochStartRegistrationHandler),
 		node.WithBlockBlackListHandler(process.BlackListHandler),
 		node.WithPeerDenialEvaluator(peerDenialEvaluator),
+		node.WithPeerBanHandler(network.PeerBlackListHandler),
 		node.WithNetworkShardingCollector(networkShardingCollector),
 		node.WithBootStorer(process.BootStorer),
 		node.WithRequestedItemsHandler(requestedItemsHandler),
@@ -2204,12 +2344,16 @@ func createNode(
 		node.WithWatchdogTimer(watchdogTimer),
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
 		node.WithHistoryRepository(historyRepository),
+		node.WithEquivocationDetector(process.EquivocationDetector),
+		node.WithConsensusMessageRecorder(consensusMessageRecorder),
+		node.WithFinalityTracker(finalityTracker),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
 	}
 
-	err = nd.StartHeartbeat(config.Heartbeat, version, preferencesConfig.Preferences)
+	heartbeatCapabilities := computeHeartbeatCapabilities(config, historyRepository)
+	err = nd.StartHeartbeat(config.Heartbeat, version, preferencesConfig.Preferences, heartbeatCapabilities)
 	if err != nil {
 		return nil, err
 	}
@@ -2242,9 +2386,75 @@ func createNode(
 		return nil, err
 	}
 
+	err = applyPeersRatingHandler(
+		config,
+		ratingConfig,
+		peerHonestyHandler,
+		networkShardingCollector,
+		process.InterceptorsContainer,
+		process.ResolversFinder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return nd, nil
 }
 
+func applyPeersRatingHandler(
+	config *config.Config,
+	ratingConfig config.RatingsConfig,
+	peerHonestyHandler consensus.PeerHonestyHandler,
+	peerShardMapper dataRetriever.PeerShardMapper,
+	interceptors process.InterceptorsContainer,
+	resolvers dataRetriever.ResolversFinder,
+) error {
+	if !config.PeersRating.Enabled {
+		return nil
+	}
+
+	peerHonestyScoreProvider, ok := peerHonestyHandler.(dataRetriever.PeerHonestyScoreProvider)
+	if !ok {
+		return fmt.Errorf("%w for the peers rating handler", dataRetriever.ErrNilPeerHonestyScoreProvider)
+	}
+
+	cache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(config.PeersRating.Cache))
+	if err != nil {
+		return err
+	}
+
+	argPeersRating := peersRating.ArgPeersRatingHandler{
+		Config:                   config.PeersRating,
+		PeerHonestyConfig:        ratingConfig.PeerHonesty,
+		Cache:                    cache,
+		PeerHonestyScoreProvider: peerHonestyScoreProvider,
+		PeerShardMapper:          peerShardMapper,
+	}
+	peersRatingHandler, err := peersRating.NewPeersRatingHandler(argPeersRating)
+	if err != nil {
+		return err
+	}
+
+	var errFound error
+	interceptors.Iterate(func(key string, interceptor process.Interceptor) bool {
+		errFound = interceptor.SetPeersRatingHandler(peersRatingHandler)
+		return errFound == nil
+	})
+	if errFound != nil {
+		return fmt.Errorf("%w while setting up the peers rating handler on interceptors", errFound)
+	}
+
+	resolvers.Iterate(func(key string, resolver dataRetriever.Resolver) bool {
+		errFound = resolver.SetPeersRatingHandler(peersRatingHandler)
+		return errFound == nil
+	})
+	if errFound != nil {
+		return fmt.Errorf("%w while setting up the peers rating handler on resolvers", errFound)
+	}
+
+	return nil
+}
+
 func createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
@@ -2259,6 +2469,33 @@ func createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
 }
 
+func createPeerStore(
+	peerStoreConfig config.PeerStoreConfig,
+	storageConfig config.StorageConfig,
+	pathManager storage.PathManagerHandler,
+	shardId string,
+) (p2p.PeerStore, error) {
+	if !peerStoreConfig.Enabled {
+		return peerStore.NewDisabledPeerStore(), nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = pathManager.PathForStatic(shardId, storageConfig.DB.FilePath)
+	storer, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(storageConfig.Bloom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return peerStore.NewPeerStore(peerStore.ArgsPeerStore{
+		Storer:      storer,
+		MaxNumPeers: peerStoreConfig.MaxNumPeers,
+	})
+}
+
 func initStatsFileMonitor(
 	config *config.Config,
 	pubKeyString string,
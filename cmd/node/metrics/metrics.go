@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/appStatusPolling"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/counting"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -134,6 +135,7 @@ func StartStatusPolling(
 	pollingInterval time.Duration,
 	networkComponents *mainFactory.NetworkComponents,
 	processComponents *factory.Process,
+	dataComponents *mainFactory.DataComponents,
 	shardCoordinator sharding.Coordinator,
 ) error {
 	if ash == nil {
@@ -145,6 +147,9 @@ func StartStatusPolling(
 	if processComponents == nil {
 		return errors.New("nil processComponents")
 	}
+	if dataComponents == nil {
+		return errors.New("nil dataComponents")
+	}
 	if check.IfNil(shardCoordinator) {
 		return errors.New("nil shard coordinator")
 	}
@@ -169,6 +174,21 @@ func StartStatusPolling(
 		return err
 	}
 
+	err = registerPollTxPoolsSizes(appStatusPollingHandler, dataComponents)
+	if err != nil {
+		return err
+	}
+
+	err = registerPollTopicsTraffic(appStatusPollingHandler, networkComponents)
+	if err != nil {
+		return err
+	}
+
+	err = registerPollCompression(appStatusPollingHandler, networkComponents)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll()
 
 	return nil
@@ -213,6 +233,86 @@ func registerShardsInformation(
 	return nil
 }
 
+func registerPollTxPoolsSizes(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	dataComponents *mainFactory.DataComponents,
+) error {
+
+	txPoolsSizesHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		datapool := dataComponents.Datapool
+		setTxPoolSizes(appStatusHandler, "transactions", datapool.Transactions().GetCounts())
+		setTxPoolSizes(appStatusHandler, "unsignedTransactions", datapool.UnsignedTransactions().GetCounts())
+		setTxPoolSizes(appStatusHandler, "rewardTransactions", datapool.RewardTransactions().GetCounts())
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(txPoolsSizesHandlerFunc)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for the transactions pools sizes", err)
+	}
+
+	return nil
+}
+
+func setTxPoolSizes(appStatusHandler core.AppStatusHandler, pool string, counts counting.Counts) {
+	for cacheID, numTxs := range counts.GetCountsByShard() {
+		metric := core.MetricWithLabels(
+			core.MetricTxPoolNumTxs,
+			core.MetricLabelPool, pool,
+			core.MetricLabelCache, cacheID,
+		)
+		appStatusHandler.SetInt64Value(metric, numTxs)
+	}
+}
+
+func registerPollTopicsTraffic(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	networkComponents *mainFactory.NetworkComponents,
+) error {
+
+	accumulator := newTopicsTrafficAccumulator()
+	topicsTrafficHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		accumulator.update(networkComponents.NetMessenger.GetPeersTraffic())
+
+		for topic, traffic := range accumulator.getTopicsTraffic() {
+			appStatusHandler.SetUInt64Value(
+				core.MetricWithLabels(core.MetricP2PTopicNumReceivedBytes, core.MetricLabelTopic, topic),
+				traffic.numBytesIn,
+			)
+			appStatusHandler.SetUInt64Value(
+				core.MetricWithLabels(core.MetricP2PTopicNumSentBytes, core.MetricLabelTopic, topic),
+				traffic.numBytesOut,
+			)
+		}
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(topicsTrafficHandlerFunc)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for the topics traffic", err)
+	}
+
+	return nil
+}
+
+func registerPollCompression(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	networkComponents *mainFactory.NetworkComponents,
+) error {
+
+	compressionHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
+		appStatusHandler.SetUInt64Value(
+			core.MetricP2PNumBytesSavedByCompression,
+			networkComponents.NetMessenger.TotalBytesSavedByCompression(),
+		)
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(compressionHandlerFunc)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for the payload compression", err)
+	}
+
+	return nil
+}
+
 func computeNumConnectedPeers(
 	appStatusHandler core.AppStatusHandler,
 	networkComponents *mainFactory.NetworkComponents,
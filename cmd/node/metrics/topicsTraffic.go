@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type topicTraffic struct {
+	numBytesIn  uint64
+	numBytesOut uint64
+}
+
+// topicsTrafficAccumulator computes the total traffic of each topic out of the per peer traffic reported by the
+// messenger. The messenger only keeps the counters of the connected peers, so the accumulator adds the differences
+// between consecutive reports in order to keep the totals monotonic when peers disconnect
+type topicsTrafficAccumulator struct {
+	lastPeersTraffic map[core.PeerID]map[string]topicTraffic
+	topicsTraffic    map[string]topicTraffic
+}
+
+func newTopicsTrafficAccumulator() *topicsTrafficAccumulator {
+	return &topicsTrafficAccumulator{
+		lastPeersTraffic: make(map[core.PeerID]map[string]topicTraffic),
+		topicsTraffic:    make(map[string]topicTraffic),
+	}
+}
+
+func (tta *topicsTrafficAccumulator) update(peersTraffic []p2p.PeerTrafficInfo) {
+	currentPeersTraffic := make(map[core.PeerID]map[string]topicTraffic, len(peersTraffic))
+	for _, peerTraffic := range peersTraffic {
+		lastTopicsTraffic := tta.lastPeersTraffic[peerTraffic.Pid]
+		currentTopicsTraffic := make(map[string]topicTraffic, len(peerTraffic.Topics))
+		for _, traffic := range peerTraffic.Topics {
+			current := topicTraffic{
+				numBytesIn:  traffic.NumBytesIn,
+				numBytesOut: traffic.NumBytesOut,
+			}
+			last := lastTopicsTraffic[traffic.Topic]
+
+			total := tta.topicsTraffic[traffic.Topic]
+			total.numBytesIn += difference(current.numBytesIn, last.numBytesIn)
+			total.numBytesOut += difference(current.numBytesOut, last.numBytesOut)
+			tta.topicsTraffic[traffic.Topic] = total
+
+			currentTopicsTraffic[traffic.Topic] = current
+		}
+		currentPeersTraffic[peerTraffic.Pid] = currentTopicsTraffic
+	}
+
+	tta.lastPeersTraffic = currentPeersTraffic
+}
+
+// difference returns the amount accounted since the last report. A smaller current value means that the counters
+// of the peer were reset in the meantime
+func difference(current uint64, last uint64) uint64 {
+	if current < last {
+		return current
+	}
+
+	return current - last
+}
+
+func (tta *topicsTrafficAccumulator) getTopicsTraffic() map[string]topicTraffic {
+	return tta.topicsTraffic
+}
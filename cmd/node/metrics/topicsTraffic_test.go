@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func createPeerTrafficInfo(pid string, topic string, numBytesIn uint64, numBytesOut uint64) p2p.PeerTrafficInfo {
+	return p2p.PeerTrafficInfo{
+		Pid: core.PeerID(pid),
+		Topics: []p2p.PeerTopicTraffic{
+			{
+				Topic:       topic,
+				NumBytesIn:  numBytesIn,
+				NumBytesOut: numBytesOut,
+			},
+		},
+	}
+}
+
+func TestTopicsTrafficAccumulator_ShouldKeepTheTotalsMonotonic(t *testing.T) {
+	t.Parallel()
+
+	tta := newTopicsTrafficAccumulator()
+	tta.update([]p2p.PeerTrafficInfo{
+		createPeerTrafficInfo("pid1", "transactions", 100, 10),
+		createPeerTrafficInfo("pid2", "transactions", 50, 0),
+	})
+	assert.Equal(t, topicTraffic{numBytesIn: 150, numBytesOut: 10}, tta.getTopicsTraffic()["transactions"])
+
+	// pid2 disconnected, pid1 received some more bytes
+	tta.update([]p2p.PeerTrafficInfo{
+		createPeerTrafficInfo("pid1", "transactions", 130, 10),
+	})
+	assert.Equal(t, topicTraffic{numBytesIn: 180, numBytesOut: 10}, tta.getTopicsTraffic()["transactions"])
+
+	// pid2 reconnected with reset counters
+	tta.update([]p2p.PeerTrafficInfo{
+		createPeerTrafficInfo("pid1", "transactions", 130, 10),
+		createPeerTrafficInfo("pid2", "transactions", 20, 5),
+	})
+	assert.Equal(t, topicTraffic{numBytesIn: 200, numBytesOut: 15}, tta.getTopicsTraffic()["transactions"])
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/display"
+	"github.com/urfave/cli"
+)
+
+const (
+	peerStorePath  = "/node/peerstore"
+	banPath        = "/node/ban"
+	unbanPath      = "/node/unban"
+	requestTimeout = time.Second * 10
+)
+
+type config struct {
+	address       string
+	pid           string
+	durationInSec uint
+	reason        string
+	onlyBanned    bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}{{end}}{{if .VisibleFlags}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// address defines a flag for setting the address and port of the node's REST API
+	address = cli.StringFlag{
+		Name:        "address",
+		Usage:       "Address and port number of the elrond-go node's REST API",
+		Value:       "http://127.0.0.1:8080",
+		Destination: &argsConfig.address,
+	}
+
+	// pid defines a flag for setting the b58-encoded peer ID on which the ban commands will operate
+	pid = cli.StringFlag{
+		Name:        "pid",
+		Usage:       "The b58-encoded p2p peer ID",
+		Destination: &argsConfig.pid,
+	}
+
+	// durationInSec defines a flag for setting the ban duration
+	durationInSec = cli.UintFlag{
+		Name:        "duration",
+		Usage:       "The ban duration, in seconds",
+		Value:       3600,
+		Destination: &argsConfig.durationInSec,
+	}
+
+	// reason defines a flag for setting the recorded ban reason
+	reason = cli.StringFlag{
+		Name:        "reason",
+		Usage:       "The ban reason that will be recorded in the node's peer store",
+		Value:       "manual ban",
+		Destination: &argsConfig.reason,
+	}
+
+	// onlyBanned is used when only the banned peers should be listed
+	onlyBanned = cli.BoolFlag{
+		Name:        "banned",
+		Usage:       "Will list only the banned peers",
+		Destination: &argsConfig.onlyBanned,
+	}
+
+	argsConfig = &config{}
+
+	log        = logger.GetOrCreate("peertool")
+	cliApp     *cli.App
+	httpClient = &http.Client{Timeout: requestTimeout}
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond Peer Tool"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Peer tool used to inspect the peer store of an elrond-go node and to manage its peer bans"
+	cliApp.Flags = []cli.Flag{
+		address,
+	}
+	cliApp.Commands = []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the peers known by the node",
+			Flags:  []cli.Flag{onlyBanned},
+			Action: listPeers,
+		},
+		{
+			Name:   "ban",
+			Usage:  "bans a peer for the provided duration",
+			Flags:  []cli.Flag{pid, durationInSec, reason},
+			Action: banPeer,
+		},
+		{
+			Name:   "unban",
+			Usage:  "removes the ban of a peer",
+			Flags:  []cli.Flag{pid},
+			Action: unbanPeer,
+		},
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+}
+
+func listPeers(_ *cli.Context) error {
+	response := &struct {
+		Data struct {
+			Peers []core.QueryP2PPeerStoreInfo `json:"peers"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	err := doRequest(http.MethodGet, peerStorePath, nil, response)
+	if err != nil {
+		return err
+	}
+
+	header := []string{"Pid", "Shard", "Type", "Honesty", "Last seen", "Banned until", "Ban reason"}
+	lines := make([]*display.LineData, 0, len(response.Data.Peers))
+	for _, peer := range response.Data.Peers {
+		if argsConfig.onlyBanned && !peer.IsBanned {
+			continue
+		}
+
+		lines = append(lines, display.NewLineData(false, []string{
+			peer.Pid,
+			fmt.Sprintf("%d", peer.ShardID),
+			peer.PeerType,
+			fmt.Sprintf("%.2f", peer.HonestyScore),
+			formatTimestamp(peer.LastSeen),
+			formatTimestamp(peer.BanExpiry),
+			peer.BanReason,
+		}))
+	}
+
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(table)
+	fmt.Printf("%d peer(s)\n", len(lines))
+
+	return nil
+}
+
+func banPeer(_ *cli.Context) error {
+	if len(argsConfig.pid) == 0 {
+		return errors.New("the pid flag is mandatory")
+	}
+
+	request := &node.BanPeerRequest{
+		Pid:           argsConfig.pid,
+		DurationInSec: uint32(argsConfig.durationInSec),
+		Reason:        argsConfig.reason,
+	}
+	err := doRequest(http.MethodPost, banPath, request, &shared.GenericAPIResponse{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("peer %s banned for %d seconds\n", argsConfig.pid, argsConfig.durationInSec)
+
+	return nil
+}
+
+func unbanPeer(_ *cli.Context) error {
+	if len(argsConfig.pid) == 0 {
+		return errors.New("the pid flag is mandatory")
+	}
+
+	request := &node.UnbanPeerRequest{
+		Pid: argsConfig.pid,
+	}
+	err := doRequest(http.MethodPost, unbanPath, request, &shared.GenericAPIResponse{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("peer %s unbanned\n", argsConfig.pid)
+
+	return nil
+}
+
+func doRequest(method string, path string, request interface{}, response interface{}) error {
+	var body []byte
+	if request != nil {
+		var err error
+		body, err = json.Marshal(request)
+		if err != nil {
+			return err
+		}
+	}
+
+	url := strings.TrimSuffix(argsConfig.address, "/") + path
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	buff, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		errResponse := &shared.GenericAPIResponse{}
+		_ = json.Unmarshal(buff, errResponse)
+		return fmt.Errorf("%s returned %s: %s", url, resp.Status, errResponse.Error)
+	}
+
+	return json.Unmarshal(buff, response)
+}
+
+func formatTimestamp(timestamp int64) string {
+	if timestamp == 0 {
+		return "-"
+	}
+
+	return time.Unix(timestamp, 0).Format(time.RFC3339)
+}
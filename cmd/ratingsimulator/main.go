@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/display"
+	"github.com/ElrondNetwork/elrond-go/process/rating/simulator"
+	"github.com/urfave/cli"
+)
+
+type argsConfigs struct {
+	ratingsConfigPath   string
+	economicsConfigPath string
+	scenarioPath        string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// ratingsConfigPath defines a flag for the path to the ratings configuration file
+	ratingsConfigPath = cli.StringFlag{
+		Name:        "ratings-config",
+		Usage:       "The path to the ratings toml configuration file to be simulated",
+		Value:       "../node/config/ratings.toml",
+		Destination: &argsConfig.ratingsConfigPath,
+	}
+
+	// economicsConfigPath defines a flag for the path to the economics configuration file
+	economicsConfigPath = cli.StringFlag{
+		Name:        "economics-config",
+		Usage:       "The path to the economics toml configuration file used to estimate the protocol rewards",
+		Value:       "../node/config/economics.toml",
+		Destination: &argsConfig.economicsConfigPath,
+	}
+
+	// scenarioPath defines a flag for the path to the scenario file
+	scenarioPath = cli.StringFlag{
+		Name:        "scenario",
+		Usage:       "The path to the toml file describing the simulated chain and how its validators behave",
+		Value:       "./scenario.toml",
+		Destination: &argsConfig.scenarioPath,
+	}
+
+	argsConfig = &argsConfigs{}
+
+	log    = logger.GetOrCreate("ratingsimulator")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "Elrond Rating Simulator"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Simulator used to tune the ratings configuration by showing how the ratings of scripted validators evolve"
+	cliApp.Flags = []cli.Flag{
+		ratingsConfigPath,
+		economicsConfigPath,
+		scenarioPath,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	cliApp.Action = simulate
+}
+
+func simulate(_ *cli.Context) error {
+	ratingsConfig := config.RatingsConfig{}
+	err := core.LoadTomlFile(&ratingsConfig, argsConfig.ratingsConfigPath)
+	if err != nil {
+		return err
+	}
+
+	economicsConfig := &config.EconomicsConfig{}
+	err = core.LoadTomlFile(economicsConfig, argsConfig.economicsConfigPath)
+	if err != nil {
+		return err
+	}
+
+	scenario := &simulator.Scenario{}
+	err = core.LoadTomlFile(scenario, argsConfig.scenarioPath)
+	if err != nil {
+		return err
+	}
+
+	ratingsSimulator, err := simulator.NewSimulator(simulator.ArgsSimulator{
+		RatingsConfig:   ratingsConfig,
+		EconomicsConfig: economicsConfig,
+		Scenario:        scenario,
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := ratingsSimulator.Run()
+	if err != nil {
+		return err
+	}
+
+	return displayReport(report)
+}
+
+func displayReport(report *simulator.Report) error {
+	header := []string{"Epoch", "Group", "Eligible", "Jailed", "Min rating", "Avg rating", "Max rating",
+		"Avg chance %", "Avg selection rate", "Avg estimated reward"}
+	lines := make([]*display.LineData, 0, len(report.Epochs))
+	for _, epochReport := range report.Epochs {
+		for i, groupReport := range epochReport.Groups {
+			isLastGroup := i == len(epochReport.Groups)-1
+			lines = append(lines, display.NewLineData(isLastGroup, []string{
+				fmt.Sprintf("%d", epochReport.Epoch),
+				groupReport.Name,
+				fmt.Sprintf("%d", groupReport.NumEligible),
+				fmt.Sprintf("%d", groupReport.NumJailed),
+				fmt.Sprintf("%d", groupReport.MinRating),
+				fmt.Sprintf("%d", groupReport.AvgRating),
+				fmt.Sprintf("%d", groupReport.MaxRating),
+				fmt.Sprintf("%.2f", groupReport.AvgChancePercent),
+				fmt.Sprintf("%.4f", groupReport.AvgSelectionRate),
+				groupReport.AvgEstimatedReward.String(),
+			}))
+		}
+	}
+
+	table, err := display.CreateTableString(header, lines)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("start rating %d with a chance of %d%%, validators with a chance below %d%% are jailed\n",
+		report.StartRating, report.StartChance, report.JailedChance)
+	fmt.Println(table)
+
+	if len(report.JailPoints) == 0 {
+		fmt.Println("no validator has been jailed")
+		return nil
+	}
+
+	header = []string{"Epoch", "Group", "Validator", "Rating"}
+	lines = make([]*display.LineData, 0, len(report.JailPoints))
+	for _, jailPoint := range report.JailPoints {
+		lines = append(lines, display.NewLineData(false, []string{
+			fmt.Sprintf("%d", jailPoint.Epoch),
+			jailPoint.Group,
+			fmt.Sprintf("%d", jailPoint.Validator),
+			fmt.Sprintf("%d", jailPoint.Rating),
+		}))
+	}
+
+	table, err = display.CreateTableString(header, lines)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("jail points")
+	fmt.Println(table)
+
+	return nil
+}
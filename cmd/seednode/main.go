@@ -20,6 +20,7 @@ import (
 	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/urfave/cli"
 )
 
@@ -215,6 +216,7 @@ func createNode(p2pConfig config.P2PConfig, marshalizer marshal.Marshalizer) (p2
 		ListenAddress: libp2p.ListenAddrWithIp4AndTcp,
 		P2pConfig:     p2pConfig,
 		SyncTimer:     &libp2p.LocalSyncTimer{},
+		PeerStore:     peerStore.NewDisabledPeerStore(),
 	}
 
 	return libp2p.NewNetworkMessenger(arg)
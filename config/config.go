@@ -106,6 +106,7 @@ type Config struct {
 	ShardHdrNonceHashStorage   StorageConfig
 	MetaHdrNonceHashStorage    StorageConfig
 	StatusMetricsStorage       StorageConfig
+	PeerStoreStorage           StorageConfig
 
 	BootstrapStorage StorageConfig
 	MetaBlockStorage StorageConfig
@@ -140,6 +141,8 @@ type Config struct {
 	PeerIdShardId         CacheConfig
 	PublicKeyPIDSignature CacheConfig
 	PeerHonesty           CacheConfig
+	PeersRating           PeersRatingConfig
+	EquivocationDetector  EquivocationDetectorConfig
 
 	Antiflood           AntifloodConfig
 	ResourceStats       ResourceStatsConfig
@@ -148,6 +151,7 @@ type Config struct {
 	GeneralSettings     GeneralSettingsConfig
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
+	CommitJournal       CommitJournalConfig
 	TxLogsStorage       StorageConfig
 
 	NTPConfig               NTPConfig
@@ -155,20 +159,65 @@ type Config struct {
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
 	VirtualMachineConfig    VirtualMachineConfig
 
-	Hardfork HardforkConfig
-	Debug    DebugConfig
-	Health   HealthServiceConfig
+	Hardfork   HardforkConfig
+	Debug      DebugConfig
+	Health     HealthServiceConfig
+	Prometheus PrometheusConfig
+	Tracing    TracingConfig
+
+	ConsensusRecorder     ConsensusRecorderConfig
+	ConsensusBackupLeader ConsensusBackupLeaderConfig
+	RoundTiming           RoundTimingConfig
+	FinalityTracker       FinalityTrackerConfig
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	FullHistory           FullHistoryConfig
 }
 
+// PeersRatingConfig will hold the settings used to rate the peers based on how they answer to requests
+type PeersRatingConfig struct {
+	Enabled                        bool
+	ResponseTimeoutInMilliseconds  uint32
+	ReferenceLatencyInMilliseconds uint32
+	ResponseRateWeight             float64
+	ValidityWeight                 float64
+	LatencyWeight                  float64
+	HonestyWeight                  float64
+	Cache                          CacheConfig
+}
+
+// EquivocationDetectorConfig will hold the settings used to detect, gossip and slash the validators signing
+// conflicting blocks in the same round
+type EquivocationDetectorConfig struct {
+	Enabled                bool
+	SignedHeadersCacheSize int
+	MaxEvidencesPerBlock   uint32
+	EvidencePool           CacheConfig
+}
+
+// CommitJournalConfig will hold settings related to the block commit journal
+type CommitJournalConfig struct {
+	Enabled          bool
+	NumRecordsToKeep uint32
+}
+
 // StoragePruningConfig will hold settings relates to storage pruning
 type StoragePruningConfig struct {
-	Enabled             bool
-	CleanOldEpochsData  bool
-	NumEpochsToKeep     uint64
-	NumActivePersisters uint64
+	Enabled                 bool
+	CleanOldEpochsData      bool
+	NumEpochsToKeep         uint64
+	NumActivePersisters     uint64
+	MaxDiskSizeInMB         uint64
+	CompactionIntervalInSec uint64
+	UnitsRetention          []UnitRetentionConfig
+}
+
+// UnitRetentionConfig will hold the retention settings that override the storage pruning ones for a storage unit
+type UnitRetentionConfig struct {
+	Identifier      string
+	KeepForever     bool
+	NumEpochsToKeep uint64
+	MaxDiskSizeInMB uint64
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -184,6 +233,7 @@ type HeartbeatConfig struct {
 	DurationToConsiderUnresponsiveInSec int
 	HeartbeatRefreshIntervalInSec       uint32
 	HideInactiveValidatorIntervalInSec  uint32
+	MaxNoncesBehindToConsiderStale      uint64
 	HeartbeatStorage                    StorageConfig
 }
 
@@ -214,6 +264,7 @@ type StateTriesConfig struct {
 	CheckpointRoundsModulus     uint
 	AccountsStatePruningEnabled bool
 	PeerStatePruningEnabled     bool
+	ArchiveModeEnabled          bool
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 }
@@ -358,6 +409,61 @@ type HealthServiceConfig struct {
 	FolderPath                                string
 }
 
+// PrometheusConfig will hold the settings of the server exposing the node's metrics in the prometheus format
+type PrometheusConfig struct {
+	Enabled                     bool
+	InterfaceAddress            string
+	Port                        uint32
+	BlockProcessingBucketsInMs  []float64
+	SubroundDurationBucketsInMs []float64
+	StorageLatencyBucketsInUs   []float64
+	MeasureStorageLatency       bool
+}
+
+// TracingConfig will hold the settings of the block lifecycle tracing
+type TracingConfig struct {
+	Enabled          bool
+	Exporter         string
+	FilePath         string
+	OTLPEndpoint     string
+	ServiceName      string
+	ExportBufferSize uint32
+}
+
+// ConsensusRecorderConfig will hold the settings of the consensus messages recorder
+type ConsensusRecorderConfig struct {
+	Enabled         bool
+	FolderPath      string
+	MaxFileSizeInMB uint32
+	MaxFiles        uint32
+}
+
+// ConsensusBackupLeaderConfig will hold the settings of the backup leader which proposes when the leader misses its slot
+type ConsensusBackupLeaderConfig struct {
+	Enabled      bool
+	TimeFraction float64
+}
+
+// RoundTimingConfig will hold the adaptive subrounds settings
+type RoundTimingConfig struct {
+	AdaptiveSubrounds AdaptiveSubroundsConfig
+}
+
+// AdaptiveSubroundsConfig will hold the settings used to adapt the end of the block subround to the measured block
+// processing and propagation times
+type AdaptiveSubroundsConfig struct {
+	Enabled             bool
+	NumRoundsToMeasure  uint32
+	SafetyFactor        float64
+	MinBlockSubroundEnd float64
+	MaxBlockSubroundEnd float64
+}
+
+// FinalityTrackerConfig will hold the settings of the component which announces the final and reverted blocks
+type FinalityTrackerConfig struct {
+	HistorySize uint32
+}
+
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
 type InterceptorResolverDebugConfig struct {
 	Enabled                    bool
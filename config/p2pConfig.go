@@ -4,7 +4,12 @@ package config
 type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
+	MdnsPeerDiscovery   MdnsPeerDiscoveryConfig
+	FilePeerDiscovery   FilePeerDiscoveryConfig
 	Sharding            ShardingConfig
+	PeerStore           PeerStoreConfig
+	PeerAccess          PeerAccessConfig
+	PayloadCompression  PayloadCompressionConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -24,6 +29,20 @@ type KadDhtPeerDiscoveryConfig struct {
 	RoutingTableRefreshIntervalInSec uint32
 }
 
+// MdnsPeerDiscoveryConfig will hold the mDNS discovery config settings
+type MdnsPeerDiscoveryConfig struct {
+	Enabled              bool
+	ServiceTag           string
+	RefreshIntervalInSec uint32
+}
+
+// FilePeerDiscoveryConfig will hold the file based discovery config settings
+type FilePeerDiscoveryConfig struct {
+	Enabled              bool
+	FilePath             string
+	RefreshIntervalInSec uint32
+}
+
 // ShardingConfig will hold the network sharding config settings
 type ShardingConfig struct {
 	TargetPeerCount         int
@@ -33,3 +52,34 @@ type ShardingConfig struct {
 	MaxCrossShardObservers  uint32
 	Type                    string
 }
+
+// PeerStoreConfig will hold the settings of the persistent peer store
+type PeerStoreConfig struct {
+	Enabled                bool
+	MaxNumPeers            uint32
+	NumKnownPeersToConnect uint32
+	RefreshIntervalInSec   uint32
+}
+
+// PeerAccessConfig will hold the static peers, trusted peers and private mode settings
+type PeerAccessConfig struct {
+	StaticPeers              []string
+	TrustedPeers             []string
+	AllowedPeers             []string
+	PrivateMode              bool
+	MinReconnectBackoffInSec uint32
+	MaxReconnectBackoffInSec uint32
+}
+
+// PayloadCompressionConfig will hold the per topic payload compression settings
+type PayloadCompressionConfig struct {
+	Enabled bool
+	Topics  []TopicCompressionConfig
+}
+
+// TopicCompressionConfig will hold the compression settings applied on the topics starting with the given prefix
+type TopicCompressionConfig struct {
+	TopicPrefix      string
+	Type             string
+	ThresholdInBytes uint32
+}
@@ -19,6 +19,7 @@ type StakingSystemSCConfig struct {
 	NumRoundsWithoutBleed                uint64
 	MaximumPercentageToBleed             float64
 	BleedPercentagePerRound              float64
+	EquivocationSlashPercentage          float64
 	MaxNumberOfNodesForStake             uint64
 	NodesToSelectInAuction               uint64
 	ActivateBLSPubKeyMessageVerification bool
@@ -33,10 +34,12 @@ type ESDTSystemSCConfig struct {
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
 type GovernanceSystemSCConfig struct {
-	ProposalCost     string
-	NumNodes         int64
-	MinQuorum        int32
-	MinPassThreshold int32
-	MinVetoThreshold int32
-	Disabled         bool
+	ProposalCost         string
+	NumNodes             int64
+	MinQuorum            int32
+	MinPassThreshold     int32
+	MinVetoThreshold     int32
+	MinRoundDurationInMs uint64
+	MaxRoundDurationInMs uint64
+	Disabled             bool
 }
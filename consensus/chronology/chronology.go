@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
@@ -34,12 +35,14 @@ type chronology struct {
 	rounder   consensus.Rounder
 	syncTimer ntp.SyncTimer
 
-	subroundId int
+	subroundId    int
+	roundDuration time.Duration
 
 	subrounds        map[int]int
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	messageRecorder  consensus.MessageRecorder
 	cancelFunc       func()
 
 	watchdog core.WatchdogTimer
@@ -67,7 +70,9 @@ func NewChronology(
 		rounder:          rounder,
 		syncTimer:        syncTimer,
 		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		messageRecorder:  recorder.NewDisabledMessageRecorder(),
 		watchdog:         watchdog,
+		roundDuration:    rounder.TimeDuration(),
 	}
 
 	chr.subroundId = srBeforeStartRound
@@ -107,6 +112,16 @@ func (chr *chronology) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetMessageRecorder sets the component which records the boundaries each subround had when it finished
+func (chr *chronology) SetMessageRecorder(messageRecorder consensus.MessageRecorder) error {
+	if check.IfNil(messageRecorder) {
+		return ErrNilMessageRecorder
+	}
+
+	chr.messageRecorder = messageRecorder
+	return nil
+}
+
 // AddSubround adds new SubroundHandler implementation to the chronology
 func (chr *chronology) AddSubround(subroundHandler consensus.SubroundHandler) {
 	chr.mutSubrounds.Lock()
@@ -129,7 +144,7 @@ func (chr *chronology) RemoveAllSubrounds() {
 
 // StartRounds actually starts the chronology and calls the DoWork() method of the subroundHandlers loaded
 func (chr *chronology) StartRounds() {
-	watchdogAlarmDuration := chr.rounder.TimeDuration() * numRoundsToWaitBeforeSignalingChronologyStuck
+	watchdogAlarmDuration := chr.roundDuration * numRoundsToWaitBeforeSignalingChronologyStuck
 	chr.watchdog.SetDefault(watchdogAlarmDuration, chronologyAlarmID)
 
 	var ctx context.Context
@@ -169,7 +184,15 @@ func (chr *chronology) startRound() {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
-	if !sr.DoWork(chr.rounder) {
+	isSubroundFinished := sr.DoWork(chr.rounder)
+	chr.messageRecorder.RecordSubround(
+		chr.rounder.Index(),
+		sr.Current(),
+		sr.Name(),
+		time.Duration(sr.StartTime()),
+		time.Duration(sr.EndTime()),
+	)
+	if !isSubroundFinished {
 		chr.subroundId = srBeforeStartRound
 		return
 	}
@@ -183,6 +206,7 @@ func (chr *chronology) updateRound() {
 	chr.rounder.UpdateRound(chr.genesisTime, chr.syncTimer.CurrentTime())
 
 	if oldRoundIndex != chr.rounder.Index() {
+		chr.updateRoundDurationIfNeeded()
 		chr.watchdog.Reset(chronologyAlarmID)
 		msg := fmt.Sprintf("ROUND %d BEGINS (%d)", chr.rounder.Index(), chr.rounder.TimeStamp().Unix())
 		log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "#"))
@@ -192,6 +216,26 @@ func (chr *chronology) updateRound() {
 	}
 }
 
+// updateRoundDurationIfNeeded adapts the chronology to the duration of the new round, when it has been changed by
+// the rounds schedule
+func (chr *chronology) updateRoundDurationIfNeeded() {
+	roundDuration := chr.rounder.TimeDuration()
+	if roundDuration == chr.roundDuration {
+		return
+	}
+
+	log.Debug("round duration has been changed",
+		"round", chr.rounder.Index(),
+		"old duration", chr.roundDuration,
+		"new duration", roundDuration,
+	)
+
+	chr.roundDuration = roundDuration
+	chr.watchdog.SetDefault(roundDuration*numRoundsToWaitBeforeSignalingChronologyStuck, chronologyAlarmID)
+	chr.appStatusHandler.SetUInt64Value(core.MetricRoundDuration, uint64(roundDuration.Milliseconds()))
+	chr.appStatusHandler.SetUInt64Value(core.MetricRoundTime, uint64(roundDuration.Seconds()))
+}
+
 // initRound is called when a new round begins and it does the necessary initialization
 func (chr *chronology) initRound() {
 	chr.subroundId = srBeforeStartRound
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/stretchr/testify/assert"
 )
@@ -237,6 +238,48 @@ func TestChronology_UpdateRoundShouldInitRound(t *testing.T) {
 	assert.Equal(t, srm.Current(), chr.SubroundId())
 }
 
+func TestChronology_UpdateRoundShouldUpdateRoundDurationWhenChanged(t *testing.T) {
+	t.Parallel()
+
+	roundIndex := int64(1)
+	roundDuration := 4 * time.Second
+	rounderMock := &mock.RounderMock{
+		IndexCalled: func() int64 {
+			return roundIndex
+		},
+		TimeDurationCalled: func() time.Duration {
+			return roundDuration
+		},
+		UpdateRoundCalled: func(_ time.Time, _ time.Time) {
+			roundIndex++
+		},
+	}
+	chr, _ := chronology.NewChronology(
+		time.Now(),
+		rounderMock,
+		&mock.SyncTimerMock{},
+		&mock.WatchdogMock{},
+	)
+
+	metrics := make(map[string]uint64)
+	_ = chr.SetAppStatusHandler(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+	})
+	chr.StartRounds()
+	_ = chr.Close()
+
+	chr.UpdateRound()
+	_, ok := metrics[core.MetricRoundDuration]
+	assert.False(t, ok)
+
+	roundDuration = 5 * time.Second
+	chr.UpdateRound()
+	assert.Equal(t, uint64(5000), metrics[core.MetricRoundDuration])
+	assert.Equal(t, uint64(5), metrics[core.MetricRoundTime])
+}
+
 func TestChronology_LoadSubrounderShouldReturnNilWhenSubroundHandlerNotExists(t *testing.T) {
 	t.Parallel()
 	rounderMock := &mock.RounderMock{}
@@ -415,3 +458,62 @@ func TestChronology_CheckIfStatusHandlerWorks(t *testing.T) {
 		assert.Fail(t, "AppStatusHandler not working")
 	}
 }
+
+func TestChronology_SetMessageRecorderWithNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock,
+		&mock.WatchdogMock{},
+	)
+
+	err := chr.SetMessageRecorder(nil)
+
+	assert.Equal(t, chronology.ErrNilMessageRecorder, err)
+}
+
+func TestChronology_StartRoundShouldRecordTheSubroundBoundaries(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	rounderMock.UpdateRound(rounderMock.TimeStamp(), rounderMock.TimeStamp().Add(rounderMock.TimeDuration()))
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock,
+		&mock.WatchdogMock{},
+	)
+
+	recordedStart := time.Duration(-1)
+	recordedEnd := time.Duration(-1)
+	recordedName := ""
+	err := chr.SetMessageRecorder(&mock.MessageRecorderStub{
+		RecordSubroundCalled: func(roundIndex int64, subroundID int, name string, startTime time.Duration, endTime time.Duration) {
+			recordedName = name
+			recordedStart = startTime
+			recordedEnd = endTime
+		},
+	})
+	assert.Nil(t, err)
+
+	srm := initSubroundHandlerMock()
+	srm.StartTimeCalled = func() int64 {
+		return int64(100 * time.Millisecond)
+	}
+	srm.EndTimeCalled = func() int64 {
+		// the subround end adapted while the subround was running
+		return int64(1500 * time.Millisecond)
+	}
+	chr.AddSubround(srm)
+	chr.SetSubroundId(0)
+	chr.StartRound()
+
+	assert.Equal(t, "(TEST)", recordedName)
+	assert.Equal(t, 100*time.Millisecond, recordedStart)
+	assert.Equal(t, 1500*time.Millisecond, recordedEnd)
+}
@@ -15,3 +15,6 @@ var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
 // ErrNilWatchdog signals that a nil watchdog has been provided
 var ErrNilWatchdog = errors.New("nil watchdog")
+
+// ErrNilMessageRecorder signals that a nil message recorder has been provided
+var ErrNilMessageRecorder = errors.New("nil message recorder")
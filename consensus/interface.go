@@ -11,6 +11,15 @@ import (
 // BlsConsensusType specifies the signature scheme used in the consensus
 const BlsConsensusType = "bls"
 
+// PbftConsensusType specifies the consensus with explicit prevote and precommit steps, also signed with BLS
+const PbftConsensusType = "pbft"
+
+// RoundDuration defines the duration of the rounds starting with the given round
+type RoundDuration struct {
+	StartRound int64
+	Duration   time.Duration
+}
+
 // Rounder defines the actions which should be handled by a round implementation
 type Rounder interface {
 	Index() int64
@@ -19,6 +28,10 @@ type Rounder interface {
 	UpdateRound(time.Time, time.Time)
 	TimeStamp() time.Time
 	TimeDuration() time.Duration
+	// TimeStampForRound returns the start time of the given round, computed from the rounds schedule
+	TimeStampForRound(round int64) time.Time
+	// Schedule returns the rounds schedule, each entry defining the duration of the rounds from its start round onwards
+	Schedule() []RoundDuration
 	RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration
 	IsInterfaceNil() bool
 }
@@ -108,6 +121,24 @@ type PeerHonestyHandler interface {
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetector defines the behaviour of a component able to detect validators that sign conflicting
+// data in the same round
+type EquivocationDetector interface {
+	CheckConsensusMessage(cnsMsg *Message)
+	IsInterfaceNil() bool
+}
+
+// MessageRecorder defines the behaviour of a component able to record, round by round, the consensus messages
+// received and sent by the node
+type MessageRecorder interface {
+	RecordRound(roundIndex int64, consensusGroup []string, leader string, selfPubKey string)
+	RecordSubround(roundIndex int64, subroundID int, name string, startTime time.Duration, endTime time.Duration)
+	RecordReceivedMessage(cnsMsg *Message, validationErr error)
+	RecordSentMessage(cnsMsg *Message)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // InterceptorSubscriber can subscribe for notifications when data is received by an interceptor
 type InterceptorSubscriber interface {
 	RegisterHandler(handler func(toShard uint32, data []byte))
@@ -22,6 +22,11 @@ func (is *InterceptorStub) SetInterceptedDebugHandler(_ process.InterceptedDebug
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (is *InterceptorStub) SetPeersRatingHandler(_ process.PeersRatingHandler) error {
+	return nil
+}
+
 // RegisterHandler -
 func (is *InterceptorStub) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	if is.RegisterHandlerCalled != nil {
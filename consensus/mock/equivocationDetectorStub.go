@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	CheckConsensusMessageCalled func(cnsMsg *consensus.Message)
+}
+
+// CheckConsensusMessage -
+func (eds *EquivocationDetectorStub) CheckConsensusMessage(cnsMsg *consensus.Message) {
+	if eds.CheckConsensusMessageCalled != nil {
+		eds.CheckConsensusMessageCalled(cnsMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (eds *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return eds == nil
+}
@@ -118,6 +118,11 @@ func (hhs *HeaderHandlerStub) GetLeaderSignature() []byte {
 	return hhs.GetSignatureCalled()
 }
 
+// GetLeaderIndex -
+func (hhs *HeaderHandlerStub) GetLeaderIndex() uint32 {
+	return 0
+}
+
 // GetChainID -
 func (hhs *HeaderHandlerStub) GetChainID() []byte {
 	return hhs.GetChainIDCalled()
@@ -192,6 +197,11 @@ func (hhs *HeaderHandlerStub) SetLeaderSignature(_ []byte) {
 	panic("implement me")
 }
 
+// SetLeaderIndex -
+func (hhs *HeaderHandlerStub) SetLeaderIndex(_ uint32) {
+	panic("implement me")
+}
+
 // SetChainID -
 func (hhs *HeaderHandlerStub) SetChainID(_ []byte) {
 	panic("implement me")
@@ -0,0 +1,58 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// MessageRecorderStub -
+type MessageRecorderStub struct {
+	RecordRoundCalled           func(roundIndex int64, consensusGroup []string, leader string, selfPubKey string)
+	RecordSubroundCalled        func(roundIndex int64, subroundID int, name string, startTime time.Duration, endTime time.Duration)
+	RecordReceivedMessageCalled func(cnsMsg *consensus.Message, validationErr error)
+	RecordSentMessageCalled     func(cnsMsg *consensus.Message)
+	CloseCalled                 func() error
+}
+
+// RecordRound -
+func (mrs *MessageRecorderStub) RecordRound(roundIndex int64, consensusGroup []string, leader string, selfPubKey string) {
+	if mrs.RecordRoundCalled != nil {
+		mrs.RecordRoundCalled(roundIndex, consensusGroup, leader, selfPubKey)
+	}
+}
+
+// RecordSubround -
+func (mrs *MessageRecorderStub) RecordSubround(roundIndex int64, subroundID int, name string, startTime time.Duration, endTime time.Duration) {
+	if mrs.RecordSubroundCalled != nil {
+		mrs.RecordSubroundCalled(roundIndex, subroundID, name, startTime, endTime)
+	}
+}
+
+// RecordReceivedMessage -
+func (mrs *MessageRecorderStub) RecordReceivedMessage(cnsMsg *consensus.Message, validationErr error) {
+	if mrs.RecordReceivedMessageCalled != nil {
+		mrs.RecordReceivedMessageCalled(cnsMsg, validationErr)
+	}
+}
+
+// RecordSentMessage -
+func (mrs *MessageRecorderStub) RecordSentMessage(cnsMsg *consensus.Message) {
+	if mrs.RecordSentMessageCalled != nil {
+		mrs.RecordSentMessageCalled(cnsMsg)
+	}
+}
+
+// Close -
+func (mrs *MessageRecorderStub) Close() error {
+	if mrs.CloseCalled != nil {
+		return mrs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (mrs *MessageRecorderStub) IsInterfaceNil() bool {
+	return mrs == nil
+}
@@ -14,19 +14,20 @@ type BelNevMock struct {
 	pubkeys     []string
 	selfId      uint16
 
-	VerifyMock               func(msg []byte, bitmap []byte) error
-	CommitmentHashMock       func(index uint16) ([]byte, error)
-	CreateCommitmentMock     func() ([]byte, []byte)
-	AggregateCommitmentsMock func(bitmap []byte) error
-	CreateSignatureShareMock func(msg []byte, bitmap []byte) ([]byte, error)
-	VerifySignatureShareMock func(index uint16, sig []byte, msg []byte, bitmap []byte) error
-	AggregateSigsMock        func(bitmap []byte) ([]byte, error)
-	SignatureShareMock       func(index uint16) ([]byte, error)
-	StoreCommitmentMock      func(index uint16, value []byte) error
-	StoreCommitmentHashMock  func(uint16, []byte) error
-	CommitmentMock           func(uint16) ([]byte, error)
-	CreateCalled             func(pubKeys []string, index uint16) (crypto.MultiSigner, error)
-	ResetCalled              func(pubKeys []string, index uint16) error
+	VerifyMock                func(msg []byte, bitmap []byte) error
+	CommitmentHashMock        func(index uint16) ([]byte, error)
+	CreateCommitmentMock      func() ([]byte, []byte)
+	AggregateCommitmentsMock  func(bitmap []byte) error
+	CreateSignatureShareMock  func(msg []byte, bitmap []byte) ([]byte, error)
+	VerifySignatureShareMock  func(index uint16, sig []byte, msg []byte, bitmap []byte) error
+	VerifySignatureSharesMock func(indexes []uint16, sigs [][]byte, msg []byte, bitmap []byte) ([]uint16, error)
+	AggregateSigsMock         func(bitmap []byte) ([]byte, error)
+	SignatureShareMock        func(index uint16) ([]byte, error)
+	StoreCommitmentMock       func(index uint16, value []byte) error
+	StoreCommitmentHashMock   func(uint16, []byte) error
+	CommitmentMock            func(uint16) ([]byte, error)
+	CreateCalled              func(pubKeys []string, index uint16) (crypto.MultiSigner, error)
+	ResetCalled               func(pubKeys []string, index uint16) error
 }
 
 // NewMultiSigner -
@@ -166,6 +167,27 @@ func (bnm *BelNevMock) VerifySignatureShare(index uint16, sig []byte, msg []byte
 	return bnm.VerifySignatureShareMock(index, sig, msg, bitmap)
 }
 
+// VerifySignatureShares verifies the partial signatures of the signers with specified positions
+func (bnm *BelNevMock) VerifySignatureShares(indexes []uint16, sigs [][]byte, msg []byte, bitmap []byte) ([]uint16, error) {
+	if bnm.VerifySignatureSharesMock != nil {
+		return bnm.VerifySignatureSharesMock(indexes, sigs, msg, bitmap)
+	}
+
+	var firstErr error
+	invalidIndexes := make([]uint16, 0)
+	for i, index := range indexes {
+		err := bnm.VerifySignatureShare(index, sigs[i], msg, bitmap)
+		if err != nil {
+			invalidIndexes = append(invalidIndexes, index)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return invalidIndexes, firstErr
+}
+
 // AggregateSigs aggregates all collected partial signatures
 func (bnm *BelNevMock) AggregateSigs(bitmap []byte) ([]byte, error) {
 	return bnm.AggregateSigsMock(bitmap)
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/consensus/recorder"
+
+// RecordWriterStub -
+type RecordWriterStub struct {
+	WriteCalled func(record *recorder.Record) error
+	CloseCalled func() error
+}
+
+// Write -
+func (rws *RecordWriterStub) Write(record *recorder.Record) error {
+	if rws.WriteCalled != nil {
+		return rws.WriteCalled(record)
+	}
+
+	return nil
+}
+
+// Close -
+func (rws *RecordWriterStub) Close() error {
+	if rws.CloseCalled != nil {
+		return rws.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (rws *RecordWriterStub) IsInterfaceNil() bool {
+	return rws == nil
+}
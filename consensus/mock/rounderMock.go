@@ -2,18 +2,22 @@ package mock
 
 import (
 	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
 // RounderMock -
 type RounderMock struct {
 	RoundIndex int64
 
-	IndexCalled         func() int64
-	TimeDurationCalled  func() time.Duration
-	TimeStampCalled     func() time.Time
-	UpdateRoundCalled   func(time.Time, time.Time)
-	RemainingTimeCalled func(startTime time.Time, maxTime time.Duration) time.Duration
-	BeforeGenesisCalled func() bool
+	IndexCalled             func() int64
+	TimeDurationCalled      func() time.Duration
+	TimeStampCalled         func() time.Time
+	UpdateRoundCalled       func(time.Time, time.Time)
+	RemainingTimeCalled     func(startTime time.Time, maxTime time.Duration) time.Duration
+	TimeStampForRoundCalled func(round int64) time.Time
+	ScheduleCalled          func() []consensus.RoundDuration
+	BeforeGenesisCalled     func() bool
 }
 
 // BeforeGenesis -
@@ -70,6 +74,24 @@ func (rndm *RounderMock) RemainingTime(startTime time.Time, maxTime time.Duratio
 	return 4000 * time.Millisecond
 }
 
+// TimeStampForRound -
+func (rndm *RounderMock) TimeStampForRound(round int64) time.Time {
+	if rndm.TimeStampForRoundCalled != nil {
+		return rndm.TimeStampForRoundCalled(round)
+	}
+
+	return rndm.TimeStamp().Add(time.Duration(round-rndm.Index()) * rndm.TimeDuration())
+}
+
+// Schedule -
+func (rndm *RounderMock) Schedule() []consensus.RoundDuration {
+	if rndm.ScheduleCalled != nil {
+		return rndm.ScheduleCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rndm *RounderMock) IsInterfaceNil() bool {
 	return rndm == nil
@@ -40,12 +40,20 @@ func (srm *SubroundHandlerMock) Current() int {
 
 // StartTime -
 func (srm *SubroundHandlerMock) StartTime() int64 {
-	return srm.StartTimeCalled()
+	if srm.StartTimeCalled != nil {
+		return srm.StartTimeCalled()
+	}
+
+	return 0
 }
 
 // EndTime -
 func (srm *SubroundHandlerMock) EndTime() int64 {
-	return srm.EndTimeCalled()
+	if srm.EndTimeCalled != nil {
+		return srm.EndTimeCalled()
+	}
+
+	return 0
 }
 
 // Name -
@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"time"
+)
+
+// SubroundsTimingStub -
+type SubroundsTimingStub struct {
+	StartTimeCalled              func(subroundID int) int64
+	EndTimeCalled                func(subroundID int) int64
+	AddProposalMeasurementCalled func(elapsedTime time.Duration)
+}
+
+// StartTime -
+func (sts *SubroundsTimingStub) StartTime(subroundID int) int64 {
+	if sts.StartTimeCalled != nil {
+		return sts.StartTimeCalled(subroundID)
+	}
+
+	return 0
+}
+
+// EndTime -
+func (sts *SubroundsTimingStub) EndTime(subroundID int) int64 {
+	if sts.EndTimeCalled != nil {
+		return sts.EndTimeCalled(subroundID)
+	}
+
+	return 0
+}
+
+// AddProposalMeasurement -
+func (sts *SubroundsTimingStub) AddProposalMeasurement(elapsedTime time.Duration) {
+	if sts.AddProposalMeasurementCalled != nil {
+		sts.AddProposalMeasurementCalled(elapsedTime)
+	}
+}
+
+// IsInterfaceNil -
+func (sts *SubroundsTimingStub) IsInterfaceNil() bool {
+	return sts == nil
+}
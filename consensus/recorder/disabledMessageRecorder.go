@@ -0,0 +1,41 @@
+package recorder
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+type disabledMessageRecorder struct {
+}
+
+// NewDisabledMessageRecorder returns a message recorder that does not record anything
+func NewDisabledMessageRecorder() *disabledMessageRecorder {
+	return &disabledMessageRecorder{}
+}
+
+// RecordRound does nothing
+func (dmr *disabledMessageRecorder) RecordRound(_ int64, _ []string, _ string, _ string) {
+}
+
+// RecordSubround does nothing
+func (dmr *disabledMessageRecorder) RecordSubround(_ int64, _ int, _ string, _ time.Duration, _ time.Duration) {
+}
+
+// RecordReceivedMessage does nothing
+func (dmr *disabledMessageRecorder) RecordReceivedMessage(_ *consensus.Message, _ error) {
+}
+
+// RecordSentMessage does nothing
+func (dmr *disabledMessageRecorder) RecordSentMessage(_ *consensus.Message) {
+}
+
+// Close does nothing
+func (dmr *disabledMessageRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmr *disabledMessageRecorder) IsInterfaceNil() bool {
+	return dmr == nil
+}
@@ -0,0 +1,33 @@
+package recorder
+
+import "errors"
+
+// ErrNilRounder signals that a nil rounder has been provided
+var ErrNilRounder = errors.New("nil rounder")
+
+// ErrNilSyncTimer signals that a nil sync timer has been provided
+var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilRecordWriter signals that a nil record writer has been provided
+var ErrNilRecordWriter = errors.New("nil record writer")
+
+// ErrNilBroadcastMessenger signals that a nil broadcast messenger has been provided
+var ErrNilBroadcastMessenger = errors.New("nil broadcast messenger")
+
+// ErrNilMessageRecorder signals that a nil message recorder has been provided
+var ErrNilMessageRecorder = errors.New("nil message recorder")
+
+// ErrEmptyFolderPath signals that an empty folder path has been provided
+var ErrEmptyFolderPath = errors.New("empty folder path")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrInvalidMaxFiles signals that an invalid maximum number of files has been provided
+var ErrInvalidMaxFiles = errors.New("invalid maximum number of files")
+
+// ErrRoundNotRecorded signals that the requested round can not be found in the records
+var ErrRoundNotRecorded = errors.New("round not recorded")
+
+// ErrNilRecordedRound signals that a nil recorded round has been provided
+var ErrNilRecordedRound = errors.New("nil recorded round")
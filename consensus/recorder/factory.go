@@ -0,0 +1,42 @@
+package recorder
+
+import (
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+// CreateMessageRecorder creates the message recorder described by the provided config. A relative folder path is
+// considered relative to the provided working directory
+func CreateMessageRecorder(
+	cfg config.ConsensusRecorderConfig,
+	workingDir string,
+	rounder consensus.Rounder,
+	syncTimer ntp.SyncTimer,
+) (consensus.MessageRecorder, error) {
+	if !cfg.Enabled {
+		return NewDisabledMessageRecorder(), nil
+	}
+
+	folderPath := cfg.FolderPath
+	if len(folderPath) > 0 && !filepath.IsAbs(folderPath) {
+		folderPath = filepath.Join(workingDir, folderPath)
+	}
+
+	writer, err := NewRotatingFileWriter(ArgRotatingFileWriter{
+		FolderPath:      folderPath,
+		MaxFileSizeInMB: cfg.MaxFileSizeInMB,
+		MaxFiles:        cfg.MaxFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMessageRecorder(ArgMessageRecorder{
+		Rounder:   rounder,
+		SyncTimer: syncTimer,
+		Writer:    writer,
+	})
+}
@@ -0,0 +1,8 @@
+package recorder
+
+// RecordWriter defines the behaviour of a component able to persist the consensus records
+type RecordWriter interface {
+	Write(record *Record) error
+	Close() error
+	IsInterfaceNil() bool
+}
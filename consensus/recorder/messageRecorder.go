@@ -0,0 +1,153 @@
+package recorder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/ntp"
+)
+
+var log = logger.GetOrCreate("consensus/recorder")
+
+// ArgMessageRecorder is the DTO used to create a new message recorder
+type ArgMessageRecorder struct {
+	Rounder   consensus.Rounder
+	SyncTimer ntp.SyncTimer
+	Writer    RecordWriter
+}
+
+// messageRecorder writes, round by round, the consensus messages received and sent by the node
+type messageRecorder struct {
+	mut       sync.Mutex
+	rounder   consensus.Rounder
+	syncTimer ntp.SyncTimer
+	writer    RecordWriter
+}
+
+// NewMessageRecorder creates a new message recorder
+func NewMessageRecorder(args ArgMessageRecorder) (*messageRecorder, error) {
+	if check.IfNil(args.Rounder) {
+		return nil, ErrNilRounder
+	}
+	if check.IfNil(args.SyncTimer) {
+		return nil, ErrNilSyncTimer
+	}
+	if check.IfNil(args.Writer) {
+		return nil, ErrNilRecordWriter
+	}
+
+	return &messageRecorder{
+		rounder:   args.Rounder,
+		syncTimer: args.SyncTimer,
+		writer:    args.Writer,
+	}, nil
+}
+
+// RecordRound records the consensus group computed by the node for the provided round
+func (mr *messageRecorder) RecordRound(roundIndex int64, consensusGroup []string, leader string, selfPubKey string) {
+	group := make([][]byte, 0, len(consensusGroup))
+	for _, pubKey := range consensusGroup {
+		group = append(group, []byte(pubKey))
+	}
+
+	mr.write(&Record{
+		Round: &RoundRecord{
+			Round:          roundIndex,
+			RoundStart:     mr.roundStart(roundIndex),
+			RoundDuration:  mr.rounder.TimeDuration(),
+			ConsensusGroup: group,
+			Leader:         []byte(leader),
+			SelfPubKey:     []byte(selfPubKey),
+		},
+	})
+}
+
+// RecordSubround records the boundaries the provided subround had in the given round
+func (mr *messageRecorder) RecordSubround(
+	roundIndex int64,
+	subroundID int,
+	name string,
+	startTime time.Duration,
+	endTime time.Duration,
+) {
+	mr.write(&Record{
+		Subround: &SubroundRecord{
+			Round:     roundIndex,
+			ID:        subroundID,
+			Name:      name,
+			StartTime: startTime,
+			EndTime:   endTime,
+		},
+	})
+}
+
+// RecordReceivedMessage records a consensus message received from the network together with its validation result
+func (mr *messageRecorder) RecordReceivedMessage(cnsMsg *consensus.Message, validationErr error) {
+	if cnsMsg == nil {
+		return
+	}
+
+	msgRecord := mr.createMessageRecord(DirectionReceived, cnsMsg)
+	if validationErr != nil {
+		msgRecord.Error = validationErr.Error()
+	}
+
+	mr.write(&Record{Message: msgRecord})
+}
+
+// RecordSentMessage records a consensus message broadcast by the node
+func (mr *messageRecorder) RecordSentMessage(cnsMsg *consensus.Message) {
+	if cnsMsg == nil {
+		return
+	}
+
+	mr.write(&Record{Message: mr.createMessageRecord(DirectionSent, cnsMsg)})
+}
+
+func (mr *messageRecorder) createMessageRecord(direction string, cnsMsg *consensus.Message) *MessageRecord {
+	now := mr.syncTimer.CurrentTime()
+
+	return &MessageRecord{
+		Direction:         direction,
+		Round:             cnsMsg.RoundIndex,
+		MsgType:           cnsMsg.MsgType,
+		PubKey:            cnsMsg.PubKey,
+		BlockHeaderHash:   cnsMsg.BlockHeaderHash,
+		BodySize:          len(cnsMsg.Body),
+		HeaderSize:        len(cnsMsg.Header),
+		HasSignatureShare: len(cnsMsg.SignatureShare) > 0,
+		HasFinalInfo:      len(cnsMsg.AggregateSignature) > 0,
+		Timestamp:         now,
+		SinceRoundStart:   now.Sub(mr.roundStart(cnsMsg.RoundIndex)),
+	}
+}
+
+func (mr *messageRecorder) roundStart(roundIndex int64) time.Time {
+	return mr.rounder.TimeStampForRound(roundIndex)
+}
+
+func (mr *messageRecorder) write(record *Record) {
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	err := mr.writer.Write(record)
+	if err != nil {
+		log.Debug("messageRecorder.write", "error", err.Error())
+	}
+}
+
+// Close closes the underlying writer
+func (mr *messageRecorder) Close() error {
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	return mr.writer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *messageRecorder) IsInterfaceNil() bool {
+	return mr == nil
+}
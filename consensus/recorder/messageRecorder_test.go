@@ -0,0 +1,221 @@
+package recorder_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var roundStart = time.Unix(1600000000, 0)
+
+const roundDuration = 4 * time.Second
+
+func createMockArgMessageRecorder(currentTime time.Time) (recorder.ArgMessageRecorder, *[]*recorder.Record) {
+	records := make([]*recorder.Record, 0)
+	return recorder.ArgMessageRecorder{
+		Rounder: &mock.RounderMock{
+			RoundIndex: 10,
+			TimeStampCalled: func() time.Time {
+				return roundStart
+			},
+			TimeDurationCalled: func() time.Duration {
+				return roundDuration
+			},
+		},
+		SyncTimer: &mock.SyncTimerMock{
+			CurrentTimeCalled: func() time.Time {
+				return currentTime
+			},
+		},
+		Writer: &mock.RecordWriterStub{
+			WriteCalled: func(record *recorder.Record) error {
+				records = append(records, record)
+				return nil
+			},
+		},
+	}, &records
+}
+
+func TestNewMessageRecorder_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgMessageRecorder(roundStart)
+	args.Rounder = nil
+	mr, err := recorder.NewMessageRecorder(args)
+
+	assert.True(t, check.IfNil(mr))
+	assert.Equal(t, recorder.ErrNilRounder, err)
+}
+
+func TestNewMessageRecorder_NilSyncTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgMessageRecorder(roundStart)
+	args.SyncTimer = nil
+	mr, err := recorder.NewMessageRecorder(args)
+
+	assert.True(t, check.IfNil(mr))
+	assert.Equal(t, recorder.ErrNilSyncTimer, err)
+}
+
+func TestNewMessageRecorder_NilWriterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgMessageRecorder(roundStart)
+	args.Writer = nil
+	mr, err := recorder.NewMessageRecorder(args)
+
+	assert.True(t, check.IfNil(mr))
+	assert.Equal(t, recorder.ErrNilRecordWriter, err)
+}
+
+func TestMessageRecorder_RecordRoundShouldWriteTheConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	args, records := createMockArgMessageRecorder(roundStart)
+	mr, err := recorder.NewMessageRecorder(args)
+	require.Nil(t, err)
+
+	mr.RecordRound(11, []string{"A", "B", "C"}, "B", "C")
+
+	require.Equal(t, 1, len(*records))
+	round := (*records)[0].Round
+	require.NotNil(t, round)
+	assert.Nil(t, (*records)[0].Message)
+	assert.Equal(t, int64(11), round.Round)
+	assert.Equal(t, roundStart.Add(roundDuration), round.RoundStart)
+	assert.Equal(t, roundDuration, round.RoundDuration)
+	assert.Equal(t, [][]byte{[]byte("A"), []byte("B"), []byte("C")}, round.ConsensusGroup)
+	assert.Equal(t, []byte("B"), round.Leader)
+	assert.Equal(t, []byte("C"), round.SelfPubKey)
+}
+
+func TestMessageRecorder_RecordSubroundShouldWriteTheSubroundBoundaries(t *testing.T) {
+	t.Parallel()
+
+	args, records := createMockArgMessageRecorder(roundStart)
+	mr, _ := recorder.NewMessageRecorder(args)
+
+	mr.RecordSubround(10, 2, "(BLOCK)", 200*time.Millisecond, 1500*time.Millisecond)
+
+	require.Equal(t, 1, len(*records))
+	subround := (*records)[0].Subround
+	require.NotNil(t, subround)
+	assert.Equal(t, int64(10), subround.Round)
+	assert.Equal(t, 2, subround.ID)
+	assert.Equal(t, "(BLOCK)", subround.Name)
+	assert.Equal(t, 200*time.Millisecond, subround.StartTime)
+	assert.Equal(t, 1500*time.Millisecond, subround.EndTime)
+}
+
+func TestMessageRecorder_RecordReceivedMessageShouldWriteTimingAndValidationResult(t *testing.T) {
+	t.Parallel()
+
+	args, records := createMockArgMessageRecorder(roundStart.Add(time.Second))
+	mr, _ := recorder.NewMessageRecorder(args)
+
+	cnsMsg := &consensus.Message{
+		BlockHeaderHash: []byte("hash"),
+		Body:            []byte("body"),
+		Header:          []byte("header bytes"),
+		PubKey:          []byte("A"),
+		MsgType:         1,
+		RoundIndex:      10,
+	}
+	mr.RecordReceivedMessage(nil, nil)
+	mr.RecordReceivedMessage(cnsMsg, errors.New("invalid signature"))
+
+	require.Equal(t, 1, len(*records))
+	msg := (*records)[0].Message
+	require.NotNil(t, msg)
+	assert.Equal(t, recorder.DirectionReceived, msg.Direction)
+	assert.Equal(t, int64(10), msg.Round)
+	assert.Equal(t, int64(1), msg.MsgType)
+	assert.Equal(t, []byte("A"), msg.PubKey)
+	assert.Equal(t, []byte("hash"), msg.BlockHeaderHash)
+	assert.Equal(t, 4, msg.BodySize)
+	assert.Equal(t, 12, msg.HeaderSize)
+	assert.Equal(t, time.Second, msg.SinceRoundStart)
+	assert.Equal(t, "invalid signature", msg.Error)
+}
+
+func TestMessageRecorder_RecordSentMessageShouldWriteTheMessage(t *testing.T) {
+	t.Parallel()
+
+	args, records := createMockArgMessageRecorder(roundStart.Add(time.Second))
+	mr, _ := recorder.NewMessageRecorder(args)
+
+	mr.RecordSentMessage(&consensus.Message{
+		PubKey:         []byte("A"),
+		SignatureShare: []byte("sig"),
+		RoundIndex:     9,
+	})
+
+	require.Equal(t, 1, len(*records))
+	msg := (*records)[0].Message
+	assert.Equal(t, recorder.DirectionSent, msg.Direction)
+	assert.True(t, msg.HasSignatureShare)
+	assert.Empty(t, msg.Error)
+	assert.Equal(t, roundDuration+time.Second, msg.SinceRoundStart)
+}
+
+func TestMessageRecorder_CloseShouldCloseTheWriter(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgMessageRecorder(roundStart)
+	closeCalled := false
+	args.Writer = &mock.RecordWriterStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	mr, _ := recorder.NewMessageRecorder(args)
+
+	err := mr.Close()
+
+	assert.Nil(t, err)
+	assert.True(t, closeCalled)
+}
+
+func TestRecordingBroadcastMessenger_ShouldRecordAndBroadcast(t *testing.T) {
+	t.Parallel()
+
+	rbm, err := recorder.NewRecordingBroadcastMessenger(nil, &mock.MessageRecorderStub{})
+	assert.True(t, check.IfNil(rbm))
+	assert.Equal(t, recorder.ErrNilBroadcastMessenger, err)
+
+	rbm, err = recorder.NewRecordingBroadcastMessenger(&mock.BroadcastMessengerMock{}, nil)
+	assert.True(t, check.IfNil(rbm))
+	assert.Equal(t, recorder.ErrNilMessageRecorder, err)
+
+	var recorded, broadcast *consensus.Message
+	rbm, err = recorder.NewRecordingBroadcastMessenger(
+		&mock.BroadcastMessengerMock{
+			BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+				broadcast = message
+				return nil
+			},
+		},
+		&mock.MessageRecorderStub{
+			RecordSentMessageCalled: func(cnsMsg *consensus.Message) {
+				recorded = cnsMsg
+			},
+		},
+	)
+	require.Nil(t, err)
+
+	cnsMsg := &consensus.Message{PubKey: []byte("A")}
+	err = rbm.BroadcastConsensusMessage(cnsMsg)
+
+	assert.Nil(t, err)
+	assert.True(t, recorded == cnsMsg)
+	assert.True(t, broadcast == cnsMsg)
+}
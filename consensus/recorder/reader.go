@@ -0,0 +1,124 @@
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+const maxRecordLineSize = 10 * 1024 * 1024
+
+// ReadRecords reads all the records from the provided path, which can be either a records file or a folder holding
+// the files written by the rotating file writer
+func ReadRecords(path string) ([]*Record, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = listRecordsFiles(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	records := make([]*Record, 0)
+	for _, file := range files {
+		fileRecords, errRead := readRecordsFile(file)
+		if errRead != nil {
+			return nil, errRead
+		}
+		records = append(records, fileRecords...)
+	}
+
+	return records, nil
+}
+
+func readRecordsFile(filePath string) ([]*Record, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make([]*Record, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxRecordLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		record := &Record{}
+		err = json.Unmarshal(scanner.Bytes(), record)
+		if err != nil {
+			return nil, fmt.Errorf("%w in file %s at line %d", err, filePath, line)
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+// GetRecordedRound extracts from the provided records the given round, with its subrounds and messages sorted by time.
+// If the round was recorded more than once, the last recorded consensus group is used
+func GetRecordedRound(records []*Record, roundIndex int64) (*RecordedRound, error) {
+	recordedRound := &RecordedRound{
+		Subrounds: make([]*SubroundRecord, 0),
+		Messages:  make([]*MessageRecord, 0),
+	}
+	for _, record := range records {
+		if record.Round != nil && record.Round.Round == roundIndex {
+			recordedRound.Round = record.Round
+		}
+		if record.Subround != nil && record.Subround.Round == roundIndex {
+			recordedRound.Subrounds = append(recordedRound.Subrounds, record.Subround)
+		}
+		if record.Message != nil && record.Message.Round == roundIndex {
+			recordedRound.Messages = append(recordedRound.Messages, record.Message)
+		}
+	}
+
+	if recordedRound.Round == nil {
+		return nil, fmt.Errorf("%w: %d", ErrRoundNotRecorded, roundIndex)
+	}
+
+	sort.SliceStable(recordedRound.Subrounds, func(i, j int) bool {
+		return recordedRound.Subrounds[i].StartTime < recordedRound.Subrounds[j].StartTime
+	})
+	sort.SliceStable(recordedRound.Messages, func(i, j int) bool {
+		return recordedRound.Messages[i].SinceRoundStart < recordedRound.Messages[j].SinceRoundStart
+	})
+
+	return recordedRound, nil
+}
+
+// GetRecordedRoundIndexes returns the sorted indexes of all the rounds found in the provided records
+func GetRecordedRoundIndexes(records []*Record) []int64 {
+	seen := make(map[int64]struct{})
+	indexes := make([]int64, 0)
+	for _, record := range records {
+		if record.Round == nil {
+			continue
+		}
+		_, found := seen[record.Round.Round]
+		if found {
+			continue
+		}
+		seen[record.Round.Round] = struct{}{}
+		indexes = append(indexes, record.Round.Round)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	return indexes
+}
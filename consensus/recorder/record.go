@@ -0,0 +1,63 @@
+package recorder
+
+import (
+	"time"
+)
+
+// DirectionReceived marks a consensus message received from the network
+const DirectionReceived = "received"
+
+// DirectionSent marks a consensus message broadcast by the node
+const DirectionSent = "sent"
+
+// RoundRecord holds the consensus group the node computed for a round, together with the round timing, so the
+// messages of the round can be replayed offline
+type RoundRecord struct {
+	Round          int64         `json:"round"`
+	RoundStart     time.Time     `json:"roundStart"`
+	RoundDuration  time.Duration `json:"roundDuration"`
+	ConsensusGroup [][]byte      `json:"consensusGroup"`
+	Leader         []byte        `json:"leader"`
+	SelfPubKey     []byte        `json:"selfPubKey"`
+}
+
+// SubroundRecord holds the boundaries, relative to the round start, a subround had when the node finished running it.
+// They reflect the rounds schedule and the adaptive subrounds timing in effect for that round
+type SubroundRecord struct {
+	Round     int64         `json:"round"`
+	ID        int           `json:"id"`
+	Name      string        `json:"name"`
+	StartTime time.Duration `json:"startTime"`
+	EndTime   time.Duration `json:"endTime"`
+}
+
+// MessageRecord holds a consensus message received or sent by the node. The body and the header are not kept,
+// only their sizes
+type MessageRecord struct {
+	Direction         string        `json:"direction"`
+	Round             int64         `json:"round"`
+	MsgType           int64         `json:"msgType"`
+	PubKey            []byte        `json:"pubKey"`
+	BlockHeaderHash   []byte        `json:"blockHeaderHash,omitempty"`
+	BodySize          int           `json:"bodySize,omitempty"`
+	HeaderSize        int           `json:"headerSize,omitempty"`
+	HasSignatureShare bool          `json:"hasSignatureShare,omitempty"`
+	HasFinalInfo      bool          `json:"hasFinalInfo,omitempty"`
+	Timestamp         time.Time     `json:"timestamp"`
+	SinceRoundStart   time.Duration `json:"sinceRoundStart"`
+	Error             string        `json:"error,omitempty"`
+}
+
+// Record is a line of the records file, holding either a round, a subround or a message
+type Record struct {
+	Round    *RoundRecord    `json:"round,omitempty"`
+	Subround *SubroundRecord `json:"subround,omitempty"`
+	Message  *MessageRecord  `json:"message,omitempty"`
+}
+
+// RecordedRound groups the round record with all the subrounds and messages recorded for that round
+type RecordedRound struct {
+	Round     *RoundRecord
+	Subrounds []*SubroundRecord
+	Messages  []*MessageRecord
+}
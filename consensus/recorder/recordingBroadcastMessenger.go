@@ -0,0 +1,43 @@
+package recorder
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// recordingBroadcastMessenger decorates a broadcast messenger, recording the consensus messages it broadcasts
+type recordingBroadcastMessenger struct {
+	consensus.BroadcastMessenger
+	recorder consensus.MessageRecorder
+}
+
+// NewRecordingBroadcastMessenger creates a broadcast messenger that records every consensus message before
+// broadcasting it through the provided messenger
+func NewRecordingBroadcastMessenger(
+	messenger consensus.BroadcastMessenger,
+	recorder consensus.MessageRecorder,
+) (*recordingBroadcastMessenger, error) {
+	if check.IfNil(messenger) {
+		return nil, ErrNilBroadcastMessenger
+	}
+	if check.IfNil(recorder) {
+		return nil, ErrNilMessageRecorder
+	}
+
+	return &recordingBroadcastMessenger{
+		BroadcastMessenger: messenger,
+		recorder:           recorder,
+	}, nil
+}
+
+// BroadcastConsensusMessage records and broadcasts the provided consensus message
+func (rbm *recordingBroadcastMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
+	rbm.recorder.RecordSentMessage(message)
+
+	return rbm.BroadcastMessenger.BroadcastConsensusMessage(message)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rbm *recordingBroadcastMessenger) IsInterfaceNil() bool {
+	return rbm == nil
+}
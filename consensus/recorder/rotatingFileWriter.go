@@ -0,0 +1,183 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const filePermissions = 0644
+const folderPermissions = 0755
+const recordsFilePrefix = "consensus-"
+const recordsFileExtension = ".jsonl"
+const bytesInMegabyte = 1024 * 1024
+
+// ArgRotatingFileWriter is the DTO used to create a new rotating file writer
+type ArgRotatingFileWriter struct {
+	FolderPath      string
+	MaxFileSizeInMB uint32
+	MaxFiles        uint32
+}
+
+// rotatingFileWriter appends the records to a file, one JSON object per line. When the file grows over the maximum
+// size a new file is started and the oldest files over the maximum number of files are removed
+type rotatingFileWriter struct {
+	mut         sync.Mutex
+	folderPath  string
+	maxFileSize int64
+	maxFiles    int
+	file        *os.File
+	fileSize    int64
+	fileIndex   int
+}
+
+// NewRotatingFileWriter creates a new rotating file writer in the provided folder, creating it if necessary
+func NewRotatingFileWriter(args ArgRotatingFileWriter) (*rotatingFileWriter, error) {
+	if len(args.FolderPath) == 0 {
+		return nil, ErrEmptyFolderPath
+	}
+	if args.MaxFileSizeInMB == 0 {
+		return nil, ErrInvalidMaxFileSize
+	}
+	if args.MaxFiles == 0 {
+		return nil, ErrInvalidMaxFiles
+	}
+
+	err := os.MkdirAll(args.FolderPath, folderPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	rfw := &rotatingFileWriter{
+		folderPath:  args.FolderPath,
+		maxFileSize: int64(args.MaxFileSizeInMB) * bytesInMegabyte,
+		maxFiles:    int(args.MaxFiles),
+	}
+
+	err = rfw.rotate()
+	if err != nil {
+		return nil, err
+	}
+
+	return rfw, nil
+}
+
+// Write appends the record in the current file, rotating the file if it became too large
+func (rfw *rotatingFileWriter) Write(record *Record) error {
+	if record == nil {
+		return nil
+	}
+
+	buff, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	buff = append(buff, '\n')
+
+	rfw.mut.Lock()
+	defer rfw.mut.Unlock()
+
+	if rfw.file == nil {
+		return os.ErrClosed
+	}
+	if rfw.fileSize > 0 && rfw.fileSize+int64(len(buff)) > rfw.maxFileSize {
+		err = rfw.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := rfw.file.Write(buff)
+	rfw.fileSize += int64(n)
+
+	return err
+}
+
+func (rfw *rotatingFileWriter) rotate() error {
+	if rfw.file != nil {
+		err := rfw.file.Close()
+		if err != nil {
+			log.Debug("rotatingFileWriter.rotate: close", "error", err.Error())
+		}
+	}
+
+	rfw.fileIndex++
+	fileName := fmt.Sprintf("%s%s-%04d%s",
+		recordsFilePrefix,
+		time.Now().UTC().Format("20060102T150405"),
+		rfw.fileIndex,
+		recordsFileExtension,
+	)
+	file, err := os.OpenFile(filepath.Join(rfw.folderPath, fileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermissions)
+	if err != nil {
+		rfw.file = nil
+		return err
+	}
+
+	rfw.file = file
+	rfw.fileSize = 0
+	rfw.removeOldFiles()
+
+	return nil
+}
+
+func (rfw *rotatingFileWriter) removeOldFiles() {
+	files, err := listRecordsFiles(rfw.folderPath)
+	if err != nil {
+		log.Debug("rotatingFileWriter.removeOldFiles", "error", err.Error())
+		return
+	}
+
+	for len(files) > rfw.maxFiles {
+		err = os.Remove(files[0])
+		if err != nil {
+			log.Debug("rotatingFileWriter.removeOldFiles", "file", files[0], "error", err.Error())
+		}
+		files = files[1:]
+	}
+}
+
+// Close closes the current file
+func (rfw *rotatingFileWriter) Close() error {
+	rfw.mut.Lock()
+	defer rfw.mut.Unlock()
+
+	if rfw.file == nil {
+		return nil
+	}
+
+	err := rfw.file.Close()
+	rfw.file = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rfw *rotatingFileWriter) IsInterfaceNil() bool {
+	return rfw == nil
+}
+
+func listRecordsFiles(folderPath string) ([]string, error) {
+	entries, err := ioutil.ReadDir(folderPath)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, recordsFilePrefix) || !strings.HasSuffix(name, recordsFileExtension) {
+			continue
+		}
+		files = append(files, filepath.Join(folderPath, name))
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
@@ -0,0 +1,150 @@
+package recorder_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempFolder(t *testing.T) string {
+	folder, err := ioutil.TempDir("", "consensus-records")
+	require.Nil(t, err)
+
+	return folder
+}
+
+func TestNewRotatingFileWriter_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rfw, err := recorder.NewRotatingFileWriter(recorder.ArgRotatingFileWriter{MaxFileSizeInMB: 1, MaxFiles: 1})
+	assert.True(t, check.IfNil(rfw))
+	assert.Equal(t, recorder.ErrEmptyFolderPath, err)
+
+	rfw, err = recorder.NewRotatingFileWriter(recorder.ArgRotatingFileWriter{FolderPath: "records", MaxFiles: 1})
+	assert.True(t, check.IfNil(rfw))
+	assert.Equal(t, recorder.ErrInvalidMaxFileSize, err)
+
+	rfw, err = recorder.NewRotatingFileWriter(recorder.ArgRotatingFileWriter{FolderPath: "records", MaxFileSizeInMB: 1})
+	assert.True(t, check.IfNil(rfw))
+	assert.Equal(t, recorder.ErrInvalidMaxFiles, err)
+}
+
+func TestRotatingFileWriter_WrittenRecordsShouldBeReadBack(t *testing.T) {
+	t.Parallel()
+
+	folder := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	rfw, err := recorder.NewRotatingFileWriter(recorder.ArgRotatingFileWriter{
+		FolderPath:      folder,
+		MaxFileSizeInMB: 1,
+		MaxFiles:        2,
+	})
+	require.Nil(t, err)
+
+	roundRecord := &recorder.RoundRecord{
+		Round:          5,
+		RoundStart:     time.Unix(1600000000, 0).UTC(),
+		RoundDuration:  time.Second,
+		ConsensusGroup: [][]byte{[]byte("A"), []byte("B")},
+		Leader:         []byte("A"),
+		SelfPubKey:     []byte("B"),
+	}
+	require.Nil(t, rfw.Write(&recorder.Record{Round: roundRecord}))
+	require.Nil(t, rfw.Write(&recorder.Record{Subround: &recorder.SubroundRecord{Round: 5, ID: 2, Name: "(SIGNATURE)", StartTime: 3}}))
+	require.Nil(t, rfw.Write(&recorder.Record{Subround: &recorder.SubroundRecord{Round: 5, ID: 1, Name: "(BLOCK)", StartTime: 1}}))
+	require.Nil(t, rfw.Write(&recorder.Record{Message: &recorder.MessageRecord{Round: 5, MsgType: 4, SinceRoundStart: 2}}))
+	require.Nil(t, rfw.Write(&recorder.Record{Message: &recorder.MessageRecord{Round: 5, MsgType: 1, SinceRoundStart: 1}}))
+	require.Nil(t, rfw.Write(&recorder.Record{Message: &recorder.MessageRecord{Round: 6, MsgType: 1}}))
+	require.Nil(t, rfw.Close())
+
+	records, err := recorder.ReadRecords(folder)
+	require.Nil(t, err)
+	assert.Equal(t, 6, len(records))
+	assert.Equal(t, []int64{5}, recorder.GetRecordedRoundIndexes(records))
+
+	recordedRound, err := recorder.GetRecordedRound(records, 5)
+	require.Nil(t, err)
+	assert.Equal(t, roundRecord, recordedRound.Round)
+	require.Equal(t, 2, len(recordedRound.Subrounds))
+	assert.Equal(t, "(BLOCK)", recordedRound.Subrounds[0].Name)
+	assert.Equal(t, "(SIGNATURE)", recordedRound.Subrounds[1].Name)
+	require.Equal(t, 2, len(recordedRound.Messages))
+	assert.Equal(t, int64(1), recordedRound.Messages[0].MsgType)
+	assert.Equal(t, int64(4), recordedRound.Messages[1].MsgType)
+
+	_, err = recorder.GetRecordedRound(records, 6)
+	assert.True(t, strings.Contains(err.Error(), recorder.ErrRoundNotRecorded.Error()))
+}
+
+func TestRotatingFileWriter_ShouldRotateAndRemoveTheOldestFiles(t *testing.T) {
+	t.Parallel()
+
+	folder := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	rfw, err := recorder.NewRotatingFileWriter(recorder.ArgRotatingFileWriter{
+		FolderPath:      folder,
+		MaxFileSizeInMB: 1,
+		MaxFiles:        2,
+	})
+	require.Nil(t, err)
+
+	largeHash := make([]byte, 300*1024)
+	for i := 0; i < 5; i++ {
+		err = rfw.Write(&recorder.Record{Message: &recorder.MessageRecord{Round: int64(i), BlockHeaderHash: largeHash}})
+		require.Nil(t, err)
+	}
+	require.Nil(t, rfw.Close())
+
+	files, err := filepath.Glob(filepath.Join(folder, "*.jsonl"))
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(files))
+
+	records, err := recorder.ReadRecords(folder)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, int64(2), records[0].Message.Round)
+	assert.Equal(t, int64(4), records[2].Message.Round)
+}
+
+func TestCreateMessageRecorder(t *testing.T) {
+	t.Parallel()
+
+	folder := createTempFolder(t)
+	defer func() {
+		_ = os.RemoveAll(folder)
+	}()
+
+	mr, err := recorder.CreateMessageRecorder(config.ConsensusRecorderConfig{}, folder, &mock.RounderMock{}, &mock.SyncTimerMock{})
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(mr))
+
+	cfg := config.ConsensusRecorderConfig{
+		Enabled:         true,
+		FolderPath:      "records",
+		MaxFileSizeInMB: 1,
+		MaxFiles:        1,
+	}
+	mr, err = recorder.CreateMessageRecorder(cfg, folder, &mock.RounderMock{}, &mock.SyncTimerMock{})
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(mr))
+	assert.Nil(t, mr.Close())
+
+	_, err = os.Stat(filepath.Join(folder, "records"))
+	assert.Nil(t, err)
+}
@@ -4,3 +4,9 @@ import "errors"
 
 // ErrNilSyncTimer is raised when a valid sync timer is expected but nil used
 var ErrNilSyncTimer = errors.New("sync timer is nil")
+
+// ErrInvalidRoundDuration signals that an invalid round duration has been provided
+var ErrInvalidRoundDuration = errors.New("invalid round duration")
+
+// ErrUnsortedRoundsSchedule signals that the rounds schedule is not sorted by the start round
+var ErrUnsortedRoundsSchedule = errors.New("rounds schedule is not sorted by the start round")
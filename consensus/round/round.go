@@ -2,6 +2,7 @@ package round
 
 import (
 	"math"
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -13,11 +14,14 @@ var _ consensus.Rounder = (*round)(nil)
 
 // round defines the data needed by the rounder
 type round struct {
-	index        int64         // represents the index of the round in the current chronology (current time - genesis time) / round duration
-	timeStamp    time.Time     // represents the start time of the round in the current chronology genesis time + round index * round duration
-	timeDuration time.Duration // represents the duration of the round in current chronology
-	syncTimer    ntp.SyncTimer
-	startRound   int64
+	index            int64         // represents the index of the round in the current chronology (current time - genesis time) / round duration
+	timeStamp        time.Time     // represents the start time of the round in the current chronology genesis time + round index * round duration
+	timeDuration     time.Duration // represents the duration of the round in current chronology
+	genesisTimeStamp time.Time
+	syncTimer        ntp.SyncTimer
+	startRound       int64
+	schedule         []consensus.RoundDuration
+	mut              sync.RWMutex
 }
 
 // NewRound defines a new round object
@@ -34,47 +38,141 @@ func NewRound(
 	}
 
 	rnd := round{
-		timeDuration: roundTimeDuration,
-		timeStamp:    genesisTimeStamp,
-		syncTimer:    syncTimer,
-		startRound:   startRound,
+		timeDuration:     roundTimeDuration,
+		timeStamp:        genesisTimeStamp,
+		genesisTimeStamp: genesisTimeStamp,
+		syncTimer:        syncTimer,
+		startRound:       startRound,
+		schedule: []consensus.RoundDuration{
+			{
+				StartRound: startRound,
+				Duration:   roundTimeDuration,
+			},
+		},
 	}
 	rnd.UpdateRound(genesisTimeStamp, currentTimeStamp)
 	return &rnd, nil
 }
 
+// SetSchedule replaces the rounds schedule, after the genesis round duration, with the given round durations, which
+// should be sorted by their start round and start after the start round
+func (rnd *round) SetSchedule(roundDurations []consensus.RoundDuration) error {
+	rnd.mut.Lock()
+	defer rnd.mut.Unlock()
+
+	schedule := make([]consensus.RoundDuration, 1, len(roundDurations)+1)
+	schedule[0] = rnd.schedule[0]
+	for _, roundDuration := range roundDurations {
+		if roundDuration.Duration <= 0 {
+			return ErrInvalidRoundDuration
+		}
+		if roundDuration.StartRound <= schedule[len(schedule)-1].StartRound {
+			return ErrUnsortedRoundsSchedule
+		}
+
+		schedule = append(schedule, roundDuration)
+	}
+
+	rnd.schedule = schedule
+	rnd.index = math.MinInt64
+	rnd.updateRound(rnd.genesisTimeStamp, rnd.syncTimer.CurrentTime())
+
+	return nil
+}
+
 // UpdateRound updates the index and the time stamp of the round depending of the genesis time and the current time given
 func (rnd *round) UpdateRound(genesisTimeStamp time.Time, currentTimeStamp time.Time) {
-	delta := currentTimeStamp.Sub(genesisTimeStamp).Nanoseconds()
+	rnd.mut.Lock()
+	rnd.updateRound(genesisTimeStamp, currentTimeStamp)
+	rnd.mut.Unlock()
+}
 
-	index := int64(math.Floor(float64(delta)/float64(rnd.timeDuration.Nanoseconds()))) + rnd.startRound
+func (rnd *round) updateRound(genesisTimeStamp time.Time, currentTimeStamp time.Time) {
+	roundDuration := rnd.schedule[0]
+	roundDurationStartTime := genesisTimeStamp
+	for _, nextRoundDuration := range rnd.schedule[1:] {
+		nextStartTime := roundDurationStartTime.Add(time.Duration(nextRoundDuration.StartRound-roundDuration.StartRound) * roundDuration.Duration)
+		if currentTimeStamp.Before(nextStartTime) {
+			break
+		}
+
+		roundDuration = nextRoundDuration
+		roundDurationStartTime = nextStartTime
+	}
+
+	delta := currentTimeStamp.Sub(roundDurationStartTime).Nanoseconds()
+
+	index := int64(math.Floor(float64(delta)/float64(roundDuration.Duration.Nanoseconds()))) + roundDuration.StartRound
 
 	if rnd.index != index {
 		rnd.index = index
-		rnd.timeStamp = genesisTimeStamp.Add(time.Duration((index - rnd.startRound) * rnd.timeDuration.Nanoseconds()))
+		rnd.timeStamp = roundDurationStartTime.Add(time.Duration((index - roundDuration.StartRound) * roundDuration.Duration.Nanoseconds()))
+		rnd.timeDuration = roundDuration.Duration
 	}
 }
 
 // Index returns the index of the round in current epoch
 func (rnd *round) Index() int64 {
+	rnd.mut.RLock()
+	defer rnd.mut.RUnlock()
+
 	return rnd.index
 }
 
 // BeforeGenesis returns true if round index is before start round
 func (rnd *round) BeforeGenesis() bool {
+	rnd.mut.RLock()
+	defer rnd.mut.RUnlock()
+
 	return rnd.index <= rnd.startRound
 }
 
 // TimeStamp returns the time stamp of the round
 func (rnd *round) TimeStamp() time.Time {
+	rnd.mut.RLock()
+	defer rnd.mut.RUnlock()
+
 	return rnd.timeStamp
 }
 
 // TimeDuration returns the duration of the round
 func (rnd *round) TimeDuration() time.Duration {
+	rnd.mut.RLock()
+	defer rnd.mut.RUnlock()
+
 	return rnd.timeDuration
 }
 
+// TimeStampForRound returns the start time of the given round, computed from the rounds schedule
+func (rnd *round) TimeStampForRound(round int64) time.Time {
+	rnd.mut.RLock()
+	defer rnd.mut.RUnlock()
+
+	roundDuration := rnd.schedule[0]
+	roundDurationStartTime := rnd.genesisTimeStamp
+	for _, nextRoundDuration := range rnd.schedule[1:] {
+		if round < nextRoundDuration.StartRound {
+			break
+		}
+
+		roundDurationStartTime = roundDurationStartTime.Add(time.Duration(nextRoundDuration.StartRound-roundDuration.StartRound) * roundDuration.Duration)
+		roundDuration = nextRoundDuration
+	}
+
+	return roundDurationStartTime.Add(time.Duration(round-roundDuration.StartRound) * roundDuration.Duration)
+}
+
+// Schedule returns the rounds schedule, the first entry holding the genesis round duration
+func (rnd *round) Schedule() []consensus.RoundDuration {
+	rnd.mut.RLock()
+	defer rnd.mut.RUnlock()
+
+	schedule := make([]consensus.RoundDuration, len(rnd.schedule))
+	copy(schedule, rnd.schedule)
+
+	return schedule
+}
+
 // RemainingTime returns the remaining time in the current round given by the current time, round start time and
 // safe threshold percent
 func (rnd *round) RemainingTime(startTime time.Time, maxTime time.Duration) time.Duration {
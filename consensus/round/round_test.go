@@ -1,9 +1,11 @@
 package round_test
 
 import (
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -153,3 +155,121 @@ func TestRound_RemainingTimeInCurrentRoundShouldReturnNegativeValue(t *testing.T
 	assert.Equal(t, time.Duration(int64(rnd.TimeDuration())-timeElapsed), remainingTime)
 	assert.True(t, remainingTime < 0)
 }
+
+func TestRound_SetScheduleInvalidRoundDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+
+	err := rnd.SetSchedule([]consensus.RoundDuration{{StartRound: 10, Duration: 0}})
+
+	assert.Equal(t, round.ErrInvalidRoundDuration, err)
+	assert.Equal(t, 1, len(rnd.Schedule()))
+}
+
+func TestRound_SetScheduleUnsortedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 5)
+
+	err := rnd.SetSchedule([]consensus.RoundDuration{{StartRound: 5, Duration: roundTimeDuration}})
+	assert.Equal(t, round.ErrUnsortedRoundsSchedule, err)
+
+	err = rnd.SetSchedule([]consensus.RoundDuration{
+		{StartRound: 20, Duration: roundTimeDuration},
+		{StartRound: 10, Duration: roundTimeDuration},
+	})
+	assert.Equal(t, round.ErrUnsortedRoundsSchedule, err)
+}
+
+func TestRound_UpdateRoundShouldFollowTheSchedule(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+
+	err := rnd.SetSchedule([]consensus.RoundDuration{
+		{StartRound: 10, Duration: 2 * roundTimeDuration},
+		{StartRound: 20, Duration: roundTimeDuration / 2},
+	})
+	assert.Nil(t, err)
+
+	rnd.UpdateRound(genesisTime, genesisTime.Add(9*roundTimeDuration+roundTimeDuration/2))
+	assert.Equal(t, int64(9), rnd.Index())
+	assert.Equal(t, roundTimeDuration, rnd.TimeDuration())
+
+	// rounds 10 to 19 last double the genesis duration, so round 10 starts at 10 and round 11 at 12 genesis durations
+	rnd.UpdateRound(genesisTime, genesisTime.Add(12*roundTimeDuration))
+	assert.Equal(t, int64(11), rnd.Index())
+	assert.Equal(t, genesisTime.Add(12*roundTimeDuration), rnd.TimeStamp())
+	assert.Equal(t, 2*roundTimeDuration, rnd.TimeDuration())
+
+	// round 20 starts at 30 genesis durations
+	rnd.UpdateRound(genesisTime, genesisTime.Add(31*roundTimeDuration))
+	assert.Equal(t, int64(22), rnd.Index())
+	assert.Equal(t, genesisTime.Add(31*roundTimeDuration), rnd.TimeStamp())
+	assert.Equal(t, roundTimeDuration/2, rnd.TimeDuration())
+}
+
+func TestRound_TimeStampForRoundShouldFollowTheSchedule(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+	_ = rnd.SetSchedule([]consensus.RoundDuration{
+		{StartRound: 10, Duration: 2 * roundTimeDuration},
+		{StartRound: 20, Duration: roundTimeDuration / 2},
+	})
+
+	assert.Equal(t, genesisTime.Add(5*roundTimeDuration), rnd.TimeStampForRound(5))
+	assert.Equal(t, genesisTime.Add(10*roundTimeDuration), rnd.TimeStampForRound(10))
+	assert.Equal(t, genesisTime.Add(14*roundTimeDuration), rnd.TimeStampForRound(12))
+	assert.Equal(t, genesisTime.Add(30*roundTimeDuration), rnd.TimeStampForRound(20))
+	assert.Equal(t, genesisTime.Add(32*roundTimeDuration), rnd.TimeStampForRound(24))
+
+	schedule := rnd.Schedule()
+	assert.Equal(t, 3, len(schedule))
+	assert.Equal(t, consensus.RoundDuration{StartRound: 0, Duration: roundTimeDuration}, schedule[0])
+}
+
+func TestRound_SetScheduleShouldReplaceThePreviousOne(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+	_ = rnd.SetSchedule([]consensus.RoundDuration{{StartRound: 10, Duration: 2 * roundTimeDuration}})
+	_ = rnd.SetSchedule([]consensus.RoundDuration{
+		{StartRound: 10, Duration: 2 * roundTimeDuration},
+		{StartRound: 20, Duration: roundTimeDuration / 2},
+	})
+
+	schedule := rnd.Schedule()
+	assert.Equal(t, 3, len(schedule))
+	assert.Equal(t, consensus.RoundDuration{StartRound: 20, Duration: roundTimeDuration / 2}, schedule[2])
+}
+
+func TestRound_SetScheduleConcurrentWithUpdateRoundShouldWork(t *testing.T) {
+	t.Parallel()
+
+	genesisTime := time.Unix(0, 0)
+	rnd, _ := round.NewRound(genesisTime, genesisTime, roundTimeDuration, &mock.SyncTimerMock{}, 0)
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(2 * numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			_ = rnd.SetSchedule([]consensus.RoundDuration{{StartRound: int64(idx + 1), Duration: 2 * roundTimeDuration}})
+			wg.Done()
+		}(i)
+		go func(idx int) {
+			rnd.UpdateRound(genesisTime, genesisTime.Add(time.Duration(idx)*roundTimeDuration))
+			_ = rnd.Index()
+			_ = rnd.TimeStampForRound(int64(idx))
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,21 @@
+package round
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// CreateSchedule converts the rounds schedule from an epoch start block into the round durations of the rounder
+func CreateSchedule(roundsSchedule []block.RoundDurationChange) []consensus.RoundDuration {
+	schedule := make([]consensus.RoundDuration, 0, len(roundsSchedule))
+	for _, roundDurationChange := range roundsSchedule {
+		schedule = append(schedule, consensus.RoundDuration{
+			StartRound: int64(roundDurationChange.StartRound),
+			Duration:   time.Duration(roundDurationChange.RoundDurationInMs) * time.Millisecond,
+		})
+	}
+
+	return schedule
+}
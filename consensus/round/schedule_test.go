@@ -0,0 +1,33 @@
+package round_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSchedule_EmptyShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	schedule := round.CreateSchedule(nil)
+	assert.Equal(t, 0, len(schedule))
+}
+
+func TestCreateSchedule_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	schedule := round.CreateSchedule([]block.RoundDurationChange{
+		{StartRound: 100, RoundDurationInMs: 5000},
+		{StartRound: 200, RoundDurationInMs: 4000},
+	})
+
+	expectedSchedule := []consensus.RoundDuration{
+		{StartRound: 100, Duration: 5 * time.Second},
+		{StartRound: 200, Duration: 4 * time.Second},
+	}
+	assert.Equal(t, expectedSchedule, schedule)
+}
@@ -3,10 +3,12 @@ package bls
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -18,9 +20,14 @@ type factory struct {
 	worker         spos.WorkerHandler
 
 	appStatusHandler core.AppStatusHandler
+	tracer           core.Tracer
 	indexer          indexer.Indexer
 	chainID          []byte
 	currentPid       core.PeerID
+
+	backupLeaderTimeFraction float64
+	adaptiveSubroundsConfig  config.AdaptiveSubroundsConfig
+	subroundsTiming          spos.SubroundsTimingHandler
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -46,6 +53,7 @@ func NewSubroundsFactory(
 		consensusState:   consensusState,
 		worker:           worker,
 		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		tracer:           tracing.NewDisabledTracer(),
 		chainID:          chainID,
 		currentPid:       currentPid,
 	}
@@ -86,6 +94,39 @@ func (fct *factory) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return fct.worker.SetAppStatusHandler(ash)
 }
 
+// SetTracer method will update the value of the factory's tracer
+func (fct *factory) SetTracer(tracer core.Tracer) error {
+	if check.IfNil(tracer) {
+		return spos.ErrNilTracer
+	}
+	fct.tracer = tracer
+
+	return nil
+}
+
+// SetBackupLeaderTimeFraction method will update the fraction of the subround Block after which the backup leader
+// proposes the block. A value of 0 disables the backup leader
+func (fct *factory) SetBackupLeaderTimeFraction(timeFraction float64) error {
+	if timeFraction < 0 || timeFraction >= 1 {
+		return spos.ErrInvalidBackupLeaderTimeFraction
+	}
+	fct.backupLeaderTimeFraction = timeFraction
+
+	return nil
+}
+
+// SetAdaptiveSubroundsConfig method will update the config used to adapt the end of the subround Block to the
+// measured block proposal times
+func (fct *factory) SetAdaptiveSubroundsConfig(adaptiveSubroundsConfig config.AdaptiveSubroundsConfig) error {
+	_, err := fct.createSubroundsTiming(adaptiveSubroundsConfig)
+	if err != nil {
+		return err
+	}
+	fct.adaptiveSubroundsConfig = adaptiveSubroundsConfig
+
+	return nil
+}
+
 // SetIndexer method will update the value of the factory's indexer
 func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
@@ -97,7 +138,13 @@ func (fct *factory) GenerateSubrounds() error {
 	fct.consensusCore.Chronology().RemoveAllSubrounds()
 	fct.worker.RemoveAllReceivedMessagesCalls()
 
-	err := fct.generateStartRoundSubround()
+	subroundsTiming, err := fct.createSubroundsTiming(fct.adaptiveSubroundsConfig)
+	if err != nil {
+		return err
+	}
+	fct.subroundsTiming = subroundsTiming
+
+	err = fct.generateStartRoundSubround()
 	if err != nil {
 		return err
 	}
@@ -148,6 +195,16 @@ func (fct *factory) generateStartRoundSubround() error {
 		return err
 	}
 
+	err = subround.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
 	subroundStartRound, err := NewSubroundStartRound(
 		subround,
 		fct.worker.Extend,
@@ -189,6 +246,16 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subround.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
 	subroundBlock, err := NewSubroundBlock(
 		subround,
 		fct.worker.Extend,
@@ -198,6 +265,11 @@ func (fct *factory) generateBlockSubround() error {
 		return err
 	}
 
+	err = subroundBlock.SetBackupLeaderTimeFraction(fct.backupLeaderTimeFraction)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockBodyAndHeader, subroundBlock.receivedBlockBodyAndHeader)
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.receivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.receivedBlockHeader)
@@ -238,6 +310,16 @@ func (fct *factory) generateSignatureSubround() error {
 		return err
 	}
 
+	err = subroundSignatureObject.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subroundSignatureObject.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtSignature, subroundSignatureObject.receivedSignature)
 	fct.consensusCore.Chronology().AddSubround(subroundSignatureObject)
 
@@ -278,6 +360,16 @@ func (fct *factory) generateEndRoundSubround() error {
 		return err
 	}
 
+	err = subroundEndRoundObject.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subroundEndRoundObject.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockHeaderFinalInfo, subroundEndRoundObject.receivedBlockHeaderFinalInfo)
 	fct.worker.AddReceivedHeaderHandler(subroundEndRoundObject.receivedHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
@@ -285,6 +377,25 @@ func (fct *factory) generateEndRoundSubround() error {
 	return nil
 }
 
+func (fct *factory) createSubroundsTiming(
+	adaptiveSubroundsConfig config.AdaptiveSubroundsConfig,
+) (spos.SubroundsTimingHandler, error) {
+	args := spos.ArgSubroundsTiming{
+		Rounder: fct.consensusCore.Rounder(),
+		Subrounds: map[int]spos.SubroundTimes{
+			SrStartRound: {StartTime: srStartStartTime, EndTime: srStartEndTime},
+			SrBlock:      {StartTime: srBlockStartTime, EndTime: srBlockEndTime},
+			SrSignature:  {StartTime: srSignatureStartTime, EndTime: srSignatureEndTime},
+			SrEndRound:   {StartTime: srEndStartTime, EndTime: srEndEndTime},
+		},
+		BlockSubroundID: SrBlock,
+		NextSubroundID:  SrSignature,
+		Config:          adaptiveSubroundsConfig,
+	}
+
+	return spos.NewSubroundsTiming(args)
+}
+
 func (fct *factory) initConsensusThreshold() {
 	pbftThreshold := fct.consensusState.ConsensusGroupSize()*2/3 + 1
 	fct.consensusState.SetThreshold(SrBlock, 1)
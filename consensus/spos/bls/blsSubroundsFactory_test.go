@@ -1,10 +1,12 @@
 package bls_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
@@ -520,6 +522,53 @@ func TestFactory_GenerateSubroundsShouldWork(t *testing.T) {
 	assert.Equal(t, 4, subroundHandlers)
 }
 
+func TestFactory_SetAdaptiveSubroundsConfigInvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+	err := fct.SetAdaptiveSubroundsConfig(config.AdaptiveSubroundsConfig{
+		Enabled:             true,
+		NumRoundsToMeasure:  10,
+		SafetyFactor:        1.5,
+		MinBlockSubroundEnd: 0.15,
+		MaxBlockSubroundEnd: 0.9,
+	})
+
+	assert.True(t, errors.Is(err, spos.ErrInvalidAdaptiveSubroundsConfig))
+}
+
+func TestFactory_GenerateSubroundsShouldSetTheAdaptiveBlockSubroundEnd(t *testing.T) {
+	t.Parallel()
+
+	subroundHandlers := make(map[int]consensus.SubroundHandler)
+	chrm := &mock.ChronologyHandlerMock{}
+	chrm.AddSubroundCalled = func(subroundHandler consensus.SubroundHandler) {
+		subroundHandlers[subroundHandler.Current()] = subroundHandler
+	}
+	container := mock.InitConsensusCore()
+	container.SetChronology(chrm)
+	fct := *initFactoryWithContainer(container)
+	err := fct.SetAdaptiveSubroundsConfig(config.AdaptiveSubroundsConfig{
+		Enabled:             true,
+		NumRoundsToMeasure:  10,
+		SafetyFactor:        1.5,
+		MinBlockSubroundEnd: 0.15,
+		MaxBlockSubroundEnd: 0.5,
+	})
+	assert.Nil(t, err)
+
+	err = fct.GenerateSubrounds()
+	assert.Nil(t, err)
+
+	roundDuration := container.Rounder().TimeDuration()
+	srBlock := subroundHandlers[bls.SrBlock].(interface{ AddProposalMeasurement(time.Duration) })
+	srBlock.AddProposalMeasurement(roundDuration / 5)
+
+	expectedBlockSubroundEnd := int64(float64(roundDuration) * 0.3)
+	assert.Equal(t, expectedBlockSubroundEnd, subroundHandlers[bls.SrBlock].EndTime())
+	assert.Equal(t, expectedBlockSubroundEnd, subroundHandlers[bls.SrSignature].StartTime())
+}
+
 func TestFactory_SetAppStatusHandlerNilStatusHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
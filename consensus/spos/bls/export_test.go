@@ -163,6 +163,11 @@ func (sr *subroundBlock) DoBlockJob() bool {
 	return sr.doBlockJob()
 }
 
+// DoBackupLeaderJob method proposes the block as backup leader if nothing was received from the leader
+func (sr *subroundBlock) DoBackupLeaderJob() bool {
+	return sr.doBackupLeaderJob()
+}
+
 // ProcessReceivedBlock method processes the received proposed block in the subround Block
 func (sr *subroundBlock) ProcessReceivedBlock(cnsDta *consensus.Message) bool {
 	return sr.processReceivedBlock(cnsDta)
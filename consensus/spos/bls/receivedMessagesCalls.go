@@ -0,0 +1,31 @@
+package bls
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+// AddReceivedMessagesCalls registers on the worker the handlers of the block subround for the provided message types.
+// It lets the consensus types which reuse the BLS block subround map it on their own message types
+func (sr *subroundBlock) AddReceivedMessagesCalls(
+	worker spos.WorkerHandler,
+	mtBlockBodyAndHeader consensus.MessageType,
+	mtBlockBody consensus.MessageType,
+	mtBlockHeader consensus.MessageType,
+) {
+	worker.AddReceivedMessageCall(mtBlockBodyAndHeader, sr.receivedBlockBodyAndHeader)
+	worker.AddReceivedMessageCall(mtBlockBody, sr.receivedBlockBody)
+	worker.AddReceivedMessageCall(mtBlockHeader, sr.receivedBlockHeader)
+}
+
+// AddReceivedMessagesCalls registers on the worker the handler of the signature subround for the provided message type
+func (sr *subroundSignature) AddReceivedMessagesCalls(worker spos.WorkerHandler, mtSignature consensus.MessageType) {
+	worker.AddReceivedMessageCall(mtSignature, sr.receivedSignature)
+}
+
+// AddReceivedMessagesCalls registers on the worker the handlers of the end round subround for the provided message
+// type and for the headers received through the headers pool
+func (sr *subroundEndRound) AddReceivedMessagesCalls(worker spos.WorkerHandler, mtBlockHeaderFinalInfo consensus.MessageType) {
+	worker.AddReceivedMessageCall(mtBlockHeaderFinalInfo, sr.receivedBlockHeaderFinalInfo)
+	worker.AddReceivedHeaderHandler(sr.receivedHeader)
+}
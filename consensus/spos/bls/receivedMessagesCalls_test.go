@@ -0,0 +1,60 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRecordingWorker(registered map[consensus.MessageType]bool, numHeaderHandlers *int) *mock.SposWorkerMock {
+	return &mock.SposWorkerMock{
+		AddReceivedMessageCallCalled: func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {
+			registered[messageType] = receivedMessageCall != nil
+		},
+		AddReceivedHeaderHandlerCalled: func(handler func(data.HeaderHandler)) {
+			*numHeaderHandlers++
+		},
+	}
+}
+
+func TestSubroundBlock_AddReceivedMessagesCallsShouldRegisterTheProvidedMessageTypes(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[consensus.MessageType]bool)
+	numHeaderHandlers := 0
+	sr := *initSubroundBlock(nil, mock.InitConsensusCore())
+
+	sr.AddReceivedMessagesCalls(createRecordingWorker(registered, &numHeaderHandlers), 10, 11, 12)
+
+	assert.Equal(t, map[consensus.MessageType]bool{10: true, 11: true, 12: true}, registered)
+	assert.Equal(t, 0, numHeaderHandlers)
+}
+
+func TestSubroundSignature_AddReceivedMessagesCallsShouldRegisterTheProvidedMessageType(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[consensus.MessageType]bool)
+	numHeaderHandlers := 0
+	sr := *initSubroundSignature()
+
+	sr.AddReceivedMessagesCalls(createRecordingWorker(registered, &numHeaderHandlers), 20)
+
+	assert.Equal(t, map[consensus.MessageType]bool{20: true}, registered)
+	assert.Equal(t, 0, numHeaderHandlers)
+}
+
+func TestSubroundEndRound_AddReceivedMessagesCallsShouldRegisterTheProvidedMessageTypeAndHeaderHandler(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[consensus.MessageType]bool)
+	numHeaderHandlers := 0
+	sr := *initSubroundEndRound()
+
+	sr.AddReceivedMessagesCalls(createRecordingWorker(registered, &numHeaderHandlers), 30)
+
+	assert.Equal(t, map[consensus.MessageType]bool{30: true}, registered)
+	assert.Equal(t, 1, numHeaderHandlers)
+}
@@ -0,0 +1,319 @@
+package bls
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+// ReplayEvent holds the outcome of replaying one recorded consensus message
+type ReplayEvent struct {
+	SinceRoundStart time.Duration
+	Subround        string
+	Direction       string
+	MsgType         string
+	PubKey          []byte
+	BlockHeaderHash []byte
+	Accepted        bool
+	Reason          string
+}
+
+// ReplayReport holds the outcome of replaying a recorded round
+type ReplayReport struct {
+	Round              int64
+	RoundDuration      time.Duration
+	Leader             []byte
+	SelfPubKey         []byte
+	ConsensusGroupSize int
+	Timeline           []*ReplayEvent
+	BlockHeaderHash    []byte
+	BlockReceivedAt    time.Duration
+	SignatureThreshold int
+	NumSignatures      int
+	MissingSigners     [][]byte
+	FinalInfoReceived  bool
+	ConsensusReached   bool
+	Reason             string
+	SubroundsRecorded  bool
+}
+
+// roundReplayer applies, offline, the rules of the BLS subrounds on the messages recorded for a round. The
+// signatures themselves are not recorded so they are not verified again: the replay only checks the senders,
+// the block header hashes and the moments the messages arrived at against the subrounds boundaries the node
+// recorded for that round. The static BLS subrounds timing is used only for the records holding no subrounds
+type roundReplayer struct {
+	consensusState *spos.ConsensusState
+	roundDuration  time.Duration
+	subrounds      []*recorder.SubroundRecord
+	report         *ReplayReport
+	pendingSigs    []*pendingSignature
+}
+
+type pendingSignature struct {
+	msg   *recorder.MessageRecord
+	event *ReplayEvent
+}
+
+// ReplayRound replays the provided recorded round against a consensus state built from the recorded consensus group
+func ReplayRound(recordedRound *recorder.RecordedRound) (*ReplayReport, error) {
+	if recordedRound == nil || recordedRound.Round == nil {
+		return nil, recorder.ErrNilRecordedRound
+	}
+
+	rr := newRoundReplayer(recordedRound.Round, recordedRound.Subrounds)
+	for _, msg := range recordedRound.Messages {
+		rr.replayMessage(msg)
+	}
+	rr.finish()
+
+	return rr.report, nil
+}
+
+func newRoundReplayer(round *recorder.RoundRecord, subrounds []*recorder.SubroundRecord) *roundReplayer {
+	consensusGroup := make([]string, 0, len(round.ConsensusGroup))
+	eligibleList := make(map[string]struct{})
+	for _, pubKey := range round.ConsensusGroup {
+		consensusGroup = append(consensusGroup, string(pubKey))
+		eligibleList[string(pubKey)] = struct{}{}
+	}
+
+	roundConsensus := spos.NewRoundConsensus(eligibleList, len(consensusGroup), string(round.SelfPubKey))
+	roundConsensus.SetConsensusGroup(consensusGroup)
+	roundConsensus.ResetRoundState()
+
+	signatureThreshold := len(consensusGroup)*2/3 + 1
+	roundThreshold := spos.NewRoundThreshold()
+	roundThreshold.SetThreshold(SrBlock, 1)
+	roundThreshold.SetThreshold(SrSignature, signatureThreshold)
+
+	roundStatus := spos.NewRoundStatus()
+	roundStatus.ResetRoundStatus()
+
+	consensusState := spos.NewConsensusState(roundConsensus, roundThreshold, roundStatus)
+	consensusState.RoundIndex = round.Round
+	consensusState.SetStatus(SrStartRound, spos.SsFinished)
+
+	return &roundReplayer{
+		consensusState: consensusState,
+		roundDuration:  round.RoundDuration,
+		subrounds:      subrounds,
+		report: &ReplayReport{
+			Round:              round.Round,
+			RoundDuration:      round.RoundDuration,
+			Leader:             round.Leader,
+			SelfPubKey:         round.SelfPubKey,
+			ConsensusGroupSize: len(consensusGroup),
+			Timeline:           make([]*ReplayEvent, 0),
+			SignatureThreshold: signatureThreshold,
+			MissingSigners:     make([][]byte, 0),
+			SubroundsRecorded:  len(subrounds) > 0,
+		},
+		pendingSigs: make([]*pendingSignature, 0),
+	}
+}
+
+func (rr *roundReplayer) replayMessage(msg *recorder.MessageRecord) {
+	if msg == nil {
+		return
+	}
+
+	event := &ReplayEvent{
+		SinceRoundStart: msg.SinceRoundStart,
+		Subround:        rr.subroundName(msg.SinceRoundStart),
+		Direction:       msg.Direction,
+		MsgType:         getStringValue(consensus.MessageType(msg.MsgType)),
+		PubKey:          msg.PubKey,
+		BlockHeaderHash: msg.BlockHeaderHash,
+	}
+	rr.report.Timeline = append(rr.report.Timeline, event)
+
+	if len(msg.Error) > 0 {
+		event.Reason = fmt.Sprintf("rejected by the worker: %s", msg.Error)
+		return
+	}
+
+	switch consensus.MessageType(msg.MsgType) {
+	case MtBlockBodyAndHeader, MtBlockHeader:
+		rr.replayBlockHeader(msg, event)
+	case MtBlockBody:
+		rr.replayBlockBody(msg, event)
+	case MtSignature:
+		rr.replaySignature(msg, event, msg.SinceRoundStart)
+	case MtBlockHeaderFinalInfo:
+		rr.replayFinalInfo(msg, event)
+	default:
+		event.Reason = "unknown message type"
+	}
+}
+
+func (rr *roundReplayer) replayBlockHeader(msg *recorder.MessageRecord, event *ReplayEvent) {
+	leader := string(msg.PubKey)
+	if !rr.consensusState.IsNodeLeaderInCurrentRound(leader) {
+		event.Reason = "sender is not the leader of the round"
+		return
+	}
+	if rr.consensusState.IsConsensusDataSet() {
+		event.Reason = "block header already received"
+		return
+	}
+	if msg.SinceRoundStart > rr.subroundEnd(SrBlock, srBlockEndTime) {
+		event.Reason = "received after the end of subround block"
+		return
+	}
+
+	event.Accepted = true
+	rr.consensusState.Data = msg.BlockHeaderHash
+	rr.report.BlockHeaderHash = msg.BlockHeaderHash
+	rr.report.BlockReceivedAt = msg.SinceRoundStart
+	_ = rr.consensusState.SetJobDone(leader, SrBlock, true)
+	rr.consensusState.SetStatus(SrBlock, spos.SsFinished)
+
+	// the leader signs its own proposal without broadcasting the signature share
+	_ = rr.consensusState.SetJobDone(leader, SrSignature, true)
+
+	pendingSigs := rr.pendingSigs
+	rr.pendingSigs = make([]*pendingSignature, 0)
+	for _, pendingSig := range pendingSigs {
+		rr.replaySignature(pendingSig.msg, pendingSig.event, msg.SinceRoundStart)
+	}
+}
+
+func (rr *roundReplayer) replayBlockBody(msg *recorder.MessageRecord, event *ReplayEvent) {
+	if !rr.consensusState.IsNodeLeaderInCurrentRound(string(msg.PubKey)) {
+		event.Reason = "sender is not the leader of the round"
+		return
+	}
+
+	event.Accepted = true
+}
+
+// replaySignature checks a signature share. As the worker stores the messages it can not process yet, a signature
+// received before the block header is executed, at the latest, when the block header is received
+func (rr *roundReplayer) replaySignature(msg *recorder.MessageRecord, event *ReplayEvent, executedAt time.Duration) {
+	pubKey := string(msg.PubKey)
+	if !rr.consensusState.IsNodeInConsensusGroup(pubKey) {
+		event.Reason = "sender is not in the consensus group"
+		return
+	}
+	if !rr.consensusState.IsSubroundFinished(SrBlock) {
+		event.Reason = "waiting for the block header"
+		rr.pendingSigs = append(rr.pendingSigs, &pendingSignature{msg: msg, event: event})
+		return
+	}
+	if !rr.consensusState.IsConsensusDataEqual(msg.BlockHeaderHash) {
+		event.Reason = "signature for another block header hash"
+		return
+	}
+	if rr.consensusState.IsJobDone(pubKey, SrSignature) {
+		event.Reason = "signature already received"
+		return
+	}
+	if executedAt > rr.subroundEnd(SrSignature, srSignatureEndTime) {
+		event.Reason = "received after the end of subround signature"
+		return
+	}
+
+	event.Accepted = true
+	event.Reason = ""
+	_ = rr.consensusState.SetJobDone(pubKey, SrSignature, true)
+}
+
+func (rr *roundReplayer) replayFinalInfo(msg *recorder.MessageRecord, event *ReplayEvent) {
+	if !rr.consensusState.IsNodeLeaderInCurrentRound(string(msg.PubKey)) {
+		event.Reason = "sender is not the leader of the round"
+		return
+	}
+	if !rr.consensusState.IsConsensusDataEqual(msg.BlockHeaderHash) {
+		event.Reason = "final info for another block header hash"
+		return
+	}
+
+	event.Accepted = true
+	rr.report.FinalInfoReceived = true
+}
+
+func (rr *roundReplayer) finish() {
+	for _, pendingSig := range rr.pendingSigs {
+		pendingSig.event.Reason = "block header never received"
+	}
+
+	rr.report.NumSignatures = rr.consensusState.ComputeSize(SrSignature)
+	for _, pubKey := range rr.consensusState.ConsensusGroup() {
+		if !rr.consensusState.IsJobDone(pubKey, SrSignature) {
+			rr.report.MissingSigners = append(rr.report.MissingSigners, []byte(pubKey))
+		}
+	}
+
+	switch {
+	case !rr.consensusState.IsSubroundFinished(SrBlock):
+		rr.report.Reason = "no valid block header was received from the leader in subround block"
+	case rr.report.NumSignatures < rr.report.SignatureThreshold:
+		rr.report.Reason = fmt.Sprintf("not enough signatures: %d received, %d needed",
+			rr.report.NumSignatures, rr.report.SignatureThreshold)
+	default:
+		rr.report.ConsensusReached = true
+		rr.report.Reason = "enough signatures were received for the proposed block"
+	}
+}
+
+// subroundEnd returns the end the provided subround had in the recorded round. A subround the node did not reach
+// ends, for the replay, together with the last subround the node ran
+func (rr *roundReplayer) subroundEnd(subroundID int, staticEndTime float64) time.Duration {
+	if len(rr.subrounds) == 0 {
+		return rr.staticTime(staticEndTime)
+	}
+
+	lastEnd := time.Duration(0)
+	for _, subround := range rr.subrounds {
+		if subround.ID == subroundID {
+			return subround.EndTime
+		}
+		if subround.EndTime > lastEnd {
+			lastEnd = subround.EndTime
+		}
+	}
+
+	return lastEnd
+}
+
+func (rr *roundReplayer) staticTime(fraction float64) time.Duration {
+	return time.Duration(float64(rr.roundDuration) * fraction)
+}
+
+func (rr *roundReplayer) subroundName(sinceRoundStart time.Duration) string {
+	if sinceRoundStart < 0 {
+		return "(BEFORE_ROUND)"
+	}
+	if sinceRoundStart >= rr.roundDuration {
+		return "(AFTER_ROUND)"
+	}
+	if len(rr.subrounds) == 0 {
+		return rr.staticSubroundName(sinceRoundStart)
+	}
+
+	for _, subround := range rr.subrounds {
+		if sinceRoundStart >= subround.StartTime && sinceRoundStart < subround.EndTime {
+			return subround.Name
+		}
+	}
+
+	return "(NO_SUBROUND)"
+}
+
+func (rr *roundReplayer) staticSubroundName(sinceRoundStart time.Duration) string {
+	switch {
+	case sinceRoundStart < rr.staticTime(srStartEndTime):
+		return getSubroundName(SrStartRound)
+	case sinceRoundStart < rr.staticTime(srBlockEndTime):
+		return getSubroundName(SrBlock)
+	case sinceRoundStart < rr.staticTime(srSignatureEndTime):
+		return getSubroundName(SrSignature)
+	case sinceRoundStart < rr.staticTime(srEndEndTime):
+		return getSubroundName(SrEndRound)
+	default:
+		return "(AFTER_ROUND)"
+	}
+}
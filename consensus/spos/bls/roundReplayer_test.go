@@ -0,0 +1,194 @@
+package bls_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const replayRoundDuration = 4 * time.Second
+
+func createRecordedRound(messages ...*recorder.MessageRecord) *recorder.RecordedRound {
+	consensusGroup := make([][]byte, 0)
+	for _, pubKey := range []string{"A", "B", "C", "D", "E", "F", "G", "H", "I"} {
+		consensusGroup = append(consensusGroup, []byte(pubKey))
+	}
+
+	return &recorder.RecordedRound{
+		Round: &recorder.RoundRecord{
+			Round:          7,
+			RoundDuration:  replayRoundDuration,
+			ConsensusGroup: consensusGroup,
+			Leader:         []byte("A"),
+			SelfPubKey:     []byte("B"),
+		},
+		Messages: messages,
+	}
+}
+
+func createBlockRecord(pubKey string, sinceRoundStart time.Duration) *recorder.MessageRecord {
+	return &recorder.MessageRecord{
+		Direction:       recorder.DirectionReceived,
+		Round:           7,
+		MsgType:         int64(bls.MtBlockBodyAndHeader),
+		PubKey:          []byte(pubKey),
+		BlockHeaderHash: []byte("hash"),
+		SinceRoundStart: sinceRoundStart,
+	}
+}
+
+func createSignatureRecords(pubKeys string, sinceRoundStart time.Duration) []*recorder.MessageRecord {
+	records := make([]*recorder.MessageRecord, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		records = append(records, &recorder.MessageRecord{
+			Direction:       recorder.DirectionReceived,
+			Round:           7,
+			MsgType:         int64(bls.MtSignature),
+			PubKey:          []byte(string(pubKey)),
+			BlockHeaderHash: []byte("hash"),
+			SinceRoundStart: sinceRoundStart,
+		})
+	}
+
+	return records
+}
+
+func TestReplayRound_NilRecordedRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	report, err := bls.ReplayRound(nil)
+
+	assert.Nil(t, report)
+	assert.Equal(t, recorder.ErrNilRecordedRound, err)
+}
+
+func TestReplayRound_EnoughSignaturesShouldReachConsensus(t *testing.T) {
+	t.Parallel()
+
+	messages := []*recorder.MessageRecord{createBlockRecord("A", 300*time.Millisecond)}
+	messages = append(messages, createSignatureRecords("BCDEFG", 1500*time.Millisecond)...)
+	messages = append(messages, &recorder.MessageRecord{
+		Round:           7,
+		MsgType:         int64(bls.MtBlockHeaderFinalInfo),
+		PubKey:          []byte("A"),
+		BlockHeaderHash: []byte("hash"),
+		SinceRoundStart: 3500 * time.Millisecond,
+	})
+
+	report, err := bls.ReplayRound(createRecordedRound(messages...))
+	require.Nil(t, err)
+
+	assert.True(t, report.ConsensusReached)
+	assert.True(t, report.FinalInfoReceived)
+	assert.Equal(t, 7, report.SignatureThreshold)
+	assert.Equal(t, 7, report.NumSignatures)
+	assert.Equal(t, [][]byte{[]byte("H"), []byte("I")}, report.MissingSigners)
+	assert.Equal(t, []byte("hash"), report.BlockHeaderHash)
+	assert.Equal(t, 300*time.Millisecond, report.BlockReceivedAt)
+	assert.Equal(t, len(messages), len(report.Timeline))
+	assert.Equal(t, "(BLOCK)", report.Timeline[0].Subround)
+	assert.Equal(t, "(SIGNATURE)", report.Timeline[1].Subround)
+}
+
+func TestReplayRound_NotEnoughSignaturesShouldExplainWhy(t *testing.T) {
+	t.Parallel()
+
+	messages := []*recorder.MessageRecord{createBlockRecord("A", 300*time.Millisecond)}
+	messages = append(messages, createSignatureRecords("BC", time.Second)...)
+	messages = append(messages, createSignatureRecords("DE", 3500*time.Millisecond)...)
+	messages = append(messages, createSignatureRecords("Z", time.Second)...)
+	rejectedSignatures := createSignatureRecords("F", time.Second)
+	rejectedSignatures[0].Error = "invalid signature share"
+	messages = append(messages, rejectedSignatures...)
+
+	report, err := bls.ReplayRound(createRecordedRound(messages...))
+	require.Nil(t, err)
+
+	assert.False(t, report.ConsensusReached)
+	assert.Equal(t, 3, report.NumSignatures)
+	assert.Equal(t, "not enough signatures: 3 received, 7 needed", report.Reason)
+	assert.Equal(t, "received after the end of subround signature", report.Timeline[3].Reason)
+	assert.Equal(t, "sender is not in the consensus group", report.Timeline[5].Reason)
+	assert.Equal(t, "rejected by the worker: invalid signature share", report.Timeline[6].Reason)
+}
+
+func TestReplayRound_BlockFromAnotherNodeOrTooLateShouldNotBeAccepted(t *testing.T) {
+	t.Parallel()
+
+	messages := []*recorder.MessageRecord{
+		createBlockRecord("B", 300*time.Millisecond),
+		createBlockRecord("A", 2*time.Second),
+	}
+	messages = append(messages, createSignatureRecords("BCDEFGH", 2500*time.Millisecond)...)
+
+	report, err := bls.ReplayRound(createRecordedRound(messages...))
+	require.Nil(t, err)
+
+	assert.False(t, report.ConsensusReached)
+	assert.Equal(t, "no valid block header was received from the leader in subround block", report.Reason)
+	assert.Equal(t, "sender is not the leader of the round", report.Timeline[0].Reason)
+	assert.Equal(t, "received after the end of subround block", report.Timeline[1].Reason)
+	assert.Equal(t, "block header never received", report.Timeline[2].Reason)
+}
+
+func TestReplayRound_SignaturesReceivedBeforeTheBlockShouldBeExecutedWithTheBlock(t *testing.T) {
+	t.Parallel()
+
+	messages := createSignatureRecords("BCDEFG", 100*time.Millisecond)
+	messages = append(messages, createBlockRecord("A", 500*time.Millisecond))
+
+	report, err := bls.ReplayRound(createRecordedRound(messages...))
+	require.Nil(t, err)
+
+	assert.True(t, report.ConsensusReached)
+	for _, event := range report.Timeline {
+		assert.True(t, event.Accepted)
+		assert.Empty(t, event.Reason)
+	}
+}
+
+func TestReplayRound_RecordedSubroundsShouldBeUsedInsteadOfTheStaticTiming(t *testing.T) {
+	t.Parallel()
+
+	messages := []*recorder.MessageRecord{createBlockRecord("A", 2*time.Second)}
+	messages = append(messages, createSignatureRecords("BCDEFG", 3*time.Second)...)
+	recordedRound := createRecordedRound(messages...)
+	recordedRound.Subrounds = []*recorder.SubroundRecord{
+		{Round: 7, ID: bls.SrStartRound, Name: "(START_ROUND)", StartTime: 0, EndTime: 200 * time.Millisecond},
+		{Round: 7, ID: bls.SrBlock, Name: "(BLOCK)", StartTime: 200 * time.Millisecond, EndTime: 2500 * time.Millisecond},
+		{Round: 7, ID: bls.SrSignature, Name: "(SIGNATURE)", StartTime: 2500 * time.Millisecond, EndTime: 3200 * time.Millisecond},
+	}
+
+	report, err := bls.ReplayRound(recordedRound)
+	require.Nil(t, err)
+
+	assert.True(t, report.SubroundsRecorded)
+	assert.True(t, report.ConsensusReached)
+	assert.Equal(t, 2*time.Second, report.BlockReceivedAt)
+	assert.Equal(t, "(BLOCK)", report.Timeline[0].Subround)
+	assert.Equal(t, "(SIGNATURE)", report.Timeline[1].Subround)
+}
+
+func TestReplayRound_SubroundNotReachedShouldEndWithTheLastRecordedSubround(t *testing.T) {
+	t.Parallel()
+
+	messages := []*recorder.MessageRecord{createBlockRecord("A", 500*time.Millisecond)}
+	messages = append(messages, createSignatureRecords("BCDEFG", 1200*time.Millisecond)...)
+	recordedRound := createRecordedRound(messages...)
+	recordedRound.Subrounds = []*recorder.SubroundRecord{
+		{Round: 7, ID: bls.SrStartRound, Name: "(START_ROUND)", StartTime: 0, EndTime: 200 * time.Millisecond},
+		{Round: 7, ID: bls.SrBlock, Name: "(BLOCK)", StartTime: 200 * time.Millisecond, EndTime: time.Second},
+	}
+
+	report, err := bls.ReplayRound(recordedRound)
+	require.Nil(t, err)
+
+	assert.False(t, report.ConsensusReached)
+	assert.True(t, report.Timeline[0].Accepted)
+	assert.Equal(t, "(NO_SUBROUND)", report.Timeline[1].Subround)
+	assert.Equal(t, "received after the end of subround signature", report.Timeline[1].Reason)
+}
@@ -1,6 +1,7 @@
 package bls
 
 import (
+	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
@@ -8,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // maxAllowedSizeInBytes defines how many bytes are allowed as payload in a message
@@ -18,6 +20,8 @@ type subroundBlock struct {
 	*spos.Subround
 
 	processingThresholdPercentage int
+	backupLeaderTimeFraction      float64
+	mutProposal                   *sync.Mutex
 }
 
 // NewSubroundBlock creates a subroundBlock object
@@ -34,6 +38,7 @@ func NewSubroundBlock(
 	srBlock := subroundBlock{
 		Subround:                      baseSubround,
 		processingThresholdPercentage: processingThresholdPercentage,
+		mutProposal:                   &sync.Mutex{},
 	}
 
 	srBlock.Job = srBlock.doBlockJob
@@ -59,6 +64,24 @@ func checkNewSubroundBlockParams(
 	return err
 }
 
+// SetBackupLeaderTimeFraction sets the fraction of the subround Block after which the backup leader proposes the
+// block if nothing was received from the leader. A value of 0 disables the backup leader
+func (sr *subroundBlock) SetBackupLeaderTimeFraction(timeFraction float64) error {
+	if timeFraction < 0 || timeFraction >= 1 {
+		return spos.ErrInvalidBackupLeaderTimeFraction
+	}
+
+	sr.backupLeaderTimeFraction = timeFraction
+	sr.DelayedJob = nil
+	sr.DelayedJobTime = nil
+	if sr.isBackupLeaderEnabled() {
+		sr.DelayedJob = sr.doBackupLeaderJob
+		sr.DelayedJobTime = sr.backupLeaderSlotTime
+	}
+
+	return nil
+}
+
 // doBlockJob method does the job of the subround Block
 func (sr *subroundBlock) doBlockJob() bool {
 	if !sr.IsSelfLeaderInCurrentRound() { // is NOT self leader in this round?
@@ -97,6 +120,8 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
+	sr.AddProposalMeasurement(time.Since(sr.RoundTimeStamp))
+
 	err = sr.SetSelfJobDone(sr.Current(), true)
 	if err != nil {
 		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
@@ -106,6 +131,72 @@ func (sr *subroundBlock) doBlockJob() bool {
 	return true
 }
 
+func (sr *subroundBlock) isBackupLeaderEnabled() bool {
+	return sr.backupLeaderTimeFraction > 0
+}
+
+// doBackupLeaderJob is called by the chronology when the backup leader slot is reached and proposes the block if
+// this node is the backup leader and nothing was received from the leader until then
+func (sr *subroundBlock) doBackupLeaderJob() bool {
+	if sr.RoundCanceled || sr.IsSubroundFinished(sr.Current()) {
+		return false
+	}
+
+	sr.mutProposal.Lock()
+	switchedToBackupLeader := sr.trySwitchToBackupLeader(sr.SelfPubKey())
+	sr.mutProposal.Unlock()
+
+	if !switchedToBackupLeader {
+		return false
+	}
+
+	log.Debug("step 1: no block has been received from the leader, proposing as backup leader",
+		"round", sr.Rounder().Index())
+
+	return sr.doBlockJob()
+}
+
+// backupLeaderSlotTime returns the time from the round start after which the backup leader may propose the block
+func (sr *subroundBlock) backupLeaderSlotTime() time.Duration {
+	return time.Duration(float64(sr.StartTime()) + float64(sr.EndTime()-sr.StartTime())*sr.backupLeaderTimeFraction)
+}
+
+func (sr *subroundBlock) backupLeaderRemainingTime() time.Duration {
+	return sr.Rounder().RemainingTime(sr.Rounder().TimeStamp(), sr.backupLeaderSlotTime())
+}
+
+func (sr *subroundBlock) isProposalReceived() bool {
+	return sr.IsConsensusDataSet() || !check.IfNil(sr.Body)
+}
+
+// canBackupLeaderPropose checks if the given node is the backup leader of the current round and may propose the
+// block, which happens once the backup leader slot has been reached and nothing was received from the leader
+func (sr *subroundBlock) canBackupLeaderPropose(node string) bool {
+	if !sr.isBackupLeaderEnabled() || sr.LeaderIndex() != 0 || sr.isProposalReceived() {
+		return false
+	}
+
+	consensusGroup := sr.ConsensusGroup()
+	if len(consensusGroup) <= process.BackupLeaderIndex || consensusGroup[process.BackupLeaderIndex] != node {
+		return false
+	}
+
+	return sr.backupLeaderRemainingTime() <= 0
+}
+
+// trySwitchToBackupLeader makes the backup leader the leader of the current round if the given node can propose the
+// block as backup leader. The caller must hold mutProposal, so that the check of the received proposal and the switch
+// can not interleave with a proposal accepted from the leader
+func (sr *subroundBlock) trySwitchToBackupLeader(node string) bool {
+	if !sr.canBackupLeaderPropose(node) {
+		return false
+	}
+
+	sr.SetLeaderIndex(process.BackupLeaderIndex)
+
+	return true
+}
+
 func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHandler) bool {
 	marshalizedBody, err := sr.Marshalizer().Marshal(body)
 	if err != nil {
@@ -297,6 +388,7 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	hdr.SetPrevRandSeed(prevRandSeed)
 	hdr.SetRandSeed(randSeed)
 	hdr.SetChainID(sr.ChainID())
+	hdr.SetLeaderIndex(uint32(sr.LeaderIndex()))
 
 	return hdr, nil
 }
@@ -313,47 +405,74 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 
 	node := string(cnsDta.PubKey)
 
-	if sr.IsConsensusDataSet() {
+	sr.mutProposal.Lock()
+	isProposalAccepted := sr.acceptBlockBodyAndHeader(cnsDta, node)
+	sr.mutProposal.Unlock()
+
+	if !isProposalAccepted {
 		return false
 	}
 
-	if !sr.IsNodeLeaderInCurrentRound(node) { // is NOT this node leader in current round?
-		sr.PeerHonestyHandler().ChangeScore(
-			node,
-			spos.GetConsensusTopicID(sr.ShardCoordinator()),
-			spos.LeaderPeerHonestyDecreaseFactor,
-		)
+	log.Debug("step 1: block body and header have been received",
+		"nonce", sr.Header.GetNonce(),
+		"hash", cnsDta.BlockHeaderHash)
 
-		return false
-	}
+	sw.Start("processReceivedBlock")
+	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
+	sw.Stop("processReceivedBlock")
 
-	if sr.IsBlockBodyAlreadyReceived() {
-		return false
-	}
+	sr.PeerHonestyHandler().ChangeScore(
+		node,
+		spos.GetConsensusTopicID(sr.ShardCoordinator()),
+		spos.LeaderPeerHonestyIncreaseFactor,
+	)
 
-	if sr.IsHeaderAlreadyReceived() {
-		return false
-	}
+	return blockProcessedWithSuccess
+}
 
-	if !sr.CanProcessReceivedMessage(cnsDta, sr.Rounder().Index(), sr.Current()) {
+// receivedBlockBody method is called when a block body is received through the block body channel
+func (sr *subroundBlock) receivedBlockBody(cnsDta *consensus.Message) bool {
+	node := string(cnsDta.PubKey)
+
+	sr.mutProposal.Lock()
+	isProposalAccepted := sr.acceptBlockBody(cnsDta, node)
+	sr.mutProposal.Unlock()
+
+	if !isProposalAccepted {
 		return false
 	}
 
-	sr.Data = cnsDta.BlockHeaderHash
-	sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
-	sr.Header = sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
+	log.Debug("step 1: block body has been received")
+
+	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
+
+	sr.PeerHonestyHandler().ChangeScore(
+		node,
+		spos.GetConsensusTopicID(sr.ShardCoordinator()),
+		spos.LeaderPeerHonestyIncreaseFactor,
+	)
+
+	return blockProcessedWithSuccess
+}
+
+// receivedBlockHeader method is called when a block header is received through the block header channel.
+// If the block header is valid, than the validatorRoundStates map corresponding to the node which sent it,
+// is set on true for the subround Block
+func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
+	node := string(cnsDta.PubKey)
 
-	if sr.Data == nil || check.IfNil(sr.Body) || check.IfNil(sr.Header) {
+	sr.mutProposal.Lock()
+	isProposalAccepted := sr.acceptBlockHeader(cnsDta, node)
+	sr.mutProposal.Unlock()
+
+	if !isProposalAccepted {
 		return false
 	}
 
-	log.Debug("step 1: block body and header have been received",
+	log.Debug("step 1: block header has been received",
 		"nonce", sr.Header.GetNonce(),
 		"hash", cnsDta.BlockHeaderHash)
-
-	sw.Start("processReceivedBlock")
 	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
-	sw.Stop("processReceivedBlock")
 
 	sr.PeerHonestyHandler().ChangeScore(
 		node,
@@ -364,11 +483,14 @@ func (sr *subroundBlock) receivedBlockBodyAndHeader(cnsDta *consensus.Message) b
 	return blockProcessedWithSuccess
 }
 
-// receivedBlockBody method is called when a block body is received through the block body channel
-func (sr *subroundBlock) receivedBlockBody(cnsDta *consensus.Message) bool {
-	node := string(cnsDta.PubKey)
+// acceptBlockBodyAndHeader checks the block body and header proposed by the given node and sets them in the consensus
+// state. The caller must hold mutProposal
+func (sr *subroundBlock) acceptBlockBodyAndHeader(cnsDta *consensus.Message, node string) bool {
+	if sr.IsConsensusDataSet() {
+		return false
+	}
 
-	if !sr.IsNodeLeaderInCurrentRound(node) { // is NOT this node leader in current round?
+	if !sr.IsNodeLeaderInCurrentRound(node) && !sr.trySwitchToBackupLeader(node) { // is NOT this node leader in current round?
 		sr.PeerHonestyHandler().ChangeScore(
 			node,
 			spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -382,40 +504,55 @@ func (sr *subroundBlock) receivedBlockBody(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	if sr.IsHeaderAlreadyReceived() {
+		return false
+	}
+
 	if !sr.CanProcessReceivedMessage(cnsDta, sr.Rounder().Index(), sr.Current()) {
 		return false
 	}
 
+	sr.Data = cnsDta.BlockHeaderHash
 	sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
+	sr.Header = sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
+
+	return sr.Data != nil && !check.IfNil(sr.Body) && !check.IfNil(sr.Header)
+}
+
+// acceptBlockBody checks the block body proposed by the given node and sets it in the consensus state. The caller
+// must hold mutProposal
+func (sr *subroundBlock) acceptBlockBody(cnsDta *consensus.Message, node string) bool {
+	if !sr.IsNodeLeaderInCurrentRound(node) && !sr.trySwitchToBackupLeader(node) { // is NOT this node leader in current round?
+		sr.PeerHonestyHandler().ChangeScore(
+			node,
+			spos.GetConsensusTopicID(sr.ShardCoordinator()),
+			spos.LeaderPeerHonestyDecreaseFactor,
+		)
 
-	if check.IfNil(sr.Body) {
 		return false
 	}
 
-	log.Debug("step 1: block body has been received")
+	if sr.IsBlockBodyAlreadyReceived() {
+		return false
+	}
 
-	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
+	if !sr.CanProcessReceivedMessage(cnsDta, sr.Rounder().Index(), sr.Current()) {
+		return false
+	}
 
-	sr.PeerHonestyHandler().ChangeScore(
-		node,
-		spos.GetConsensusTopicID(sr.ShardCoordinator()),
-		spos.LeaderPeerHonestyIncreaseFactor,
-	)
+	sr.Body = sr.BlockProcessor().DecodeBlockBody(cnsDta.Body)
 
-	return blockProcessedWithSuccess
+	return !check.IfNil(sr.Body)
 }
 
-// receivedBlockHeader method is called when a block header is received through the block header channel.
-// If the block header is valid, than the validatorRoundStates map corresponding to the node which sent it,
-// is set on true for the subround Block
-func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
-	node := string(cnsDta.PubKey)
-
+// acceptBlockHeader checks the block header proposed by the given node and sets it in the consensus state. The caller
+// must hold mutProposal
+func (sr *subroundBlock) acceptBlockHeader(cnsDta *consensus.Message, node string) bool {
 	if sr.IsConsensusDataSet() {
 		return false
 	}
 
-	if !sr.IsNodeLeaderInCurrentRound(node) { // is NOT this node leader in current round?
+	if !sr.IsNodeLeaderInCurrentRound(node) && !sr.trySwitchToBackupLeader(node) { // is NOT this node leader in current round?
 		sr.PeerHonestyHandler().ChangeScore(
 			node,
 			spos.GetConsensusTopicID(sr.ShardCoordinator()),
@@ -436,22 +573,7 @@ func (sr *subroundBlock) receivedBlockHeader(cnsDta *consensus.Message) bool {
 	sr.Data = cnsDta.BlockHeaderHash
 	sr.Header = sr.BlockProcessor().DecodeBlockHeader(cnsDta.Header)
 
-	if sr.Data == nil || check.IfNil(sr.Header) {
-		return false
-	}
-
-	log.Debug("step 1: block header has been received",
-		"nonce", sr.Header.GetNonce(),
-		"hash", cnsDta.BlockHeaderHash)
-	blockProcessedWithSuccess := sr.processReceivedBlock(cnsDta)
-
-	sr.PeerHonestyHandler().ChangeScore(
-		node,
-		spos.GetConsensusTopicID(sr.ShardCoordinator()),
-		spos.LeaderPeerHonestyIncreaseFactor,
-	)
-
-	return blockProcessedWithSuccess
+	return sr.Data != nil && !check.IfNil(sr.Header)
 }
 
 func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
@@ -479,6 +601,19 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	if int(sr.Header.GetLeaderIndex()) != sr.LeaderIndex() {
+		log.Debug("canceled round, block proposed with a different leader index",
+			"round", sr.Rounder().Index(),
+			"subround", sr.Name(),
+			"header leader index", sr.Header.GetLeaderIndex(),
+			"leader index", sr.LeaderIndex(),
+		)
+
+		sr.RoundCanceled = true
+
+		return false
+	}
+
 	node := string(cnsDta.PubKey)
 
 	startTime := sr.RoundTimeStamp
@@ -516,6 +651,8 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.AddProposalMeasurement(time.Since(sr.RoundTimeStamp))
+
 	err = sr.SetJobDone(node, sr.Current(), true)
 	if err != nil {
 		log.Debug("canceled round",
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -403,6 +404,294 @@ func TestSubroundBlock_ReceivedBlock(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundBlock_SetBackupLeaderTimeFraction(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	err := sr.SetBackupLeaderTimeFraction(-0.1)
+	assert.Equal(t, spos.ErrInvalidBackupLeaderTimeFraction, err)
+
+	err = sr.SetBackupLeaderTimeFraction(1)
+	assert.Equal(t, spos.ErrInvalidBackupLeaderTimeFraction, err)
+
+	err = sr.SetBackupLeaderTimeFraction(0.5)
+	assert.Nil(t, err)
+}
+
+func TestSubroundBlock_DoBackupLeaderJobShouldBeSetOnlyWhenTheBackupLeaderIsEnabled(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	assert.Nil(t, sr.DelayedJob)
+	assert.Nil(t, sr.DelayedJobTime)
+
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	assert.NotNil(t, sr.DelayedJob)
+	assert.Equal(t, time.Duration(float64(sr.StartTime())+float64(sr.EndTime()-sr.StartTime())*0.5), sr.DelayedJobTime())
+
+	_ = sr.SetBackupLeaderTimeFraction(0)
+	assert.Nil(t, sr.DelayedJob)
+	assert.Nil(t, sr.DelayedJobTime)
+}
+
+func TestSubroundBlock_DoBackupLeaderJobShouldProposeWhenNothingReceived(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBlockProcessor(mock.InitBlockProcessorMock())
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return 0
+		},
+	})
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			return nil
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	sr.Data = nil
+
+	r := sr.DoBlockJob()
+	assert.False(t, r)
+	assert.False(t, sr.DoBackupLeaderJob())
+	assert.Equal(t, 0, sr.LeaderIndex())
+
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	r = sr.DoBackupLeaderJob()
+	assert.True(t, r)
+	assert.Equal(t, 1, sr.LeaderIndex())
+	assert.True(t, sr.IsSelfJobDone(bls.SrBlock))
+	assert.Equal(t, uint32(1), sr.Header.GetLeaderIndex())
+}
+
+func TestSubroundBlock_DoBackupLeaderJobShouldNotProposeBeforeTheBackupLeaderSlot(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return time.Millisecond
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	sr.Data = nil
+
+	assert.False(t, sr.DoBackupLeaderJob())
+	assert.Equal(t, 0, sr.LeaderIndex())
+	assert.False(t, sr.IsSelfJobDone(bls.SrBlock))
+}
+
+func TestSubroundBlock_DoBackupLeaderJobShouldNotProposeWhenBlockReceived(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return 0
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[1])
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	sr.Data = []byte("X")
+
+	assert.False(t, sr.DoBackupLeaderJob())
+	assert.Equal(t, 0, sr.LeaderIndex())
+	assert.False(t, sr.IsSelfJobDone(bls.SrBlock))
+}
+
+func TestSubroundBlock_DoBackupLeaderJobShouldNotProposeWhenNotBackupLeader(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		RoundIndex: 1,
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return 0
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[2])
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	sr.Data = nil
+
+	assert.False(t, sr.DoBackupLeaderJob())
+	assert.Equal(t, 0, sr.LeaderIndex())
+}
+
+func TestSubroundBlock_ReceivedBlockHeaderFromBackupLeader(t *testing.T) {
+	t.Parallel()
+
+	remainingTime := time.Second
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return remainingTime
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[2])
+	sr.Data = nil
+
+	hdr := &block.Header{
+		Nonce:       1,
+		LeaderIndex: 1,
+	}
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	hdrHash := mock.HasherMock{}.Compute(string(hdrStr))
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(sr.ConsensusGroup()[1]),
+		[]byte("sig"),
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+
+	r := sr.ReceivedBlockHeader(cnsMsg)
+	assert.False(t, r)
+
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	r = sr.ReceivedBlockHeader(cnsMsg)
+	assert.False(t, r)
+	assert.Equal(t, 0, sr.LeaderIndex())
+
+	remainingTime = 0
+	blockProcessorMock := mock.InitBlockProcessorMock()
+	blockProcessorMock.DecodeBlockHeaderCalled = func(dta []byte) data.HeaderHandler {
+		return hdr
+	}
+	container.SetBlockProcessor(blockProcessorMock)
+	blkBodyStr, _ := mock.MarshalizerMock{}.Marshal(&block.Body{})
+	cnsMsgBody := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkBodyStr,
+		nil,
+		[]byte(sr.ConsensusGroup()[1]),
+		[]byte("sig"),
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	r = sr.ReceivedBlockBody(cnsMsgBody)
+	assert.False(t, r)
+	assert.Equal(t, 1, sr.LeaderIndex())
+
+	r = sr.ReceivedBlockHeader(cnsMsg)
+	assert.True(t, r)
+}
+
+func TestSubroundBlock_ReceivedBlockHeaderFromBackupLeaderBeforeTheSlotShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		RemainingTimeCalled: func(startTime time.Time, maxTime time.Duration) time.Duration {
+			return time.Millisecond
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[2])
+	_ = sr.SetBackupLeaderTimeFraction(0.5)
+	sr.Data = nil
+
+	changedScores := make(map[string]int)
+	container.PeerHonestyHandler().(*testscommon.PeerHonestyHandlerStub).ChangeScoreCalled = func(pk string, topic string, units int) {
+		changedScores[pk] += units
+	}
+
+	backupLeaderHeader := &block.Header{
+		Nonce:       1,
+		LeaderIndex: 1,
+	}
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(backupLeaderHeader)
+	hdrHash := mock.HasherMock{}.Compute(string(hdrStr))
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(sr.ConsensusGroup()[1]),
+		[]byte("sig"),
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+
+	r := sr.ReceivedBlockHeader(cnsMsg)
+	assert.False(t, r)
+	assert.Equal(t, 0, sr.LeaderIndex())
+	assert.Nil(t, sr.Data)
+	assert.Nil(t, sr.Header)
+	assert.Equal(t, spos.LeaderPeerHonestyDecreaseFactor, changedScores[sr.ConsensusGroup()[1]])
+
+	blockProcessorMock := mock.InitBlockProcessorMock()
+	blockProcessorMock.DecodeBlockHeaderCalled = func(dta []byte) data.HeaderHandler {
+		return &block.Header{Nonce: 1}
+	}
+	container.SetBlockProcessor(blockProcessorMock)
+	cnsMsg.PubKey = []byte(sr.ConsensusGroup()[0])
+
+	_ = sr.ReceivedBlockHeader(cnsMsg)
+	assert.Equal(t, 0, sr.LeaderIndex())
+	assert.Equal(t, hdrHash, sr.Data)
+	assert.NotNil(t, sr.Header)
+	assert.Equal(t, spos.LeaderPeerHonestyIncreaseFactor, changedScores[sr.ConsensusGroup()[0]])
+}
+
+func TestSubroundBlock_ProcessReceivedBlockWithDifferentLeaderIndexShouldCancelRound(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		nil,
+		nil,
+		[]byte(sr.ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	sr.Header = &block.Header{LeaderIndex: 1}
+	sr.Body = &block.Body{}
+
+	assert.False(t, sr.ProcessReceivedBlock(cnsMsg))
+	assert.True(t, sr.RoundCanceled)
+}
+
 func TestSubroundBlock_ProcessReceivedBlockShouldReturnFalseWhenBodyAndHeaderAreNotSet(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
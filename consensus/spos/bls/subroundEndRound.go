@@ -462,6 +462,8 @@ func (sr *subroundEndRound) checkSignaturesValidity(bitmap []byte) error {
 		size = nbBitsBitmap
 	}
 
+	indexes := make([]uint16, 0, size)
+	signatures := make([][]byte, 0, size)
 	for i := 0; i < size; i++ {
 		indexRequired := (bitmap[i/8] & (1 << uint16(i%8))) > 0
 		if !indexRequired {
@@ -483,10 +485,19 @@ func (sr *subroundEndRound) checkSignaturesValidity(bitmap []byte) error {
 			return err
 		}
 
-		err = sr.MultiSigner().VerifySignatureShare(uint16(i), signature, sr.GetData(), bitmap)
-		if err != nil {
-			return err
+		indexes = append(indexes, uint16(i))
+		signatures = append(signatures, signature)
+	}
+
+	invalidIndexes, err := sr.MultiSigner().VerifySignatureShares(indexes, signatures, sr.GetData(), bitmap)
+	if err != nil {
+		for _, index := range invalidIndexes {
+			log.Debug("checkSignaturesValidity: invalid signature share",
+				"index", index,
+				"pk", []byte(consensusGroup[index]))
 		}
+
+		return err
 	}
 
 	return nil
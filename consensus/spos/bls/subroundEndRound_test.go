@@ -601,6 +601,38 @@ func TestSubroundEndRound_CheckSignaturesValidityShouldErrInvalidSignatureShare(
 	assert.Equal(t, err, err2)
 }
 
+func TestSubroundEndRound_CheckSignaturesValidityShouldVerifyAllSharesInOneBatch(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundEndRoundWithContainer(container)
+	multiSignerMock := mock.InitMultiSignerMock()
+	multiSignerMock.SignatureShareMock = func(index uint16) ([]byte, error) {
+		return []byte{byte(index)}, nil
+	}
+	numCalls := 0
+	var verifiedIndexes []uint16
+	var verifiedSigs [][]byte
+	errInvalidShare := errors.New("invalid signature share")
+	multiSignerMock.VerifySignatureSharesMock = func(indexes []uint16, sigs [][]byte, msg []byte, bitmap []byte) ([]uint16, error) {
+		numCalls++
+		verifiedIndexes = indexes
+		verifiedSigs = sigs
+		return []uint16{2}, errInvalidShare
+	}
+	container.SetMultiSigner(multiSignerMock)
+
+	for i := 0; i < 3; i++ {
+		_ = sr.SetJobDone(sr.ConsensusGroup()[i], bls.SrSignature, true)
+	}
+
+	err := sr.CheckSignaturesValidity([]byte{0x07, 0x00})
+	assert.Equal(t, errInvalidShare, err)
+	assert.Equal(t, 1, numCalls)
+	assert.Equal(t, []uint16{0, 1, 2}, verifiedIndexes)
+	assert.Equal(t, [][]byte{{0}, {1}, {2}}, verifiedSigs)
+}
+
 func TestSubroundEndRound_CheckSignaturesValidityShouldReturnNil(t *testing.T) {
 	t.Parallel()
 
@@ -32,6 +32,9 @@ type ConsensusState struct {
 	processingBlock    bool
 	mutProcessingBlock sync.RWMutex
 
+	leaderIndex    int
+	mutLeaderIndex sync.RWMutex
+
 	*roundConsensus
 	*roundThreshold
 	*roundStatus
@@ -65,6 +68,7 @@ func (cns *ConsensusState) ResetConsensusState() {
 
 	cns.RoundCanceled = false
 	cns.ExtendedCalled = false
+	cns.SetLeaderIndex(0)
 
 	cns.ResetRoundStatus()
 	cns.ResetRoundState()
@@ -108,7 +112,8 @@ func (cns *ConsensusState) IsSelfLeaderInCurrentRound() bool {
 	return cns.IsNodeLeaderInCurrentRound(cns.selfPubKey)
 }
 
-// GetLeader method gets the leader of the current round
+// GetLeader method gets the leader of the current round, which is the validator from the consensus group found at
+// the current leader index
 func (cns *ConsensusState) GetLeader() (string, error) {
 	if cns.consensusGroup == nil {
 		return "", ErrNilConsensusGroup
@@ -118,7 +123,28 @@ func (cns *ConsensusState) GetLeader() (string, error) {
 		return "", ErrEmptyConsensusGroup
 	}
 
-	return cns.consensusGroup[0], nil
+	leaderIndex := cns.LeaderIndex()
+	if leaderIndex >= len(cns.consensusGroup) {
+		return "", ErrInvalidLeaderIndex
+	}
+
+	return cns.consensusGroup[leaderIndex], nil
+}
+
+// LeaderIndex returns the index in the consensus group of the leader of the current round
+func (cns *ConsensusState) LeaderIndex() int {
+	cns.mutLeaderIndex.RLock()
+	defer cns.mutLeaderIndex.RUnlock()
+
+	return cns.leaderIndex
+}
+
+// SetLeaderIndex sets the index in the consensus group of the leader of the current round. It is changed from the
+// default 0 only when a backup leader takes over the proposal
+func (cns *ConsensusState) SetLeaderIndex(leaderIndex int) {
+	cns.mutLeaderIndex.Lock()
+	cns.leaderIndex = leaderIndex
+	cns.mutLeaderIndex.Unlock()
 }
 
 // GetNextConsensusGroup gets the new consensus group for the current round based on current eligible list and a random
@@ -135,6 +135,36 @@ func TestConsensusState_GetLeaderShouldWork(t *testing.T) {
 	assert.Equal(t, cns.ConsensusGroup()[0], leader)
 }
 
+func TestConsensusState_GetLeaderShouldErrInvalidLeaderIndex(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	cns.SetLeaderIndex(len(cns.ConsensusGroup()))
+
+	_, err := cns.GetLeader()
+	assert.Equal(t, spos.ErrInvalidLeaderIndex, err)
+}
+
+func TestConsensusState_GetLeaderShouldReturnValidatorAtLeaderIndex(t *testing.T) {
+	t.Parallel()
+
+	cns := internalInitConsensusState()
+
+	cns.SetLeaderIndex(1)
+
+	leader, err := cns.GetLeader()
+	assert.Nil(t, err)
+	assert.Equal(t, cns.ConsensusGroup()[1], leader)
+	assert.True(t, cns.IsNodeLeaderInCurrentRound(cns.ConsensusGroup()[1]))
+
+	cns.ResetConsensusState()
+
+	assert.Equal(t, 0, cns.LeaderIndex())
+	leader, _ = cns.GetLeader()
+	assert.Equal(t, cns.ConsensusGroup()[0], leader)
+}
+
 func TestConsensusState_GetNextConsensusGroupShouldFailWhenComputeValidatorsGroupErr(t *testing.T) {
 	t.Parallel()
 
@@ -154,6 +154,9 @@ var ErrNilExecuteStoredMessages = errors.New("executeStoredMessages is nil")
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilTracer signals that a nil tracer has been provided
+var ErrNilTracer = errors.New("nil tracer")
+
 // ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
 var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
 
@@ -207,3 +210,24 @@ var ErrOriginatorMismatch = errors.New("consensus message originator mismatch")
 
 // ErrNilPeerSignatureHandler signals that a nil peerSignatureHandler object has been provided
 var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHandler")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilMessageRecorder signals that a nil message recorder has been provided
+var ErrNilMessageRecorder = errors.New("nil message recorder")
+
+// ErrInvalidLeaderIndex signals that the leader index is outside the consensus group
+var ErrInvalidLeaderIndex = errors.New("invalid leader index")
+
+// ErrInvalidBackupLeaderTimeFraction signals that an invalid backup leader time fraction has been provided
+var ErrInvalidBackupLeaderTimeFraction = errors.New("invalid backup leader time fraction")
+
+// ErrNilSubroundsTiming signals that a nil subrounds timing handler has been provided
+var ErrNilSubroundsTiming = errors.New("nil subrounds timing handler")
+
+// ErrInvalidSubroundsTiming signals that the subrounds times are invalid
+var ErrInvalidSubroundsTiming = errors.New("invalid subrounds timing")
+
+// ErrInvalidAdaptiveSubroundsConfig signals that the adaptive subrounds config is invalid
+var ErrInvalidAdaptiveSubroundsConfig = errors.New("invalid adaptive subrounds config")
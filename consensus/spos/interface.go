@@ -1,6 +1,8 @@
 package spos
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -141,3 +143,11 @@ type HeaderIntegrityVerifier interface {
 	Verify(header data.HeaderHandler) error
 	IsInterfaceNil() bool
 }
+
+// SubroundsTimingHandler computes the start and end times of the subrounds, relative to the start of the current round
+type SubroundsTimingHandler interface {
+	StartTime(subroundID int) int64
+	EndTime(subroundID int) int64
+	AddProposalMeasurement(elapsedTime time.Duration)
+	IsInterfaceNil() bool
+}
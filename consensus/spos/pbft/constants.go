@@ -0,0 +1,128 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+)
+
+var log = logger.GetOrCreate("consensus/spos/pbft")
+
+// The start round, block, precommit and end round subrounds reuse the BLS implementations, so their IDs and the
+// IDs of the messages they send must stay aligned with the ones defined in the bls package. The precommit
+// subround takes the place of the BLS signature subround, which means the end round aggregates the precommit
+// shares into the header signature.
+const (
+	// SrStartRound defines ID of Subround "Start round"
+	SrStartRound = bls.SrStartRound
+	// SrBlock defines ID of Subround "block"
+	SrBlock = bls.SrBlock
+	// SrPrecommit defines ID of Subround "precommit"
+	SrPrecommit = bls.SrSignature
+	// SrEndRound defines ID of Subround "End round"
+	SrEndRound = bls.SrEndRound
+	// SrPrevote defines ID of Subround "prevote"
+	SrPrevote = bls.SrEndRound + 1
+)
+
+const (
+	// MtUnknown defines ID of a message that has unknown Data inside
+	MtUnknown = bls.MtUnknown
+	// MtBlockBodyAndHeader defines ID of a message that has a block body and a block header inside
+	MtBlockBodyAndHeader = bls.MtBlockBodyAndHeader
+	// MtBlockBody defines ID of a message that has a block body inside
+	MtBlockBody = bls.MtBlockBody
+	// MtBlockHeader defines ID of a message that has a block header inside
+	MtBlockHeader = bls.MtBlockHeader
+	// MtPrecommit defines ID of a message that has a precommit signature share inside
+	MtPrecommit = bls.MtSignature
+	// MtBlockHeaderFinalInfo defines ID of a message that has a block header final info inside
+	// (aggregate signature, bitmap and seal leader signature for the proposed and precommitted header)
+	MtBlockHeaderFinalInfo = bls.MtBlockHeaderFinalInfo
+	// MtPrevote defines ID of a message that has a prevote signature inside
+	MtPrevote = bls.MtBlockHeaderFinalInfo + 1
+)
+
+// prevotePrefix separates the prevote signatures from the precommit signature shares, which are computed on the
+// bare header hash, so that a prevote can never be aggregated as a precommit
+const prevotePrefix = "prevote"
+
+// processingThresholdPercent specifies the max allocated time for processing the block as a percentage of the total time of the round
+const processingThresholdPercent = 85
+
+// srStartStartTime specifies the start time, from the total time of the round, of Subround Start
+const srStartStartTime = 0.0
+
+// srStartEndTime specifies the end time, from the total time of the round, of Subround Start
+const srStartEndTime = 0.05
+
+// srBlockStartTime specifies the start time, from the total time of the round, of Subround Block
+const srBlockStartTime = 0.05
+
+// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block
+const srBlockEndTime = 0.25
+
+// srPrevoteStartTime specifies the start time, from the total time of the round, of Subround Prevote
+const srPrevoteStartTime = 0.25
+
+// srPrevoteEndTime specifies the end time, from the total time of the round, of Subround Prevote
+const srPrevoteEndTime = 0.5
+
+// srPrecommitStartTime specifies the start time, from the total time of the round, of Subround Precommit
+const srPrecommitStartTime = 0.5
+
+// srPrecommitEndTime specifies the end time, from the total time of the round, of Subround Precommit
+const srPrecommitEndTime = 0.85
+
+// srEndStartTime specifies the start time, from the total time of the round, of Subround End
+const srEndStartTime = 0.85
+
+// srEndEndTime specifies the end time, from the total time of the round, of Subround End
+const srEndEndTime = 0.95
+
+const (
+	// BlockPrevoteStringValue represents the string to be used to identify a block's prevote
+	BlockPrevoteStringValue = "(PREVOTE)"
+
+	// BlockPrecommitStringValue represents the string to be used to identify a block's precommit
+	BlockPrecommitStringValue = "(PRECOMMIT)"
+)
+
+func getStringValue(msgType consensus.MessageType) string {
+	switch msgType {
+	case MtBlockBodyAndHeader:
+		return bls.BlockBodyAndHeaderStringValue
+	case MtBlockBody:
+		return bls.BlockBodyStringValue
+	case MtBlockHeader:
+		return bls.BlockHeaderStringValue
+	case MtPrevote:
+		return BlockPrevoteStringValue
+	case MtPrecommit:
+		return BlockPrecommitStringValue
+	case MtBlockHeaderFinalInfo:
+		return bls.BlockHeaderFinalInfoStringValue
+	case MtUnknown:
+		return bls.BlockUnknownStringValue
+	default:
+		return bls.BlockDefaultStringValue
+	}
+}
+
+// getSubroundName returns the name of each Subround from a given Subround ID
+func getSubroundName(subroundId int) string {
+	switch subroundId {
+	case SrStartRound:
+		return "(START_ROUND)"
+	case SrBlock:
+		return "(BLOCK)"
+	case SrPrevote:
+		return "(PREVOTE)"
+	case SrPrecommit:
+		return "(PRECOMMIT)"
+	case SrEndRound:
+		return "(END_ROUND)"
+	default:
+		return "Undefined subround"
+	}
+}
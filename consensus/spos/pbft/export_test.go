@@ -0,0 +1,72 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// factory
+
+// Factory defines a type for the factory structure
+type Factory *factory
+
+// ConsensusState gets the consensus state struct pointer
+func (fct *factory) ConsensusState() *spos.ConsensusState {
+	return fct.consensusState
+}
+
+// Worker gets the worker object
+func (fct *factory) Worker() spos.WorkerHandler {
+	return fct.worker
+}
+
+// GeneratePrevoteSubround generates the instance of subround Prevote and added it to the chronology subrounds list
+func (fct *factory) GeneratePrevoteSubround() error {
+	return fct.generatePrevoteSubround()
+}
+
+// GeneratePrecommitSubround generates the instance of subround Precommit and added it to the chronology subrounds list
+func (fct *factory) GeneratePrecommitSubround() error {
+	return fct.generatePrecommitSubround()
+}
+
+// AppStatusHandler gets the app status handler object
+func (fct *factory) AppStatusHandler() core.AppStatusHandler {
+	return fct.appStatusHandler
+}
+
+// subroundPrevote
+
+// SubroundPrevote defines a type for the subroundPrevote structure
+type SubroundPrevote *subroundPrevote
+
+// DoPrevoteJob method does the job of the subround Prevote
+func (sr *subroundPrevote) DoPrevoteJob() bool {
+	return sr.doPrevoteJob()
+}
+
+// ReceivedPrevote method is called when a prevote is received through the prevote channel
+func (sr *subroundPrevote) ReceivedPrevote(cnsDta *consensus.Message) bool {
+	return sr.receivedPrevote(cnsDta)
+}
+
+// DoPrevoteConsensusCheck method checks if the consensus in the subround Prevote is achieved
+func (sr *subroundPrevote) DoPrevoteConsensusCheck() bool {
+	return sr.doPrevoteConsensusCheck()
+}
+
+// PrevotesCollected method checks if the prevotes received are more than the given threshold
+func (sr *subroundPrevote) PrevotesCollected(threshold int) (bool, int) {
+	return sr.prevotesCollected(threshold)
+}
+
+// CreatePrevoteMessage returns the message signed by a prevote for the given header hash
+func CreatePrevoteMessage(headerHash []byte) []byte {
+	return createPrevoteMessage(headerHash)
+}
+
+// GetStringValue gets the name of the message type
+func GetStringValue(messageType consensus.MessageType) string {
+	return getStringValue(messageType)
+}
@@ -0,0 +1,413 @@
+package pbft
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+// factory defines the data needed by this factory to create all the subrounds and give them their specific
+// functionality
+type factory struct {
+	consensusCore  spos.ConsensusCoreHandler
+	consensusState *spos.ConsensusState
+	worker         spos.WorkerHandler
+
+	appStatusHandler core.AppStatusHandler
+	tracer           core.Tracer
+	indexer          indexer.Indexer
+	chainID          []byte
+	currentPid       core.PeerID
+
+	backupLeaderTimeFraction float64
+	adaptiveSubroundsConfig  config.AdaptiveSubroundsConfig
+	subroundsTiming          spos.SubroundsTimingHandler
+}
+
+// NewSubroundsFactory creates a new factory object
+func NewSubroundsFactory(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	chainID []byte,
+	currentPid core.PeerID,
+) (*factory, error) {
+	err := checkNewFactoryParams(
+		consensusDataContainer,
+		consensusState,
+		worker,
+		chainID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fct := factory{
+		consensusCore:    consensusDataContainer,
+		consensusState:   consensusState,
+		worker:           worker,
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		tracer:           tracing.NewDisabledTracer(),
+		chainID:          chainID,
+		currentPid:       currentPid,
+	}
+
+	return &fct, nil
+}
+
+func checkNewFactoryParams(
+	container spos.ConsensusCoreHandler,
+	state *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	chainID []byte,
+) error {
+	err := spos.ValidateConsensusCore(container)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return spos.ErrNilConsensusState
+	}
+	if check.IfNil(worker) {
+		return spos.ErrNilWorker
+	}
+	if len(chainID) == 0 {
+		return spos.ErrInvalidChainID
+	}
+
+	return nil
+}
+
+// SetAppStatusHandler method will update the value of the factory's appStatusHandler
+func (fct *factory) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if check.IfNil(ash) {
+		return spos.ErrNilAppStatusHandler
+	}
+	fct.appStatusHandler = ash
+
+	return fct.worker.SetAppStatusHandler(ash)
+}
+
+// SetTracer method will update the value of the factory's tracer
+func (fct *factory) SetTracer(tracer core.Tracer) error {
+	if check.IfNil(tracer) {
+		return spos.ErrNilTracer
+	}
+	fct.tracer = tracer
+
+	return nil
+}
+
+// SetBackupLeaderTimeFraction method will update the fraction of the subround Block after which the backup leader
+// proposes the block. A value of 0 disables the backup leader
+func (fct *factory) SetBackupLeaderTimeFraction(timeFraction float64) error {
+	if timeFraction < 0 || timeFraction >= 1 {
+		return spos.ErrInvalidBackupLeaderTimeFraction
+	}
+	fct.backupLeaderTimeFraction = timeFraction
+
+	return nil
+}
+
+// SetAdaptiveSubroundsConfig method will update the config used to adapt the end of the subround Block to the
+// measured block proposal times
+func (fct *factory) SetAdaptiveSubroundsConfig(adaptiveSubroundsConfig config.AdaptiveSubroundsConfig) error {
+	_, err := fct.createSubroundsTiming(adaptiveSubroundsConfig)
+	if err != nil {
+		return err
+	}
+	fct.adaptiveSubroundsConfig = adaptiveSubroundsConfig
+
+	return nil
+}
+
+// SetIndexer method will update the value of the factory's indexer
+func (fct *factory) SetIndexer(indexer indexer.Indexer) {
+	fct.indexer = indexer
+}
+
+// GenerateSubrounds will generate the subrounds used in PBFT Cns
+func (fct *factory) GenerateSubrounds() error {
+	fct.initConsensusThreshold()
+	fct.consensusCore.Chronology().RemoveAllSubrounds()
+	fct.worker.RemoveAllReceivedMessagesCalls()
+
+	subroundsTiming, err := fct.createSubroundsTiming(fct.adaptiveSubroundsConfig)
+	if err != nil {
+		return err
+	}
+	fct.subroundsTiming = subroundsTiming
+
+	err = fct.generateStartRoundSubround()
+	if err != nil {
+		return err
+	}
+
+	err = fct.generateBlockSubround()
+	if err != nil {
+		return err
+	}
+
+	err = fct.generatePrevoteSubround()
+	if err != nil {
+		return err
+	}
+
+	err = fct.generatePrecommitSubround()
+	if err != nil {
+		return err
+	}
+
+	err = fct.generateEndRoundSubround()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fct *factory) getTimeDuration() time.Duration {
+	return fct.consensusCore.Rounder().TimeDuration()
+}
+
+func (fct *factory) newSubround(previous int, current int, next int, startTime float64, endTime float64) (*spos.Subround, error) {
+	return spos.NewSubround(
+		previous,
+		current,
+		next,
+		int64(float64(fct.getTimeDuration())*startTime),
+		int64(float64(fct.getTimeDuration())*endTime),
+		getSubroundName(current),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+		fct.currentPid,
+	)
+}
+
+func (fct *factory) generateStartRoundSubround() error {
+	subround, err := fct.newSubround(-1, SrStartRound, SrBlock, srStartStartTime, srStartEndTime)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
+	subroundStartRound, err := bls.NewSubroundStartRound(
+		subround,
+		fct.worker.Extend,
+		processingThresholdPercent,
+		fct.worker.ExecuteStoredMessages,
+	)
+	if err != nil {
+		return err
+	}
+
+	subroundStartRound.SetIndexer(fct.indexer)
+
+	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
+
+	return nil
+}
+
+func (fct *factory) generateBlockSubround() error {
+	subround, err := fct.newSubround(SrStartRound, SrBlock, SrPrevote, srBlockStartTime, srBlockEndTime)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
+	subroundBlock, err := bls.NewSubroundBlock(
+		subround,
+		fct.worker.Extend,
+		processingThresholdPercent,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subroundBlock.SetBackupLeaderTimeFraction(fct.backupLeaderTimeFraction)
+	if err != nil {
+		return err
+	}
+
+	subroundBlock.AddReceivedMessagesCalls(fct.worker, MtBlockBodyAndHeader, MtBlockBody, MtBlockHeader)
+	fct.consensusCore.Chronology().AddSubround(subroundBlock)
+
+	return nil
+}
+
+func (fct *factory) generatePrevoteSubround() error {
+	subround, err := fct.newSubround(SrBlock, SrPrevote, SrPrecommit, srPrevoteStartTime, srPrevoteEndTime)
+	if err != nil {
+		return err
+	}
+
+	subroundPrevoteObject, err := NewSubroundPrevote(
+		subround,
+		fct.worker.Extend,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subroundPrevoteObject.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subroundPrevoteObject.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subroundPrevoteObject.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
+	fct.worker.AddReceivedMessageCall(MtPrevote, subroundPrevoteObject.receivedPrevote)
+	fct.consensusCore.Chronology().AddSubround(subroundPrevoteObject)
+
+	return nil
+}
+
+func (fct *factory) generatePrecommitSubround() error {
+	subround, err := fct.newSubround(SrPrevote, SrPrecommit, SrEndRound, srPrecommitStartTime, srPrecommitEndTime)
+	if err != nil {
+		return err
+	}
+
+	subroundPrecommitObject, err := bls.NewSubroundSignature(
+		subround,
+		fct.worker.Extend,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subroundPrecommitObject.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subroundPrecommitObject.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subroundPrecommitObject.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
+	subroundPrecommitObject.AddReceivedMessagesCalls(fct.worker, MtPrecommit)
+	fct.consensusCore.Chronology().AddSubround(subroundPrecommitObject)
+
+	return nil
+}
+
+func (fct *factory) generateEndRoundSubround() error {
+	subround, err := fct.newSubround(SrPrecommit, SrEndRound, -1, srEndStartTime, srEndEndTime)
+	if err != nil {
+		return err
+	}
+
+	subroundEndRoundObject, err := bls.NewSubroundEndRound(
+		subround,
+		fct.worker.Extend,
+		spos.MaxThresholdPercent,
+		fct.worker.DisplayStatistics,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subroundEndRoundObject.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	err = subroundEndRoundObject.SetTracer(fct.tracer)
+	if err != nil {
+		return err
+	}
+
+	err = subroundEndRoundObject.SetSubroundsTiming(fct.subroundsTiming)
+	if err != nil {
+		return err
+	}
+
+	subroundEndRoundObject.AddReceivedMessagesCalls(fct.worker, MtBlockHeaderFinalInfo)
+	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
+
+	return nil
+}
+
+func (fct *factory) createSubroundsTiming(
+	adaptiveSubroundsConfig config.AdaptiveSubroundsConfig,
+) (spos.SubroundsTimingHandler, error) {
+	args := spos.ArgSubroundsTiming{
+		Rounder: fct.consensusCore.Rounder(),
+		Subrounds: map[int]spos.SubroundTimes{
+			SrStartRound: {StartTime: srStartStartTime, EndTime: srStartEndTime},
+			SrBlock:      {StartTime: srBlockStartTime, EndTime: srBlockEndTime},
+			SrPrevote:    {StartTime: srPrevoteStartTime, EndTime: srPrevoteEndTime},
+			SrPrecommit:  {StartTime: srPrecommitStartTime, EndTime: srPrecommitEndTime},
+			SrEndRound:   {StartTime: srEndStartTime, EndTime: srEndEndTime},
+		},
+		BlockSubroundID: SrBlock,
+		NextSubroundID:  SrPrevote,
+		Config:          adaptiveSubroundsConfig,
+	}
+
+	return spos.NewSubroundsTiming(args)
+}
+
+func (fct *factory) initConsensusThreshold() {
+	pbftThreshold := fct.consensusState.ConsensusGroupSize()*2/3 + 1
+	fct.consensusState.SetThreshold(SrBlock, 1)
+	fct.consensusState.SetThreshold(SrPrevote, pbftThreshold)
+	fct.consensusState.SetThreshold(SrPrecommit, pbftThreshold)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fct *factory) IsInterfaceNil() bool {
+	return fct == nil
+}
@@ -0,0 +1,225 @@
+package pbft_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var chainID = []byte("chain ID")
+
+const currentPid = core.PeerID("pid")
+
+const roundTimeDuration = 100 * time.Millisecond
+
+func extend(subroundId int) {
+	fmt.Println(subroundId)
+}
+
+// executeStoredMessages tries to execute all the messages received which are valid for execution
+func executeStoredMessages() {
+}
+
+func initWorker() *mock.SposWorkerMock {
+	sposWorker := &mock.SposWorkerMock{}
+	sposWorker.GetConsensusStateChangedChannelsCalled = func() chan bool {
+		return make(chan bool)
+	}
+	sposWorker.RemoveAllReceivedMessagesCallsCalled = func() {}
+
+	sposWorker.AddReceivedMessageCallCalled =
+		func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {}
+
+	return sposWorker
+}
+
+func initFactoryWithContainer(container *mock.ConsensusCoreMock) pbft.Factory {
+	worker := initWorker()
+	consensusState := initConsensusState()
+
+	fct, _ := pbft.NewSubroundsFactory(
+		container,
+		consensusState,
+		worker,
+		chainID,
+		currentPid,
+	)
+
+	return fct
+}
+
+func initFactory() pbft.Factory {
+	container := mock.InitConsensusCore()
+	return initFactoryWithContainer(container)
+}
+
+func TestFactory_NewFactoryNilContainerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := pbft.NewSubroundsFactory(
+		nil,
+		initConsensusState(),
+		initWorker(),
+		chainID,
+		currentPid,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, spos.ErrNilConsensusCore, err)
+}
+
+func TestFactory_NewFactoryNilConsensusStateShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := pbft.NewSubroundsFactory(
+		mock.InitConsensusCore(),
+		nil,
+		initWorker(),
+		chainID,
+		currentPid,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, spos.ErrNilConsensusState, err)
+}
+
+func TestFactory_NewFactoryNilWorkerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := pbft.NewSubroundsFactory(
+		mock.InitConsensusCore(),
+		initConsensusState(),
+		nil,
+		chainID,
+		currentPid,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, spos.ErrNilWorker, err)
+}
+
+func TestFactory_NewFactoryEmptyChainIDShouldFail(t *testing.T) {
+	t.Parallel()
+
+	fct, err := pbft.NewSubroundsFactory(
+		mock.InitConsensusCore(),
+		initConsensusState(),
+		initWorker(),
+		nil,
+		currentPid,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, spos.ErrInvalidChainID, err)
+}
+
+func TestFactory_NewFactoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fct, err := pbft.NewSubroundsFactory(
+		mock.InitConsensusCore(),
+		initConsensusState(),
+		initWorker(),
+		chainID,
+		currentPid,
+	)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(fct))
+}
+
+func TestFactory_GeneratePrevoteSubroundShouldFailWhenNewSubroundFail(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+	fct.Worker().(*mock.SposWorkerMock).GetConsensusStateChangedChannelsCalled = func() chan bool {
+		return nil
+	}
+
+	err := fct.GeneratePrevoteSubround()
+
+	assert.Equal(t, spos.ErrNilChannel, err)
+}
+
+func TestFactory_GeneratePrecommitSubroundShouldFailWhenNewSubroundFail(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+	fct.Worker().(*mock.SposWorkerMock).GetConsensusStateChangedChannelsCalled = func() chan bool {
+		return nil
+	}
+
+	err := fct.GeneratePrecommitSubround()
+
+	assert.Equal(t, spos.ErrNilChannel, err)
+}
+
+func TestFactory_GenerateSubroundsShouldChainAllSubrounds(t *testing.T) {
+	t.Parallel()
+
+	subroundHandlers := make([]consensus.SubroundHandler, 0)
+	chrm := &mock.ChronologyHandlerMock{}
+	chrm.AddSubroundCalled = func(subroundHandler consensus.SubroundHandler) {
+		subroundHandlers = append(subroundHandlers, subroundHandler)
+	}
+
+	registeredMessages := make(map[consensus.MessageType]struct{})
+	container := mock.InitConsensusCore()
+	container.SetChronology(chrm)
+	fct := *initFactoryWithContainer(container)
+	fct.Worker().(*mock.SposWorkerMock).AddReceivedMessageCallCalled =
+		func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {
+			registeredMessages[messageType] = struct{}{}
+		}
+
+	err := fct.GenerateSubrounds()
+	assert.Nil(t, err)
+
+	expectedOrder := []int{pbft.SrStartRound, pbft.SrBlock, pbft.SrPrevote, pbft.SrPrecommit, pbft.SrEndRound}
+	assert.Equal(t, len(expectedOrder), len(subroundHandlers))
+	for i, subroundHandler := range subroundHandlers {
+		assert.Equal(t, expectedOrder[i], subroundHandler.Current())
+		if i+1 < len(expectedOrder) {
+			assert.Equal(t, expectedOrder[i+1], subroundHandler.Next())
+		}
+	}
+
+	service, _ := pbft.NewConsensusService()
+	for _, msgType := range service.GetMessageRange() {
+		_, found := registeredMessages[msgType]
+		assert.True(t, found, service.GetStringValue(msgType))
+	}
+
+	pbftThreshold := fct.ConsensusState().ConsensusGroupSize()*2/3 + 1
+	assert.Equal(t, pbftThreshold, fct.ConsensusState().Threshold(pbft.SrPrevote))
+	assert.Equal(t, pbftThreshold, fct.ConsensusState().Threshold(pbft.SrPrecommit))
+}
+
+func TestFactory_SetAppStatusHandlerNilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	err := fct.SetAppStatusHandler(nil)
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
+func TestFactory_SetAppStatusHandlerOkStatusHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fct := *initFactory()
+
+	ash := &mock.AppStatusHandlerMock{}
+	err := fct.SetAppStatusHandler(ash)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ash, fct.AppStatusHandler())
+}
@@ -0,0 +1,161 @@
+package pbft_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createEligibleList(size int) []string {
+	eligibleList := make([]string, 0)
+	for i := 0; i < size; i++ {
+		eligibleList = append(eligibleList, fmt.Sprintf("%c", rune(i+65)))
+	}
+	return eligibleList
+}
+
+func initConsensusState() *spos.ConsensusState {
+	consensusGroupSize := 9
+	eligibleList := createEligibleList(consensusGroupSize)
+
+	eligibleNodesPubKeys := make(map[string]struct{})
+	for _, key := range eligibleList {
+		eligibleNodesPubKeys[key] = struct{}{}
+	}
+
+	indexLeader := 1
+	rcns := spos.NewRoundConsensus(
+		eligibleNodesPubKeys,
+		consensusGroupSize,
+		eligibleList[indexLeader])
+
+	rcns.SetConsensusGroup(eligibleList)
+	rcns.ResetRoundState()
+
+	pbftThreshold := consensusGroupSize*2/3 + 1
+
+	rthr := spos.NewRoundThreshold()
+	rthr.SetThreshold(pbft.SrBlock, 1)
+	rthr.SetThreshold(pbft.SrPrevote, pbftThreshold)
+	rthr.SetThreshold(pbft.SrPrecommit, pbftThreshold)
+
+	rstatus := spos.NewRoundStatus()
+	rstatus.ResetRoundStatus()
+
+	cns := spos.NewConsensusState(
+		rcns,
+		rthr,
+		rstatus,
+	)
+
+	cns.Data = []byte("X")
+	cns.RoundIndex = 0
+	return cns
+}
+
+func TestWorker_NewConsensusServiceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	service, err := pbft.NewConsensusService()
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(service))
+}
+
+func TestWorker_InitReceivedMessagesShouldContainAllMessageTypes(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+	messages := service.InitReceivedMessages()
+
+	assert.Equal(t, 6, len(messages))
+	for _, msgType := range service.GetMessageRange() {
+		assert.NotNil(t, messages[msgType])
+	}
+}
+
+func TestWorker_MessageTypesShouldNotOverlap(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+	seen := make(map[consensus.MessageType]struct{})
+	for _, msgType := range service.GetMessageRange() {
+		_, found := seen[msgType]
+		assert.False(t, found)
+		seen[msgType] = struct{}{}
+		assert.True(t, service.IsMessageTypeValid(msgType))
+	}
+
+	assert.False(t, service.IsMessageTypeValid(pbft.MtUnknown))
+}
+
+func TestWorker_GetMessageRangeShouldOrderPrevotesBeforePrecommits(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+	v := service.GetMessageRange()
+
+	assert.Equal(t, []consensus.MessageType{
+		pbft.MtBlockBodyAndHeader,
+		pbft.MtBlockBody,
+		pbft.MtBlockHeader,
+		pbft.MtPrevote,
+		pbft.MtPrecommit,
+		pbft.MtBlockHeaderFinalInfo,
+	}, v)
+}
+
+func TestWorker_IsMessageWithSignatureShouldAcceptPrevotesAndPrecommits(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+
+	assert.True(t, service.IsMessageWithSignature(pbft.MtPrevote))
+	assert.True(t, service.IsMessageWithSignature(pbft.MtPrecommit))
+	assert.False(t, service.IsMessageWithSignature(pbft.MtBlockHeader))
+	assert.False(t, service.IsMessageWithSignature(pbft.MtBlockHeaderFinalInfo))
+}
+
+func TestWorker_CanProceedShouldFollowTheSubroundsOrder(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+	consensusState := initConsensusState()
+
+	assert.False(t, service.CanProceed(consensusState, pbft.MtBlockHeader))
+	consensusState.SetStatus(pbft.SrStartRound, spos.SsFinished)
+	assert.True(t, service.CanProceed(consensusState, pbft.MtBlockHeader))
+
+	assert.False(t, service.CanProceed(consensusState, pbft.MtPrevote))
+	consensusState.SetStatus(pbft.SrBlock, spos.SsFinished)
+	assert.True(t, service.CanProceed(consensusState, pbft.MtPrevote))
+
+	assert.False(t, service.CanProceed(consensusState, pbft.MtPrecommit))
+	consensusState.SetStatus(pbft.SrPrevote, spos.SsFinished)
+	assert.True(t, service.CanProceed(consensusState, pbft.MtPrecommit))
+
+	assert.False(t, service.CanProceed(consensusState, pbft.MtBlockHeaderFinalInfo))
+	consensusState.SetStatus(pbft.SrPrecommit, spos.SsFinished)
+	assert.True(t, service.CanProceed(consensusState, pbft.MtBlockHeaderFinalInfo))
+
+	assert.False(t, service.CanProceed(consensusState, pbft.MtUnknown))
+}
+
+func TestWorker_GetSubroundNameAndStringValue(t *testing.T) {
+	t.Parallel()
+
+	service, _ := pbft.NewConsensusService()
+
+	assert.Equal(t, "(PREVOTE)", service.GetSubroundName(pbft.SrPrevote))
+	assert.Equal(t, "(PRECOMMIT)", service.GetSubroundName(pbft.SrPrecommit))
+	assert.Equal(t, "Undefined subround", service.GetSubroundName(-1))
+
+	assert.Equal(t, pbft.BlockPrevoteStringValue, pbft.GetStringValue(pbft.MtPrevote))
+	assert.Equal(t, pbft.BlockPrecommitStringValue, pbft.GetStringValue(pbft.MtPrecommit))
+	assert.Equal(t, "(FINAL_INFO)", pbft.GetStringValue(pbft.MtBlockHeaderFinalInfo))
+	assert.Equal(t, "Undefined message type", pbft.GetStringValue(consensus.MessageType(-1)))
+}
@@ -0,0 +1,242 @@
+package pbft
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+// subroundPrevote is the first voting step: every validator of the consensus group that has processed the
+// proposed block broadcasts a signed vote on its hash. A validator moves to precommit only after it has seen the
+// prevotes of a qualified majority, so no precommit share is produced for a block the group did not agree on
+type subroundPrevote struct {
+	*spos.Subround
+
+	appStatusHandler core.AppStatusHandler
+}
+
+// NewSubroundPrevote creates a subroundPrevote object
+func NewSubroundPrevote(
+	baseSubround *spos.Subround,
+	extend func(subroundId int),
+) (*subroundPrevote, error) {
+	err := checkNewSubroundPrevoteParams(
+		baseSubround,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	srPrevote := subroundPrevote{
+		Subround:         baseSubround,
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+	}
+	srPrevote.Job = srPrevote.doPrevoteJob
+	srPrevote.Check = srPrevote.doPrevoteConsensusCheck
+	srPrevote.Extend = extend
+
+	return &srPrevote, nil
+}
+
+// SetAppStatusHandler method set appStatusHandler
+func (sr *subroundPrevote) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if check.IfNil(ash) {
+		return spos.ErrNilAppStatusHandler
+	}
+
+	sr.appStatusHandler = ash
+	return nil
+}
+
+func checkNewSubroundPrevoteParams(
+	baseSubround *spos.Subround,
+) error {
+	if baseSubround == nil {
+		return spos.ErrNilSubround
+	}
+	if baseSubround.ConsensusState == nil {
+		return spos.ErrNilConsensusState
+	}
+
+	err := spos.ValidateConsensusCore(baseSubround.ConsensusCoreHandler)
+
+	return err
+}
+
+// doPrevoteJob method does the job of the subround Prevote
+func (sr *subroundPrevote) doPrevoteJob() bool {
+	if !sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
+		return true
+	}
+	if !sr.CanDoSubroundJob(sr.Current()) {
+		return false
+	}
+
+	prevote, err := sr.SingleSigner().Sign(sr.PrivateKey(), createPrevoteMessage(sr.GetData()))
+	if err != nil {
+		log.Debug("doPrevoteJob.Sign", "error", err.Error())
+		return false
+	}
+
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.GetData(),
+		prevote,
+		nil,
+		nil,
+		[]byte(sr.SelfPubKey()),
+		nil,
+		int(MtPrevote),
+		sr.Rounder().Index(),
+		sr.ChainID(),
+		nil,
+		nil,
+		nil,
+		sr.CurrentPid(),
+	)
+
+	err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+	if err != nil {
+		log.Debug("doPrevoteJob.BroadcastConsensusMessage", "error", err.Error())
+		return false
+	}
+
+	log.Debug("step 2: prevote has been sent")
+
+	err = sr.SetSelfJobDone(sr.Current(), true)
+	if err != nil {
+		log.Debug("doPrevoteJob.SetSelfJobDone",
+			"subround", sr.Name(),
+			"error", err.Error())
+		return false
+	}
+
+	return true
+}
+
+// receivedPrevote method is called when a prevote is received through the prevote channel.
+// If the prevote is valid, than the jobDone map corresponding to the node which sent it,
+// is set on true for the subround Prevote
+func (sr *subroundPrevote) receivedPrevote(cnsDta *consensus.Message) bool {
+	node := string(cnsDta.PubKey)
+
+	if !sr.IsConsensusDataSet() {
+		return false
+	}
+
+	if !sr.IsNodeInConsensusGroup(node) {
+		sr.PeerHonestyHandler().ChangeScore(
+			node,
+			spos.GetConsensusTopicID(sr.ShardCoordinator()),
+			spos.ValidatorPeerHonestyDecreaseFactor,
+		)
+
+		return false
+	}
+
+	if !sr.IsConsensusDataEqual(cnsDta.BlockHeaderHash) {
+		return false
+	}
+
+	if !sr.CanProcessReceivedMessage(cnsDta, sr.Rounder().Index(), sr.Current()) {
+		return false
+	}
+
+	index, err := sr.ConsensusGroupIndex(node)
+	if err != nil {
+		log.Debug("receivedPrevote.ConsensusGroupIndex",
+			"node", node,
+			"error", err.Error())
+		return false
+	}
+
+	err = sr.MultiSigner().VerifySignatureShare(uint16(index), cnsDta.SignatureShare, createPrevoteMessage(cnsDta.BlockHeaderHash), nil)
+	if err != nil {
+		log.Debug("receivedPrevote.VerifySignatureShare",
+			"index", index,
+			"error", err.Error())
+		return false
+	}
+
+	err = sr.SetJobDone(node, sr.Current(), true)
+	if err != nil {
+		log.Debug("receivedPrevote.SetJobDone",
+			"node", node,
+			"subround", sr.Name(),
+			"error", err.Error())
+		return false
+	}
+
+	sr.PeerHonestyHandler().ChangeScore(
+		node,
+		spos.GetConsensusTopicID(sr.ShardCoordinator()),
+		spos.ValidatorPeerHonestyIncreaseFactor,
+	)
+
+	return true
+}
+
+// doPrevoteConsensusCheck method checks if the consensus in the subround Prevote is achieved
+func (sr *subroundPrevote) doPrevoteConsensusCheck() bool {
+	if sr.RoundCanceled {
+		return false
+	}
+
+	if sr.IsSubroundFinished(sr.Current()) {
+		sr.appStatusHandler.SetStringValue(core.MetricConsensusRoundState, "prevoted")
+
+		return true
+	}
+
+	isSelfInConsensusGroup := sr.IsNodeInConsensusGroup(sr.SelfPubKey())
+	arePrevotesCollected, numPrevotes := sr.prevotesCollected(sr.Threshold(sr.Current()))
+	isJobDoneByConsensusNode := isSelfInConsensusGroup && sr.IsSelfJobDone(sr.Current()) && arePrevotesCollected
+
+	isSubroundFinished := !isSelfInConsensusGroup || isJobDoneByConsensusNode
+	if isSubroundFinished {
+		log.Debug("step 2: subround has been finished",
+			"subround", sr.Name(),
+			"prevotes", numPrevotes,
+			"total", len(sr.ConsensusGroup()))
+		sr.SetStatus(sr.Current(), spos.SsFinished)
+
+		sr.appStatusHandler.SetStringValue(core.MetricConsensusRoundState, "prevoted")
+
+		return true
+	}
+
+	return false
+}
+
+// prevotesCollected method checks if the prevotes received from the nodes, belonging to the current
+// jobDone group, are more than the necessary given threshold
+func (sr *subroundPrevote) prevotesCollected(threshold int) (bool, int) {
+	n := 0
+
+	for i := 0; i < len(sr.ConsensusGroup()); i++ {
+		node := sr.ConsensusGroup()[i]
+
+		isPrevoteJobDone, err := sr.JobDone(node, sr.Current())
+		if err != nil {
+			log.Debug("prevotesCollected.JobDone",
+				"node", node,
+				"subround", sr.Name(),
+				"error", err.Error())
+			continue
+		}
+
+		if isPrevoteJobDone {
+			n++
+		}
+	}
+
+	return n >= threshold, n
+}
+
+func createPrevoteMessage(headerHash []byte) []byte {
+	message := make([]byte, 0, len(prevotePrefix)+len(headerHash))
+	message = append(message, prevotePrefix...)
+
+	return append(message, headerHash...)
+}
@@ -0,0 +1,237 @@
+package pbft_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func initSubroundPrevoteWithContainer(container *mock.ConsensusCoreMock) pbft.SubroundPrevote {
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+
+	sr, _ := spos.NewSubround(
+		pbft.SrBlock,
+		pbft.SrPrevote,
+		pbft.SrPrecommit,
+		int64(25*roundTimeDuration/100),
+		int64(50*roundTimeDuration/100),
+		"(PREVOTE)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+
+	srPrevote, _ := pbft.NewSubroundPrevote(
+		sr,
+		extend,
+	)
+
+	return srPrevote
+}
+
+func initSubroundPrevote() pbft.SubroundPrevote {
+	container := mock.InitConsensusCore()
+	return initSubroundPrevoteWithContainer(container)
+}
+
+func createPrevoteMessage(headerHash []byte, pubKey string) *consensus.Message {
+	return consensus.NewConsensusMessage(
+		headerHash,
+		[]byte("prevote"),
+		nil,
+		nil,
+		[]byte(pubKey),
+		[]byte("sig"),
+		int(pbft.MtPrevote),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+}
+
+func TestSubroundPrevote_NewSubroundPrevoteNilSubroundShouldFail(t *testing.T) {
+	t.Parallel()
+
+	srPrevote, err := pbft.NewSubroundPrevote(
+		nil,
+		extend,
+	)
+
+	assert.Nil(t, srPrevote)
+	assert.Equal(t, spos.ErrNilSubround, err)
+}
+
+func TestSubroundPrevote_NewSubroundPrevoteShouldWork(t *testing.T) {
+	t.Parallel()
+
+	srPrevote := initSubroundPrevote()
+
+	assert.NotNil(t, srPrevote)
+}
+
+func TestSubroundPrevote_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundPrevote()
+
+	err := sr.SetAppStatusHandler(nil)
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
+func TestSubroundPrevote_DoPrevoteJobNotInConsensusGroupShouldReturnTrueWithoutBroadcast(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			assert.Fail(t, "should not broadcast a prevote")
+			return nil
+		},
+	})
+	sr := *initSubroundPrevoteWithContainer(container)
+	sr.SetSelfPubKey("not in consensus")
+
+	assert.True(t, sr.DoPrevoteJob())
+}
+
+func TestSubroundPrevote_DoPrevoteJobShouldBroadcastSignedPrevote(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	var signedMessage []byte
+	container.SetSingleSigner(&mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			signedMessage = msg
+			return []byte("prevote"), nil
+		},
+	})
+	var broadcastMessage *consensus.Message
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			broadcastMessage = message
+			return nil
+		},
+	})
+	sr := *initSubroundPrevoteWithContainer(container)
+
+	sr.Data = nil
+	assert.False(t, sr.DoPrevoteJob())
+
+	sr.Data = []byte("X")
+	assert.True(t, sr.DoPrevoteJob())
+	assert.True(t, sr.IsSelfJobDone(pbft.SrPrevote))
+	assert.Equal(t, pbft.CreatePrevoteMessage([]byte("X")), signedMessage)
+	assert.NotEqual(t, []byte("X"), signedMessage)
+	assert.Equal(t, int64(pbft.MtPrevote), broadcastMessage.MsgType)
+	assert.Equal(t, []byte("prevote"), broadcastMessage.SignatureShare)
+	assert.Equal(t, []byte("X"), broadcastMessage.BlockHeaderHash)
+
+	assert.False(t, sr.DoPrevoteJob())
+}
+
+func TestSubroundPrevote_DoPrevoteJobSignErrorShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	container.SetSingleSigner(&mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return nil, errors.New("sign error")
+		},
+	})
+	sr := *initSubroundPrevoteWithContainer(container)
+
+	assert.False(t, sr.DoPrevoteJob())
+	assert.False(t, sr.IsSelfJobDone(pbft.SrPrevote))
+}
+
+func TestSubroundPrevote_ReceivedPrevote(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundPrevote()
+	cnsMsg := createPrevoteMessage([]byte("X"), sr.ConsensusGroup()[2])
+
+	sr.Data = nil
+	assert.False(t, sr.ReceivedPrevote(cnsMsg))
+
+	sr.Data = []byte("Y")
+	assert.False(t, sr.ReceivedPrevote(cnsMsg))
+
+	sr.Data = []byte("X")
+	cnsMsg.PubKey = []byte("not in consensus")
+	assert.False(t, sr.ReceivedPrevote(cnsMsg))
+
+	cnsMsg.PubKey = []byte(sr.ConsensusGroup()[2])
+	assert.True(t, sr.ReceivedPrevote(cnsMsg))
+
+	isJobDone, _ := sr.JobDone(sr.ConsensusGroup()[2], pbft.SrPrevote)
+	assert.True(t, isJobDone)
+}
+
+func TestSubroundPrevote_ReceivedPrevoteInvalidSignatureShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	multiSigner := mock.InitMultiSignerMock()
+	var verifiedMessage []byte
+	multiSigner.VerifySignatureShareMock = func(index uint16, sig []byte, msg []byte, bitmap []byte) error {
+		verifiedMessage = msg
+		return crypto.ErrSigNotValid
+	}
+	container.SetMultiSigner(multiSigner)
+	sr := *initSubroundPrevoteWithContainer(container)
+	cnsMsg := createPrevoteMessage([]byte("X"), sr.ConsensusGroup()[2])
+
+	assert.False(t, sr.ReceivedPrevote(cnsMsg))
+	assert.Equal(t, pbft.CreatePrevoteMessage(cnsMsg.BlockHeaderHash), verifiedMessage)
+
+	isJobDone, _ := sr.JobDone(sr.ConsensusGroup()[2], pbft.SrPrevote)
+	assert.False(t, isJobDone)
+}
+
+func TestSubroundPrevote_DoPrevoteConsensusCheckShouldWaitForThreshold(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundPrevote()
+
+	sr.RoundCanceled = true
+	assert.False(t, sr.DoPrevoteConsensusCheck())
+	sr.RoundCanceled = false
+
+	_ = sr.SetSelfJobDone(pbft.SrPrevote, true)
+	assert.False(t, sr.DoPrevoteConsensusCheck())
+
+	threshold := sr.Threshold(pbft.SrPrevote)
+	for i := 0; i < threshold; i++ {
+		_ = sr.SetJobDone(sr.ConsensusGroup()[i], pbft.SrPrevote, true)
+	}
+
+	isCollected, numPrevotes := sr.PrevotesCollected(threshold)
+	assert.True(t, isCollected)
+	assert.Equal(t, threshold, numPrevotes)
+
+	assert.True(t, sr.DoPrevoteConsensusCheck())
+	assert.True(t, sr.IsSubroundFinished(pbft.SrPrevote))
+}
+
+func TestSubroundPrevote_DoPrevoteConsensusCheckNotInConsensusGroupShouldFinish(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundPrevote()
+	sr.SetSelfPubKey("not in consensus")
+
+	assert.True(t, sr.DoPrevoteConsensusCheck())
+	assert.True(t, sr.IsSubroundFinished(pbft.SrPrevote))
+}
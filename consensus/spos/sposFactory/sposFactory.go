@@ -1,10 +1,12 @@
 package sposFactory
 
 import (
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/pbft"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -22,8 +24,11 @@ func GetSubroundsFactory(
 	consensusType string,
 	appStatusHandler core.AppStatusHandler,
 	indexer indexer.Indexer,
+	tracer core.Tracer,
 	chainID []byte,
 	currentPid core.PeerID,
+	backupLeaderTimeFraction float64,
+	adaptiveSubroundsConfig config.AdaptiveSubroundsConfig,
 ) (spos.SubroundsFactory, error) {
 	switch consensusType {
 	case blsConsensusType:
@@ -43,9 +48,59 @@ func GetSubroundsFactory(
 			return nil, err
 		}
 
+		err = subRoundFactoryBls.SetTracer(tracer)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryBls.SetBackupLeaderTimeFraction(backupLeaderTimeFraction)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryBls.SetAdaptiveSubroundsConfig(adaptiveSubroundsConfig)
+		if err != nil {
+			return nil, err
+		}
+
 		subRoundFactoryBls.SetIndexer(indexer)
 
 		return subRoundFactoryBls, nil
+	case pbftConsensusType:
+		subRoundFactoryPbft, err := pbft.NewSubroundsFactory(
+			consensusDataContainer,
+			consensusState,
+			worker,
+			chainID,
+			currentPid,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryPbft.SetAppStatusHandler(appStatusHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryPbft.SetTracer(tracer)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryPbft.SetBackupLeaderTimeFraction(backupLeaderTimeFraction)
+		if err != nil {
+			return nil, err
+		}
+
+		err = subRoundFactoryPbft.SetAdaptiveSubroundsConfig(adaptiveSubroundsConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		subRoundFactoryPbft.SetIndexer(indexer)
+
+		return subRoundFactoryPbft, nil
 	default:
 		return nil, ErrInvalidConsensusType
 	}
@@ -56,6 +111,8 @@ func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error
 	switch consensusType {
 	case blsConsensusType:
 		return bls.NewConsensusService()
+	case pbftConsensusType:
+		return pbft.NewConsensusService()
 	default:
 		return nil, ErrInvalidConsensusType
 	}
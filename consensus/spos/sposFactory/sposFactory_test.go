@@ -1,14 +1,17 @@
 package sposFactory_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +35,15 @@ func TestGetConsensusCoreFactory_BlsShouldWork(t *testing.T) {
 	assert.False(t, check.IfNil(csf))
 }
 
+func TestGetConsensusCoreFactory_PbftShouldWork(t *testing.T) {
+	t.Parallel()
+
+	csf, err := sposFactory.GetConsensusCoreFactory(consensus.PbftConsensusType)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(csf))
+}
+
 func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -47,8 +59,11 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		consensusType,
 		statusHandler,
 		indexer,
+		tracing.NewDisabledTracer(),
 		chainID,
 		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
 	)
 
 	assert.Nil(t, sf)
@@ -70,14 +85,98 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		consensusType,
 		nil,
 		indexer,
+		tracing.NewDisabledTracer(),
 		chainID,
 		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
 	)
 
 	assert.Nil(t, sf)
 	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
 }
 
+func TestGetSubroundsFactory_BlsNilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.BlsConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		nil,
+		chainID,
+		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
+	)
+
+	assert.Nil(t, sf)
+	assert.Equal(t, spos.ErrNilTracer, err)
+}
+
+func TestGetSubroundsFactory_BlsInvalidBackupLeaderTimeFractionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.BlsConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		tracing.NewDisabledTracer(),
+		chainID,
+		currentPid,
+		1,
+		config.AdaptiveSubroundsConfig{},
+	)
+
+	assert.Nil(t, sf)
+	assert.Equal(t, spos.ErrInvalidBackupLeaderTimeFraction, err)
+}
+
+func TestGetSubroundsFactory_BlsInvalidAdaptiveSubroundsConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.BlsConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		tracing.NewDisabledTracer(),
+		chainID,
+		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{Enabled: true},
+	)
+
+	assert.Nil(t, sf)
+	assert.True(t, errors.Is(err, spos.ErrInvalidAdaptiveSubroundsConfig))
+}
+
 func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -94,8 +193,63 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		consensusType,
 		statusHandler,
 		indexer,
+		tracing.NewDisabledTracer(),
+		chainID,
+		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
+	)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sf))
+}
+
+func TestGetSubroundsFactory_PbftNilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.PbftConsensusType
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		nil,
+		indexer,
+		tracing.NewDisabledTracer(),
+		chainID,
+		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
+	)
+
+	assert.Nil(t, sf)
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
+func TestGetSubroundsFactory_PbftShouldWork(t *testing.T) {
+	t.Parallel()
+
+	consensusCore := mock.InitConsensusCore()
+	worker := &mock.SposWorkerMock{}
+	consensusType := consensus.PbftConsensusType
+	statusHandler := &mock.AppStatusHandlerMock{}
+	chainID := []byte("chain-id")
+	indexer := &mock.IndexerMock{}
+	sf, err := sposFactory.GetSubroundsFactory(
+		consensusCore,
+		&spos.ConsensusState{},
+		worker,
+		consensusType,
+		statusHandler,
+		indexer,
+		tracing.NewDisabledTracer(),
 		chainID,
 		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
 	)
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sf))
@@ -113,7 +267,10 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		currentPid,
+		0,
+		config.AdaptiveSubroundsConfig{},
 	)
 
 	assert.Nil(t, sf)
@@ -1,11 +1,13 @@
 package spos
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -14,7 +16,8 @@ var _ consensus.SubroundHandler = (*Subround)(nil)
 // Subround struct contains the needed data for one Subround and the Subround properties. It defines a Subround
 // with it's properties (it's ID, next Subround ID, it's duration, it's name) and also it has some handler functions
 // which should be set. Job function will be the main function of this Subround, Extend function will handle the overtime
-// situation of the Subround and Check function will decide if in this Subround the consensus is achieved
+// situation of the Subround and Check function will decide if in this Subround the consensus is achieved. The optional
+// DelayedJob function is called once, when the time returned by DelayedJobTime has elapsed from the round start
 type Subround struct {
 	ConsensusCoreHandler
 	*ConsensusState
@@ -31,10 +34,15 @@ type Subround struct {
 	consensusStateChangedChannel chan bool
 	executeStoredMessages        func()
 	appStatusHandler             core.AppStatusHandler
+	tracer                       core.Tracer
+	subroundsTiming              SubroundsTimingHandler
 
 	Job    func() bool          // method does the Subround Job and send the result to the peers
 	Check  func() bool          // method checks if the consensus of the Subround is done
 	Extend func(subroundId int) // method is called when round time is out
+
+	DelayedJob     func() bool          // method does the delayed job of the Subround, if any
+	DelayedJobTime func() time.Duration // method returns the time from the round start when the delayed job is done
 }
 
 // NewSubround creates a new SubroundId object
@@ -79,6 +87,7 @@ func NewSubround(
 		Check:                        nil,
 		Extend:                       nil,
 		appStatusHandler:             statusHandler.NewNilStatusHandler(),
+		tracer:                       tracing.NewDisabledTracer(),
 		currentPid:                   currentPid,
 	}
 
@@ -120,9 +129,15 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 		return false
 	}
 
+	// the span is started before executing the stored messages so the processing they trigger is recorded as its child
+	span := sr.tracer.StartSpan("consensus.Subround" + sr.name)
+	defer sr.endSpan(span)
+
 	// execute stored messages which were received in this new round but before this initialisation
 	go sr.executeStoredMessages()
 
+	defer sr.saveDuration(time.Now())
+
 	startTime := rounder.TimeStamp()
 	maxTime := rounder.TimeDuration() * MaxThresholdPercent / 100
 
@@ -131,12 +146,24 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 		return true
 	}
 
+	// a nil channel is never selected, so the delayed job is done at most once and only if it was set
+	var delayedJobChannel <-chan time.Time
+	if sr.DelayedJob != nil && sr.DelayedJobTime != nil {
+		delayedJobChannel = time.After(rounder.RemainingTime(startTime, sr.DelayedJobTime()))
+	}
+
 	for {
 		select {
 		case <-sr.consensusStateChangedChannel:
 			if sr.Check() {
 				return true
 			}
+		case <-delayedJobChannel:
+			delayedJobChannel = nil
+			sr.DelayedJob()
+			if sr.Check() {
+				return true
+			}
 		case <-time.After(rounder.RemainingTime(startTime, maxTime)):
 			if sr.Extend != nil {
 				sr.RoundCanceled = true
@@ -148,6 +175,22 @@ func (sr *Subround) DoWork(rounder consensus.Rounder) bool {
 	}
 }
 
+// saveDuration reports the time elapsed since the provided start time as the duration of this subround
+func (sr *Subround) saveDuration(startTime time.Time) {
+	duration := time.Since(startTime)
+	metric := core.MetricWithLabels(core.MetricConsensusSubroundDuration, core.MetricLabelSubround, sr.name)
+	sr.appStatusHandler.SetUInt64Value(metric, uint64(duration.Milliseconds()))
+}
+
+// endSpan sets the round and, if already known, the block hash as trace attributes before ending the span
+func (sr *Subround) endSpan(span core.Span) {
+	span.SetTraceAttribute(core.TraceAttributeRound, sr.RoundIndex)
+	if len(sr.Data) > 0 {
+		span.SetTraceAttribute(core.TraceAttributeBlockHash, hex.EncodeToString(sr.Data))
+	}
+	span.End()
+}
+
 // Previous method returns the ID of the previous Subround
 func (sr *Subround) Previous() int {
 	return sr.previous
@@ -165,11 +208,19 @@ func (sr *Subround) Next() int {
 
 // StartTime method returns the start time of the Subround
 func (sr *Subround) StartTime() int64 {
+	if sr.subroundsTiming != nil {
+		return sr.subroundsTiming.StartTime(sr.current)
+	}
+
 	return sr.startTime
 }
 
 // EndTime method returns the upper time limit of the Subround
 func (sr *Subround) EndTime() int64 {
+	if sr.subroundsTiming != nil {
+		return sr.subroundsTiming.EndTime(sr.current)
+	}
+
 	return sr.endTime
 }
 
@@ -198,6 +249,33 @@ func (sr *Subround) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetTracer method sets the tracer recording the subround spans
+func (sr *Subround) SetTracer(tracer core.Tracer) error {
+	if check.IfNil(tracer) {
+		return ErrNilTracer
+	}
+	sr.tracer = tracer
+
+	return nil
+}
+
+// SetSubroundsTiming method sets the handler computing the subround times from the current round duration
+func (sr *Subround) SetSubroundsTiming(subroundsTiming SubroundsTimingHandler) error {
+	if check.IfNil(subroundsTiming) {
+		return ErrNilSubroundsTiming
+	}
+	sr.subroundsTiming = subroundsTiming
+
+	return nil
+}
+
+// AddProposalMeasurement records the time elapsed since the round start until the block proposal was ready
+func (sr *Subround) AddProposalMeasurement(elapsedTime time.Duration) {
+	if sr.subroundsTiming != nil {
+		sr.subroundsTiming.AddProposalMeasurement(elapsedTime)
+	}
+}
+
 // AppStatusHandler method returns the appStatusHandler instance
 func (sr *Subround) AppStatusHandler() core.AppStatusHandler {
 	return sr.appStatusHandler
@@ -1,6 +1,7 @@
 package spos_test
 
 import (
+	"encoding/hex"
 	"sync"
 	"testing"
 	"time"
@@ -710,6 +711,61 @@ func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhi
 	assert.True(t, r)
 }
 
+func TestSubround_DoWorkShouldDoTheDelayedJobOnceItsTimeIsReached(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	ch := make(chan bool, 1)
+	container := mock.InitConsensusCore()
+
+	sr, _ := spos.NewSubround(
+		-1,
+		bls.SrStartRound,
+		bls.SrBlock,
+		int64(0*roundTimeDuration/100),
+		int64(5*roundTimeDuration/100),
+		"(START_ROUND)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+
+	numDelayedJobCalls := 0
+	sr.Job = func() bool {
+		return false
+	}
+	sr.Check = func() bool {
+		return numDelayedJobCalls > 0
+	}
+	delayedJobTime := 50 * time.Millisecond
+	sr.DelayedJob = func() bool {
+		numDelayedJobCalls++
+		return true
+	}
+	sr.DelayedJobTime = func() time.Duration {
+		return delayedJobTime
+	}
+
+	maxTime := time.Now().Add(2000 * time.Millisecond)
+	rounderMock := &mock.RounderMock{}
+	rounderMock.RemainingTimeCalled = func(startTime time.Time, maxDuration time.Duration) time.Duration {
+		if maxDuration == delayedJobTime {
+			return delayedJobTime
+		}
+
+		return time.Until(maxTime)
+	}
+
+	r := sr.DoWork(rounderMock)
+
+	assert.True(t, r)
+	assert.Equal(t, 1, numDelayedJobCalls)
+	assert.True(t, time.Until(maxTime) > time.Second)
+}
+
 func TestSubround_Previous(t *testing.T) {
 	t.Parallel()
 
@@ -915,3 +971,116 @@ func TestSubround_AppStatusHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ash == sr.AppStatusHandler())
 }
+
+func TestSubround_SetTracerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := &spos.Subround{}
+	err := sr.SetTracer(nil)
+
+	assert.Equal(t, spos.ErrNilTracer, err)
+}
+
+func TestSubround_DoWorkShouldRecordASpanHoldingTheRoundAndTheBlockHash(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	consensusState.RoundIndex = 37
+	consensusState.Data = []byte("block hash")
+	container := mock.InitConsensusCore()
+	sr, _ := spos.NewSubround(
+		bls.SrStartRound,
+		bls.SrBlock,
+		bls.SrSignature,
+		int64(5*roundTimeDuration/100),
+		int64(25*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		make(chan bool, 1),
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+	sr.Job = func() bool {
+		return true
+	}
+	sr.Check = func() bool {
+		return true
+	}
+
+	startedSpan := ""
+	spanEnded := false
+	traceAttributes := make(map[string]interface{})
+	err := sr.SetTracer(&mock.TracerStub{
+		StartSpanCalled: func(name string) core.Span {
+			startedSpan = name
+			return &mock.SpanStub{
+				SetTraceAttributeCalled: func(key string, value interface{}) {
+					traceAttributes[key] = value
+				},
+				EndCalled: func() {
+					spanEnded = true
+				},
+			}
+		},
+	})
+	assert.Nil(t, err)
+
+	assert.True(t, sr.DoWork(&mock.RounderMock{}))
+	assert.Equal(t, "consensus.Subround(BLOCK)", startedSpan)
+	assert.True(t, spanEnded)
+	assert.Equal(t, int64(37), traceAttributes[core.TraceAttributeRound])
+	assert.Equal(t, hex.EncodeToString([]byte("block hash")), traceAttributes[core.TraceAttributeBlockHash])
+}
+
+func TestSubround_SetSubroundsTimingNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sr := &spos.Subround{}
+	err := sr.SetSubroundsTiming(nil)
+
+	assert.Equal(t, spos.ErrNilSubroundsTiming, err)
+}
+
+func TestSubround_StartTimeAndEndTimeShouldUseTheSubroundsTimingWhenSet(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	container := mock.InitConsensusCore()
+	sr, _ := spos.NewSubround(
+		bls.SrStartRound,
+		bls.SrBlock,
+		bls.SrSignature,
+		int64(5*roundTimeDuration/100),
+		int64(25*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		make(chan bool, 1),
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+	assert.Equal(t, int64(5*roundTimeDuration/100), sr.StartTime())
+	assert.Equal(t, int64(25*roundTimeDuration/100), sr.EndTime())
+
+	var measuredTime time.Duration
+	err := sr.SetSubroundsTiming(&mock.SubroundsTimingStub{
+		StartTimeCalled: func(subroundID int) int64 {
+			return int64(subroundID) * 10
+		},
+		EndTimeCalled: func(subroundID int) int64 {
+			return int64(subroundID) * 20
+		},
+		AddProposalMeasurementCalled: func(elapsedTime time.Duration) {
+			measuredTime = elapsedTime
+		},
+	})
+	assert.Nil(t, err)
+
+	sr.AddProposalMeasurement(time.Second)
+	assert.Equal(t, int64(bls.SrBlock*10), sr.StartTime())
+	assert.Equal(t, int64(bls.SrBlock*20), sr.EndTime())
+	assert.Equal(t, time.Second, measuredTime)
+}
@@ -0,0 +1,185 @@
+package spos
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var _ SubroundsTimingHandler = (*subroundsTiming)(nil)
+
+// SubroundTimes holds the start and end times of a subround, as fractions of the round duration
+type SubroundTimes struct {
+	StartTime float64
+	EndTime   float64
+}
+
+// ArgSubroundsTiming holds the arguments needed to create a subrounds timing handler
+type ArgSubroundsTiming struct {
+	Rounder         consensus.Rounder
+	Subrounds       map[int]SubroundTimes
+	BlockSubroundID int
+	NextSubroundID  int
+	Config          config.AdaptiveSubroundsConfig
+}
+
+// subroundsTiming computes the subrounds times from the duration of the current round, so they follow the rounds
+// schedule. When adaptive, the end of the block subround, which is also the start of the next subround, is moved so
+// that it covers the slowest block proposal measured in the last rounds, within the configured bounds
+type subroundsTiming struct {
+	rounder         consensus.Rounder
+	subrounds       map[int]SubroundTimes
+	blockSubroundID int
+	nextSubroundID  int
+
+	adaptive            bool
+	numRoundsToMeasure  int
+	safetyFactor        float64
+	minBlockSubroundEnd float64
+	maxBlockSubroundEnd float64
+
+	mutMeasurements  sync.RWMutex
+	measurements     []float64
+	blockSubroundEnd float64
+}
+
+// NewSubroundsTiming creates a new subrounds timing handler
+func NewSubroundsTiming(args ArgSubroundsTiming) (*subroundsTiming, error) {
+	err := checkArgSubroundsTiming(args)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &subroundsTiming{
+		rounder:             args.Rounder,
+		subrounds:           args.Subrounds,
+		blockSubroundID:     args.BlockSubroundID,
+		nextSubroundID:      args.NextSubroundID,
+		adaptive:            args.Config.Enabled,
+		numRoundsToMeasure:  int(args.Config.NumRoundsToMeasure),
+		safetyFactor:        args.Config.SafetyFactor,
+		minBlockSubroundEnd: args.Config.MinBlockSubroundEnd,
+		maxBlockSubroundEnd: args.Config.MaxBlockSubroundEnd,
+		measurements:        make([]float64, 0, args.Config.NumRoundsToMeasure),
+		blockSubroundEnd:    args.Subrounds[args.BlockSubroundID].EndTime,
+	}
+
+	return st, nil
+}
+
+func checkArgSubroundsTiming(args ArgSubroundsTiming) error {
+	if check.IfNil(args.Rounder) {
+		return ErrNilRounder
+	}
+
+	blockSubround, ok := args.Subrounds[args.BlockSubroundID]
+	if !ok {
+		return fmt.Errorf("%w: missing block subround %d", ErrInvalidSubroundsTiming, args.BlockSubroundID)
+	}
+	nextSubround, ok := args.Subrounds[args.NextSubroundID]
+	if !ok {
+		return fmt.Errorf("%w: missing next subround %d", ErrInvalidSubroundsTiming, args.NextSubroundID)
+	}
+	if !args.Config.Enabled {
+		return nil
+	}
+
+	if args.Config.NumRoundsToMeasure == 0 {
+		return fmt.Errorf("%w: NumRoundsToMeasure should be greater than 0", ErrInvalidAdaptiveSubroundsConfig)
+	}
+	if args.Config.SafetyFactor < 1 {
+		return fmt.Errorf("%w: SafetyFactor should be at least 1", ErrInvalidAdaptiveSubroundsConfig)
+	}
+	isMinBlockSubroundEndValid := args.Config.MinBlockSubroundEnd > blockSubround.StartTime &&
+		args.Config.MinBlockSubroundEnd <= args.Config.MaxBlockSubroundEnd
+	if !isMinBlockSubroundEndValid {
+		return fmt.Errorf("%w: MinBlockSubroundEnd should be between the block subround start %v and MaxBlockSubroundEnd",
+			ErrInvalidAdaptiveSubroundsConfig, blockSubround.StartTime)
+	}
+	if args.Config.MaxBlockSubroundEnd >= nextSubround.EndTime {
+		return fmt.Errorf("%w: MaxBlockSubroundEnd should be lower than the next subround end %v",
+			ErrInvalidAdaptiveSubroundsConfig, nextSubround.EndTime)
+	}
+
+	return nil
+}
+
+// StartTime returns the start time of the given subround in the current round
+func (st *subroundsTiming) StartTime(subroundID int) int64 {
+	startTime := st.subrounds[subroundID].StartTime
+	if subroundID == st.nextSubroundID {
+		startTime = st.getBlockSubroundEnd()
+	}
+
+	return st.toRoundTime(startTime)
+}
+
+// EndTime returns the end time of the given subround in the current round
+func (st *subroundsTiming) EndTime(subroundID int) int64 {
+	endTime := st.subrounds[subroundID].EndTime
+	if subroundID == st.blockSubroundID {
+		endTime = st.getBlockSubroundEnd()
+	}
+
+	return st.toRoundTime(endTime)
+}
+
+func (st *subroundsTiming) toRoundTime(fraction float64) int64 {
+	return int64(float64(st.rounder.TimeDuration()) * fraction)
+}
+
+func (st *subroundsTiming) getBlockSubroundEnd() float64 {
+	st.mutMeasurements.RLock()
+	defer st.mutMeasurements.RUnlock()
+
+	return st.blockSubroundEnd
+}
+
+// AddProposalMeasurement adds the time elapsed since the round start until the block proposal was created or
+// processed. It does nothing if the subrounds timing is not adaptive
+func (st *subroundsTiming) AddProposalMeasurement(elapsedTime time.Duration) {
+	roundDuration := st.rounder.TimeDuration()
+	if !st.adaptive || roundDuration <= 0 || elapsedTime <= 0 {
+		return
+	}
+
+	st.mutMeasurements.Lock()
+	defer st.mutMeasurements.Unlock()
+
+	st.measurements = append(st.measurements, float64(elapsedTime)/float64(roundDuration))
+	if len(st.measurements) > st.numRoundsToMeasure {
+		st.measurements = st.measurements[len(st.measurements)-st.numRoundsToMeasure:]
+	}
+
+	slowestProposal := 0.0
+	for _, measurement := range st.measurements {
+		if measurement > slowestProposal {
+			slowestProposal = measurement
+		}
+	}
+
+	blockSubroundEnd := slowestProposal * st.safetyFactor
+	if blockSubroundEnd < st.minBlockSubroundEnd {
+		blockSubroundEnd = st.minBlockSubroundEnd
+	}
+	if blockSubroundEnd > st.maxBlockSubroundEnd {
+		blockSubroundEnd = st.maxBlockSubroundEnd
+	}
+
+	if blockSubroundEnd != st.blockSubroundEnd {
+		log.Debug("block subround end has been adapted",
+			"old end", st.blockSubroundEnd,
+			"new end", blockSubroundEnd,
+		)
+	}
+	st.blockSubroundEnd = blockSubroundEnd
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (st *subroundsTiming) IsInterfaceNil() bool {
+	return st == nil
+}
@@ -0,0 +1,167 @@
+package spos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	srStart = iota
+	srBlock
+	srSignature
+	srEnd
+)
+
+func createMockArgSubroundsTiming(roundDuration *time.Duration) spos.ArgSubroundsTiming {
+	return spos.ArgSubroundsTiming{
+		Rounder: &mock.RounderMock{
+			TimeDurationCalled: func() time.Duration {
+				return *roundDuration
+			},
+		},
+		Subrounds: map[int]spos.SubroundTimes{
+			srStart:     {StartTime: 0, EndTime: 0.05},
+			srBlock:     {StartTime: 0.05, EndTime: 0.25},
+			srSignature: {StartTime: 0.25, EndTime: 0.85},
+			srEnd:       {StartTime: 0.85, EndTime: 0.95},
+		},
+		BlockSubroundID: srBlock,
+		NextSubroundID:  srSignature,
+		Config: config.AdaptiveSubroundsConfig{
+			Enabled:             true,
+			NumRoundsToMeasure:  3,
+			SafetyFactor:        1.5,
+			MinBlockSubroundEnd: 0.15,
+			MaxBlockSubroundEnd: 0.5,
+		},
+	}
+}
+
+func TestNewSubroundsTiming_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	args.Rounder = nil
+	st, err := spos.NewSubroundsTiming(args)
+
+	assert.Nil(t, st)
+	assert.Equal(t, spos.ErrNilRounder, err)
+}
+
+func TestNewSubroundsTiming_MissingSubroundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	delete(args.Subrounds, srSignature)
+	st, err := spos.NewSubroundsTiming(args)
+
+	assert.Nil(t, st)
+	assert.True(t, errors.Is(err, spos.ErrInvalidSubroundsTiming))
+}
+
+func TestNewSubroundsTiming_InvalidAdaptiveConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := time.Second
+	testCases := map[string]func(cfg *config.AdaptiveSubroundsConfig){
+		"no rounds to measure":          func(cfg *config.AdaptiveSubroundsConfig) { cfg.NumRoundsToMeasure = 0 },
+		"safety factor lower than 1":    func(cfg *config.AdaptiveSubroundsConfig) { cfg.SafetyFactor = 0.9 },
+		"min before block start":        func(cfg *config.AdaptiveSubroundsConfig) { cfg.MinBlockSubroundEnd = 0.05 },
+		"min greater than max":          func(cfg *config.AdaptiveSubroundsConfig) { cfg.MinBlockSubroundEnd = 0.6 },
+		"max after next subround's end": func(cfg *config.AdaptiveSubroundsConfig) { cfg.MaxBlockSubroundEnd = 0.85 },
+	}
+
+	for name, modify := range testCases {
+		args := createMockArgSubroundsTiming(&roundDuration)
+		modify(&args.Config)
+		st, err := spos.NewSubroundsTiming(args)
+
+		assert.Nil(t, st, name)
+		assert.True(t, errors.Is(err, spos.ErrInvalidAdaptiveSubroundsConfig), name)
+	}
+}
+
+func TestNewSubroundsTiming_InvalidAdaptiveConfigShouldWorkWhenDisabled(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	args.Config = config.AdaptiveSubroundsConfig{}
+	st, err := spos.NewSubroundsTiming(args)
+
+	assert.Nil(t, err)
+	assert.False(t, st.IsInterfaceNil())
+}
+
+func TestSubroundsTiming_TimesShouldFollowTheRoundDuration(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 4 * time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	st, _ := spos.NewSubroundsTiming(args)
+
+	assert.Equal(t, int64(200*time.Millisecond), st.StartTime(srBlock))
+	assert.Equal(t, int64(time.Second), st.EndTime(srBlock))
+	assert.Equal(t, int64(time.Second), st.StartTime(srSignature))
+	assert.Equal(t, int64(3800*time.Millisecond), st.EndTime(srEnd))
+
+	roundDuration = 8 * time.Second
+	assert.Equal(t, int64(400*time.Millisecond), st.StartTime(srBlock))
+	assert.Equal(t, int64(2*time.Second), st.EndTime(srBlock))
+	assert.Equal(t, int64(2*time.Second), st.StartTime(srSignature))
+	assert.Equal(t, int64(7600*time.Millisecond), st.EndTime(srEnd))
+}
+
+func TestSubroundsTiming_AddProposalMeasurementShouldDoNothingWhenDisabled(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 10 * time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	args.Config.Enabled = false
+	st, _ := spos.NewSubroundsTiming(args)
+
+	st.AddProposalMeasurement(4 * time.Second)
+
+	assert.Equal(t, int64(2500*time.Millisecond), st.EndTime(srBlock))
+	assert.Equal(t, int64(2500*time.Millisecond), st.StartTime(srSignature))
+}
+
+func TestSubroundsTiming_AddProposalMeasurementShouldAdaptTheBlockSubroundEnd(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 10 * time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	st, _ := spos.NewSubroundsTiming(args)
+
+	st.AddProposalMeasurement(2 * time.Second)
+	assert.Equal(t, int64(3*time.Second), st.EndTime(srBlock))
+	assert.Equal(t, int64(3*time.Second), st.StartTime(srSignature))
+	assert.Equal(t, int64(8500*time.Millisecond), st.EndTime(srSignature))
+
+	st.AddProposalMeasurement(time.Second)
+	assert.Equal(t, int64(3*time.Second), st.EndTime(srBlock), "the slowest proposal should be kept")
+}
+
+func TestSubroundsTiming_AddProposalMeasurementShouldKeepTheBlockSubroundEndWithinBounds(t *testing.T) {
+	t.Parallel()
+
+	roundDuration := 10 * time.Second
+	args := createMockArgSubroundsTiming(&roundDuration)
+	st, _ := spos.NewSubroundsTiming(args)
+
+	st.AddProposalMeasurement(9 * time.Second)
+	assert.Equal(t, int64(5*time.Second), st.EndTime(srBlock))
+
+	st.AddProposalMeasurement(100 * time.Millisecond)
+	st.AddProposalMeasurement(100 * time.Millisecond)
+	st.AddProposalMeasurement(100 * time.Millisecond)
+	assert.Equal(t, int64(1500*time.Millisecond), st.EndTime(srBlock), "old measurements should be dropped")
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
@@ -20,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slash/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
@@ -47,6 +49,8 @@ type Worker struct {
 	headerSigVerifier       RandSeedVerifier
 	headerIntegrityVerifier HeaderIntegrityVerifier
 	appStatusHandler        core.AppStatusHandler
+	equivocationDetector    consensus.EquivocationDetector
+	messageRecorder         consensus.MessageRecorder
 	chainID                 []byte
 
 	networkShardingCollector consensus.NetworkShardingCollector
@@ -59,6 +63,7 @@ type Worker struct {
 
 	mutReceivedMessages      sync.RWMutex
 	mutReceivedMessagesCalls sync.RWMutex
+	lastRecordedRound        int64
 
 	mapDisplayHashConsensusMessage map[string][]*consensus.Message
 	mutDisplayHashConsensusMessage sync.RWMutex
@@ -125,6 +130,9 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		headerIntegrityVerifier:  args.HeaderIntegrityVerifier,
 		chainID:                  args.ChainID,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		equivocationDetector:     disabled.NewDisabledEquivocationDetector(),
+		messageRecorder:          recorder.NewDisabledMessageRecorder(),
+		lastRecordedRound:        -1,
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
@@ -329,6 +337,10 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 		return err
 	}
 
+	defer func() {
+		wrk.messageRecorder.RecordReceivedMessage(cnsMsg, err)
+	}()
+
 	msgType := consensus.MessageType(cnsMsg.MsgType)
 
 	log.Trace("received message from consensus topic",
@@ -344,6 +356,8 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 		return err
 	}
 
+	wrk.equivocationDetector.CheckConsensusMessage(cnsMsg)
+
 	wrk.updateNetworkShardingVals(message, cnsMsg)
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
@@ -610,10 +624,32 @@ func (wrk *Worker) GetConsensusStateChangedChannel() chan bool {
 // ExecuteStoredMessages tries to execute all the messages received which are valid for execution
 func (wrk *Worker) ExecuteStoredMessages() {
 	wrk.mutReceivedMessages.Lock()
+	wrk.recordRoundIfNeeded()
 	wrk.executeStoredMessages()
 	wrk.mutReceivedMessages.Unlock()
 }
 
+// recordRoundIfNeeded records the consensus group of the current round, once per round, as soon as it is known
+func (wrk *Worker) recordRoundIfNeeded() {
+	roundIndex := wrk.consensusState.RoundIndex
+	if roundIndex == wrk.lastRecordedRound {
+		return
+	}
+
+	consensusGroup := wrk.consensusState.ConsensusGroup()
+	if len(consensusGroup) == 0 {
+		return
+	}
+
+	leader, err := wrk.consensusState.GetLeader()
+	if err != nil {
+		return
+	}
+
+	wrk.lastRecordedRound = roundIndex
+	wrk.messageRecorder.RecordRound(roundIndex, consensusGroup, leader, wrk.consensusState.SelfPubKey())
+}
+
 // SetAppStatusHandler sets the status metric handler
 func (wrk *Worker) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	if check.IfNil(ash) {
@@ -624,6 +660,27 @@ func (wrk *Worker) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetEquivocationDetector sets the component which checks the consensus messages for double signing
+func (wrk *Worker) SetEquivocationDetector(detector consensus.EquivocationDetector) error {
+	if check.IfNil(detector) {
+		return ErrNilEquivocationDetector
+	}
+	wrk.equivocationDetector = detector
+
+	return nil
+}
+
+// SetMessageRecorder sets the component which records the consensus messages received by the node
+func (wrk *Worker) SetMessageRecorder(messageRecorder consensus.MessageRecorder) error {
+	if check.IfNil(messageRecorder) {
+		return ErrNilMessageRecorder
+	}
+
+	wrk.messageRecorder = messageRecorder
+
+	return nil
+}
+
 // Close will close the endless running go routine
 func (wrk *Worker) Close() error {
 	if wrk.cancelFunc != nil {
@@ -1468,6 +1468,155 @@ func TestWorker_SetAppStatusHandlerShouldWork(t *testing.T) {
 	assert.True(t, handler == wrk.AppStatusHandler())
 }
 
+func TestWorker_SetEquivocationDetectorNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrk := spos.Worker{}
+	err := wrk.SetEquivocationDetector(nil)
+
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func TestWorker_ProcessReceivedMessageShouldCallEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	wrk := *initWorker()
+	wrk.SetBlockProcessor(
+		&mock.BlockProcessorMock{
+			DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+				return &mock.HeaderHandlerStub{
+					CheckChainIDCalled: func(reference []byte) error {
+						return nil
+					},
+					GetPrevHashCalled: func() []byte {
+						return make([]byte, 0)
+					},
+				}
+			},
+			RevertAccountStateCalled: func(header data.HeaderHandler) {
+			},
+			DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+				return nil
+			},
+		},
+	)
+
+	var checkedHash []byte
+	err := wrk.SetEquivocationDetector(&mock.EquivocationDetectorStub{
+		CheckConsensusMessageCalled: func(cnsMsg *consensus.Message) {
+			checkedHash = cnsMsg.BlockHeaderHash
+		},
+	})
+	assert.Nil(t, err)
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, mock.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err = wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hdrHash, checkedHash)
+}
+
+func TestWorker_SetMessageRecorderNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrk := spos.Worker{}
+	err := wrk.SetMessageRecorder(nil)
+
+	assert.Equal(t, spos.ErrNilMessageRecorder, err)
+}
+
+func TestWorker_ProcessReceivedMessageShouldRecordTheMessageAndTheValidationResult(t *testing.T) {
+	t.Parallel()
+
+	wrk := *initWorker()
+
+	recordedErrors := make([]error, 0)
+	recordedMessages := make([]*consensus.Message, 0)
+	err := wrk.SetMessageRecorder(&mock.MessageRecorderStub{
+		RecordReceivedMessageCalled: func(cnsMsg *consensus.Message, validationErr error) {
+			recordedMessages = append(recordedMessages, cnsMsg)
+			recordedErrors = append(recordedErrors, validationErr)
+		},
+	})
+	assert.Nil(t, err)
+
+	cnsMsg := consensus.NewConsensusMessage(
+		[]byte("X"),
+		nil,
+		nil,
+		nil,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtSignature),
+		0,
+		[]byte("other chain"),
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err = wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, len(recordedMessages))
+	assert.Equal(t, cnsMsg.PubKey, recordedMessages[0].PubKey)
+	assert.Equal(t, err, recordedErrors[0])
+}
+
+func TestWorker_ExecuteStoredMessagesShouldRecordTheRoundOnce(t *testing.T) {
+	t.Parallel()
+
+	wrk := *initWorker()
+
+	recordedRounds := make([]int64, 0)
+	var recordedGroup []string
+	var recordedLeader string
+	_ = wrk.SetMessageRecorder(&mock.MessageRecorderStub{
+		RecordRoundCalled: func(roundIndex int64, consensusGroup []string, leader string, selfPubKey string) {
+			recordedRounds = append(recordedRounds, roundIndex)
+			recordedGroup = consensusGroup
+			recordedLeader = leader
+		},
+	})
+
+	wrk.ExecuteStoredMessages()
+	wrk.ExecuteStoredMessages()
+	wrk.ConsensusState().RoundIndex = 1
+	wrk.ExecuteStoredMessages()
+
+	expectedLeader, _ := wrk.ConsensusState().GetLeader()
+	assert.Equal(t, []int64{0, 1}, recordedRounds)
+	assert.Equal(t, wrk.ConsensusState().ConsensusGroup(), recordedGroup)
+	assert.Equal(t, expectedLeader, recordedLeader)
+}
+
 func TestWorker_ProcessReceivedMessageWrongHeaderShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -3,8 +3,11 @@ package core
 import (
 	"crypto/rand"
 	"os"
+	"strings"
 )
 
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
 // EmptyChannel empties the given channel
 func EmptyChannel(ch chan bool) int {
 	readsCnt := 0
@@ -33,3 +36,27 @@ func DoesFileExist(path string) bool {
 
 	return true
 }
+
+// MetricWithLabels returns the key of the provided metric series having the provided label name and value pairs, in
+// the prometheus notation: metric{name1="value1",name2="value2"}. An unpaired last label name is ignored
+func MetricWithLabels(metric string, labelPairs ...string) string {
+	if len(labelPairs) < 2 {
+		return metric
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(metric)
+	builder.WriteString("{")
+	for i := 0; i+1 < len(labelPairs); i += 2 {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+		builder.WriteString(labelPairs[i])
+		builder.WriteString("=\"")
+		builder.WriteString(labelValueReplacer.Replace(labelPairs[i+1]))
+		builder.WriteString("\"")
+	}
+	builder.WriteString("}")
+
+	return builder.String()
+}
@@ -64,3 +64,13 @@ func TestEmptyChannelShouldWorkOnNotBufferedChannel(t *testing.T) {
 	assert.Equal(t, 0, len(ch))
 	assert.Equal(t, int32(numConcurrentWrites), atomic.LoadInt32(&readsCnt))
 }
+
+func TestMetricWithLabels(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "metric", MetricWithLabels("metric"))
+	assert.Equal(t, "metric", MetricWithLabels("metric", "name"))
+	assert.Equal(t, `metric{name="value"}`, MetricWithLabels("metric", "name", "value"))
+	assert.Equal(t, `metric{a="1",b="2"}`, MetricWithLabels("metric", "a", "1", "b", "2", "c"))
+	assert.Equal(t, `metric{name="a\"b\\c\nd"}`, MetricWithLabels("metric", "name", "a\"b\\c\nd"))
+}
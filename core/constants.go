@@ -72,6 +72,9 @@ const ConsensusTopic = "consensus"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// EquivocationEvidenceTopic is the topic used to gossip proofs of validators signing conflicting blocks
+const EquivocationEvidenceTopic = "equivocationEvidence"
+
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
 
@@ -105,6 +108,15 @@ const MetricSynchronizedRound = "erd_synchronized_round"
 // MetricIsSyncing is the metric for monitoring if a node is syncing
 const MetricIsSyncing = "erd_is_syncing"
 
+// MetricTrieSyncNumSyncedNodes is the metric for monitoring the number of trie nodes synced so far
+const MetricTrieSyncNumSyncedNodes = "erd_trie_sync_num_synced_nodes"
+
+// MetricTrieSyncNumPendingNodes is the metric for monitoring the number of trie nodes still awaited from the network
+const MetricTrieSyncNumPendingNodes = "erd_trie_sync_num_pending_nodes"
+
+// MetricTrieSyncNumReceivedBytes is the metric for monitoring the number of bytes received while syncing tries
+const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_received_bytes"
+
 // MetricPublicKeyBlockSign is the metric for monitoring public key of a node used in block signing
 const MetricPublicKeyBlockSign = "erd_public_key_block_sign"
 
@@ -368,6 +380,8 @@ const (
 	NetworkShardingOrder
 	// IndexerOrder defines the order in which Indexer is notified of a start of epoch event
 	IndexerOrder
+	// RoundsScheduleOrder defines the order in which the rounder is notified of a start of epoch event
+	RoundsScheduleOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -403,6 +417,51 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricP2PTopicNumReceivedBytes is the metric for monitoring the number of bytes received on a topic. It is labeled
+// with the topic name
+const MetricP2PTopicNumReceivedBytes = "erd_p2p_topic_num_received_bytes"
+
+// MetricP2PTopicNumSentBytes is the metric for monitoring the number of bytes sent directly to peers on a topic. It is
+// labeled with the topic name
+const MetricP2PTopicNumSentBytes = "erd_p2p_topic_num_sent_bytes"
+
+// MetricP2PNumBytesSavedByCompression is the metric for monitoring the number of bytes saved by compressing the sent
+// payloads
+const MetricP2PNumBytesSavedByCompression = "erd_p2p_num_bytes_saved_by_compression"
+
+// MetricBlockProcessingTime is the metric for monitoring the time, in milliseconds, needed to process a block
+const MetricBlockProcessingTime = "erd_block_processing_time_ms"
+
+// MetricConsensusSubroundDuration is the metric for monitoring the time, in milliseconds, spent in a consensus
+// subround. It is labeled with the subround name
+const MetricConsensusSubroundDuration = "erd_consensus_subround_duration_ms"
+
+// MetricTxPoolNumTxs is the metric for monitoring the number of transactions held in a transactions pool cache. It is
+// labeled with the pool type and with the cache identifier, which contains the sender and the destination shards
+const MetricTxPoolNumTxs = "erd_tx_pool_num_txs"
+
+// MetricStorageOperationLatency is the metric for monitoring the time, in microseconds, needed by a storage operation.
+// It is labeled with the storage unit and with the operation name
+const MetricStorageOperationLatency = "erd_storage_operation_latency_us"
+
+// MetricLabelTopic is the label holding the p2p topic of a metric
+const MetricLabelTopic = "topic"
+
+// MetricLabelSubround is the label holding the consensus subround name of a metric
+const MetricLabelSubround = "subround"
+
+// MetricLabelPool is the label holding the data pool type of a metric
+const MetricLabelPool = "pool"
+
+// MetricLabelCache is the label holding the data pool cache identifier of a metric
+const MetricLabelCache = "cache"
+
+// MetricLabelUnit is the label holding the storage unit of a metric
+const MetricLabelUnit = "unit"
+
+// MetricLabelOperation is the label holding the operation name of a metric
+const MetricLabelOperation = "operation"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -489,3 +548,15 @@ const WrongP2PMessageBlacklistDuration = time.Second * 7200
 
 // MaxWaitingTimeToReceiveRequestedItem represents the maximum waiting time in seconds needed to receive the requested items
 const MaxWaitingTimeToReceiveRequestedItem = 5 * time.Second
+
+// TraceAttributeRound is the trace attribute holding the round of the traced block
+const TraceAttributeRound = "round"
+
+// TraceAttributeBlockHash is the trace attribute holding the hash of the traced block
+const TraceAttributeBlockHash = "blockHash"
+
+// TraceAttributeNonce is the span attribute holding the nonce of the traced block
+const TraceAttributeNonce = "nonce"
+
+// TraceAttributeBlockType is the span attribute holding the block type handled by a preprocessor
+const TraceAttributeBlockType = "blockType"
@@ -43,6 +43,18 @@ func (counts *ConcurrentShardedCounts) GetTotal() int64 {
 	return total
 }
 
+// GetCountsByShard gets the count of each shard
+func (counts *ConcurrentShardedCounts) GetCountsByShard() map[string]int64 {
+	counts.mutex.RLock()
+	defer counts.mutex.RUnlock()
+
+	countsByShard := make(map[string]int64, len(counts.byShard))
+	for shardName, count := range counts.byShard {
+		countsByShard[shardName] = count
+	}
+	return countsByShard
+}
+
 func (counts *ConcurrentShardedCounts) String() string {
 	var builder strings.Builder
 
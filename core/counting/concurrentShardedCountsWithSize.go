@@ -60,6 +60,18 @@ func (counts *ConcurrentShardedCountsWithSize) GetTotalSize() int64 {
 	return total
 }
 
+// GetCountsByShard gets the count of each shard
+func (counts *ConcurrentShardedCountsWithSize) GetCountsByShard() map[string]int64 {
+	counts.mutex.RLock()
+	defer counts.mutex.RUnlock()
+
+	countsByShard := make(map[string]int64, len(counts.byShard))
+	for shardName, item := range counts.byShard {
+		countsByShard[shardName] = item.counter
+	}
+	return countsByShard
+}
+
 func (counts *ConcurrentShardedCountsWithSize) String() string {
 	var builder strings.Builder
 
@@ -19,6 +19,7 @@ func TestConcurrentShardedCountsWithSize(t *testing.T) {
 
 	require.Equal(t, int64(85), total)
 	require.Equal(t, "Total:85 (4.00 MB); [bar]=43 (3.00 MB); [foo]=42 (1.00 MB); ", asString)
+	require.Equal(t, map[string]int64{"foo": 42, "bar": 43}, counts.GetCountsByShard())
 }
 
 func TestConcurrentShardedCountsWithSize_ConcurrentReadsAndWrites(t *testing.T) {
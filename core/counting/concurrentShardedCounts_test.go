@@ -18,6 +18,7 @@ func TestConcurrentShardedCounts(t *testing.T) {
 
 	require.Equal(t, int64(85), total)
 	require.Equal(t, "Total:85; [bar]=43; [foo]=42; ", asString)
+	require.Equal(t, map[string]int64{"foo": 42, "bar": 43}, counts.GetCountsByShard())
 }
 
 func TestConcurrentShardedCounts_ConcurrentReadsAndWrites(t *testing.T) {
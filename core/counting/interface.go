@@ -3,6 +3,7 @@ package counting
 // Counts is an interface to interact with counts
 type Counts interface {
 	GetTotal() int64
+	GetCountsByShard() map[string]int64
 	String() string
 	IsInterfaceNil() bool
 }
@@ -14,6 +14,7 @@ func TestNullCounts(t *testing.T) {
 
 	require.Equal(t, int64(-1), total)
 	require.Equal(t, asString, "counts not applicable")
+	require.Empty(t, counts.GetCountsByShard())
 }
 
 func TestNullCounts_IsInterfaceNil(t *testing.T) {
@@ -73,3 +73,6 @@ var ErrNilStore = errors.New("nil data storage service")
 
 // ErrNilSignalChan returns whenever a nil signal channel is provided
 var ErrNilSignalChan = errors.New("nil signal channel")
+
+// ErrEmptyPeerID signals that an empty peer ID has been provided
+var ErrEmptyPeerID = errors.New("empty peer ID")
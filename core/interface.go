@@ -14,6 +14,20 @@ type AppStatusHandler interface {
 	Close()
 }
 
+// Tracer defines the behavior of a component able to record the spans of the block lifecycle
+type Tracer interface {
+	StartSpan(name string) Span
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// Span defines a timed operation recorded by a tracer
+type Span interface {
+	SetAttribute(key string, value interface{})
+	SetTraceAttribute(key string, value interface{})
+	End()
+}
+
 // ConnectedAddressesHandler interface will be used for passing the network component to AppStatusPolling
 type ConnectedAddressesHandler interface {
 	ConnectedAddresses() []string
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core/tracing"
+
+// SpanExporterStub -
+type SpanExporterStub struct {
+	ExportSpansCalled func(spans []*tracing.SpanData) error
+	CloseCalled       func() error
+}
+
+// ExportSpans -
+func (ses *SpanExporterStub) ExportSpans(spans []*tracing.SpanData) error {
+	if ses.ExportSpansCalled != nil {
+		return ses.ExportSpansCalled(spans)
+	}
+
+	return nil
+}
+
+// Close -
+func (ses *SpanExporterStub) Close() error {
+	if ses.CloseCalled != nil {
+		return ses.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ses *SpanExporterStub) IsInterfaceNil() bool {
+	return ses == nil
+}
@@ -39,3 +39,37 @@ type QueryP2PPeerInfo struct {
 	PeerType      string   `json:"peertype"`
 	Addresses     []string `json:"addresses"`
 }
+
+// QueryP2PPeerTopicTraffic represents a DTO used in exporting the traffic exchanged with a peer on a topic
+type QueryP2PPeerTopicTraffic struct {
+	Topic            string `json:"topic"`
+	NumMessagesIn    uint64 `json:"nummessagesin"`
+	NumBytesIn       uint64 `json:"numbytesin"`
+	NumMessagesOut   uint64 `json:"nummessagesout"`
+	NumBytesOut      uint64 `json:"numbytesout"`
+	NumRejected      uint64 `json:"numrejected"`
+	NumAntifloodHits uint64 `json:"numantifloodhits"`
+}
+
+// QueryP2PPeerTrafficInfo represents a DTO used in exporting the traffic accounted for a connected peer
+type QueryP2PPeerTrafficInfo struct {
+	Pid                 string                     `json:"pid"`
+	Pk                  string                     `json:"pk"`
+	ShardID             uint32                     `json:"shard"`
+	PeerType            string                     `json:"peertype"`
+	ConnectionDirection string                     `json:"direction"`
+	Topics              []QueryP2PPeerTopicTraffic `json:"topics"`
+}
+
+// QueryP2PPeerStoreInfo represents a DTO used in exporting the peers recorded in the peer store
+type QueryP2PPeerStoreInfo struct {
+	Pid          string   `json:"pid"`
+	Addresses    []string `json:"addresses"`
+	LastSeen     int64    `json:"lastseen"`
+	ShardID      uint32   `json:"shard"`
+	PeerType     string   `json:"peertype"`
+	HonestyScore float64  `json:"honestyscore"`
+	IsBanned     bool     `json:"isbanned"`
+	BanExpiry    int64    `json:"banexpiry"`
+	BanReason    string   `json:"banreason"`
+}
@@ -14,3 +14,17 @@ func (pid PeerID) Bytes() []byte {
 func (pid PeerID) Pretty() string {
 	return base58.Encode(pid.Bytes())
 }
+
+// NewPeerIDFromPretty decodes the provided b58-encoded string into a peer ID
+func NewPeerIDFromPretty(pretty string) (PeerID, error) {
+	if len(pretty) == 0 {
+		return "", ErrEmptyPeerID
+	}
+
+	buff, err := base58.Decode(pretty)
+	if err != nil {
+		return "", err
+	}
+
+	return PeerID(buff), nil
+}
@@ -0,0 +1,32 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerIDFromPretty_EmptyStringShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pid, err := core.NewPeerIDFromPretty("")
+	assert.Equal(t, core.ErrEmptyPeerID, err)
+	assert.Equal(t, core.PeerID(""), pid)
+}
+
+func TestNewPeerIDFromPretty_InvalidStringShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := core.NewPeerIDFromPretty("0OIl")
+	assert.NotNil(t, err)
+}
+
+func TestNewPeerIDFromPretty_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedPid := core.PeerID("peer id bytes")
+	pid, err := core.NewPeerIDFromPretty(expectedPid.Pretty())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPid, pid)
+}
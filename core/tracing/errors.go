@@ -0,0 +1,21 @@
+package tracing
+
+import "errors"
+
+// ErrNilSpanExporter signals that a nil span exporter has been provided
+var ErrNilSpanExporter = errors.New("nil span exporter")
+
+// ErrInvalidExportBufferSize signals that an invalid export buffer size has been provided
+var ErrInvalidExportBufferSize = errors.New("invalid export buffer size")
+
+// ErrInvalidExporterType signals that an unknown exporter type has been provided
+var ErrInvalidExporterType = errors.New("invalid exporter type")
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrEmptyEndpoint signals that an empty endpoint has been provided
+var ErrEmptyEndpoint = errors.New("empty endpoint")
+
+// ErrExportFailed signals that the collector did not accept the exported spans
+var ErrExportFailed = errors.New("export failed")
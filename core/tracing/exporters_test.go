@@ -0,0 +1,174 @@
+package tracing_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSpanData() *tracing.SpanData {
+	startTime := time.Unix(1600000000, 0)
+	return &tracing.SpanData{
+		TraceID:      "0102030405060708090a0b0c0d0e0f10",
+		SpanID:       "0102030405060708",
+		ParentSpanID: "0807060504030201",
+		Name:         "shardProcessor.ProcessBlock",
+		StartTime:    startTime,
+		EndTime:      startTime.Add(time.Millisecond * 250),
+		Attributes: map[string]interface{}{
+			"round":     uint64(37),
+			"blockHash": "aabb",
+		},
+	}
+}
+
+func TestNewFileExporter_EmptyFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fe, err := tracing.NewFileExporter("")
+
+	assert.True(t, check.IfNil(fe))
+	assert.Equal(t, tracing.ErrEmptyFilePath, err)
+}
+
+func TestFileExporter_ExportSpansShouldAppendJSONLines(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "tracing")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "traces", "spans.json")
+	fe, err := tracing.NewFileExporter(filePath)
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(fe))
+
+	err = fe.ExportSpans([]*tracing.SpanData{createSpanData(), createSpanData()})
+	assert.Nil(t, err)
+	assert.Nil(t, fe.Close())
+
+	buff, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(buff)), "\n")
+	require.Equal(t, 2, len(lines))
+
+	decoded := make(map[string]interface{})
+	err = json.Unmarshal([]byte(lines[0]), &decoded)
+	require.Nil(t, err)
+	assert.Equal(t, "shardProcessor.ProcessBlock", decoded["name"])
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", decoded["traceId"])
+	assert.Equal(t, "250ms", decoded["duration"])
+	assert.Equal(t, float64(37), decoded["attributes"].(map[string]interface{})["round"])
+}
+
+func TestNewOTLPExporter_EmptyEndpointShouldErr(t *testing.T) {
+	t.Parallel()
+
+	oe, err := tracing.NewOTLPExporter(tracing.ArgOTLPExporter{})
+
+	assert.True(t, check.IfNil(oe))
+	assert.Equal(t, tracing.ErrEmptyEndpoint, err)
+}
+
+func TestOTLPExporter_ExportSpansShouldPostTheOTLPEncoding(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]interface{}
+	contentType := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	oe, _ := tracing.NewOTLPExporter(tracing.ArgOTLPExporter{
+		Endpoint:    server.URL + "/v1/traces",
+		ServiceName: "elrond-node",
+		Timeout:     time.Second,
+	})
+	err := oe.ExportSpans([]*tracing.SpanData{createSpanData()})
+	require.Nil(t, err)
+	assert.Equal(t, "application/json", contentType)
+
+	resourceSpans := received["resourceSpans"].([]interface{})[0].(map[string]interface{})
+	resourceAttribute := resourceSpans["resource"].(map[string]interface{})["attributes"].([]interface{})[0]
+	assert.Equal(t, map[string]interface{}{
+		"key":   "service.name",
+		"value": map[string]interface{}{"stringValue": "elrond-node"},
+	}, resourceAttribute)
+
+	scopeSpans := resourceSpans["scopeSpans"].([]interface{})[0].(map[string]interface{})
+	span := scopeSpans["spans"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "shardProcessor.ProcessBlock", span["name"])
+	assert.Equal(t, "0807060504030201", span["parentSpanId"])
+	assert.Equal(t, "1600000000000000000", span["startTimeUnixNano"])
+	assert.Equal(t, "1600000000250000000", span["endTimeUnixNano"])
+	assert.Contains(t, span["attributes"], map[string]interface{}{
+		"key":   "round",
+		"value": map[string]interface{}{"intValue": "37"},
+	})
+	assert.Contains(t, span["attributes"], map[string]interface{}{
+		"key":   "blockHash",
+		"value": map[string]interface{}{"stringValue": "aabb"},
+	})
+}
+
+func TestOTLPExporter_ExportSpansRejectedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	oe, _ := tracing.NewOTLPExporter(tracing.ArgOTLPExporter{
+		Endpoint: server.URL,
+		Timeout:  time.Second,
+	})
+	err := oe.ExportSpans([]*tracing.SpanData{createSpanData()})
+
+	assert.True(t, errors.Is(err, tracing.ErrExportFailed))
+}
+
+func TestCreateTracer(t *testing.T) {
+	t.Parallel()
+
+	tr, err := tracing.CreateTracer(config.TracingConfig{Enabled: false}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, tracing.NewDisabledTracer(), tr)
+
+	tr, err = tracing.CreateTracer(config.TracingConfig{Enabled: true, Exporter: "invalid", ExportBufferSize: 1}, "")
+	assert.True(t, check.IfNil(tr))
+	assert.True(t, errors.Is(err, tracing.ErrInvalidExporterType))
+
+	tr, err = tracing.CreateTracer(config.TracingConfig{Enabled: true, Exporter: tracing.OTLPExporterType, ExportBufferSize: 1}, "")
+	assert.True(t, check.IfNil(tr))
+	assert.Equal(t, tracing.ErrEmptyEndpoint, err)
+
+	cfg := config.TracingConfig{
+		Enabled:          true,
+		Exporter:         tracing.OTLPExporterType,
+		OTLPEndpoint:     "http://127.0.0.1:4318/v1/traces",
+		ExportBufferSize: 1,
+	}
+	tr, err = tracing.CreateTracer(cfg, "")
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(tr))
+	assert.Nil(t, tr.Close())
+}
@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// FileExporterType is the exporter type that writes the spans to a local file
+const FileExporterType = "file"
+
+// OTLPExporterType is the exporter type that sends the spans to an OpenTelemetry collector
+const OTLPExporterType = "otlp"
+
+const otlpExportTimeout = 5 * time.Second
+
+// CreateTracer creates the tracer described by the provided config. A relative file path is considered relative to
+// the provided working directory
+func CreateTracer(cfg config.TracingConfig, workingDir string) (core.Tracer, error) {
+	if !cfg.Enabled {
+		return NewDisabledTracer(), nil
+	}
+
+	exporter, err := createExporter(cfg, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTracer(ArgTracer{
+		Exporter:         exporter,
+		ExportBufferSize: cfg.ExportBufferSize,
+	})
+}
+
+func createExporter(cfg config.TracingConfig, workingDir string) (SpanExporter, error) {
+	switch cfg.Exporter {
+	case FileExporterType:
+		filePath := cfg.FilePath
+		if len(filePath) > 0 && !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(workingDir, filePath)
+		}
+
+		return NewFileExporter(filePath)
+	case OTLPExporterType:
+		return NewOTLPExporter(ArgOTLPExporter{
+			Endpoint:    cfg.OTLPEndpoint,
+			ServiceName: cfg.ServiceName,
+			Timeout:     otlpExportTimeout,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExporterType, cfg.Exporter)
+	}
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const filePermissions = 0644
+const folderPermissions = 0755
+
+type fileSpan struct {
+	*SpanData
+	Duration string `json:"duration"`
+}
+
+// fileExporter appends the finished spans to a file, one JSON object per line
+type fileExporter struct {
+	mut    sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// NewFileExporter creates a new exporter that writes the spans in the provided file, creating it if necessary
+func NewFileExporter(filePath string) (*fileExporter, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(filePath), folderPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileExporter{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+// ExportSpans writes the provided spans in the file
+func (fe *fileExporter) ExportSpans(spans []*SpanData) error {
+	fe.mut.Lock()
+	defer fe.mut.Unlock()
+
+	encoder := json.NewEncoder(fe.writer)
+	for _, spanData := range spans {
+		err := encoder.Encode(&fileSpan{
+			SpanData: spanData,
+			Duration: spanData.EndTime.Sub(spanData.StartTime).String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return fe.writer.Flush()
+}
+
+// Close flushes and closes the file
+func (fe *fileExporter) Close() error {
+	fe.mut.Lock()
+	defer fe.mut.Unlock()
+
+	err := fe.writer.Flush()
+	if err != nil {
+		log.Debug("fileExporter.Close", "error", err.Error())
+	}
+
+	return fe.file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fe *fileExporter) IsInterfaceNil() bool {
+	return fe == nil
+}
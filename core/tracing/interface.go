@@ -0,0 +1,8 @@
+package tracing
+
+// SpanExporter defines the behavior of a component able to send the finished spans outside of the node
+type SpanExporter interface {
+	ExportSpans(spans []*SpanData) error
+	Close() error
+	IsInterfaceNil() bool
+}
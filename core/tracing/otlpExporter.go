@@ -0,0 +1,198 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const otlpContentType = "application/json"
+const otlpScopeName = "github.com/ElrondNetwork/elrond-go"
+const otlpSpanKindInternal = 1
+const serviceNameAttribute = "service.name"
+
+// ArgOTLPExporter is the DTO used to create a new OTLP exporter
+type ArgOTLPExporter struct {
+	Endpoint    string
+	ServiceName string
+	Timeout     time.Duration
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpTracesRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+// otlpExporter sends the finished spans to an OpenTelemetry collector using the OTLP/HTTP protocol with the JSON
+// encoding
+type otlpExporter struct {
+	endpoint    string
+	serviceName string
+	httpClient  *http.Client
+}
+
+// NewOTLPExporter creates a new exporter that posts the spans to the provided collector endpoint
+func NewOTLPExporter(arg ArgOTLPExporter) (*otlpExporter, error) {
+	if len(arg.Endpoint) == 0 {
+		return nil, ErrEmptyEndpoint
+	}
+
+	return &otlpExporter{
+		endpoint:    arg.Endpoint,
+		serviceName: arg.ServiceName,
+		httpClient:  &http.Client{Timeout: arg.Timeout},
+	}, nil
+}
+
+// ExportSpans posts the provided spans to the collector
+func (oe *otlpExporter) ExportSpans(spans []*SpanData) error {
+	buff, err := json.Marshal(oe.createRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	response, err := oe.httpClient.Post(oe.endpoint, otlpContentType, bytes.NewReader(buff))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w, collector responded with status %s", ErrExportFailed, response.Status)
+	}
+
+	return nil
+}
+
+func (oe *otlpExporter) createRequest(spans []*SpanData) *otlpTracesRequest {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, spanData := range spans {
+		otlpSpans = append(otlpSpans, otlpSpan{
+			TraceID:           spanData.TraceID,
+			SpanID:            spanData.SpanID,
+			ParentSpanID:      spanData.ParentSpanID,
+			Name:              spanData.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(spanData.StartTime.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(spanData.EndTime.UnixNano(), 10),
+			Attributes:        createOTLPAttributes(spanData.Attributes),
+		})
+	}
+
+	return &otlpTracesRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: createOTLPAttributes(map[string]interface{}{
+						serviceNameAttribute: oe.serviceName,
+					}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: otlpScopeName},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func createOTLPAttributes(attributes map[string]interface{}) []otlpKeyValue {
+	keyValues := make([]otlpKeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		keyValues = append(keyValues, otlpKeyValue{
+			Key:   key,
+			Value: createOTLPValue(value),
+		})
+	}
+
+	return keyValues
+}
+
+func createOTLPValue(value interface{}) otlpAnyValue {
+	var intValue string
+	switch v := value.(type) {
+	case string:
+		return otlpAnyValue{StringValue: &v}
+	case bool:
+		return otlpAnyValue{BoolValue: &v}
+	case float64:
+		return otlpAnyValue{DoubleValue: &v}
+	case []byte:
+		str := hex.EncodeToString(v)
+		return otlpAnyValue{StringValue: &str}
+	case int:
+		intValue = strconv.FormatInt(int64(v), 10)
+	case int64:
+		intValue = strconv.FormatInt(v, 10)
+	case uint32:
+		intValue = strconv.FormatUint(uint64(v), 10)
+	case uint64:
+		intValue = strconv.FormatUint(v, 10)
+	default:
+		str := fmt.Sprint(v)
+		return otlpAnyValue{StringValue: &str}
+	}
+
+	return otlpAnyValue{IntValue: &intValue}
+}
+
+// Close does nothing and returns nil as the exporter does not keep any connection open
+func (oe *otlpExporter) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oe *otlpExporter) IsInterfaceNil() bool {
+	return oe == nil
+}
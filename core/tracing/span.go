@@ -0,0 +1,28 @@
+package tracing
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+var _ core.Span = (*span)(nil)
+
+type span struct {
+	tracer  *tracer
+	trace   *trace
+	data    *SpanData
+	isEnded bool
+}
+
+// SetAttribute sets an attribute of this span. Setting attributes on an ended span has no effect
+func (s *span) SetAttribute(key string, value interface{}) {
+	s.tracer.setSpanAttribute(s, key, value)
+}
+
+// SetTraceAttribute sets an attribute of the whole trace. It will be exported on all the spans of the trace that do
+// not hold an attribute with the same key, as long as it is set before the last span of the trace ends
+func (s *span) SetTraceAttribute(key string, value interface{}) {
+	s.tracer.setTraceAttribute(s, key, value)
+}
+
+// End marks the span as finished. Ending a span multiple times has no effect
+func (s *span) End() {
+	s.tracer.endSpan(s)
+}
@@ -0,0 +1,15 @@
+package tracing
+
+import "time"
+
+// SpanData holds the information recorded for a finished span. The trace and span identifiers are hex encoded,
+// as required by the OTLP JSON encoding
+type SpanData struct {
+	TraceID      string                 `json:"traceId"`
+	SpanID       string                 `json:"spanId"`
+	ParentSpanID string                 `json:"parentSpanId,omitempty"`
+	Name         string                 `json:"name"`
+	StartTime    time.Time              `json:"startTime"`
+	EndTime      time.Time              `json:"endTime"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+}
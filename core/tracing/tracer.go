@@ -0,0 +1,211 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var _ core.Tracer = (*tracer)(nil)
+
+var log = logger.GetOrCreate("core/tracing")
+
+const traceIDLength = 16
+const spanIDLength = 8
+
+// ArgTracer is the DTO used to create a new tracer
+type ArgTracer struct {
+	Exporter         SpanExporter
+	ExportBufferSize uint32
+}
+
+type trace struct {
+	id            string
+	attributes    map[string]interface{}
+	numOpenSpans  int
+	finishedSpans []*SpanData
+}
+
+// tracer records the spans of the block lifecycle. A started span becomes the child of the most recently started
+// span that is still active, regardless of the go routine that started it. This matches the block lifecycle where
+// the block is processed and committed, on the worker's go routine, while the consensus subround is still running.
+// A trace is handed to the exporter, on a separate go routine, only after all its spans have ended
+type tracer struct {
+	exporter       SpanExporter
+	chanTraces     chan []*SpanData
+	chanDone       chan struct{}
+	getTimeHandler func() time.Time
+
+	mut         sync.Mutex
+	activeSpans []*span
+	isClosed    bool
+}
+
+// NewTracer creates a new tracer that exports the finished traces using the provided exporter
+func NewTracer(arg ArgTracer) (*tracer, error) {
+	if check.IfNil(arg.Exporter) {
+		return nil, ErrNilSpanExporter
+	}
+	if arg.ExportBufferSize == 0 {
+		return nil, ErrInvalidExportBufferSize
+	}
+
+	t := &tracer{
+		exporter:       arg.Exporter,
+		chanTraces:     make(chan []*SpanData, arg.ExportBufferSize),
+		chanDone:       make(chan struct{}),
+		getTimeHandler: time.Now,
+		activeSpans:    make([]*span, 0),
+	}
+
+	go t.exportTraces()
+
+	return t, nil
+}
+
+func (t *tracer) exportTraces() {
+	defer close(t.chanDone)
+
+	for spans := range t.chanTraces {
+		err := t.exporter.ExportSpans(spans)
+		if err != nil {
+			log.Debug("tracer.exportTraces", "num spans", len(spans), "error", err.Error())
+		}
+	}
+}
+
+// StartSpan starts a new span as a child of the last active span. If there is no active span, the new span will be
+// the root of a new trace
+func (t *tracer) StartSpan(name string) core.Span {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	var tr *trace
+	parentSpanID := ""
+	numActiveSpans := len(t.activeSpans)
+	if numActiveSpans > 0 {
+		parent := t.activeSpans[numActiveSpans-1]
+		tr = parent.trace
+		parentSpanID = parent.data.SpanID
+	} else {
+		tr = &trace{
+			id:         createRandomID(traceIDLength),
+			attributes: make(map[string]interface{}),
+		}
+	}
+	tr.numOpenSpans++
+
+	s := &span{
+		tracer: t,
+		trace:  tr,
+		data: &SpanData{
+			TraceID:      tr.id,
+			SpanID:       createRandomID(spanIDLength),
+			ParentSpanID: parentSpanID,
+			Name:         name,
+			StartTime:    t.getTimeHandler(),
+			Attributes:   make(map[string]interface{}),
+		},
+	}
+	t.activeSpans = append(t.activeSpans, s)
+
+	return s
+}
+
+func (t *tracer) endSpan(s *span) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	if s.isEnded {
+		return
+	}
+	s.isEnded = true
+	s.data.EndTime = t.getTimeHandler()
+
+	for i := len(t.activeSpans) - 1; i >= 0; i-- {
+		if t.activeSpans[i] == s {
+			t.activeSpans = append(t.activeSpans[:i], t.activeSpans[i+1:]...)
+			break
+		}
+	}
+
+	tr := s.trace
+	tr.finishedSpans = append(tr.finishedSpans, s.data)
+	tr.numOpenSpans--
+	if tr.numOpenSpans > 0 {
+		return
+	}
+
+	t.finishTrace(tr)
+}
+
+func (t *tracer) finishTrace(tr *trace) {
+	for _, spanData := range tr.finishedSpans {
+		for key, value := range tr.attributes {
+			_, exists := spanData.Attributes[key]
+			if !exists {
+				spanData.Attributes[key] = value
+			}
+		}
+	}
+
+	if t.isClosed {
+		return
+	}
+
+	select {
+	case t.chanTraces <- tr.finishedSpans:
+	default:
+		log.Debug("tracer: export buffer is full, trace dropped", "trace", tr.id, "num spans", len(tr.finishedSpans))
+	}
+}
+
+func (t *tracer) setSpanAttribute(s *span, key string, value interface{}) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+
+	// the attributes of an ended span might be read by the exporter
+	if s.isEnded {
+		return
+	}
+	s.data.Attributes[key] = value
+}
+
+func (t *tracer) setTraceAttribute(s *span, key string, value interface{}) {
+	t.mut.Lock()
+	s.trace.attributes[key] = value
+	t.mut.Unlock()
+}
+
+// Close stops accepting new traces, waits for the pending ones to be exported and closes the exporter
+func (t *tracer) Close() error {
+	t.mut.Lock()
+	if t.isClosed {
+		t.mut.Unlock()
+		return nil
+	}
+	t.isClosed = true
+	close(t.chanTraces)
+	t.mut.Unlock()
+
+	<-t.chanDone
+
+	return t.exporter.Close()
+}
+
+func createRandomID(length int) string {
+	buff := make([]byte, length)
+	_, _ = rand.Read(buff)
+
+	return hex.EncodeToString(buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *tracer) IsInterfaceNil() bool {
+	return t == nil
+}
@@ -0,0 +1,228 @@
+package tracing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportTimeout = time.Second
+
+func createRecordingExporter() (*mock.SpanExporterStub, chan []*tracing.SpanData) {
+	chanExported := make(chan []*tracing.SpanData, 10)
+	exporter := &mock.SpanExporterStub{
+		ExportSpansCalled: func(spans []*tracing.SpanData) error {
+			chanExported <- spans
+			return nil
+		},
+	}
+
+	return exporter, chanExported
+}
+
+func waitExportedSpans(t *testing.T, chanExported chan []*tracing.SpanData) []*tracing.SpanData {
+	select {
+	case spans := <-chanExported:
+		return spans
+	case <-time.After(exportTimeout):
+		require.Fail(t, "timeout waiting for the exported spans")
+		return nil
+	}
+}
+
+func TestNewTracer_NilExporterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, err := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         nil,
+		ExportBufferSize: 1,
+	})
+
+	assert.True(t, check.IfNil(tr))
+	assert.Equal(t, tracing.ErrNilSpanExporter, err)
+}
+
+func TestNewTracer_InvalidExportBufferSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, err := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         &mock.SpanExporterStub{},
+		ExportBufferSize: 0,
+	})
+
+	assert.True(t, check.IfNil(tr))
+	assert.Equal(t, tracing.ErrInvalidExportBufferSize, err)
+}
+
+func TestNewTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tr, err := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         &mock.SpanExporterStub{},
+		ExportBufferSize: 1,
+	})
+
+	assert.False(t, check.IfNil(tr))
+	assert.Nil(t, err)
+	assert.Nil(t, tr.Close())
+}
+
+func TestTracer_NestedSpansShouldBeExportedAsOneTrace(t *testing.T) {
+	t.Parallel()
+
+	exporter, chanExported := createRecordingExporter()
+	tr, _ := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         exporter,
+		ExportBufferSize: 10,
+	})
+	defer func() {
+		_ = tr.Close()
+	}()
+
+	root := tr.StartSpan("root")
+	child := tr.StartSpan("child")
+	grandChild := tr.StartSpan("grandChild")
+	grandChild.End()
+	sibling := tr.StartSpan("sibling")
+	child.SetAttribute("key", "child value")
+	child.SetTraceAttribute("key", "trace value")
+	child.SetTraceAttribute("round", uint64(37))
+	sibling.End()
+	child.End()
+
+	select {
+	case <-chanExported:
+		require.Fail(t, "the trace should not be exported while the root span is active")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	root.End()
+	spans := waitExportedSpans(t, chanExported)
+	require.Equal(t, 4, len(spans))
+
+	spansByName := make(map[string]*tracing.SpanData)
+	for _, spanData := range spans {
+		spansByName[spanData.Name] = spanData
+		assert.Equal(t, spans[0].TraceID, spanData.TraceID)
+		assert.Equal(t, uint64(37), spanData.Attributes["round"])
+		assert.False(t, spanData.EndTime.Before(spanData.StartTime))
+	}
+	assert.Equal(t, "", spansByName["root"].ParentSpanID)
+	assert.Equal(t, spansByName["root"].SpanID, spansByName["child"].ParentSpanID)
+	assert.Equal(t, spansByName["child"].SpanID, spansByName["grandChild"].ParentSpanID)
+	assert.Equal(t, spansByName["child"].SpanID, spansByName["sibling"].ParentSpanID)
+	assert.Equal(t, "child value", spansByName["child"].Attributes["key"])
+	assert.Equal(t, "trace value", spansByName["root"].Attributes["key"])
+}
+
+func TestTracer_ConsecutiveRootSpansShouldStartDifferentTraces(t *testing.T) {
+	t.Parallel()
+
+	exporter, chanExported := createRecordingExporter()
+	tr, _ := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         exporter,
+		ExportBufferSize: 10,
+	})
+	defer func() {
+		_ = tr.Close()
+	}()
+
+	tr.StartSpan("first").End()
+	tr.StartSpan("second").End()
+
+	firstTrace := waitExportedSpans(t, chanExported)
+	secondTrace := waitExportedSpans(t, chanExported)
+	require.Equal(t, 1, len(firstTrace))
+	require.Equal(t, 1, len(secondTrace))
+	assert.NotEqual(t, firstTrace[0].TraceID, secondTrace[0].TraceID)
+	assert.Equal(t, "", secondTrace[0].ParentSpanID)
+}
+
+func TestTracer_TraceShouldWaitForTheSpansThatOutliveTheRoot(t *testing.T) {
+	t.Parallel()
+
+	exporter, chanExported := createRecordingExporter()
+	tr, _ := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         exporter,
+		ExportBufferSize: 10,
+	})
+	defer func() {
+		_ = tr.Close()
+	}()
+
+	root := tr.StartSpan("root")
+	child := tr.StartSpan("child")
+	root.End()
+	root.End()
+
+	select {
+	case <-chanExported:
+		require.Fail(t, "the trace should not be exported while the child span is active")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	child.End()
+	child.SetAttribute("ignored", true)
+	spans := waitExportedSpans(t, chanExported)
+	require.Equal(t, 2, len(spans))
+	for _, spanData := range spans {
+		assert.NotContains(t, spanData.Attributes, "ignored")
+	}
+}
+
+func TestTracer_CloseShouldExportThePendingTracesAndCloseTheExporter(t *testing.T) {
+	t.Parallel()
+
+	numExported := 0
+	closeCalled := false
+	exporter := &mock.SpanExporterStub{
+		ExportSpansCalled: func(spans []*tracing.SpanData) error {
+			time.Sleep(time.Millisecond * 10)
+			numExported += len(spans)
+			return nil
+		},
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	tr, _ := tracing.NewTracer(tracing.ArgTracer{
+		Exporter:         exporter,
+		ExportBufferSize: 10,
+	})
+
+	for i := 0; i < 5; i++ {
+		tr.StartSpan("span").End()
+	}
+
+	err := tr.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, numExported)
+	assert.True(t, closeCalled)
+
+	tr.StartSpan("span after close").End()
+	assert.Nil(t, tr.Close())
+}
+
+func TestDisabledTracer_ShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r)
+	}()
+
+	tr := tracing.NewDisabledTracer()
+	span := tr.StartSpan("span")
+	span.SetAttribute("key", "value")
+	span.SetTraceAttribute("key", "value")
+	span.End()
+
+	assert.False(t, check.IfNil(tr))
+	assert.Nil(t, tr.Close())
+}
@@ -132,3 +132,12 @@ var ErrInvalidSignature = errors.New("invalid signature")
 
 // ErrWrongTypeAssertion signals wrong type assertion
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrIndexesSignaturesLenMismatch signals that the number of indexes is different from the number of signatures
+var ErrIndexesSignaturesLenMismatch = errors.New("indexes and signatures lengths mismatch")
+
+// ErrPubKeysSignaturesLenMismatch signals that the number of public keys is different from the number of signatures
+var ErrPubKeysSignaturesLenMismatch = errors.New("public keys and signatures lengths mismatch")
+
+// ErrBatchSigNotValid signals that a batch of signatures is not valid
+var ErrBatchSigNotValid = errors.New("batch of signatures is not valid")
@@ -186,6 +186,9 @@ type MultiSigner interface {
 	SignatureShare(index uint16) ([]byte, error)
 	// VerifySignatureShare verifies the partial signature of the signer with specified position
 	VerifySignatureShare(index uint16, sig []byte, msg []byte, bitmap []byte) error
+	// VerifySignatureShares verifies in a batch the partial signatures of the signers with specified positions and
+	// returns the positions of the invalid ones
+	VerifySignatureShares(indexes []uint16, sigs [][]byte, msg []byte, bitmap []byte) ([]uint16, error)
 	// AggregateSigs aggregates all collected partial signatures
 	AggregateSigs(bitmap []byte) ([]byte, error)
 }
@@ -208,6 +211,8 @@ type MultiSigVerifier interface {
 type LowLevelSignerBLS interface {
 	// VerifySigShare verifies a BLS single signature
 	VerifySigShare(pubKey PublicKey, message []byte, sig []byte) error
+	// VerifySigSharesBatch verifies at once BLS single signatures over the same message
+	VerifySigSharesBatch(pubKeys []PublicKey, message []byte, sigs [][]byte) error
 	// SignShare creates a BLS single signature over a given message
 	SignShare(privKey PrivateKey, message []byte) ([]byte, error)
 	// VerifySigBytes verifies if a byte array represents a BLS signature
@@ -1,6 +1,7 @@
 package multisig
 
 import (
+	"crypto/rand"
 	"encoding/hex"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -16,6 +17,9 @@ var _ crypto.LowLevelSignerBLS = (*BlsMultiSigner)(nil)
 // 16bytes output hasher!
 const hasherOutputSize = 16
 
+// batchCoefficientSize is the size in bytes of the random coefficients used to batch verify signature shares
+const batchCoefficientSize = 16
+
 // BlsMultiSigner provides an implements of the crypto.LowLevelSignerBLS interface
 type BlsMultiSigner struct {
 	singlesig.BlsSingleSigner
@@ -32,6 +36,93 @@ func (bms *BlsMultiSigner) VerifySigShare(pubKey crypto.PublicKey, message []byt
 	return bms.Verify(pubKey, message, sig)
 }
 
+// VerifySigSharesBatch verifies at once BLS signature shares (single BLS signatures) over the same message.
+// Every share and its public key are multiplied with a random 128 bits coefficient r_i, so the single pairing check
+// e(sum(r_i*sig_i), g2) == e(H(m), sum(r_i*pk_i)) passes only if all the shares are valid, except with a negligible
+// probability. The random coefficients prevent invalid shares crafted to cancel each other
+func (bms *BlsMultiSigner) VerifySigSharesBatch(pubKeys []crypto.PublicKey, message []byte, sigs [][]byte) error {
+	if len(pubKeys) == 0 {
+		return crypto.ErrNilPublicKeys
+	}
+	if len(pubKeys) != len(sigs) {
+		return crypto.ErrPubKeysSignaturesLenMismatch
+	}
+	if len(message) == 0 {
+		return crypto.ErrNilMessage
+	}
+
+	sigPoints := make([]bls.G1, len(sigs))
+	pubKeyPoints := make([]bls.G2, len(pubKeys))
+	coefficients := make([]bls.Fr, len(sigs))
+	for i := range sigs {
+		pubKeyPoint, err := pubKeyToG2(pubKeys[i])
+		if err != nil {
+			return err
+		}
+		pubKeyPoints[i] = *pubKeyPoint
+
+		if len(sigs[i]) == 0 {
+			return crypto.ErrNilSignature
+		}
+		sigPoint, err := bms.sigBytesToPoint(sigs[i])
+		if err != nil {
+			return err
+		}
+		sigPoints[i] = *sigPoint.(*mcl.PointG1).G1
+
+		err = setRandomCoefficient(&coefficients[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	aggSig := &bls.G1{}
+	bls.G1MulVec(aggSig, sigPoints, coefficients)
+	aggPubKey := &bls.G2{}
+	bls.G2MulVec(aggPubKey, pubKeyPoints, coefficients)
+
+	if !bls.CastToSign(aggSig).Verify(bls.CastToPublicKey(aggPubKey), string(message)) {
+		return crypto.ErrBatchSigNotValid
+	}
+
+	return nil
+}
+
+func pubKeyToG2(pubKey crypto.PublicKey) (*bls.G2, error) {
+	if check.IfNil(pubKey) {
+		return nil, crypto.ErrNilPublicKey
+	}
+
+	point := pubKey.Point()
+	if check.IfNil(point) {
+		return nil, crypto.ErrNilPublicKeyPoint
+	}
+
+	pubKeyPoint, isPoint := point.(*mcl.PointG2)
+	if !isPoint || !singlesig.IsPubKeyPointValid(pubKeyPoint) {
+		return nil, crypto.ErrInvalidPublicKey
+	}
+
+	return pubKeyPoint.G2, nil
+}
+
+func setRandomCoefficient(coefficient *bls.Fr) error {
+	buff := make([]byte, batchCoefficientSize)
+	for coefficient.IsZero() {
+		_, err := rand.Read(buff)
+		if err != nil {
+			return err
+		}
+
+		err = coefficient.SetLittleEndian(buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // VerifySigBytes provides an "cheap" integrity check of a signature given as a byte array
 // It does not validate the signature over a message, only verifies that it is a signature
 func (bms *BlsMultiSigner) VerifySigBytes(_ crypto.Suite, sig []byte) error {
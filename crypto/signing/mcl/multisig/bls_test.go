@@ -205,6 +205,73 @@ func TestMultiSignerBLS_VerifySigShareOK(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestMultiSignerBLS_VerifySigSharesBatchInvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(5, msg)
+
+	err := llSig.VerifySigSharesBatch(nil, msg, sigShares)
+	require.Equal(t, crypto.ErrNilPublicKeys, err)
+
+	err = llSig.VerifySigSharesBatch(pubKeys, msg, sigShares[:4])
+	require.Equal(t, crypto.ErrPubKeysSignaturesLenMismatch, err)
+
+	err = llSig.VerifySigSharesBatch(pubKeys, nil, sigShares)
+	require.Equal(t, crypto.ErrNilMessage, err)
+
+	pubKeys[2] = nil
+	err = llSig.VerifySigSharesBatch(pubKeys, msg, sigShares)
+	require.Equal(t, crypto.ErrNilPublicKey, err)
+}
+
+func TestMultiSignerBLS_VerifySigSharesBatchInvalidSigShouldErr(t *testing.T) {
+	t.Parallel()
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(5, msg)
+
+	sigShares[3] = []byte("invalid signature")
+	err := llSig.VerifySigSharesBatch(pubKeys, msg, sigShares)
+
+	require.NotNil(t, err)
+}
+
+func TestMultiSignerBLS_VerifySigSharesBatchOtherMessageShouldErr(t *testing.T) {
+	t.Parallel()
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(5, msg)
+
+	err := llSig.VerifySigSharesBatch(pubKeys, []byte("message2"), sigShares)
+
+	require.Equal(t, crypto.ErrBatchSigNotValid, err)
+}
+
+func TestMultiSignerBLS_VerifySigSharesBatchSwappedSharesShouldErr(t *testing.T) {
+	t.Parallel()
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(5, msg)
+
+	// the plain sum of the shares is unchanged, only the random coefficients can catch the swap
+	sigShares[0], sigShares[1] = sigShares[1], sigShares[0]
+	err := llSig.VerifySigSharesBatch(pubKeys, msg, sigShares)
+
+	require.Equal(t, crypto.ErrBatchSigNotValid, err)
+}
+
+func TestMultiSignerBLS_VerifySigSharesBatchOK(t *testing.T) {
+	t.Parallel()
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(21, msg)
+
+	err := llSig.VerifySigSharesBatch(pubKeys, msg, sigShares)
+
+	require.Nil(t, err)
+}
+
 func TestMultiSignerBLS_AggregateSignaturesNilSuiteShouldErr(t *testing.T) {
 	t.Parallel()
 	msg := []byte(testMessage)
@@ -787,3 +854,29 @@ func TestBlsMultiSigner_IsInterfaceNil(t *testing.T) {
 
 	require.False(t, check.IfNil(llSig))
 }
+
+func BenchmarkBlsMultiSigner_VerifySigShareOneByOne(b *testing.B) {
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(63, msg)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range sigShares {
+			err := llSig.VerifySigShare(pubKeys[j], msg, sigShares[j])
+			require.Nil(b, err)
+		}
+	}
+}
+
+func BenchmarkBlsMultiSigner_VerifySigSharesBatch(b *testing.B) {
+	msg := []byte(testMessage)
+	llSig := &multisig.BlsMultiSigner{Hasher: &mock.HasherSpongeMock{}}
+	pubKeys, sigShares := createSigSharesBLS(63, msg)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := llSig.VerifySigSharesBatch(pubKeys, msg, sigShares)
+		require.Nil(b, err)
+	}
+}
@@ -183,6 +183,63 @@ func (bms *blsMultiSigner) VerifySignatureShare(index uint16, sig []byte, messag
 	return bms.llSigner.VerifySigShare(pubKey, message, sig)
 }
 
+// VerifySignatureShares verifies in a single batch the signature shares of the signers with specified positions.
+// If the batch is not valid, it is split in halves until the invalid shares are found. The positions of the
+// invalid shares are returned together with the verification error of the first one
+func (bms *blsMultiSigner) VerifySignatureShares(indexes []uint16, sigs [][]byte, message []byte, _ []byte) ([]uint16, error) {
+	if len(indexes) != len(sigs) {
+		return nil, crypto.ErrIndexesSignaturesLenMismatch
+	}
+	if len(indexes) == 0 {
+		return nil, nil
+	}
+
+	bms.mutSigData.RLock()
+	pubKeys := make([]crypto.PublicKey, 0, len(indexes))
+	for _, index := range indexes {
+		indexOutOfBounds := index >= uint16(len(bms.data.pubKeys))
+		if indexOutOfBounds {
+			bms.mutSigData.RUnlock()
+			return nil, crypto.ErrIndexOutOfBounds
+		}
+
+		pubKeys = append(pubKeys, bms.data.pubKeys[index])
+	}
+	bms.mutSigData.RUnlock()
+
+	return bms.findInvalidSignatureShares(indexes, pubKeys, sigs, message)
+}
+
+func (bms *blsMultiSigner) findInvalidSignatureShares(
+	indexes []uint16,
+	pubKeys []crypto.PublicKey,
+	sigs [][]byte,
+	message []byte,
+) ([]uint16, error) {
+	if len(sigs) == 1 {
+		err := bms.llSigner.VerifySigShare(pubKeys[0], message, sigs[0])
+		if err != nil {
+			return indexes, err
+		}
+
+		return nil, nil
+	}
+
+	err := bms.llSigner.VerifySigSharesBatch(pubKeys, message, sigs)
+	if err == nil {
+		return nil, nil
+	}
+
+	half := len(sigs) / 2
+	invalidIndexes, firstErr := bms.findInvalidSignatureShares(indexes[:half], pubKeys[:half], sigs[:half], message)
+	invalidIndexesSecondHalf, errSecondHalf := bms.findInvalidSignatureShares(indexes[half:], pubKeys[half:], sigs[half:], message)
+	if firstErr == nil {
+		firstErr = errSecondHalf
+	}
+
+	return append(invalidIndexes, invalidIndexesSecondHalf...), firstErr
+}
+
 // StoreSignatureShare stores the partial signature of the signer with specified position
 // Function does not validate the signature, as it expects caller to have already called VerifySignatureShare
 func (bms *blsMultiSigner) StoreSignatureShare(index uint16, sig []byte) error {
@@ -417,6 +417,61 @@ func TestBLSMultiSigner_VerifySignatureShareOK(t *testing.T) {
 	assert.Nil(t, verifErr)
 }
 
+func TestBLSMultiSigner_VerifySignatureSharesInvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	sigShares, multiSig := createSigSharesBLS(4, 4, msg, 0)
+
+	invalidIndexes, err := multiSig.VerifySignatureShares([]uint16{0, 1}, sigShares, msg, nil)
+	assert.Nil(t, invalidIndexes)
+	assert.Equal(t, crypto.ErrIndexesSignaturesLenMismatch, err)
+
+	invalidIndexes, err = multiSig.VerifySignatureShares([]uint16{0, 1, 2, 4}, sigShares, msg, nil)
+	assert.Nil(t, invalidIndexes)
+	assert.Equal(t, crypto.ErrIndexOutOfBounds, err)
+
+	invalidIndexes, err = multiSig.VerifySignatureShares(nil, nil, msg, nil)
+	assert.Nil(t, invalidIndexes)
+	assert.Nil(t, err)
+}
+
+func TestBLSMultiSigner_VerifySignatureSharesOK(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	sigShares, multiSig := createSigSharesBLS(21, 21, msg, 0)
+	indexes := make([]uint16, 0, len(sigShares))
+	for i := range sigShares {
+		indexes = append(indexes, uint16(i))
+	}
+
+	invalidIndexes, err := multiSig.VerifySignatureShares(indexes, sigShares, msg, nil)
+
+	assert.Nil(t, err)
+	assert.Empty(t, invalidIndexes)
+}
+
+func TestBLSMultiSigner_VerifySignatureSharesShouldFindTheInvalidShares(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("message")
+	sigShares, multiSig := createSigSharesBLS(21, 21, msg, 0)
+	indexes := make([]uint16, 0, len(sigShares))
+	for i := range sigShares {
+		indexes = append(indexes, uint16(i))
+	}
+	otherSigShares, _ := createSigSharesBLS(21, 21, []byte("other message"), 0)
+	sigShares[5] = otherSigShares[5]
+	sigShares[17] = nil
+
+	invalidIndexes, err := multiSig.VerifySignatureShares(indexes, sigShares, msg, nil)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "signature is invalid")
+	assert.Equal(t, []uint16{5, 17}, invalidIndexes)
+}
+
 func TestBLSMultiSigner_AddSignatureShareNilSigShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -70,6 +70,11 @@ func (h *Header) SetLeaderSignature(sg []byte) {
 	h.LeaderSignature = sg
 }
 
+// SetLeaderIndex sets the index in the consensus group of the validator which proposed the block
+func (h *Header) SetLeaderIndex(index uint32) {
+	h.LeaderIndex = index
+}
+
 // SetChainID sets the chain ID on which this block is valid on
 func (h *Header) SetChainID(chainID []byte) {
 	h.ChainID = chainID
@@ -291,6 +291,7 @@ type Header struct {
 	AccumulatedFees    *math_big.Int     `protobuf:"bytes,22,opt,name=AccumulatedFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"AccumulatedFees,omitempty"`
 	DeveloperFees      *math_big.Int     `protobuf:"bytes,23,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DeveloperFees,omitempty"`
 	Reserved           []byte            `protobuf:"bytes,24,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	LeaderIndex        uint32            `protobuf:"varint,25,opt,name=LeaderIndex,proto3" json:"LeaderIndex,omitempty"`
 }
 
 func (m *Header) Reset()      { *m = Header{} }
@@ -489,6 +490,13 @@ func (m *Header) GetReserved() []byte {
 	return nil
 }
 
+func (m *Header) GetLeaderIndex() uint32 {
+	if m != nil {
+		return m.LeaderIndex
+	}
+	return 0
+}
+
 type Body struct {
 	MiniBlocks []*MiniBlock `protobuf:"bytes,1,rep,name=MiniBlocks,proto3" json:"MiniBlocks,omitempty"`
 }
@@ -589,63 +597,63 @@ func init() {
 func init() { proto.RegisterFile("block.proto", fileDescriptor_8e550b1f5926e92d) }
 
 var fileDescriptor_8e550b1f5926e92d = []byte{
-	// 882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x24, 0xb6, 0x73, 0x19, 0xdb, 0x89, 0x33, 0x1c, 0x61, 0x74, 0x3a, 0x6d, 0x2c, 0xeb,
-	0x0a, 0x0b, 0xe9, 0x6c, 0x08, 0x0d, 0x88, 0x93, 0xd0, 0xd9, 0xc9, 0x29, 0x16, 0xdc, 0x29, 0xda,
-	0x8d, 0x28, 0xae, 0x1b, 0x7b, 0xdf, 0xd9, 0xab, 0xd8, 0x3b, 0xd6, 0xec, 0xac, 0x93, 0x74, 0x94,
-	0x94, 0x54, 0xfc, 0x0d, 0x88, 0x9a, 0x3f, 0xe2, 0x0a, 0x8a, 0x94, 0xa9, 0x80, 0x38, 0x0d, 0x65,
-	0x2a, 0x5a, 0xd0, 0xbc, 0xd9, 0xf5, 0x8f, 0x8d, 0x0b, 0x8a, 0xab, 0xbc, 0xdf, 0xf7, 0xde, 0xbc,
-	0x5f, 0xf3, 0xcd, 0x33, 0x2d, 0xf5, 0x46, 0xb2, 0x7f, 0xde, 0x9c, 0x28, 0xa9, 0x25, 0x2b, 0xe0,
-	0xcf, 0x93, 0xe7, 0x83, 0x40, 0x0f, 0xe3, 0x5e, 0xb3, 0x2f, 0xc7, 0xad, 0x81, 0x1c, 0xc8, 0x16,
-	0xd2, 0xbd, 0xf8, 0x1d, 0x22, 0x04, 0xf8, 0x65, 0x4f, 0xd5, 0x7f, 0x23, 0x74, 0xfb, 0x75, 0x10,
-	0x06, 0x6d, 0x13, 0x89, 0x3d, 0xa1, 0x8f, 0xce, 0x2e, 0x4f, 0x44, 0x34, 0x84, 0x88, 0x93, 0xda,
-	0x66, 0xa3, 0xec, 0xce, 0x31, 0x6b, 0xd0, 0x5d, 0x17, 0xfa, 0x10, 0x4c, 0x41, 0x79, 0x43, 0xa1,
-	0xfc, 0xee, 0x11, 0xdf, 0xa8, 0x91, 0x46, 0xc5, 0xcd, 0xd2, 0xec, 0x19, 0xad, 0x78, 0x10, 0xfa,
-	0x0b, 0xbf, 0x4d, 0xf4, 0x5b, 0x25, 0xd9, 0x01, 0xcd, 0x9f, 0x5d, 0x4d, 0x80, 0xe7, 0x6b, 0xa4,
-	0xb1, 0x73, 0x58, 0xb2, 0xf5, 0x34, 0x0d, 0xe5, 0xa2, 0xc1, 0x14, 0xe3, 0x42, 0x04, 0x6a, 0x0a,
-	0x3e, 0x2f, 0xd4, 0x88, 0x29, 0x26, 0xc5, 0xf5, 0xdf, 0x09, 0xdd, 0x9d, 0x97, 0x7d, 0x02, 0xc2,
-	0x07, 0xc5, 0x18, 0xcd, 0x9b, 0x52, 0x39, 0x41, 0x5f, 0xfc, 0x7e, 0x58, 0xca, 0xc6, 0xba, 0x52,
-	0xd6, 0xb4, 0xb6, 0xb9, 0xbe, 0x35, 0x4e, 0xb7, 0xce, 0x2e, 0x3b, 0x32, 0x0e, 0x35, 0xd6, 0x5d,
-	0x71, 0x53, 0x38, 0x6f, 0xa7, 0xf0, 0x7f, 0xda, 0x29, 0x66, 0xda, 0x79, 0x45, 0xe9, 0x29, 0x80,
-	0xea, 0x0c, 0x45, 0x38, 0x00, 0xb6, 0x4f, 0x8b, 0xa7, 0x71, 0xef, 0x5b, 0xb8, 0x4a, 0x5a, 0x49,
-	0x10, 0xab, 0xd1, 0x92, 0xad, 0xc3, 0x3f, 0x82, 0x48, 0x27, 0xad, 0x2c, 0x53, 0xf5, 0x7f, 0xb6,
-	0x68, 0x31, 0x99, 0xc6, 0x63, 0x5a, 0x78, 0x23, 0xc3, 0x3e, 0x60, 0x8c, 0xbc, 0x6b, 0x81, 0x29,
-	0xe2, 0x54, 0xc1, 0x14, 0xe7, 0xb4, 0x61, 0x8b, 0x48, 0x31, 0xab, 0xd3, 0xb2, 0xf9, 0x76, 0x45,
-	0xe8, 0x7b, 0x00, 0x3e, 0x8e, 0xa0, 0xec, 0xae, 0x70, 0xd8, 0x44, 0x6a, 0xcf, 0x27, 0x4d, 0xa4,
-	0xb6, 0x67, 0xb4, 0x62, 0x0b, 0x8d, 0xda, 0x81, 0x1e, 0x8b, 0x49, 0x72, 0x69, 0xab, 0xa4, 0x99,
-	0x60, 0x3a, 0xe3, 0xa2, 0x9d, 0x60, 0x3a, 0xdb, 0xa7, 0x74, 0xfb, 0x2c, 0x18, 0x83, 0xa7, 0xc5,
-	0x78, 0xc2, 0xb7, 0xb0, 0xea, 0x05, 0x61, 0xfa, 0x71, 0x65, 0x1c, 0xfa, 0xfc, 0x91, 0xed, 0x07,
-	0x81, 0x61, 0x8f, 0x27, 0xb2, 0x3f, 0xe4, 0xdb, 0x18, 0xcb, 0x02, 0xf6, 0x39, 0xad, 0xa0, 0x30,
-	0xda, 0xd2, 0xbf, 0xc2, 0x4b, 0xa1, 0x0f, 0x2f, 0x65, 0xd5, 0xc3, 0x24, 0xf7, 0x82, 0x41, 0x28,
-	0x74, 0xac, 0x80, 0x97, 0xb0, 0xf0, 0x05, 0x61, 0x04, 0xf2, 0x1d, 0x8e, 0x75, 0xe1, 0x53, 0x46,
-	0x9f, 0x2c, 0xcd, 0x4e, 0x68, 0x35, 0xa3, 0xcb, 0x88, 0x57, 0x6a, 0x9b, 0x8d, 0xd2, 0xe1, 0x7e,
-	0x92, 0x3d, 0x63, 0x6e, 0xe7, 0xdf, 0xff, 0x71, 0x90, 0x73, 0x1f, 0x9c, 0x62, 0x5f, 0xd1, 0xd2,
-	0x42, 0x13, 0x11, 0xdf, 0xc1, 0x20, 0x7b, 0x49, 0x90, 0x85, 0x25, 0x39, 0xbf, 0xec, 0x8b, 0xb7,
-	0x24, 0xa5, 0xc6, 0x5b, 0xde, 0x4d, 0x6e, 0x29, 0xc1, 0xa6, 0x95, 0xd7, 0xa0, 0x85, 0x4d, 0x65,
-	0x5f, 0x7a, 0x15, 0x5f, 0x7a, 0x96, 0x5e, 0xd6, 0xfa, 0xde, 0xaa, 0xd6, 0x9b, 0x94, 0xe1, 0xa0,
-	0x3d, 0x2d, 0x94, 0x36, 0xc7, 0x30, 0x13, 0xc3, 0x4c, 0x6b, 0x2c, 0x46, 0x59, 0xf8, 0x90, 0x26,
-	0x3a, 0x42, 0xcf, 0x8f, 0xac, 0xb2, 0x96, 0x39, 0x93, 0xad, 0x33, 0x14, 0x41, 0xd8, 0x3d, 0xe2,
-	0x8f, 0xd1, 0x9c, 0x42, 0x53, 0xb1, 0x27, 0xdf, 0xe9, 0x0b, 0xa1, 0xe0, 0x7b, 0x50, 0x51, 0x20,
-	0x43, 0xfe, 0xb1, 0x1d, 0x7e, 0x86, 0x66, 0x92, 0xee, 0xbe, 0xec, 0xf7, 0xe3, 0x71, 0x3c, 0x12,
-	0x1a, 0xfc, 0x57, 0x00, 0x11, 0xdf, 0x37, 0x9e, 0xed, 0xe3, 0x5f, 0xff, 0x3c, 0x78, 0x39, 0x16,
-	0x7a, 0xd8, 0xea, 0x05, 0x83, 0x66, 0x37, 0xd4, 0x5f, 0x2f, 0x6d, 0xc9, 0xe3, 0x91, 0x92, 0xa1,
-	0xff, 0x06, 0xf4, 0x85, 0x54, 0xe7, 0x2d, 0x40, 0xf4, 0x7c, 0x20, 0x5b, 0xbe, 0xd0, 0xa2, 0xd9,
-	0x0e, 0x06, 0xdd, 0x50, 0x77, 0x44, 0xa4, 0x41, 0xb9, 0xd9, 0xe8, 0xec, 0x9c, 0x56, 0x8e, 0x60,
-	0x0a, 0x23, 0x39, 0x01, 0x85, 0xe9, 0x3e, 0xf9, 0x90, 0xe9, 0x56, 0x63, 0xaf, 0x2c, 0x10, 0x9e,
-	0x59, 0x20, 0x5f, 0xd2, 0xbc, 0x91, 0x32, 0xfb, 0x8c, 0xd2, 0xb9, 0x90, 0xec, 0x0a, 0x2f, 0x1d,
-	0x56, 0xb3, 0xc2, 0x73, 0x97, 0x7c, 0xea, 0x2f, 0xe8, 0x8e, 0x39, 0x69, 0x55, 0x77, 0x2a, 0x02,
-	0xdc, 0xa3, 0x86, 0x49, 0xf7, 0x28, 0xc6, 0xdd, 0x4f, 0xf7, 0x4a, 0xb2, 0x35, 0x12, 0xf4, 0xe9,
-	0x8f, 0xc4, 0xae, 0x3d, 0x56, 0x32, 0x62, 0xc1, 0x90, 0xd5, 0x1c, 0xdb, 0xa1, 0xd4, 0xd3, 0x42,
-	0x83, 0xc5, 0x0e, 0xab, 0xd0, 0x6d, 0x23, 0x4f, 0x0b, 0x5f, 0xb0, 0xa7, 0x94, 0x7b, 0x63, 0xa1,
-	0x74, 0x47, 0x86, 0x5a, 0x89, 0xbe, 0x76, 0x21, 0x8a, 0x47, 0xda, 0x5a, 0xdf, 0xb2, 0x2a, 0x2d,
-	0x77, 0xc3, 0xa9, 0x18, 0x05, 0xbe, 0x65, 0x2e, 0xd9, 0xde, 0x5c, 0x3e, 0x96, 0xf9, 0x99, 0x58,
-	0xea, 0x42, 0x28, 0x3f, 0xb2, 0xd4, 0xbf, 0xa4, 0xfd, 0xcd, 0xf5, 0xad, 0x93, 0xbb, 0xb9, 0x75,
-	0x72, 0xf7, 0xb7, 0x0e, 0xf9, 0x61, 0xe6, 0x90, 0x5f, 0x66, 0x0e, 0x79, 0x3f, 0x73, 0xc8, 0xf5,
-	0xcc, 0x21, 0x37, 0x33, 0x87, 0xfc, 0x35, 0x73, 0xc8, 0xdf, 0x33, 0x27, 0x77, 0x3f, 0x73, 0xc8,
-	0x4f, 0x77, 0x4e, 0xee, 0xfa, 0xce, 0xc9, 0xdd, 0xdc, 0x39, 0xb9, 0xb7, 0x05, 0xfc, 0x1b, 0xed,
-	0x15, 0x71, 0x4c, 0x5f, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x18, 0x2f, 0x4f, 0x6d, 0x56, 0x07,
-	0x00, 0x00,
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x6f, 0x23, 0x45,
+	0x14, 0xf7, 0x24, 0xb6, 0x2f, 0x19, 0xdb, 0x89, 0x33, 0x1c, 0x61, 0x38, 0x9d, 0x36, 0x96, 0x75,
+	0x85, 0x85, 0x74, 0x36, 0x84, 0x06, 0xc4, 0x49, 0xe8, 0xec, 0xe4, 0x14, 0x0b, 0xee, 0x14, 0xed,
+	0x46, 0x14, 0xd7, 0x8d, 0xbd, 0xef, 0xec, 0x55, 0xec, 0x1d, 0x6b, 0x76, 0xd6, 0x49, 0x3a, 0x4a,
+	0x4a, 0x2a, 0xc4, 0x47, 0x40, 0xd4, 0x7c, 0x88, 0x2b, 0x28, 0x52, 0xa6, 0x02, 0xe2, 0x34, 0x94,
+	0xf9, 0x06, 0xa0, 0x79, 0xb3, 0xeb, 0x3f, 0x1b, 0x17, 0x14, 0x54, 0xde, 0xdf, 0xef, 0xbd, 0x79,
+	0xff, 0xe6, 0x37, 0xcf, 0xb4, 0xd4, 0x1b, 0xc9, 0xfe, 0x79, 0x73, 0xa2, 0xa4, 0x96, 0xac, 0x80,
+	0x3f, 0x4f, 0x9e, 0x0f, 0x02, 0x3d, 0x8c, 0x7b, 0xcd, 0xbe, 0x1c, 0xb7, 0x06, 0x72, 0x20, 0x5b,
+	0x48, 0xf7, 0xe2, 0x77, 0x88, 0x10, 0xe0, 0x97, 0x3d, 0x55, 0xff, 0x8d, 0xd0, 0xed, 0xd7, 0x41,
+	0x18, 0xb4, 0x4d, 0x24, 0xf6, 0x84, 0x6e, 0x9d, 0x5d, 0x9e, 0x88, 0x68, 0x08, 0x11, 0x27, 0xb5,
+	0xcd, 0x46, 0xd9, 0x9d, 0x63, 0xd6, 0xa0, 0xbb, 0x2e, 0xf4, 0x21, 0x98, 0x82, 0xf2, 0x86, 0x42,
+	0xf9, 0xdd, 0x23, 0xbe, 0x51, 0x23, 0x8d, 0x8a, 0x9b, 0xa5, 0xd9, 0x33, 0x5a, 0xf1, 0x20, 0xf4,
+	0x17, 0x7e, 0x9b, 0xe8, 0xb7, 0x4a, 0xb2, 0x03, 0x9a, 0x3f, 0xbb, 0x9a, 0x00, 0xcf, 0xd7, 0x48,
+	0x63, 0xe7, 0xb0, 0x64, 0xeb, 0x69, 0x1a, 0xca, 0x45, 0x83, 0x29, 0xc6, 0x85, 0x08, 0xd4, 0x14,
+	0x7c, 0x5e, 0xa8, 0x11, 0x53, 0x4c, 0x8a, 0xeb, 0xbf, 0x13, 0xba, 0x3b, 0x2f, 0xfb, 0x04, 0x84,
+	0x0f, 0x8a, 0x31, 0x9a, 0x37, 0xa5, 0x72, 0x82, 0xbe, 0xf8, 0xfd, 0xb0, 0x94, 0x8d, 0x75, 0xa5,
+	0xac, 0x69, 0x6d, 0x73, 0x7d, 0x6b, 0x9c, 0x3e, 0x3a, 0xbb, 0xec, 0xc8, 0x38, 0xd4, 0x58, 0x77,
+	0xc5, 0x4d, 0xe1, 0xbc, 0x9d, 0xc2, 0x7f, 0x69, 0xa7, 0x98, 0x69, 0xe7, 0x15, 0xa5, 0xa7, 0x00,
+	0xaa, 0x33, 0x14, 0xe1, 0x00, 0xd8, 0x3e, 0x2d, 0x9e, 0xc6, 0xbd, 0x6f, 0xe0, 0x2a, 0x69, 0x25,
+	0x41, 0xac, 0x46, 0x4b, 0xb6, 0x0e, 0xff, 0x08, 0x22, 0x9d, 0xb4, 0xb2, 0x4c, 0xd5, 0x7f, 0xde,
+	0xa2, 0xc5, 0x64, 0x1a, 0x8f, 0x69, 0xe1, 0x8d, 0x0c, 0xfb, 0x80, 0x31, 0xf2, 0xae, 0x05, 0xa6,
+	0x88, 0x53, 0x05, 0x53, 0x9c, 0xd3, 0x86, 0x2d, 0x22, 0xc5, 0xac, 0x4e, 0xcb, 0xe6, 0xdb, 0x15,
+	0xa1, 0xef, 0x01, 0xf8, 0x38, 0x82, 0xb2, 0xbb, 0xc2, 0x61, 0x13, 0xa9, 0x3d, 0x9f, 0x34, 0x91,
+	0xda, 0x9e, 0xd1, 0x8a, 0x2d, 0x34, 0x6a, 0x07, 0x7a, 0x2c, 0x26, 0xc9, 0xa5, 0xad, 0x92, 0x66,
+	0x82, 0xe9, 0x8c, 0x8b, 0x76, 0x82, 0x09, 0x64, 0x4f, 0xe9, 0xf6, 0x59, 0x30, 0x06, 0x4f, 0x8b,
+	0xf1, 0x84, 0x3f, 0xc2, 0xaa, 0x17, 0x84, 0xe9, 0xc7, 0x95, 0x71, 0xe8, 0xf3, 0x2d, 0xdb, 0x0f,
+	0x02, 0xc3, 0x1e, 0x4f, 0x64, 0x7f, 0xc8, 0xb7, 0x31, 0x96, 0x05, 0xec, 0x33, 0x5a, 0x41, 0x61,
+	0xb4, 0xa5, 0x7f, 0x85, 0x97, 0x42, 0x1f, 0x5e, 0xca, 0xaa, 0x87, 0x49, 0xee, 0x05, 0x83, 0x50,
+	0xe8, 0x58, 0x01, 0x2f, 0x61, 0xe1, 0x0b, 0xc2, 0x08, 0xe4, 0x5b, 0x1c, 0xeb, 0xc2, 0xa7, 0x8c,
+	0x3e, 0x59, 0x9a, 0x9d, 0xd0, 0x6a, 0x46, 0x97, 0x11, 0xaf, 0xd4, 0x36, 0x1b, 0xa5, 0xc3, 0xfd,
+	0x24, 0x7b, 0xc6, 0xdc, 0xce, 0xbf, 0xff, 0xe3, 0x20, 0xe7, 0x3e, 0x38, 0xc5, 0xbe, 0xa4, 0xa5,
+	0x85, 0x26, 0x22, 0xbe, 0x83, 0x41, 0xf6, 0x92, 0x20, 0x0b, 0x4b, 0x72, 0x7e, 0xd9, 0x17, 0x6f,
+	0x49, 0x4a, 0x8d, 0xb7, 0xbc, 0x9b, 0xdc, 0x52, 0x82, 0x4d, 0x2b, 0xaf, 0x41, 0x0b, 0x9b, 0xca,
+	0xbe, 0xf4, 0x2a, 0xbe, 0xf4, 0x2c, 0xbd, 0xac, 0xf5, 0xbd, 0x55, 0xad, 0x37, 0x29, 0xc3, 0x41,
+	0x7b, 0x5a, 0x28, 0x6d, 0x8e, 0x61, 0x26, 0x86, 0x99, 0xd6, 0x58, 0x8c, 0xb2, 0xf0, 0x21, 0x4d,
+	0x74, 0x84, 0x9e, 0x1f, 0x58, 0x65, 0x2d, 0x73, 0x26, 0x5b, 0x67, 0x28, 0x82, 0xb0, 0x7b, 0xc4,
+	0x1f, 0xa3, 0x39, 0x85, 0xa6, 0x62, 0x4f, 0xbe, 0xd3, 0x17, 0x42, 0xc1, 0x77, 0xa0, 0xa2, 0x40,
+	0x86, 0xfc, 0x43, 0x3b, 0xfc, 0x0c, 0xcd, 0x24, 0xdd, 0x7d, 0xd9, 0xef, 0xc7, 0xe3, 0x78, 0x24,
+	0x34, 0xf8, 0xaf, 0x00, 0x22, 0xbe, 0x6f, 0x3c, 0xdb, 0xc7, 0xbf, 0xfe, 0x79, 0xf0, 0x72, 0x2c,
+	0xf4, 0xb0, 0xd5, 0x0b, 0x06, 0xcd, 0x6e, 0xa8, 0xbf, 0x5a, 0xda, 0x92, 0xc7, 0x23, 0x25, 0x43,
+	0xff, 0x0d, 0xe8, 0x0b, 0xa9, 0xce, 0x5b, 0x80, 0xe8, 0xf9, 0x40, 0xb6, 0x7c, 0xa1, 0x45, 0xb3,
+	0x1d, 0x0c, 0xba, 0xa1, 0xee, 0x88, 0x48, 0x83, 0x72, 0xb3, 0xd1, 0xd9, 0x39, 0xad, 0x1c, 0xc1,
+	0x14, 0x46, 0x72, 0x02, 0x0a, 0xd3, 0x7d, 0xf4, 0x7f, 0xa6, 0x5b, 0x8d, 0xbd, 0xb2, 0x40, 0xf8,
+	0xea, 0x02, 0x31, 0xab, 0xc1, 0x2a, 0xb1, 0x1b, 0xfa, 0x70, 0xc9, 0x3f, 0xb6, 0xab, 0x61, 0x89,
+	0xaa, 0x7f, 0x41, 0xf3, 0x46, 0xec, 0xec, 0x53, 0x4a, 0xe7, 0x52, 0xb3, 0x4b, 0xbe, 0x74, 0x58,
+	0xcd, 0x4a, 0xd3, 0x5d, 0xf2, 0xa9, 0xbf, 0xa0, 0x3b, 0xe6, 0xa4, 0xd5, 0xe5, 0xa9, 0x08, 0x70,
+	0xd3, 0x1a, 0x26, 0xdd, 0xb4, 0x18, 0x77, 0x3f, 0xdd, 0x3c, 0xc9, 0x5e, 0x49, 0xd0, 0x27, 0x3f,
+	0x10, 0xbb, 0x18, 0x59, 0xc9, 0xc8, 0x09, 0x43, 0x56, 0x73, 0x6c, 0x87, 0x52, 0x4f, 0x0b, 0x0d,
+	0x16, 0x3b, 0xac, 0x42, 0xb7, 0x8d, 0x80, 0x2d, 0x7c, 0xc1, 0x9e, 0x52, 0xee, 0x8d, 0x85, 0xd2,
+	0x1d, 0x19, 0x6a, 0x25, 0xfa, 0xda, 0x85, 0x28, 0x1e, 0x69, 0x6b, 0x7d, 0xcb, 0xaa, 0xb4, 0xdc,
+	0x0d, 0xa7, 0x62, 0x14, 0xf8, 0x96, 0xb9, 0x64, 0x7b, 0x73, 0x81, 0x59, 0xe6, 0x27, 0x62, 0xa9,
+	0x0b, 0xa1, 0xfc, 0xc8, 0x52, 0xff, 0x90, 0xf6, 0xd7, 0xd7, 0xb7, 0x4e, 0xee, 0xe6, 0xd6, 0xc9,
+	0xdd, 0xdf, 0x3a, 0xe4, 0xfb, 0x99, 0x43, 0x7e, 0x99, 0x39, 0xe4, 0xfd, 0xcc, 0x21, 0xd7, 0x33,
+	0x87, 0xdc, 0xcc, 0x1c, 0xf2, 0xd7, 0xcc, 0x21, 0x7f, 0xcf, 0x9c, 0xdc, 0xfd, 0xcc, 0x21, 0x3f,
+	0xde, 0x39, 0xb9, 0xeb, 0x3b, 0x27, 0x77, 0x73, 0xe7, 0xe4, 0xde, 0x16, 0xf0, 0x8f, 0xb6, 0x57,
+	0xc4, 0x31, 0x7d, 0xfe, 0xef, 0x00, 0xfc, 0x09, 0xe2, 0x43, 0x78, 0x07, 0x00, 0x00,
 }
 
 func (x Type) String() string {
@@ -874,6 +882,9 @@ func (this *Header) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Reserved, that1.Reserved) {
 		return false
 	}
+	if this.LeaderIndex != that1.LeaderIndex {
+		return false
+	}
 	return true
 }
 func (this *Body) Equal(that interface{}) bool {
@@ -976,7 +987,7 @@ func (this *Header) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 28)
+	s := make([]string, 0, 29)
 	s = append(s, "&block.Header{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "PrevHash: "+fmt.Sprintf("%#v", this.PrevHash)+",\n")
@@ -1014,6 +1025,7 @@ func (this *Header) GoString() string {
 	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
 	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
 	s = append(s, "Reserved: "+fmt.Sprintf("%#v", this.Reserved)+",\n")
+	s = append(s, "LeaderIndex: "+fmt.Sprintf("%#v", this.LeaderIndex)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1214,6 +1226,13 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LeaderIndex != 0 {
+		i = encodeVarintBlock(dAtA, i, uint64(m.LeaderIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
 	if len(m.Reserved) > 0 {
 		i -= len(m.Reserved)
 		copy(dAtA[i:], m.Reserved)
@@ -1674,6 +1693,9 @@ func (m *Header) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovBlock(uint64(l))
 	}
+	if m.LeaderIndex != 0 {
+		n += 2 + sovBlock(uint64(m.LeaderIndex))
+	}
 	return n
 }
 
@@ -1794,6 +1816,7 @@ func (this *Header) String() string {
 		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
 		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
 		`Reserved:` + fmt.Sprintf("%v", this.Reserved) + `,`,
+		`LeaderIndex:` + fmt.Sprintf("%v", this.LeaderIndex) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3057,6 +3080,25 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				m.Reserved = []byte{}
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderIndex", wireType)
+			}
+			m.LeaderIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlock(dAtA[iNdEx:])
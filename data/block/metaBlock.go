@@ -71,6 +71,11 @@ func (m *MetaBlock) SetLeaderSignature(sg []byte) {
 	m.LeaderSignature = sg
 }
 
+// SetLeaderIndex sets the index in the consensus group of the validator which proposed the block
+func (m *MetaBlock) SetLeaderIndex(index uint32) {
+	m.LeaderIndex = index
+}
+
 // SetChainID sets the chain ID on which this block is valid on
 func (m *MetaBlock) SetChainID(chainID []byte) {
 	m.ChainID = chainID
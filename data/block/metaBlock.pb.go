@@ -72,12 +72,14 @@ func (PeerAction) EnumDescriptor() ([]byte, []int) {
 // PeerData holds information about actions taken by a peer:
 //  - a peer can register with an amount to become a validator
 //  - a peer can choose to deregister and get back the deposited value
+//  - a peer can be slashed and jailed for signing conflicting data in the same round, the proof being held in Evidence
 type PeerData struct {
 	Address     []byte        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	PublicKey   []byte        `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
 	Action      PeerAction    `protobuf:"varint,3,opt,name=Action,proto3,enum=proto.PeerAction" json:"Action,omitempty"`
 	TimeStamp   uint64        `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
 	ValueChange *math_big.Int `protobuf:"bytes,5,opt,name=ValueChange,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ValueChange,omitempty"`
+	Evidence    []byte        `protobuf:"bytes,6,opt,name=Evidence,proto3" json:"Evidence,omitempty"`
 }
 
 func (m *PeerData) Reset()      { *m = PeerData{} }
@@ -143,6 +145,13 @@ func (m *PeerData) GetValueChange() *math_big.Int {
 	return nil
 }
 
+func (m *PeerData) GetEvidence() []byte {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 // ShardData holds the block information sent by the shards to the metachain
 type ShardData struct {
 	HeaderHash            []byte            `protobuf:"bytes,2,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
@@ -487,16 +496,65 @@ func (m *Economics) GetPrevEpochStartHash() []byte {
 	return nil
 }
 
+// RoundDurationChange holds a round duration voted through governance and the round it applies from
+type RoundDurationChange struct {
+	StartRound        uint64 `protobuf:"varint,1,opt,name=StartRound,proto3" json:"StartRound,omitempty"`
+	RoundDurationInMs uint64 `protobuf:"varint,2,opt,name=RoundDurationInMs,proto3" json:"RoundDurationInMs,omitempty"`
+}
+
+func (m *RoundDurationChange) Reset()      { *m = RoundDurationChange{} }
+func (*RoundDurationChange) ProtoMessage() {}
+func (*RoundDurationChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87b91ab531130b2b, []int{4}
+}
+func (m *RoundDurationChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundDurationChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RoundDurationChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundDurationChange.Merge(m, src)
+}
+func (m *RoundDurationChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundDurationChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundDurationChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundDurationChange proto.InternalMessageInfo
+
+func (m *RoundDurationChange) GetStartRound() uint64 {
+	if m != nil {
+		return m.StartRound
+	}
+	return 0
+}
+
+func (m *RoundDurationChange) GetRoundDurationInMs() uint64 {
+	if m != nil {
+		return m.RoundDurationInMs
+	}
+	return 0
+}
+
 // EpochStart holds the block information for end-of-epoch
 type EpochStart struct {
 	LastFinalizedHeaders []EpochStartShardData `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
 	Economics            Economics             `protobuf:"bytes,2,opt,name=Economics,proto3" json:"Economics"`
+	RoundsSchedule       []RoundDurationChange `protobuf:"bytes,3,rep,name=RoundsSchedule,proto3" json:"RoundsSchedule"`
 }
 
 func (m *EpochStart) Reset()      { *m = EpochStart{} }
 func (*EpochStart) ProtoMessage() {}
 func (*EpochStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{4}
+	return fileDescriptor_87b91ab531130b2b, []int{5}
 }
 func (m *EpochStart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,6 +593,13 @@ func (m *EpochStart) GetEconomics() Economics {
 	return Economics{}
 }
 
+func (m *EpochStart) GetRoundsSchedule() []RoundDurationChange {
+	if m != nil {
+		return m.RoundsSchedule
+	}
+	return nil
+}
+
 // MetaBlock holds the data that will be saved to the metachain each round
 type MetaBlock struct {
 	Nonce                  uint64            `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
@@ -562,12 +627,13 @@ type MetaBlock struct {
 	DevFeesInEpoch         *math_big.Int     `protobuf:"bytes,24,opt,name=DevFeesInEpoch,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"DevFeesInEpoch,omitempty"`
 	TxCount                uint32            `protobuf:"varint,25,opt,name=TxCount,proto3" json:"TxCount,omitempty"`
 	Reserved               []byte            `protobuf:"bytes,26,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	LeaderIndex            uint32            `protobuf:"varint,27,opt,name=LeaderIndex,proto3" json:"LeaderIndex,omitempty"`
 }
 
 func (m *MetaBlock) Reset()      { *m = MetaBlock{} }
 func (*MetaBlock) ProtoMessage() {}
 func (*MetaBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{5}
+	return fileDescriptor_87b91ab531130b2b, []int{6}
 }
 func (m *MetaBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,12 +833,20 @@ func (m *MetaBlock) GetReserved() []byte {
 	return nil
 }
 
+func (m *MetaBlock) GetLeaderIndex() uint32 {
+	if m != nil {
+		return m.LeaderIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("proto.PeerAction", PeerAction_name, PeerAction_value)
 	proto.RegisterType((*PeerData)(nil), "proto.PeerData")
 	proto.RegisterType((*ShardData)(nil), "proto.ShardData")
 	proto.RegisterType((*EpochStartShardData)(nil), "proto.EpochStartShardData")
 	proto.RegisterType((*Economics)(nil), "proto.Economics")
+	proto.RegisterType((*RoundDurationChange)(nil), "proto.RoundDurationChange")
 	proto.RegisterType((*EpochStart)(nil), "proto.EpochStart")
 	proto.RegisterType((*MetaBlock)(nil), "proto.MetaBlock")
 }
@@ -780,86 +854,91 @@ func init() {
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0x6f, 0x1c, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xb6, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x6a, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x1f, 0xa1, 0xbd, 0xf5, 0x01, 0x7a, 0x08, 0xda, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0x9a, 0x46,
-	0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x77, 0x49, 0x91, 0xa2, 0xe8, 0x26, 0x07, 0xe5, 0x64,
-	0xcf, 0x6f, 0x76, 0x66, 0xb4, 0xf3, 0x6f, 0x7f, 0x84, 0xb5, 0x01, 0x11, 0xf8, 0x30, 0xe0, 0xee,
-	0xc5, 0xee, 0x30, 0xe4, 0x82, 0xa3, 0xaa, 0xfa, 0xb3, 0x75, 0xcf, 0xa7, 0xa2, 0x1f, 0xf7, 0x76,
-	0x5d, 0x3e, 0xd8, 0xf3, 0xb9, 0xcf, 0xf7, 0x14, 0xdc, 0x8b, 0x9f, 0x28, 0x49, 0x09, 0xea, 0x3f,
-	0x6d, 0xb5, 0x55, 0xef, 0x65, 0x2e, 0x9a, 0xff, 0x18, 0xb0, 0xdc, 0x21, 0x24, 0x6c, 0x61, 0x81,
-	0x91, 0x05, 0x4b, 0x07, 0x9e, 0x17, 0x92, 0x28, 0xb2, 0x8c, 0x6d, 0x63, 0x67, 0xc5, 0x49, 0x45,
-	0xf4, 0x7f, 0xa8, 0x75, 0xe2, 0x5e, 0x40, 0xdd, 0x87, 0xe4, 0xda, 0x9a, 0x53, 0xba, 0x0c, 0x40,
-	0x1f, 0xc2, 0xe2, 0x81, 0x2b, 0x28, 0x67, 0xd6, 0xfc, 0xb6, 0xb1, 0xb3, 0xba, 0xbf, 0xae, 0x9d,
-	0xef, 0x4a, 0xc7, 0x5a, 0xe1, 0x24, 0x07, 0xa4, 0xa3, 0x33, 0x3a, 0x20, 0x5d, 0x81, 0x07, 0x43,
-	0x6b, 0x61, 0xdb, 0xd8, 0x59, 0x70, 0x32, 0x00, 0xf9, 0x50, 0x3f, 0xc7, 0x41, 0x4c, 0x8e, 0xfa,
-	0x98, 0xf9, 0xc4, 0xaa, 0xca, 0x40, 0x87, 0xc7, 0xbf, 0xbd, 0xbc, 0x7b, 0x30, 0xc0, 0xa2, 0xbf,
-	0xd7, 0xa3, 0xfe, 0x6e, 0x9b, 0x89, 0x2f, 0x72, 0xf7, 0x3d, 0x0e, 0x42, 0xce, 0xbc, 0x53, 0x22,
-	0xae, 0x78, 0x78, 0xb1, 0x47, 0x94, 0x74, 0xcf, 0xe7, 0x7b, 0x1e, 0x16, 0x78, 0xf7, 0x90, 0xfa,
-	0x6d, 0x26, 0x8e, 0x70, 0x24, 0x48, 0xe8, 0xe4, 0x3d, 0x37, 0x7f, 0xaf, 0x42, 0xad, 0xdb, 0xc7,
-	0xa1, 0xa7, 0xee, 0x6d, 0x03, 0x3c, 0x20, 0xd8, 0x23, 0xe1, 0x03, 0x1c, 0xf5, 0x93, 0xeb, 0xe5,
-	0x10, 0xe4, 0xc0, 0x6d, 0x75, 0xf8, 0x31, 0x65, 0x54, 0xe5, 0x5f, 0xeb, 0x22, 0x6b, 0x7e, 0x7b,
-	0x7e, 0xa7, 0xbe, 0xbf, 0x99, 0x5c, 0xb7, 0xa0, 0x3e, 0x5c, 0x78, 0xf6, 0xc7, 0xdd, 0x8a, 0x53,
-	0x6e, 0x8a, 0x9a, 0xb0, 0xd2, 0x09, 0xc9, 0xa5, 0x83, 0x99, 0xd7, 0x25, 0xc4, 0x53, 0xb9, 0x58,
-	0x71, 0x26, 0x30, 0xf4, 0x01, 0x34, 0x3a, 0x71, 0xef, 0x21, 0xb9, 0x8e, 0x0e, 0xa9, 0x18, 0xe0,
-	0xa1, 0x4e, 0x88, 0x33, 0x09, 0xca, 0x94, 0x76, 0xa9, 0xcf, 0xb0, 0x88, 0x43, 0x62, 0x2d, 0xea,
-	0xda, 0x8c, 0x01, 0xb4, 0x01, 0x55, 0x87, 0xc7, 0xcc, 0xb3, 0x96, 0x55, 0xb2, 0xb5, 0x80, 0xb6,
-	0x60, 0x59, 0x46, 0x52, 0xf7, 0xad, 0x29, 0x93, 0xb1, 0x2c, 0x2d, 0x4e, 0x39, 0x73, 0x89, 0x05,
-	0xda, 0x42, 0x09, 0x88, 0xc3, 0xda, 0x81, 0xeb, 0xc6, 0x83, 0x38, 0xc0, 0x82, 0x78, 0x27, 0x84,
-	0x44, 0xd6, 0xca, 0x2c, 0xcb, 0x53, 0xf4, 0x8e, 0x2e, 0xa0, 0xd1, 0x22, 0x97, 0x24, 0xe0, 0x43,
-	0x12, 0xaa, 0x70, 0xab, 0xb3, 0x0c, 0x37, 0xe9, 0x1b, 0xed, 0xc3, 0xc6, 0x69, 0x3c, 0xe8, 0x10,
-	0xe6, 0x51, 0xe6, 0x8f, 0x6b, 0x15, 0x59, 0xf5, 0x6d, 0x63, 0xa7, 0xe1, 0x94, 0xea, 0xd0, 0x7d,
-	0xb8, 0xfd, 0x08, 0x47, 0xa2, 0xcd, 0xdc, 0x20, 0xf6, 0x88, 0xf7, 0x98, 0x08, 0xac, 0xf3, 0xd6,
-	0x50, 0x79, 0x2b, 0x57, 0xca, 0x19, 0x53, 0x0d, 0xd1, 0x6e, 0xa9, 0x19, 0x6b, 0x38, 0xa9, 0x28,
-	0x35, 0x67, 0xdf, 0x1d, 0xf1, 0x98, 0x09, 0x6b, 0x49, 0x6b, 0x12, 0xb1, 0xf9, 0xf7, 0x1c, 0xdc,
-	0x3a, 0x1e, 0x72, 0xb7, 0xdf, 0x15, 0x38, 0x14, 0x59, 0xdf, 0xde, 0xec, 0x6b, 0x03, 0xaa, 0xca,
-	0x40, 0x15, 0xb7, 0xe1, 0x68, 0x21, 0xeb, 0x85, 0xa5, 0x7c, 0x2f, 0x8c, 0xeb, 0xbd, 0x9c, 0xaf,
-	0xf7, 0xdb, 0x66, 0x62, 0x0b, 0x96, 0x1d, 0xce, 0x85, 0xd2, 0xce, 0xeb, 0x0e, 0x4a, 0x65, 0x99,
-	0x99, 0x13, 0x1a, 0x46, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c,
-	0xa1, 0x8c, 0x46, 0x7d, 0x9d, 0x32, 0x6d, 0xa5, 0xbb, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x4e, 0xb1,
-	0x34, 0xe9, 0x74, 0x2e, 0xbe, 0xc3, 0x74, 0xde, 0x64, 0xdc, 0x7c, 0xba, 0x08, 0xb5, 0x63, 0x97,
-	0x33, 0x3e, 0xa0, 0x6e, 0x24, 0x17, 0xd3, 0x19, 0x17, 0x38, 0xe8, 0xc6, 0xc3, 0x61, 0x70, 0xad,
-	0xb7, 0xe3, 0xcc, 0x16, 0x53, 0xce, 0x33, 0x8a, 0x60, 0x5d, 0x89, 0x67, 0xbc, 0x45, 0x23, 0x11,
-	0xd2, 0x5e, 0x2c, 0x88, 0xce, 0xfe, 0xac, 0xc2, 0x4d, 0xfb, 0x47, 0xdf, 0x82, 0xa9, 0xc0, 0x53,
-	0x72, 0x15, 0x5c, 0x3f, 0xa6, 0x4c, 0x10, 0x4f, 0xd7, 0x74, 0x56, 0x31, 0xa7, 0xdc, 0xcb, 0x75,
-	0xe2, 0x90, 0x2b, 0x1c, 0x7a, 0x51, 0x87, 0x84, 0xb9, 0xe6, 0x98, 0xd9, 0x3a, 0x29, 0x78, 0x47,
-	0x3f, 0x1b, 0xb0, 0x9d, 0x60, 0x27, 0x3c, 0xec, 0xc8, 0x96, 0x70, 0x79, 0xd0, 0x8d, 0x23, 0x81,
-	0x29, 0xc3, 0x3d, 0x1a, 0x50, 0x71, 0x3d, 0xdb, 0x07, 0xe7, 0xad, 0xe1, 0x90, 0x0b, 0xb5, 0x53,
-	0xee, 0x91, 0x4e, 0x48, 0xdd, 0x64, 0x73, 0xcf, 0x2a, 0x76, 0xe6, 0x17, 0x7d, 0x0c, 0xb7, 0xe4,
-	0x6a, 0xcf, 0xf6, 0x47, 0x7e, 0x05, 0x94, 0xa9, 0xd0, 0x2e, 0xa0, 0x49, 0x58, 0x0d, 0xf9, 0xb2,
-	0x9a, 0xc2, 0x12, 0x4d, 0xf3, 0x17, 0x03, 0x20, 0x83, 0xd0, 0x19, 0x6c, 0x24, 0xa3, 0x8a, 0x03,
-	0xfa, 0x3d, 0xf1, 0xd2, 0x71, 0x34, 0xd4, 0x38, 0x6e, 0x25, 0xe3, 0x58, 0xb2, 0xcf, 0x92, 0x91,
-	0x2c, 0xb5, 0x46, 0xf7, 0x73, 0xe3, 0xa8, 0x06, 0xa2, 0xbe, 0x6f, 0xa6, 0xae, 0x52, 0x3c, 0x71,
-	0x90, 0x1d, 0x6c, 0xbe, 0xac, 0x41, 0x2d, 0xdb, 0x15, 0xe3, 0x4d, 0x67, 0xe4, 0x37, 0xdd, 0x78,
-	0x57, 0xce, 0x95, 0xee, 0xca, 0xf9, 0xfc, 0xae, 0xfc, 0x6f, 0xfa, 0x72, 0x3f, 0x21, 0x15, 0x6d,
-	0xf6, 0x84, 0x5b, 0x55, 0x75, 0xdd, 0xf4, 0x37, 0x16, 0x2f, 0x99, 0x1d, 0x44, 0x9f, 0x68, 0x06,
-	0xa6, 0x8c, 0xf4, 0xca, 0x5a, 0xcb, 0xf1, 0xa7, 0x9c, 0xcd, 0xf8, 0xd8, 0xe4, 0x93, 0xbf, 0x54,
-	0x7c, 0xf2, 0x77, 0x60, 0xed, 0x91, 0xca, 0x5a, 0x76, 0x46, 0x17, 0xaf, 0x08, 0x4f, 0x13, 0x8c,
-	0x5a, 0x19, 0xc1, 0xc8, 0x93, 0x05, 0x28, 0x90, 0x85, 0x22, 0x8d, 0xa9, 0x97, 0xd0, 0x18, 0xf9,
-	0x54, 0xa4, 0xfa, 0x95, 0xe4, 0xa9, 0xc8, 0xeb, 0xd2, 0x67, 0xa4, 0x51, 0x78, 0x46, 0x3e, 0x83,
-	0xcd, 0x73, 0x1c, 0x50, 0x0f, 0x0b, 0x1e, 0x76, 0x05, 0x16, 0xd1, 0xf8, 0xa4, 0xa2, 0x02, 0xce,
-	0x0d, 0x5a, 0xf4, 0x00, 0xcc, 0xa9, 0xb7, 0xc0, 0x7c, 0x87, 0xb7, 0xc0, 0x2c, 0x23, 0x69, 0x0e,
-	0x71, 0x09, 0x1d, 0x8a, 0x48, 0xc5, 0x5d, 0xd7, 0xb7, 0xcb, 0x63, 0xe8, 0xf3, 0x7c, 0xf3, 0x5b,
-	0x48, 0x75, 0xe6, 0xfa, 0x54, 0x93, 0x27, 0x21, 0xf2, 0x73, 0x62, 0xc1, 0xd2, 0x51, 0x1f, 0x53,
-	0xd6, 0x6e, 0x59, 0xb7, 0x34, 0xdb, 0x4e, 0x44, 0x59, 0xc0, 0x2e, 0x7f, 0x22, 0xae, 0x70, 0x48,
-	0xce, 0x49, 0x18, 0x49, 0x62, 0xbd, 0xa1, 0x0b, 0x58, 0x80, 0xcb, 0x58, 0xd9, 0xed, 0xf7, 0xca,
-	0xca, 0x7e, 0x80, 0xcd, 0x02, 0xd4, 0x66, 0x7a, 0x7a, 0x36, 0x67, 0x19, 0xf7, 0x86, 0x20, 0xd3,
-	0xa4, 0xf0, 0xce, 0x7b, 0x24, 0x85, 0x03, 0x58, 0x6d, 0x91, 0xcb, 0xfc, 0x1d, 0xad, 0x59, 0x46,
-	0x2b, 0x38, 0xcf, 0xf3, 0xbf, 0xff, 0x4d, 0xf0, 0x3f, 0x35, 0x24, 0x24, 0x22, 0xe1, 0x25, 0xf1,
-	0xac, 0xad, 0x64, 0x48, 0x12, 0xf9, 0xa3, 0x5f, 0x0d, 0x80, 0xec, 0x3b, 0x0b, 0xad, 0x43, 0xa3,
-	0xcd, 0x2e, 0xe5, 0x5c, 0x68, 0xc0, 0xac, 0xa0, 0x0d, 0x30, 0xe5, 0x01, 0x87, 0xf8, 0xf2, 0xc5,
-	0xc7, 0x0a, 0x35, 0xe4, 0x41, 0x89, 0x7e, 0xcd, 0x22, 0x81, 0x2f, 0x28, 0xf3, 0xcd, 0x39, 0xb4,
-	0x09, 0x48, 0x6d, 0x1c, 0x12, 0xe6, 0x8f, 0xce, 0xa3, 0x55, 0x1d, 0xe1, 0x2b, 0x4c, 0x03, 0xe2,
-	0x99, 0x0b, 0xc8, 0x84, 0x15, 0x6d, 0x9a, 0x20, 0x55, 0xb4, 0x06, 0x75, 0x89, 0x74, 0x03, 0x2c,
-	0xc9, 0x99, 0xb9, 0x98, 0x02, 0x8e, 0x5c, 0x8c, 0x17, 0xc4, 0x5c, 0x3a, 0xfc, 0xf2, 0xf9, 0x2b,
-	0xbb, 0xf2, 0xe2, 0x95, 0x5d, 0x79, 0xf3, 0xca, 0x36, 0x7e, 0x1c, 0xd9, 0xc6, 0xd3, 0x91, 0x6d,
-	0x3c, 0x1b, 0xd9, 0xc6, 0xf3, 0x91, 0x6d, 0xbc, 0x18, 0xd9, 0xc6, 0x9f, 0x23, 0xdb, 0xf8, 0x6b,
-	0x64, 0x57, 0xde, 0x8c, 0x6c, 0xe3, 0xa7, 0xd7, 0x76, 0xe5, 0xf9, 0x6b, 0xbb, 0xf2, 0xe2, 0xb5,
-	0x5d, 0xf9, 0xa6, 0xaa, 0x3e, 0x57, 0x7b, 0x8b, 0x6a, 0xa2, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0xf8, 0x08, 0x32, 0x05, 0x0f, 0x00, 0x00,
+	// 1333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x53, 0xdb, 0xc6,
+	0x17, 0xb7, 0x30, 0x06, 0xfc, 0x8c, 0xc1, 0x2c, 0x84, 0xe8, 0xcb, 0xb7, 0xa3, 0x78, 0x3c, 0x3d,
+	0xd0, 0x4e, 0x03, 0x2d, 0xcd, 0xb4, 0x87, 0x1e, 0x3a, 0x80, 0x61, 0x70, 0x13, 0x18, 0x8f, 0x4c,
+	0x39, 0xf4, 0xb6, 0x96, 0x5e, 0xec, 0x1d, 0x64, 0xad, 0x2b, 0xad, 0x20, 0x74, 0xa6, 0x33, 0xed,
+	0x7f, 0x90, 0xfe, 0x0f, 0x3d, 0x64, 0xda, 0x7f, 0x24, 0xc7, 0x1c, 0x73, 0x6a, 0x1b, 0xe7, 0xd2,
+	0x63, 0xfa, 0x1f, 0x74, 0x76, 0x25, 0x59, 0xb2, 0x2c, 0x9a, 0x1c, 0x9c, 0x13, 0xbc, 0xcf, 0xdb,
+	0xf7, 0x9e, 0xf7, 0xfd, 0xda, 0x8f, 0x60, 0x75, 0x80, 0x82, 0x1e, 0x38, 0xdc, 0xba, 0xdc, 0x19,
+	0x7a, 0x5c, 0x70, 0x52, 0x52, 0x7f, 0xb6, 0xee, 0xf7, 0x98, 0xe8, 0x07, 0xdd, 0x1d, 0x8b, 0x0f,
+	0x76, 0x7b, 0xbc, 0xc7, 0x77, 0x15, 0xdc, 0x0d, 0x1e, 0x2b, 0x49, 0x09, 0xea, 0xbf, 0xd0, 0x6a,
+	0xab, 0xd2, 0x4d, 0x5c, 0x34, 0x9e, 0xce, 0xc1, 0x52, 0x1b, 0xd1, 0x6b, 0x52, 0x41, 0x89, 0x0e,
+	0x8b, 0xfb, 0xb6, 0xed, 0xa1, 0xef, 0xeb, 0x5a, 0x5d, 0xdb, 0x5e, 0x36, 0x63, 0x91, 0x7c, 0x00,
+	0xe5, 0x76, 0xd0, 0x75, 0x98, 0xf5, 0x10, 0x6f, 0xf4, 0x39, 0xa5, 0x4b, 0x00, 0xf2, 0x11, 0x2c,
+	0xec, 0x5b, 0x82, 0x71, 0x57, 0x2f, 0xd6, 0xb5, 0xed, 0x95, 0xbd, 0xb5, 0xd0, 0xf9, 0x8e, 0x74,
+	0x1c, 0x2a, 0xcc, 0xe8, 0x80, 0x74, 0x74, 0xce, 0x06, 0xd8, 0x11, 0x74, 0x30, 0xd4, 0xe7, 0xeb,
+	0xda, 0xf6, 0xbc, 0x99, 0x00, 0xa4, 0x07, 0x95, 0x0b, 0xea, 0x04, 0x78, 0xd8, 0xa7, 0x6e, 0x0f,
+	0xf5, 0x92, 0x0c, 0x74, 0x70, 0xf4, 0xdb, 0x9f, 0xf7, 0xf6, 0x07, 0x54, 0xf4, 0x77, 0xbb, 0xac,
+	0xb7, 0xd3, 0x72, 0xc5, 0x57, 0xa9, 0xfb, 0x1e, 0x39, 0x1e, 0x77, 0xed, 0x33, 0x14, 0xd7, 0xdc,
+	0xbb, 0xdc, 0x45, 0x25, 0xdd, 0xef, 0xf1, 0x5d, 0x9b, 0x0a, 0xba, 0x73, 0xc0, 0x7a, 0x2d, 0x57,
+	0x1c, 0x52, 0x5f, 0xa0, 0x67, 0xa6, 0x3d, 0x93, 0x2d, 0x58, 0x3a, 0xba, 0x62, 0x36, 0xba, 0x16,
+	0xea, 0x0b, 0xea, 0x3a, 0x63, 0xb9, 0xf1, 0x7b, 0x09, 0xca, 0x9d, 0x3e, 0xf5, 0x6c, 0x95, 0x13,
+	0x03, 0xe0, 0x04, 0xa9, 0x8d, 0xde, 0x09, 0xf5, 0xfb, 0xd1, 0xd5, 0x53, 0x08, 0x31, 0xe1, 0x8e,
+	0x3a, 0x7c, 0xca, 0x5c, 0xa6, 0x6a, 0x13, 0xea, 0x7c, 0xbd, 0x58, 0x2f, 0x6e, 0x57, 0xf6, 0x36,
+	0xa3, 0x54, 0x64, 0xd4, 0x07, 0xf3, 0xcf, 0xff, 0xb8, 0x57, 0x30, 0xf3, 0x4d, 0x49, 0x03, 0x96,
+	0xdb, 0x1e, 0x5e, 0x99, 0xd4, 0xb5, 0x3b, 0x88, 0xb6, 0xca, 0xd3, 0xb2, 0x39, 0x81, 0x91, 0x0f,
+	0xa1, 0xda, 0x0e, 0xba, 0x0f, 0xf1, 0xc6, 0x3f, 0x60, 0x62, 0x40, 0x87, 0x61, 0xb2, 0xcc, 0x49,
+	0x50, 0xa6, 0xbb, 0xc3, 0x7a, 0x2e, 0x15, 0x81, 0x17, 0x5f, 0x34, 0x01, 0xc8, 0x06, 0x94, 0x4c,
+	0x1e, 0xb8, 0xb6, 0xbe, 0xa4, 0x0a, 0x11, 0x0a, 0x32, 0x37, 0x32, 0x92, 0xba, 0x6f, 0x39, 0xcc,
+	0x4d, 0x2c, 0x4b, 0x8b, 0x33, 0x2e, 0x93, 0x06, 0xa1, 0x85, 0x12, 0x08, 0x87, 0xd5, 0x7d, 0xcb,
+	0x0a, 0x06, 0x81, 0x43, 0x05, 0xda, 0xc7, 0x88, 0xbe, 0xbe, 0x3c, 0xcb, 0xd2, 0x65, 0xbd, 0x93,
+	0x4b, 0xa8, 0x36, 0xf1, 0x0a, 0x1d, 0x3e, 0x44, 0x4f, 0x85, 0x5b, 0x99, 0x65, 0xb8, 0x49, 0xdf,
+	0x64, 0x0f, 0x36, 0xce, 0x82, 0x41, 0x1b, 0x5d, 0x9b, 0xb9, 0xbd, 0x71, 0xad, 0x7c, 0xbd, 0x52,
+	0xd7, 0xb6, 0xab, 0x66, 0xae, 0x8e, 0x3c, 0x80, 0x3b, 0x8f, 0xa8, 0x2f, 0x5a, 0xae, 0xe5, 0x04,
+	0x36, 0xda, 0xa7, 0x28, 0x68, 0x98, 0xb7, 0xaa, 0xca, 0x5b, 0xbe, 0x52, 0xce, 0x9f, 0x6a, 0x88,
+	0x56, 0x53, 0xcd, 0x5f, 0xd5, 0x8c, 0x45, 0xa9, 0x39, 0x7f, 0x72, 0xc8, 0x03, 0x57, 0xe8, 0x8b,
+	0xa1, 0x26, 0x12, 0x1b, 0xff, 0xcc, 0xc1, 0xfa, 0xd1, 0x90, 0x5b, 0xfd, 0x8e, 0xa0, 0x9e, 0x48,
+	0xfa, 0xf6, 0x76, 0x5f, 0x1b, 0x50, 0x52, 0x06, 0xaa, 0xb8, 0x55, 0x33, 0x14, 0x92, 0x5e, 0x58,
+	0x4c, 0xf7, 0xc2, 0xb8, 0xde, 0x4b, 0xe9, 0x7a, 0xbf, 0x6d, 0x26, 0xb6, 0x60, 0xc9, 0xe4, 0x5c,
+	0x28, 0x6d, 0x31, 0xec, 0xa0, 0x58, 0x96, 0x99, 0x39, 0x66, 0x9e, 0x2f, 0xe2, 0x9c, 0xc5, 0x2b,
+	0x2d, 0x6a, 0xf2, 0x7c, 0x65, 0x9c, 0xcf, 0x63, 0xe6, 0x32, 0xbf, 0x8f, 0xf6, 0x58, 0x11, 0x75,
+	0x7d, 0xbe, 0x92, 0x5c, 0xc0, 0xdd, 0x6c, 0x69, 0xe2, 0xe9, 0x5c, 0x78, 0x87, 0xe9, 0xbc, 0xcd,
+	0xb8, 0xf1, 0x6c, 0x01, 0xca, 0x47, 0x16, 0x77, 0xf9, 0x80, 0x59, 0xbe, 0x5c, 0x5a, 0xe7, 0x5c,
+	0x50, 0xa7, 0x13, 0x0c, 0x87, 0xce, 0x8d, 0xae, 0xcd, 0xb2, 0x15, 0xd3, 0x9e, 0x89, 0x0f, 0x6b,
+	0x4a, 0x3c, 0xe7, 0x4d, 0xe6, 0x0b, 0x8f, 0x75, 0x03, 0x81, 0xfa, 0xdc, 0x2c, 0xc3, 0x4d, 0xfb,
+	0x27, 0xdf, 0x43, 0x4d, 0x81, 0x67, 0x78, 0xed, 0xdc, 0x9c, 0x32, 0x57, 0xa0, 0xad, 0x17, 0x67,
+	0x19, 0x73, 0xca, 0xbd, 0x5c, 0x27, 0x26, 0x5e, 0x53, 0xcf, 0xf6, 0xdb, 0xe8, 0xa5, 0x9a, 0x63,
+	0x66, 0xeb, 0x24, 0xe3, 0x9d, 0xfc, 0xa2, 0x41, 0x3d, 0xc2, 0x8e, 0xb9, 0xd7, 0x96, 0x2d, 0x61,
+	0x71, 0xa7, 0x13, 0xf8, 0x82, 0x32, 0x97, 0x76, 0x99, 0xc3, 0xc4, 0xcd, 0x6c, 0x1f, 0xa3, 0xb7,
+	0x86, 0x23, 0x16, 0x94, 0xcf, 0xb8, 0x8d, 0x6d, 0x8f, 0xc5, 0x4f, 0xd4, 0xac, 0x62, 0x27, 0x7e,
+	0xc9, 0xa7, 0xb0, 0x2e, 0x57, 0x7b, 0xb2, 0x3f, 0xd2, 0x2b, 0x20, 0x4f, 0x45, 0x76, 0x80, 0x4c,
+	0xc2, 0x6a, 0xc8, 0x97, 0xd4, 0x14, 0xe6, 0x68, 0x1a, 0x16, 0xac, 0x2b, 0xc3, 0x66, 0xe0, 0x51,
+	0x49, 0x00, 0xa2, 0xf7, 0xd7, 0x00, 0x48, 0xc5, 0xd3, 0x54, 0xbc, 0x14, 0x42, 0x3e, 0x81, 0xb5,
+	0x09, 0xb3, 0x96, 0x7b, 0xea, 0xab, 0x56, 0x9f, 0x37, 0xa7, 0x15, 0x8d, 0x91, 0x06, 0x90, 0xc4,
+	0x25, 0xe7, 0xb0, 0x11, 0xed, 0x03, 0xea, 0xb0, 0x1f, 0xd0, 0x8e, 0x67, 0x5e, 0x53, 0x33, 0xbf,
+	0x15, 0xcd, 0x7c, 0xce, 0xd2, 0x8c, 0xe6, 0x3e, 0xd7, 0x9a, 0x3c, 0x48, 0xcd, 0xbc, 0xfa, 0x29,
+	0x95, 0xbd, 0x5a, 0xec, 0x2a, 0xc6, 0x23, 0x07, 0xc9, 0x41, 0x72, 0x02, 0x2b, 0xea, 0xf7, 0xfa,
+	0x1d, 0xab, 0x8f, 0x76, 0xe0, 0xa0, 0x5e, 0x9c, 0xf8, 0x15, 0x39, 0xc9, 0x89, 0x9c, 0x64, 0xec,
+	0x1a, 0x3f, 0x03, 0x94, 0x93, 0xd5, 0x36, 0x5e, 0xcc, 0x5a, 0x7a, 0x31, 0x8f, 0x57, 0xfb, 0x5c,
+	0xee, 0x6a, 0x2f, 0xa6, 0x57, 0xfb, 0x7f, 0x33, 0xb1, 0x07, 0x11, 0x07, 0x6a, 0xb9, 0x8f, 0xb9,
+	0x5e, 0xaa, 0x17, 0x53, 0xb7, 0xcd, 0xa6, 0x2b, 0x39, 0x48, 0x3e, 0x0b, 0xc9, 0xa4, 0x32, 0x0a,
+	0x37, 0xec, 0x6a, 0x8a, 0x0a, 0xa6, 0x6c, 0xc6, 0xc7, 0x26, 0x19, 0xca, 0x62, 0x96, 0xa1, 0x6c,
+	0xc3, 0xea, 0x23, 0x95, 0xff, 0xe4, 0x4c, 0xd8, 0x6b, 0x59, 0x78, 0x9a, 0x0f, 0x95, 0xf3, 0xf8,
+	0x50, 0x9a, 0xdb, 0x40, 0x86, 0xdb, 0x64, 0x59, 0x57, 0x25, 0x87, 0x75, 0xc9, 0x97, 0x2d, 0xd6,
+	0x2f, 0x47, 0x2f, 0x5b, 0x5a, 0x17, 0xbf, 0x7a, 0xd5, 0xcc, 0xab, 0xf7, 0x05, 0x6c, 0x5e, 0x50,
+	0x87, 0xd9, 0x54, 0x70, 0xaf, 0x23, 0xa8, 0xf0, 0xc7, 0x27, 0x15, 0x73, 0x31, 0x6f, 0xd1, 0x92,
+	0x13, 0xa8, 0x4d, 0x3d, 0x5d, 0xb5, 0x77, 0x78, 0xba, 0x6a, 0x79, 0x9c, 0xd2, 0x44, 0x0b, 0xd9,
+	0x50, 0xf8, 0x2a, 0xee, 0x5a, 0x78, 0xbb, 0x34, 0x46, 0xbe, 0x4c, 0x8f, 0x91, 0x4e, 0x54, 0x8f,
+	0xaf, 0x4d, 0x8d, 0x4b, 0x14, 0x22, 0x3d, 0x71, 0x3a, 0x2c, 0x1e, 0xf6, 0x29, 0x73, 0x5b, 0x4d,
+	0x7d, 0x3d, 0xfc, 0x70, 0x88, 0x44, 0x59, 0xc0, 0x0e, 0x7f, 0x2c, 0xae, 0xa9, 0x87, 0x17, 0xe8,
+	0xf9, 0xf2, 0x1b, 0x61, 0x23, 0x2c, 0x60, 0x06, 0xce, 0x23, 0x91, 0x77, 0xde, 0x2b, 0x89, 0xfc,
+	0x11, 0x36, 0x33, 0x50, 0xcb, 0x0d, 0xa7, 0x67, 0x73, 0x96, 0x71, 0x6f, 0x09, 0x32, 0xcd, 0x61,
+	0xef, 0xbe, 0x47, 0x0e, 0x3b, 0x80, 0x95, 0x26, 0x5e, 0xa5, 0xef, 0xa8, 0xcf, 0x32, 0x5a, 0xc6,
+	0x79, 0x9a, 0xae, 0xfe, 0x6f, 0x82, 0xae, 0xaa, 0x21, 0x41, 0x1f, 0xbd, 0x2b, 0xb4, 0xf5, 0xad,
+	0x68, 0x48, 0x22, 0x99, 0xd4, 0xa1, 0x12, 0x4e, 0x75, 0xcb, 0xb5, 0xf1, 0x89, 0xfe, 0x7f, 0x65,
+	0x99, 0x86, 0x3e, 0xfe, 0x55, 0x03, 0x48, 0x3e, 0x2a, 0xc9, 0x1a, 0x54, 0x5b, 0xee, 0x95, 0x9c,
+	0x9c, 0x10, 0xa8, 0x15, 0xc8, 0x06, 0xd4, 0xe4, 0x01, 0x13, 0x7b, 0x92, 0xc2, 0xa8, 0xad, 0x5a,
+	0xd3, 0xe4, 0x41, 0x89, 0x7e, 0xeb, 0xfa, 0x82, 0x5e, 0x32, 0xb7, 0x57, 0x9b, 0x23, 0x9b, 0x40,
+	0xd4, 0x4e, 0x42, 0x2f, 0x7d, 0xb4, 0x48, 0x56, 0xc2, 0x08, 0xdf, 0x50, 0xe6, 0xa0, 0x5d, 0x9b,
+	0x27, 0x35, 0x58, 0x0e, 0x4d, 0x23, 0xa4, 0x44, 0x56, 0xa1, 0x22, 0x91, 0x8e, 0x43, 0x25, 0xdb,
+	0xac, 0x2d, 0xc4, 0x80, 0x29, 0x57, 0xe7, 0x25, 0xd6, 0x16, 0x0f, 0xbe, 0x7e, 0xf1, 0xca, 0x28,
+	0xbc, 0x7c, 0x65, 0x14, 0xde, 0xbc, 0x32, 0xb4, 0x9f, 0x46, 0x86, 0xf6, 0x6c, 0x64, 0x68, 0xcf,
+	0x47, 0x86, 0xf6, 0x62, 0x64, 0x68, 0x2f, 0x47, 0x86, 0xf6, 0xd7, 0xc8, 0xd0, 0xfe, 0x1e, 0x19,
+	0x85, 0x37, 0x23, 0x43, 0x7b, 0xfa, 0xda, 0x28, 0xbc, 0x78, 0x6d, 0x14, 0x5e, 0xbe, 0x36, 0x0a,
+	0xdf, 0x95, 0xd4, 0xb7, 0x79, 0x77, 0x41, 0xcd, 0xdc, 0xe7, 0xff, 0x0e, 0x00, 0x35, 0xd0, 0x0a,
+	0x71, 0xf2, 0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -906,6 +985,9 @@ func (this *PeerData) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.Evidence, that1.Evidence) {
+		return false
+	}
 	return true
 }
 func (this *ShardData) Equal(that interface{}) bool {
@@ -1098,6 +1180,33 @@ func (this *Economics) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RoundDurationChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoundDurationChange)
+	if !ok {
+		that2, ok := that.(RoundDurationChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartRound != that1.StartRound {
+		return false
+	}
+	if this.RoundDurationInMs != that1.RoundDurationInMs {
+		return false
+	}
+	return true
+}
 func (this *EpochStart) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1128,6 +1237,14 @@ func (this *EpochStart) Equal(that interface{}) bool {
 	if !this.Economics.Equal(&that1.Economics) {
 		return false
 	}
+	if len(this.RoundsSchedule) != len(that1.RoundsSchedule) {
+		return false
+	}
+	for i := range this.RoundsSchedule {
+		if !this.RoundsSchedule[i].Equal(&that1.RoundsSchedule[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *MetaBlock) Equal(that interface{}) bool {
@@ -1251,19 +1368,23 @@ func (this *MetaBlock) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Reserved, that1.Reserved) {
 		return false
 	}
+	if this.LeaderIndex != that1.LeaderIndex {
+		return false
+	}
 	return true
 }
 func (this *PeerData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&block.PeerData{")
 	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
 	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
 	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
 	s = append(s, "TimeStamp: "+fmt.Sprintf("%#v", this.TimeStamp)+",\n")
 	s = append(s, "ValueChange: "+fmt.Sprintf("%#v", this.ValueChange)+",\n")
+	s = append(s, "Evidence: "+fmt.Sprintf("%#v", this.Evidence)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1337,11 +1458,22 @@ func (this *Economics) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *EpochStart) GoString() string {
+func (this *RoundDurationChange) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&block.RoundDurationChange{")
+	s = append(s, "StartRound: "+fmt.Sprintf("%#v", this.StartRound)+",\n")
+	s = append(s, "RoundDurationInMs: "+fmt.Sprintf("%#v", this.RoundDurationInMs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochStart) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
 	s = append(s, "&block.EpochStart{")
 	if this.LastFinalizedHeaders != nil {
 		vs := make([]EpochStartShardData, len(this.LastFinalizedHeaders))
@@ -1351,6 +1483,13 @@ func (this *EpochStart) GoString() string {
 		s = append(s, "LastFinalizedHeaders: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
 	s = append(s, "Economics: "+strings.Replace(this.Economics.GoString(), `&`, ``, 1)+",\n")
+	if this.RoundsSchedule != nil {
+		vs := make([]RoundDurationChange, len(this.RoundsSchedule))
+		for i := range vs {
+			vs[i] = this.RoundsSchedule[i]
+		}
+		s = append(s, "RoundsSchedule: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1358,7 +1497,7 @@ func (this *MetaBlock) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 29)
+	s := make([]string, 0, 30)
 	s = append(s, "&block.MetaBlock{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
@@ -1403,6 +1542,7 @@ func (this *MetaBlock) GoString() string {
 	s = append(s, "DevFeesInEpoch: "+fmt.Sprintf("%#v", this.DevFeesInEpoch)+",\n")
 	s = append(s, "TxCount: "+fmt.Sprintf("%#v", this.TxCount)+",\n")
 	s = append(s, "Reserved: "+fmt.Sprintf("%#v", this.Reserved)+",\n")
+	s = append(s, "LeaderIndex: "+fmt.Sprintf("%#v", this.LeaderIndex)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1434,6 +1574,13 @@ func (m *PeerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
 		size := __caster.Size(m.ValueChange)
@@ -1782,6 +1929,39 @@ func (m *Economics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoundDurationChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundDurationChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundDurationChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoundDurationInMs != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.RoundDurationInMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartRound != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.StartRound))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EpochStart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1802,6 +1982,20 @@ func (m *EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoundsSchedule) > 0 {
+		for iNdEx := len(m.RoundsSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundsSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetaBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Economics.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1849,6 +2043,13 @@ func (m *MetaBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LeaderIndex != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.LeaderIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
 	if len(m.Reserved) > 0 {
 		i -= len(m.Reserved)
 		copy(dAtA[i:], m.Reserved)
@@ -2115,6 +2316,10 @@ func (m *PeerData) Size() (n int) {
 		l = __caster.Size(m.ValueChange)
 		n += 1 + l + sovMetaBlock(uint64(l))
 	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
 	return n
 }
 
@@ -2270,6 +2475,21 @@ func (m *Economics) Size() (n int) {
 	return n
 }
 
+func (m *RoundDurationChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartRound != 0 {
+		n += 1 + sovMetaBlock(uint64(m.StartRound))
+	}
+	if m.RoundDurationInMs != 0 {
+		n += 1 + sovMetaBlock(uint64(m.RoundDurationInMs))
+	}
+	return n
+}
+
 func (m *EpochStart) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2284,6 +2504,12 @@ func (m *EpochStart) Size() (n int) {
 	}
 	l = m.Economics.Size()
 	n += 1 + l + sovMetaBlock(uint64(l))
+	if len(m.RoundsSchedule) > 0 {
+		for _, e := range m.RoundsSchedule {
+			l = e.Size()
+			n += 1 + l + sovMetaBlock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2396,6 +2622,9 @@ func (m *MetaBlock) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovMetaBlock(uint64(l))
 	}
+	if m.LeaderIndex != 0 {
+		n += 2 + sovMetaBlock(uint64(m.LeaderIndex))
+	}
 	return n
 }
 
@@ -2415,6 +2644,7 @@ func (this *PeerData) String() string {
 		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
 		`TimeStamp:` + fmt.Sprintf("%v", this.TimeStamp) + `,`,
 		`ValueChange:` + fmt.Sprintf("%v", this.ValueChange) + `,`,
+		`Evidence:` + fmt.Sprintf("%v", this.Evidence) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2487,6 +2717,17 @@ func (this *Economics) String() string {
 	}, "")
 	return s
 }
+func (this *RoundDurationChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoundDurationChange{`,
+		`StartRound:` + fmt.Sprintf("%v", this.StartRound) + `,`,
+		`RoundDurationInMs:` + fmt.Sprintf("%v", this.RoundDurationInMs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EpochStart) String() string {
 	if this == nil {
 		return "nil"
@@ -2496,9 +2737,15 @@ func (this *EpochStart) String() string {
 		repeatedStringForLastFinalizedHeaders += strings.Replace(strings.Replace(f.String(), "EpochStartShardData", "EpochStartShardData", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForLastFinalizedHeaders += "}"
+	repeatedStringForRoundsSchedule := "[]RoundDurationChange{"
+	for _, f := range this.RoundsSchedule {
+		repeatedStringForRoundsSchedule += strings.Replace(strings.Replace(f.String(), "RoundDurationChange", "RoundDurationChange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRoundsSchedule += "}"
 	s := strings.Join([]string{`&EpochStart{`,
 		`LastFinalizedHeaders:` + repeatedStringForLastFinalizedHeaders + `,`,
 		`Economics:` + strings.Replace(strings.Replace(this.Economics.String(), "Economics", "Economics", 1), `&`, ``, 1) + `,`,
+		`RoundsSchedule:` + repeatedStringForRoundsSchedule + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2548,6 +2795,7 @@ func (this *MetaBlock) String() string {
 		`DevFeesInEpoch:` + fmt.Sprintf("%v", this.DevFeesInEpoch) + `,`,
 		`TxCount:` + fmt.Sprintf("%v", this.TxCount) + `,`,
 		`Reserved:` + fmt.Sprintf("%v", this.Reserved) + `,`,
+		`LeaderIndex:` + fmt.Sprintf("%v", this.LeaderIndex) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2733,6 +2981,40 @@ func (m *PeerData) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence[:0], dAtA[iNdEx:postIndex]...)
+			if m.Evidence == nil {
+				m.Evidence = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -3837,6 +4119,97 @@ func (m *Economics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RoundDurationChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetaBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundDurationChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundDurationChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRound", wireType)
+			}
+			m.StartRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundDurationInMs", wireType)
+			}
+			m.RoundDurationInMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundDurationInMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetaBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EpochStart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3933,6 +4306,40 @@ func (m *EpochStart) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundsSchedule = append(m.RoundsSchedule, RoundDurationChange{})
+			if err := m.RoundsSchedule[len(m.RoundsSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -4776,6 +5183,25 @@ func (m *MetaBlock) Unmarshal(dAtA []byte) error {
 				m.Reserved = []byte{}
 			}
 			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderIndex", wireType)
+			}
+			m.LeaderIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -35,6 +35,7 @@ type HeaderHandler interface {
 	GetPubKeysBitmap() []byte
 	GetSignature() []byte
 	GetLeaderSignature() []byte
+	GetLeaderIndex() uint32
 	GetChainID() []byte
 	GetSoftwareVersion() []byte
 	GetTimeStamp() uint64
@@ -60,6 +61,7 @@ type HeaderHandler interface {
 	SetPubKeysBitmap(pkbm []byte)
 	SetSignature(sg []byte)
 	SetLeaderSignature(sg []byte)
+	SetLeaderIndex(index uint32)
 	SetChainID(chainID []byte)
 	SetSoftwareVersion(version []byte)
 	SetTxCount(txCount uint32)
@@ -165,6 +167,7 @@ type Trie interface {
 	GetAllLeaves() (map[string][]byte, error)
 	GetAllLeavesOnChannel() chan core.KeyValueHolder
 	GetAllHashes() ([][]byte, error)
+	Diff(oldRootHash []byte, newRootHash []byte) ([]LeafChange, error)
 	IsPruningEnabled() bool
 	EnterSnapshotMode()
 	ExitSnapshotMode()
@@ -28,6 +28,7 @@ type TrieStub struct {
 	GetAllLeavesCalled          func() (map[string][]byte, error)
 	GetAllLeavesOnChannelCalled func() chan core.KeyValueHolder
 	GetAllHashesCalled          func() ([][]byte, error)
+	DiffCalled                  func(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
 }
@@ -222,3 +223,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
+
+// Diff -
+func (ts *TrieStub) Diff(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error) {
+	if ts.DiffCalled != nil {
+		return ts.DiffCalled(oldRootHash, newRootHash)
+	}
+
+	return nil, nil
+}
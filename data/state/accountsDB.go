@@ -32,7 +32,8 @@ type AccountsDB struct {
 	entries      []JournalEntry
 	mutOp        sync.RWMutex
 
-	numCheckpoints uint32
+	numCheckpoints     uint32
+	archiveModeEnabled bool
 }
 
 var log = logger.GetOrCreate("state")
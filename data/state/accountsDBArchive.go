@@ -0,0 +1,151 @@
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	archiveNonceKeyPrefix = "archive nonce "
+	archiveEpochKeyPrefix = "archive epoch "
+	uint32Size            = 4
+	uint64Size            = 8
+)
+
+// EnableArchiveMode will make the accounts DB index every archived root hash by block nonce and epoch.
+// The archive mode can only be enabled if the underlying trie does not prune old states
+func (adb *AccountsDB) EnableArchiveMode() error {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	if adb.mainTrie.IsPruningEnabled() {
+		return ErrArchiveModeWithPruning
+	}
+
+	adb.archiveModeEnabled = true
+	log.Debug("accountsDB: archive mode enabled")
+
+	return nil
+}
+
+// IsArchiveModeEnabled returns true if the archive mode is enabled
+func (adb *AccountsDB) IsArchiveModeEnabled() bool {
+	adb.mutOp.RLock()
+	defer adb.mutOp.RUnlock()
+
+	return adb.archiveModeEnabled
+}
+
+// ArchiveRootHash saves the root hash committed for the provided block nonce and epoch so it can be
+// later used to recreate the state at that block. It does nothing if the archive mode is disabled
+func (adb *AccountsDB) ArchiveRootHash(nonce uint64, epoch uint32, rootHash []byte) error {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	if !adb.archiveModeEnabled {
+		return nil
+	}
+	if len(rootHash) == 0 {
+		return ErrInvalidRootHash
+	}
+
+	log.Trace("accountsDB.ArchiveRootHash",
+		"nonce", nonce,
+		"epoch", epoch,
+		"root hash", rootHash,
+	)
+
+	db := adb.mainTrie.Database()
+
+	nonceVal := make([]byte, uint32Size+len(rootHash))
+	binary.BigEndian.PutUint32(nonceVal, epoch)
+	copy(nonceVal[uint32Size:], rootHash)
+	err := db.Put(archiveNonceKey(nonce), nonceVal)
+	if err != nil {
+		return err
+	}
+
+	epochVal := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(epochVal, nonce)
+
+	return db.Put(archiveEpochKey(epoch), epochVal)
+}
+
+// GetArchivedRootHash returns the root hash and the epoch archived for the provided block nonce
+func (adb *AccountsDB) GetArchivedRootHash(nonce uint64) ([]byte, uint32, error) {
+	adb.mutOp.RLock()
+	defer adb.mutOp.RUnlock()
+
+	return adb.getArchivedRootHash(nonce)
+}
+
+func (adb *AccountsDB) getArchivedRootHash(nonce uint64) ([]byte, uint32, error) {
+	if !adb.archiveModeEnabled {
+		return nil, 0, ErrArchiveModeNotEnabled
+	}
+
+	val, err := adb.mainTrie.Database().Get(archiveNonceKey(nonce))
+	if err != nil || len(val) <= uint32Size {
+		return nil, 0, fmt.Errorf("%w for nonce %d", ErrRootHashNotArchived, nonce)
+	}
+
+	epoch := binary.BigEndian.Uint32(val[:uint32Size])
+	rootHash := make([]byte, len(val)-uint32Size)
+	copy(rootHash, val[uint32Size:])
+
+	return rootHash, epoch, nil
+}
+
+// GetLastArchivedNonceInEpoch returns the highest block nonce archived in the provided epoch
+func (adb *AccountsDB) GetLastArchivedNonceInEpoch(epoch uint32) (uint64, error) {
+	adb.mutOp.RLock()
+	defer adb.mutOp.RUnlock()
+
+	if !adb.archiveModeEnabled {
+		return 0, ErrArchiveModeNotEnabled
+	}
+
+	val, err := adb.mainTrie.Database().Get(archiveEpochKey(epoch))
+	if err != nil || len(val) != uint64Size {
+		return 0, fmt.Errorf("%w for epoch %d", ErrRootHashNotArchived, epoch)
+	}
+
+	return binary.BigEndian.Uint64(val), nil
+}
+
+// RecreateAtBlock recreates the main trie at the root hash archived for the provided block nonce.
+// As it replaces the current state, it should only be called on an accounts DB instance which is not
+// used for block processing
+func (adb *AccountsDB) RecreateAtBlock(nonce uint64) error {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	rootHash, _, err := adb.getArchivedRootHash(nonce)
+	if err != nil {
+		return err
+	}
+
+	err = adb.recreateTrie(rootHash)
+	if err != nil {
+		return err
+	}
+	adb.lastRootHash = rootHash
+
+	return nil
+}
+
+func archiveNonceKey(nonce uint64) []byte {
+	key := make([]byte, len(archiveNonceKeyPrefix)+uint64Size)
+	copy(key, archiveNonceKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(archiveNonceKeyPrefix):], nonce)
+
+	return key
+}
+
+func archiveEpochKey(epoch uint32) []byte {
+	key := make([]byte, len(archiveEpochKeyPrefix)+uint32Size)
+	copy(key, archiveEpochKeyPrefix)
+	binary.BigEndian.PutUint32(key[len(archiveEpochKeyPrefix):], epoch)
+
+	return key
+}
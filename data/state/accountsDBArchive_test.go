@@ -0,0 +1,130 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArchiveAccountsDB() *state.AccountsDB {
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, factory.NewAccountCreator())
+
+	return adb
+}
+
+func TestAccountsDB_EnableArchiveModeWithPruningShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := state.NewAccountsDB(
+		&mock.TrieStub{
+			IsPruningEnabledCalled: func() bool {
+				return true
+			},
+		},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsFactoryStub{},
+	)
+
+	err := adb.EnableArchiveMode()
+	assert.Equal(t, state.ErrArchiveModeWithPruning, err)
+	assert.False(t, adb.IsArchiveModeEnabled())
+}
+
+func TestAccountsDB_ArchiveRootHashArchiveModeDisabledShouldNotSave(t *testing.T) {
+	t.Parallel()
+
+	adb := createArchiveAccountsDB()
+
+	err := adb.ArchiveRootHash(1, 0, []byte("root hash"))
+	assert.Nil(t, err)
+
+	err = adb.RecreateAtBlock(1)
+	assert.Equal(t, state.ErrArchiveModeNotEnabled, err)
+}
+
+func TestAccountsDB_ArchiveRootHashEmptyRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := createArchiveAccountsDB()
+	_ = adb.EnableArchiveMode()
+
+	err := adb.ArchiveRootHash(1, 0, nil)
+	assert.Equal(t, state.ErrInvalidRootHash, err)
+}
+
+func TestAccountsDB_GetArchivedRootHashMissingNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := createArchiveAccountsDB()
+	_ = adb.EnableArchiveMode()
+
+	rootHash, _, err := adb.GetArchivedRootHash(7)
+	assert.True(t, errors.Is(err, state.ErrRootHashNotArchived))
+	assert.Nil(t, rootHash)
+}
+
+func TestAccountsDB_ArchiveRootHashShouldIndexByNonceAndEpoch(t *testing.T) {
+	t.Parallel()
+
+	adb := createArchiveAccountsDB()
+	err := adb.EnableArchiveMode()
+	assert.Nil(t, err)
+
+	_ = adb.ArchiveRootHash(10, 2, []byte("root hash 10"))
+	_ = adb.ArchiveRootHash(11, 2, []byte("root hash 11"))
+	_ = adb.ArchiveRootHash(12, 3, []byte("root hash 12"))
+
+	rootHash, epoch, err := adb.GetArchivedRootHash(11)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("root hash 11"), rootHash)
+	assert.Equal(t, uint32(2), epoch)
+
+	lastNonce, err := adb.GetLastArchivedNonceInEpoch(2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(11), lastNonce)
+
+	_, err = adb.GetLastArchivedNonceInEpoch(4)
+	assert.True(t, errors.Is(err, state.ErrRootHashNotArchived))
+}
+
+func TestAccountsDB_RecreateAtBlockShouldRestoreHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	adb := createArchiveAccountsDB()
+	_ = adb.EnableArchiveMode()
+	address := make([]byte, 32)
+
+	commitBalance := func(nonce uint64, balance int64) {
+		acc, _ := adb.LoadAccount(address)
+		userAcc := acc.(state.UserAccountHandler)
+		_ = userAcc.AddToBalance(big.NewInt(balance))
+		_ = adb.SaveAccount(userAcc)
+		rootHash, _ := adb.Commit()
+		_ = adb.ArchiveRootHash(nonce, 0, rootHash)
+	}
+
+	commitBalance(1, 10)
+	commitBalance(2, 15)
+
+	err := adb.RecreateAtBlock(1)
+	assert.Nil(t, err)
+	acc, _ := adb.GetExistingAccount(address)
+	assert.Equal(t, big.NewInt(10), acc.(state.UserAccountHandler).GetBalance())
+
+	err = adb.RecreateAtBlock(2)
+	assert.Nil(t, err)
+	acc, _ = adb.GetExistingAccount(address)
+	assert.Equal(t, big.NewInt(25), acc.(state.UserAccountHandler).GetBalance())
+}
@@ -0,0 +1,111 @@
+package state
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// AccountChange holds the account level modifications found between two state root hashes
+type AccountChange struct {
+	Address         []byte
+	Type            data.LeafChangeType
+	OldNonce        uint64
+	NewNonce        uint64
+	OldBalance      *big.Int
+	NewBalance      *big.Int
+	CodeChanged     bool
+	OldCodeHash     []byte
+	NewCodeHash     []byte
+	DataTrieChanges []data.LeafChange
+}
+
+// GetAccountsDiff returns the user accounts changes found between the provided root hashes. Besides the
+// balance, nonce and code changes, the data trie keys that were modified are also reported
+func (adb *AccountsDB) GetAccountsDiff(oldRootHash []byte, newRootHash []byte) ([]*AccountChange, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	leavesChanges, err := adb.mainTrie.Diff(oldRootHash, newRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	accountsChanges := make([]*AccountChange, 0, len(leavesChanges))
+	for _, leafChange := range leavesChanges {
+		oldAccount, oldOk := adb.decodeUserAccountLeaf(leafChange.Key, leafChange.OldValue)
+		newAccount, newOk := adb.decodeUserAccountLeaf(leafChange.Key, leafChange.NewValue)
+		if !oldOk && !newOk {
+			log.Trace("accountsDB.GetAccountsDiff: this must be a leaf with code", "key", leafChange.Key)
+			continue
+		}
+
+		accountChange, errCompute := adb.computeAccountChange(leafChange, oldAccount, newAccount)
+		if errCompute != nil {
+			return nil, errCompute
+		}
+
+		accountsChanges = append(accountsChanges, accountChange)
+	}
+
+	return accountsChanges, nil
+}
+
+func (adb *AccountsDB) decodeUserAccountLeaf(key []byte, value []byte) (*userAccount, bool) {
+	if len(value) == 0 {
+		return nil, false
+	}
+
+	account := NewEmptyUserAccount()
+	err := adb.marshalizer.Unmarshal(account, value)
+	if err != nil {
+		return nil, false
+	}
+	if !bytes.Equal(account.Address, key) {
+		return nil, false
+	}
+
+	return account, true
+}
+
+func (adb *AccountsDB) computeAccountChange(
+	leafChange data.LeafChange,
+	oldAccount *userAccount,
+	newAccount *userAccount,
+) (*AccountChange, error) {
+	accountChange := &AccountChange{
+		Address:    leafChange.Key,
+		Type:       leafChange.Type,
+		OldBalance: big.NewInt(0),
+		NewBalance: big.NewInt(0),
+	}
+
+	var oldDataRootHash, newDataRootHash []byte
+	if oldAccount != nil {
+		accountChange.OldNonce = oldAccount.Nonce
+		accountChange.OldBalance = oldAccount.Balance
+		accountChange.OldCodeHash = oldAccount.CodeHash
+		oldDataRootHash = oldAccount.RootHash
+	}
+	if newAccount != nil {
+		accountChange.NewNonce = newAccount.Nonce
+		accountChange.NewBalance = newAccount.Balance
+		accountChange.NewCodeHash = newAccount.CodeHash
+		newDataRootHash = newAccount.RootHash
+	}
+	accountChange.CodeChanged = !bytes.Equal(accountChange.OldCodeHash, accountChange.NewCodeHash)
+
+	if bytes.Equal(oldDataRootHash, newDataRootHash) {
+		accountChange.DataTrieChanges = make([]data.LeafChange, 0)
+		return accountChange, nil
+	}
+
+	dataTrieChanges, err := adb.mainTrie.Diff(oldDataRootHash, newDataRootHash)
+	if err != nil {
+		return nil, err
+	}
+	accountChange.DataTrieChanges = dataTrieChanges
+
+	return accountChange, nil
+}
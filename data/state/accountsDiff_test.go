@@ -0,0 +1,93 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAccountsDBForDiff() *state.AccountsDB {
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, 5)
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, factory.NewAccountCreator())
+
+	return adb
+}
+
+func TestAccountsDB_GetAccountsDiffTrieErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := trie.ErrHashNotFound
+	adb, _ := state.NewAccountsDB(
+		&mock.TrieStub{
+			DiffCalled: func(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error) {
+				return nil, expectedErr
+			},
+		},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.AccountsFactoryStub{},
+	)
+
+	changes, err := adb.GetAccountsDiff([]byte("old"), []byte("new"))
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, changes)
+}
+
+func TestAccountsDB_GetAccountsDiffShouldDecodeAccountChanges(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBForDiff()
+	addrUpdated := []byte("12345678901234567890123456789012")
+	addrCreated := []byte("abcdefghijabcdefghijabcdefghijab")
+
+	acc, _ := adb.LoadAccount(addrUpdated)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(100))
+	_ = adb.SaveAccount(acc)
+	oldRootHash, _ := adb.Commit()
+
+	acc, _ = adb.LoadAccount(addrUpdated)
+	userAcc := acc.(state.UserAccountHandler)
+	_ = userAcc.AddToBalance(big.NewInt(50))
+	userAcc.IncreaseNonce(1)
+	userAcc.SetCode([]byte("code"))
+	userAcc.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = adb.SaveAccount(userAcc)
+
+	acc, _ = adb.LoadAccount(addrCreated)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(7))
+	_ = adb.SaveAccount(acc)
+	newRootHash, _ := adb.Commit()
+
+	changes, err := adb.GetAccountsDiff(oldRootHash, newRootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(changes))
+
+	changesByAddress := make(map[string]*state.AccountChange)
+	for _, change := range changes {
+		changesByAddress[string(change.Address)] = change
+	}
+
+	updated := changesByAddress[string(addrUpdated)]
+	assert.Equal(t, data.LeafUpdated, updated.Type)
+	assert.Equal(t, big.NewInt(100), updated.OldBalance)
+	assert.Equal(t, big.NewInt(150), updated.NewBalance)
+	assert.Equal(t, uint64(0), updated.OldNonce)
+	assert.Equal(t, uint64(1), updated.NewNonce)
+	assert.True(t, updated.CodeChanged)
+	assert.Equal(t, 1, len(updated.DataTrieChanges))
+	assert.Equal(t, data.LeafInserted, updated.DataTrieChanges[0].Type)
+
+	created := changesByAddress[string(addrCreated)]
+	assert.Equal(t, data.LeafInserted, created.Type)
+	assert.Equal(t, big.NewInt(7), created.NewBalance)
+	assert.False(t, created.CodeChanged)
+}
@@ -0,0 +1,119 @@
+package state
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// MigrateAccounts moves the user accounts found under the provided root hash and selected by the provided function
+// into the destination accounts adapter, together with their code and data tries. The moved accounts are removed
+// from this state. It is used when shards are split or merged and the addresses change shard. Neither this state nor
+// the destination one are committed. Returns the addresses of the moved accounts
+func (adb *AccountsDB) MigrateAccounts(
+	rootHash []byte,
+	destination AccountsAdapter,
+	shouldMigrate func(address []byte) bool,
+) ([][]byte, error) {
+	if destination == nil || destination.IsInterfaceNil() {
+		return nil, ErrNilAccountsAdapter
+	}
+	if shouldMigrate == nil {
+		return nil, ErrNilMigrationSelector
+	}
+
+	leaves, err := adb.GetAllLeaves(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	migratedAddresses := make([][]byte, 0)
+	for key, value := range leaves {
+		address := []byte(key)
+		_, isAccount := adb.decodeUserAccountLeaf(address, value)
+		if !isAccount || !shouldMigrate(address) {
+			continue
+		}
+
+		err = adb.migrateAccount(address, destination)
+		if err != nil {
+			return nil, fmt.Errorf("%w when migrating account %s", err, hex.EncodeToString(address))
+		}
+
+		migratedAddresses = append(migratedAddresses, address)
+	}
+
+	log.Debug("accounts migrated", "num accounts", len(migratedAddresses))
+
+	return migratedAddresses, nil
+}
+
+func (adb *AccountsDB) migrateAccount(address []byte, destination AccountsAdapter) error {
+	_, err := destination.GetExistingAccount(address)
+	if err == nil {
+		return ErrAccountAlreadyExists
+	}
+
+	account, err := adb.GetExistingAccount(address)
+	if err != nil {
+		return err
+	}
+	sourceAccount, ok := account.(UserAccountHandler)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	account, err = destination.LoadAccount(address)
+	if err != nil {
+		return err
+	}
+	destinationAccount, ok := account.(UserAccountHandler)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	destinationAccount.IncreaseNonce(sourceAccount.GetNonce())
+	err = destinationAccount.AddToBalance(sourceAccount.GetBalance())
+	if err != nil {
+		return err
+	}
+	destinationAccount.AddToDeveloperReward(sourceAccount.GetDeveloperReward())
+	destinationAccount.SetCode(sourceAccount.GetCode())
+	destinationAccount.SetCodeMetadata(sourceAccount.GetCodeMetadata())
+	destinationAccount.SetOwnerAddress(sourceAccount.GetOwnerAddress())
+	destinationAccount.SetUserName(sourceAccount.GetUserName())
+
+	err = copyDataTrie(sourceAccount, destinationAccount)
+	if err != nil {
+		return err
+	}
+
+	err = destination.SaveAccount(destinationAccount)
+	if err != nil {
+		return err
+	}
+
+	return adb.RemoveAccount(address)
+}
+
+func copyDataTrie(source UserAccountHandler, destination UserAccountHandler) error {
+	dataTrie := source.DataTrie()
+	if dataTrie == nil || dataTrie.IsInterfaceNil() {
+		return nil
+	}
+
+	leaves, err := dataTrie.GetAllLeaves()
+	if err != nil {
+		return err
+	}
+
+	for key := range leaves {
+		value, errRetrieve := source.DataTrieTracker().RetrieveValue([]byte(key))
+		if errRetrieve != nil {
+			return errRetrieve
+		}
+
+		destination.DataTrieTracker().SaveKeyValue([]byte(key), value)
+	}
+
+	return nil
+}
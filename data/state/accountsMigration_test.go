@@ -0,0 +1,99 @@
+package state_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsDB_MigrateAccountsNilDestinationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBForDiff()
+	rootHash, _ := adb.Commit()
+
+	migrated, err := adb.MigrateAccounts(rootHash, nil, func(_ []byte) bool { return true })
+	assert.Equal(t, state.ErrNilAccountsAdapter, err)
+	assert.Nil(t, migrated)
+}
+
+func TestAccountsDB_MigrateAccountsNilSelectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb := createAccountsDBForDiff()
+	rootHash, _ := adb.Commit()
+
+	migrated, err := adb.MigrateAccounts(rootHash, createAccountsDBForDiff(), nil)
+	assert.Equal(t, state.ErrNilMigrationSelector, err)
+	assert.Nil(t, migrated)
+}
+
+func TestAccountsDB_MigrateAccountsExistingDestinationAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("12345678901234567890123456789012")
+	source := createAccountsDBForDiff()
+	acc, _ := source.LoadAccount(address)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = source.SaveAccount(acc)
+	rootHash, _ := source.Commit()
+
+	destination := createAccountsDBForDiff()
+	acc, _ = destination.LoadAccount(address)
+	_ = destination.SaveAccount(acc)
+	_, _ = destination.Commit()
+
+	_, err := source.MigrateAccounts(rootHash, destination, func(_ []byte) bool { return true })
+	assert.NotNil(t, err)
+}
+
+func TestAccountsDB_MigrateAccountsShouldMoveSelectedAccounts(t *testing.T) {
+	t.Parallel()
+
+	addrMoved := []byte("12345678901234567890123456789012")
+	addrKept := []byte("abcdefghijabcdefghijabcdefghijab")
+
+	source := createAccountsDBForDiff()
+	acc, _ := source.LoadAccount(addrMoved)
+	userAcc := acc.(state.UserAccountHandler)
+	_ = userAcc.AddToBalance(big.NewInt(100))
+	userAcc.IncreaseNonce(3)
+	userAcc.SetCode([]byte("code"))
+	userAcc.SetOwnerAddress(addrKept)
+	userAcc.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = source.SaveAccount(userAcc)
+
+	acc, _ = source.LoadAccount(addrKept)
+	_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(7))
+	_ = source.SaveAccount(acc)
+	rootHash, _ := source.Commit()
+
+	destination := createAccountsDBForDiff()
+	migrated, err := source.MigrateAccounts(rootHash, destination, func(address []byte) bool {
+		return bytes.Equal(address, addrMoved)
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{addrMoved}, migrated)
+
+	_, _ = source.Commit()
+	_, _ = destination.Commit()
+
+	_, err = source.GetExistingAccount(addrMoved)
+	assert.Equal(t, state.ErrAccNotFound, err)
+	_, err = source.GetExistingAccount(addrKept)
+	assert.Nil(t, err)
+
+	acc, err = destination.GetExistingAccount(addrMoved)
+	assert.Nil(t, err)
+	movedAcc := acc.(state.UserAccountHandler)
+	assert.Equal(t, big.NewInt(100), movedAcc.GetBalance())
+	assert.Equal(t, uint64(3), movedAcc.GetNonce())
+	assert.Equal(t, []byte("code"), movedAcc.GetCode())
+	assert.Equal(t, addrKept, movedAcc.GetOwnerAddress())
+	value, err := movedAcc.DataTrieTracker().RetrieveValue([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
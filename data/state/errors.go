@@ -115,3 +115,21 @@ var ErrNilMapOfHashes = errors.New("nil map of hashes")
 
 // ErrInvalidRootHash signals that the provided root hash is invalid
 var ErrInvalidRootHash = errors.New("invalid root hash")
+
+// ErrArchiveModeWithPruning signals that the archive mode can not be enabled while the trie pruning is active
+var ErrArchiveModeWithPruning = errors.New("archive mode can not be enabled while trie pruning is enabled")
+
+// ErrArchiveModeNotEnabled signals that an archive operation was requested while the archive mode is disabled
+var ErrArchiveModeNotEnabled = errors.New("archive mode is not enabled")
+
+// ErrRootHashNotArchived signals that no root hash was archived for the requested block
+var ErrRootHashNotArchived = errors.New("root hash was not archived")
+
+// ErrNilTrieSyncStatistics signals that a nil trie sync statistics handler has been provided
+var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics")
+
+// ErrAccountAlreadyExists signals that an account already exists where it should have been created
+var ErrAccountAlreadyExists = errors.New("account already exists")
+
+// ErrNilMigrationSelector signals that a nil function selecting the accounts to be migrated has been provided
+var ErrNilMigrationSelector = errors.New("nil migration selector")
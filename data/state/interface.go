@@ -139,6 +139,11 @@ type AccountsAdapter interface {
 	IsPruningEnabled() bool
 	GetAllLeaves(rootHash []byte) (map[string][]byte, error)
 	RecreateAllTries(rootHash []byte) (map[string]data.Trie, error)
+	IsArchiveModeEnabled() bool
+	ArchiveRootHash(nonce uint64, epoch uint32, rootHash []byte) error
+	GetArchivedRootHash(nonce uint64) ([]byte, uint32, error)
+	GetLastArchivedNonceInEpoch(epoch uint32) (uint64, error)
+	RecreateAtBlock(nonce uint64) error
 	IsInterfaceNil() bool
 }
 
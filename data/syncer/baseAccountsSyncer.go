@@ -28,6 +28,7 @@ type baseAccountsSyncer struct {
 	cacher               storage.Cacher
 	rootHash             []byte
 	maxTrieLevelInMemory uint
+	trieSyncStatistics   trie.SyncStatisticsHandler
 }
 
 const minWaitTime = time.Second
@@ -41,6 +42,7 @@ type ArgsNewBaseAccountsSyncer struct {
 	WaitTime             time.Duration
 	Cacher               storage.Cacher
 	MaxTrieLevelInMemory uint
+	TrieSyncStatistics   trie.SyncStatisticsHandler
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -62,6 +64,9 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if check.IfNil(args.Cacher) {
 		return state.ErrNilCacher
 	}
+	if check.IfNil(args.TrieSyncStatistics) {
+		return state.ErrNilTrieSyncStatistics
+	}
 
 	return nil
 }
@@ -75,7 +80,15 @@ func (b *baseAccountsSyncer) syncMainTrie(rootHash []byte, trieTopic string, ctx
 	}
 
 	b.dataTries[string(rootHash)] = dataTrie
-	trieSyncer, err := trie.NewTrieSyncer(b.requestHandler, b.cacher, dataTrie, b.shardId, trieTopic)
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     b.requestHandler,
+		InterceptedNodes:   b.cacher,
+		Trie:               dataTrie,
+		ShardId:            b.shardId,
+		Topic:              trieTopic,
+		TrieSyncStatistics: b.trieSyncStatistics,
+	}
+	trieSyncer, err := trie.NewTrieSyncer(arg)
 	if err != nil {
 		return err
 	}
@@ -56,6 +56,7 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		cacher:               args.Cacher,
 		rootHash:             nil,
 		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		trieSyncStatistics:   args.TrieSyncStatistics,
 	}
 
 	u := &userAccountsSyncer{
@@ -150,7 +151,15 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ctx context.Context)
 	}
 
 	u.dataTries[string(rootHash)] = dataTrie
-	trieSyncer, err := trie.NewTrieSyncer(u.requestHandler, u.cacher, dataTrie, u.shardId, factory.AccountTrieNodesTopic)
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     u.requestHandler,
+		InterceptedNodes:   u.cacher,
+		Trie:               dataTrie,
+		ShardId:            u.shardId,
+		Topic:              factory.AccountTrieNodesTopic,
+		TrieSyncStatistics: u.trieSyncStatistics,
+	}
+	trieSyncer, err := trie.NewTrieSyncer(arg)
 	if err != nil {
 		u.syncerMutex.Unlock()
 		return err
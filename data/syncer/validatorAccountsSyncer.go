@@ -39,6 +39,7 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		cacher:               args.Cacher,
 		rootHash:             nil,
 		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		trieSyncStatistics:   args.TrieSyncStatistics,
 	}
 
 	u := &validatorAccountsSyncer{
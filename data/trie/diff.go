@@ -0,0 +1,213 @@
+package trie
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// diffCursor points to a subtrie found at a given nibble path. A virtual cursor is built when stepping
+// inside an extension node key, so it does not have a hash that can be compared
+type diffCursor struct {
+	n       node
+	virtual bool
+}
+
+func (dc diffCursor) isEmpty() bool {
+	return dc.n == nil
+}
+
+// Diff walks the tries found at the given root hashes and returns the leaves that were inserted, updated
+// or deleted. Identical subtries are skipped by comparing their hashes. Both root hashes must be present
+// in the trie main database
+func (tr *patriciaMerkleTrie) Diff(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error) {
+	tr.mutOperation.RLock()
+	defer tr.mutOperation.RUnlock()
+
+	if bytes.Equal(oldRootHash, newRootHash) {
+		return make([]data.LeafChange, 0), nil
+	}
+
+	db := tr.Database()
+	oldRoot, err := tr.loadRootForDiff(oldRootHash, db)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err := tr.loadRootForDiff(newRootHash, db)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]data.LeafChange, 0)
+	err = diffSubtries(oldRoot, newRoot, []byte{}, db, &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+
+	return changes, nil
+}
+
+func (tr *patriciaMerkleTrie) loadRootForDiff(rootHash []byte, db data.DBWriteCacher) (diffCursor, error) {
+	if emptyTrie(rootHash) {
+		return diffCursor{}, nil
+	}
+
+	root, err := getNodeFromDBAndDecode(rootHash, db, tr.marshalizer, tr.hasher)
+	if err != nil {
+		return diffCursor{}, err
+	}
+	root.setGivenHash(rootHash)
+
+	return diffCursor{n: root}, nil
+}
+
+func diffSubtries(oldCursor diffCursor, newCursor diffCursor, path []byte, db data.DBWriteCacher, changes *[]data.LeafChange) error {
+	if oldCursor.isEmpty() && newCursor.isEmpty() {
+		return nil
+	}
+	if haveSameHash(oldCursor, newCursor) {
+		return nil
+	}
+
+	_, oldIsLeaf := oldCursor.n.(*leafNode)
+	_, newIsLeaf := newCursor.n.(*leafNode)
+	if oldCursor.isEmpty() || newCursor.isEmpty() || oldIsLeaf || newIsLeaf {
+		return diffLeaves(oldCursor, newCursor, path, db, changes)
+	}
+
+	for i := byte(0); i < nrOfChildren; i++ {
+		oldChild, err := stepIntoChild(oldCursor, i, db)
+		if err != nil {
+			return err
+		}
+		newChild, err := stepIntoChild(newCursor, i, db)
+		if err != nil {
+			return err
+		}
+
+		err = diffSubtries(oldChild, newChild, concat(path, i), db, changes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func haveSameHash(oldCursor diffCursor, newCursor diffCursor) bool {
+	if oldCursor.isEmpty() || newCursor.isEmpty() {
+		return false
+	}
+	if oldCursor.virtual || newCursor.virtual {
+		return false
+	}
+
+	oldHash := oldCursor.n.getHash()
+	if len(oldHash) == 0 {
+		return false
+	}
+
+	return bytes.Equal(oldHash, newCursor.n.getHash())
+}
+
+func stepIntoChild(cursor diffCursor, pos byte, db data.DBWriteCacher) (diffCursor, error) {
+	switch n := cursor.n.(type) {
+	case *branchNode:
+		err := resolveIfCollapsed(n, pos, db)
+		if err != nil {
+			return diffCursor{}, err
+		}
+
+		return diffCursor{n: n.children[pos]}, nil
+	case *extensionNode:
+		if len(n.Key) == 0 || n.Key[0] != pos {
+			return diffCursor{}, nil
+		}
+
+		err := resolveIfCollapsed(n, 0, db)
+		if err != nil {
+			return diffCursor{}, err
+		}
+		if len(n.Key) == 1 {
+			return diffCursor{n: n.child}, nil
+		}
+
+		virtualNode, err := newExtensionNode(n.Key[1:], n.child, n.marsh, n.hasher)
+		if err != nil {
+			return diffCursor{}, err
+		}
+
+		return diffCursor{n: virtualNode, virtual: true}, nil
+	default:
+		return diffCursor{}, ErrInvalidNode
+	}
+}
+
+func diffLeaves(oldCursor diffCursor, newCursor diffCursor, path []byte, db data.DBWriteCacher, changes *[]data.LeafChange) error {
+	oldLeaves, err := getSubtrieLeaves(oldCursor, path, db)
+	if err != nil {
+		return err
+	}
+	newLeaves, err := getSubtrieLeaves(newCursor, path, db)
+	if err != nil {
+		return err
+	}
+
+	for key, oldValue := range oldLeaves {
+		newValue, ok := newLeaves[key]
+		if !ok {
+			*changes = append(*changes, data.LeafChange{
+				Type:     data.LeafDeleted,
+				Key:      []byte(key),
+				OldValue: oldValue,
+			})
+			continue
+		}
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+
+		*changes = append(*changes, data.LeafChange{
+			Type:     data.LeafUpdated,
+			Key:      []byte(key),
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	for key, newValue := range newLeaves {
+		_, ok := oldLeaves[key]
+		if ok {
+			continue
+		}
+
+		*changes = append(*changes, data.LeafChange{
+			Type:     data.LeafInserted,
+			Key:      []byte(key),
+			NewValue: newValue,
+		})
+	}
+
+	return nil
+}
+
+func getSubtrieLeaves(cursor diffCursor, path []byte, db data.DBWriteCacher) (map[string][]byte, error) {
+	leaves := make(map[string][]byte)
+	if cursor.isEmpty() {
+		return leaves, nil
+	}
+
+	key := make([]byte, len(path))
+	copy(key, path)
+	err := cursor.n.getAllLeaves(leaves, key, db, cursor.n.getMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	return leaves, nil
+}
@@ -0,0 +1,132 @@
+package trie_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/stretchr/testify/assert"
+)
+
+func commitAndGetRoot(tr data.Trie) []byte {
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	return rootHash
+}
+
+func TestPatriciaMerkleTrie_DiffSameRootHashShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(10)
+	rootHash := commitAndGetRoot(tr)
+
+	changes, err := tr.Diff(rootHash, rootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(changes))
+}
+
+func TestPatriciaMerkleTrie_DiffMissingRootShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(10)
+	rootHash := commitAndGetRoot(tr)
+
+	changes, err := tr.Diff(rootHash, []byte("missing root hash"))
+	assert.NotNil(t, err)
+	assert.Nil(t, changes)
+}
+
+func TestPatriciaMerkleTrie_DiffFromEmptyTrieShouldReturnInsertedLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(5)
+	rootHash := commitAndGetRoot(tr)
+
+	changes, err := tr.Diff(emptyTrieHash, rootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, len(values), len(changes))
+	for _, change := range changes {
+		assert.Equal(t, data.LeafInserted, change.Type)
+		assert.Equal(t, change.Key, change.NewValue)
+	}
+}
+
+func TestPatriciaMerkleTrie_DiffShouldReportInsertedUpdatedAndDeletedLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(20)
+	oldRootHash := commitAndGetRoot(tr)
+
+	_ = tr.Update(values[3], []byte("updated value"))
+	_ = tr.Delete(values[7])
+	_ = tr.Update([]byte("new key"), []byte("new value"))
+	newRootHash := commitAndGetRoot(tr)
+
+	changes, err := tr.Diff(oldRootHash, newRootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(changes))
+
+	changesByKey := make(map[string]data.LeafChange)
+	for _, change := range changes {
+		changesByKey[string(change.Key)] = change
+	}
+
+	assert.Equal(t, data.LeafUpdated, changesByKey[string(values[3])].Type)
+	assert.Equal(t, values[3], changesByKey[string(values[3])].OldValue)
+	assert.Equal(t, []byte("updated value"), changesByKey[string(values[3])].NewValue)
+	assert.Equal(t, data.LeafDeleted, changesByKey[string(values[7])].Type)
+	assert.Equal(t, data.LeafInserted, changesByKey["new key"].Type)
+	assert.Equal(t, []byte("new value"), changesByKey["new key"].NewValue)
+}
+
+func TestPatriciaMerkleTrie_DiffShouldMatchLeavesComparison(t *testing.T) {
+	t.Parallel()
+
+	tr, values := initTrieMultipleValues(200)
+	oldRootHash := commitAndGetRoot(tr)
+	oldLeaves, _ := tr.GetAllLeaves()
+
+	hsh := keccak.Keccak{}
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		idx := rnd.Intn(len(values))
+		switch rnd.Intn(3) {
+		case 0:
+			_ = tr.Delete(values[idx])
+		case 1:
+			_ = tr.Update(values[idx], []byte(fmt.Sprintf("value %d", i)))
+		default:
+			key := hsh.Compute(fmt.Sprintf("new key %d", i))
+			_ = tr.Update(key, key)
+		}
+	}
+	newRootHash := commitAndGetRoot(tr)
+	newLeaves, _ := tr.GetAllLeaves()
+
+	changes, err := tr.Diff(oldRootHash, newRootHash)
+	assert.Nil(t, err)
+
+	numExpectedChanges := 0
+	for key, oldValue := range oldLeaves {
+		newValue, ok := newLeaves[key]
+		if !ok || !bytes.Equal(oldValue, newValue) {
+			numExpectedChanges++
+		}
+	}
+	for key := range newLeaves {
+		_, ok := oldLeaves[key]
+		if !ok {
+			numExpectedChanges++
+		}
+	}
+
+	assert.Equal(t, numExpectedChanges, len(changes))
+	for _, change := range changes {
+		assert.Equal(t, oldLeaves[string(change.Key)], change.OldValue)
+		assert.Equal(t, newLeaves[string(change.Key)], change.NewValue)
+	}
+}
@@ -87,3 +87,9 @@ var ErrInvalidIdentifier = errors.New("invalid identifier")
 
 // ErrInvalidLevelValue signals that the given value for maxTrieLevelInMemory is invalid
 var ErrInvalidLevelValue = errors.New("invalid trie level in memory value")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrNilTrieSyncStatistics signals that a nil trie sync statistics handler has been provided
+var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics")
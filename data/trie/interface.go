@@ -65,3 +65,14 @@ type RequestHandler interface {
 	RequestInterval() time.Duration
 	IsInterfaceNil() bool
 }
+
+// SyncStatisticsHandler defines the methods used to account the trie syncing progress
+type SyncStatisticsHandler interface {
+	AddNumSynced(value int)
+	AddNumPending(delta int)
+	AddNumBytesReceived(bytes uint64)
+	NumSynced() uint64
+	NumPending() int64
+	NumBytesReceived() uint64
+	IsInterfaceNil() bool
+}
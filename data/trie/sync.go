@@ -6,7 +6,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -14,62 +13,77 @@ import (
 
 var _ data.TrieSyncer = (*trieSyncer)(nil)
 
-type trieNodeInfo struct {
-	trieNode node
-	received bool
+const maxHashesPerRequest = 100
+const numConcurrentNodesProcessors = 8
+
+// ArgTrieSyncer is the argument for the trie syncer
+type ArgTrieSyncer struct {
+	RequestHandler     RequestHandler
+	InterceptedNodes   storage.Cacher
+	Trie               data.Trie
+	ShardId            uint32
+	Topic              string
+	TrieSyncStatistics SyncStatisticsHandler
+}
+
+type syncedNode struct {
+	hash    []byte
+	node    node
+	encNode []byte
 }
 
 type trieSyncer struct {
-	rootFound               bool
 	shardId                 uint32
 	topic                   string
 	rootHash                []byte
-	nodesForTrie            map[string]trieNodeInfo
 	waitTimeBetweenRequests time.Duration
 	trie                    *patriciaMerkleTrie
 	requestHandler          RequestHandler
 	interceptedNodes        storage.Cacher
-	mutOperation            sync.RWMutex
-	handlerID               string
+	statistics              SyncStatisticsHandler
+
+	mutOperation sync.Mutex
+	missingNodes map[string]struct{}
+	checkedNodes map[string]struct{}
+	mutNodes     sync.Mutex
+	rootNode     *syncedNode
 }
 
-const maxNewMissingAddedPerTurn = 10
-
-// NewTrieSyncer creates a new instance of trieSyncer
-func NewTrieSyncer(
-	requestHandler RequestHandler,
-	interceptedNodes storage.Cacher,
-	trie data.Trie,
-	shardId uint32,
-	topic string,
-) (*trieSyncer, error) {
-	if check.IfNil(requestHandler) {
+// NewTrieSyncer creates a new instance of trieSyncer. The syncer requests the missing nodes in batches that
+// are spread by the request handler on multiple peers, processes the received nodes concurrently and
+// persists every verified node as soon as it is received, so an interrupted sync can be resumed
+func NewTrieSyncer(arg ArgTrieSyncer) (*trieSyncer, error) {
+	if check.IfNil(arg.RequestHandler) {
 		return nil, ErrNilRequestHandler
 	}
-	if check.IfNil(interceptedNodes) {
+	if check.IfNil(arg.InterceptedNodes) {
 		return nil, data.ErrNilCacher
 	}
-	if check.IfNil(trie) {
+	if check.IfNil(arg.Trie) {
 		return nil, ErrNilTrie
 	}
-	if len(topic) == 0 {
+	if len(arg.Topic) == 0 {
 		return nil, ErrInvalidTrieTopic
 	}
+	if check.IfNil(arg.TrieSyncStatistics) {
+		return nil, ErrNilTrieSyncStatistics
+	}
 
-	pmt, ok := trie.(*patriciaMerkleTrie)
+	pmt, ok := arg.Trie.(*patriciaMerkleTrie)
 	if !ok {
 		return nil, ErrWrongTypeAssertion
 	}
 
 	ts := &trieSyncer{
-		requestHandler:          requestHandler,
-		interceptedNodes:        interceptedNodes,
+		requestHandler:          arg.RequestHandler,
+		interceptedNodes:        arg.InterceptedNodes,
 		trie:                    pmt,
-		nodesForTrie:            make(map[string]trieNodeInfo),
-		topic:                   topic,
-		shardId:                 shardId,
+		topic:                   arg.Topic,
+		shardId:                 arg.ShardId,
+		statistics:              arg.TrieSyncStatistics,
 		waitTimeBetweenRequests: time.Second,
-		handlerID:               core.UniqueIdentifier(),
+		missingNodes:            make(map[string]struct{}),
+		checkedNodes:            make(map[string]struct{}),
 	}
 
 	return ts, nil
@@ -85,29 +99,37 @@ func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 	}
 
 	ts.mutOperation.Lock()
-	ts.nodesForTrie = make(map[string]trieNodeInfo)
-	ts.nodesForTrie[string(rootHash)] = trieNodeInfo{received: false}
-	ts.mutOperation.Unlock()
+	defer ts.mutOperation.Unlock()
 
-	ts.rootFound = false
 	ts.rootHash = rootHash
+	ts.rootNode = nil
+	ts.checkedNodes = make(map[string]struct{})
+
+	rootFromDb, err := ts.getNodeFromDb(rootHash)
+	if err == nil {
+		log.Debug("trie syncer: root hash already synced", "root hash", rootHash)
+		ts.setTrieRoot(rootFromDb)
+		return nil
+	}
+
+	ts.missingNodes = map[string]struct{}{string(rootHash): {}}
+	ts.statistics.AddNumPending(1)
+	defer func() {
+		ts.statistics.AddNumPending(-len(ts.missingNodes))
+	}()
 
 	for {
-		shouldRetryAfterRequest, err := ts.checkIfSynced()
+		err = ts.processReceivedNodes()
 		if err != nil {
 			return err
 		}
 
-		numUnResolved := ts.requestNodes()
-		if !shouldRetryAfterRequest && numUnResolved == 0 {
-			err = ts.trie.Commit()
-			if err != nil {
-				return err
-			}
-
-			return nil
+		if len(ts.missingNodes) == 0 {
+			return ts.commitRoot()
 		}
 
+		ts.requestMissingNodes()
+
 		select {
 		case <-time.After(ts.waitTimeBetweenRequests):
 			continue
@@ -117,121 +139,174 @@ func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 	}
 }
 
-func (ts *trieSyncer) checkIfSynced() (bool, error) {
-	var currentNode node
-	var err error
-	var nextNodes []node
-	missingNodes := make(map[string]struct{})
-	currentMissingNodes := make([][]byte, 0)
-	checkedNodes := make(map[string]struct{})
-
-	newElement := true
-	shouldRetryAfterRequest := false
+func (ts *trieSyncer) processReceivedNodes() error {
+	received := make([]*syncedNode, 0)
+	for hash := range ts.missingNodes {
+		sn, ok := ts.getReceivedNode([]byte(hash))
+		if !ok {
+			continue
+		}
 
-	ts.mutOperation.Lock()
-	defer ts.mutOperation.Unlock()
+		delete(ts.missingNodes, hash)
+		received = append(received, sn)
+		ts.statistics.AddNumBytesReceived(uint64(len(sn.encNode)))
+	}
+	ts.statistics.AddNumPending(-len(received))
 
-	for newElement {
-		newElement = false
+	level := received
+	for len(level) > 0 {
+		nextLevel, newMissing, err := ts.processLevel(level)
+		if err != nil {
+			return err
+		}
 
-		for nodeHash, nodeInfo := range ts.nodesForTrie {
-			currentMissingNodes = currentMissingNodes[:0]
-			if _, ok := checkedNodes[nodeHash]; ok {
-				continue
-			}
+		for _, hash := range newMissing {
+			ts.missingNodes[string(hash)] = struct{}{}
+		}
+		ts.statistics.AddNumPending(len(newMissing))
 
-			currentNode = nodeInfo.trieNode
-			if !nodeInfo.received {
-				currentNode, err = ts.getNode([]byte(nodeHash))
-				if err != nil {
-					continue
-				}
-			}
+		level = nextLevel
+	}
 
-			if !ts.rootFound && bytes.Equal([]byte(nodeHash), ts.rootHash) {
-				ts.trie.root = currentNode
-				ts.rootFound = true
-			}
+	return nil
+}
 
-			checkedNodes[nodeHash] = struct{}{}
+// processLevel concurrently persists the provided nodes and resolves their children. Children found in
+// the database or in the intercepted nodes cacher are returned to be processed next, the others are missing
+func (ts *trieSyncer) processLevel(level []*syncedNode) ([]*syncedNode, [][]byte, error) {
+	nextLevel := make([]*syncedNode, 0)
+	missing := make([][]byte, 0)
+	var errFound error
 
-			currentMissingNodes, nextNodes, err = currentNode.loadChildren(ts.getNode)
-			if err != nil {
-				return false, err
-			}
-			log.Trace("loaded children for node", "hash", currentNode.getHash())
+	mutResults := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(len(level))
+	throttle := make(chan struct{}, numConcurrentNodesProcessors)
 
-			if len(currentMissingNodes) > 0 {
-				for _, hash := range currentMissingNodes {
-					missingNodes[string(hash)] = struct{}{}
-				}
+	for _, sn := range level {
+		throttle <- struct{}{}
 
-				nextNodes = append(nextNodes, currentNode)
-				_ = ts.addNew(nextNodes)
-				shouldRetryAfterRequest = true
+		go func(currentNode *syncedNode) {
+			defer func() {
+				<-throttle
+				wg.Done()
+			}()
 
-				if len(missingNodes) > maxNewMissingAddedPerTurn {
-					newElement = false
-				}
+			found, notFound, err := ts.processNode(currentNode)
 
-				continue
-			}
+			mutResults.Lock()
+			defer mutResults.Unlock()
 
-			nextNodes, err = currentNode.getChildren(ts.trie.Database())
 			if err != nil {
-				return false, err
+				errFound = err
+				return
 			}
+			nextLevel = append(nextLevel, found...)
+			missing = append(missing, notFound...)
+		}(sn)
+	}
+
+	wg.Wait()
+
+	return nextLevel, missing, errFound
+}
 
-			tmpNewElement := ts.addNew(nextNodes)
-			newElement = newElement || tmpNewElement
+func (ts *trieSyncer) processNode(sn *syncedNode) ([]*syncedNode, [][]byte, error) {
+	if !ts.markAsChecked(sn.hash) {
+		return nil, nil, nil
+	}
 
-			delete(ts.nodesForTrie, nodeHash)
+	if bytes.Equal(sn.hash, ts.rootHash) {
+		ts.mutNodes.Lock()
+		ts.rootNode = sn
+		ts.mutNodes.Unlock()
+	} else if len(sn.encNode) > 0 {
+		err := ts.trie.Database().Put(sn.hash, sn.encNode)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
+	ts.statistics.AddNumSynced(1)
 
-	for hash := range missingNodes {
-		ts.nodesForTrie[hash] = trieNodeInfo{received: false}
+	childrenHashes, err := getChildrenHashes(sn.node)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return shouldRetryAfterRequest, nil
-}
+	found := make([]*syncedNode, 0, len(childrenHashes))
+	notFound := make([][]byte, 0)
+	for _, childHash := range childrenHashes {
+		child, errGet := ts.getNodeFromDb(childHash)
+		if errGet == nil {
+			found = append(found, &syncedNode{hash: childHash, node: child})
+			continue
+		}
 
-// adds new elements to needed hash map, lock ts.nodeHashesMutex before calling
-func (ts *trieSyncer) addNew(nextNodes []node) bool {
-	newElement := false
-	for _, nextNode := range nextNodes {
-		nextHash := string(nextNode.getHash())
-
-		nodeInfo, ok := ts.nodesForTrie[nextHash]
-		if !ok || !nodeInfo.received {
-			newElement = true
-			ts.nodesForTrie[nextHash] = trieNodeInfo{
-				trieNode: nextNode,
-				received: true,
-			}
+		receivedChild, ok := ts.getReceivedNode(childHash)
+		if ok {
+			ts.statistics.AddNumBytesReceived(uint64(len(receivedChild.encNode)))
+			found = append(found, receivedChild)
+			continue
 		}
+
+		notFound = append(notFound, childHash)
 	}
 
-	return newElement
+	return found, notFound, nil
 }
 
-// Trie returns the synced trie
-func (ts *trieSyncer) Trie() data.Trie {
-	return ts.trie
+func (ts *trieSyncer) markAsChecked(hash []byte) bool {
+	ts.mutNodes.Lock()
+	defer ts.mutNodes.Unlock()
+
+	_, ok := ts.checkedNodes[string(hash)]
+	if ok {
+		return false
+	}
+
+	ts.checkedNodes[string(hash)] = struct{}{}
+	return true
 }
 
-func (ts *trieSyncer) getNode(hash []byte) (node, error) {
-	nodeInfo, ok := ts.nodesForTrie[string(hash)]
-	if ok && nodeInfo.received {
-		return nodeInfo.trieNode, nil
+func getChildrenHashes(n node) ([][]byte, error) {
+	// all the children are reported as missing, without being linked to the parent node
+	childrenHashes, _, err := n.loadChildren(func(_ []byte) (node, error) {
+		return nil, ErrNodeNotFound
+	})
+
+	return childrenHashes, err
+}
+
+func (ts *trieSyncer) getNodeFromDb(hash []byte) (node, error) {
+	n, err := getNodeFromDBAndDecode(hash, ts.trie.Database(), ts.trie.marshalizer, ts.trie.hasher)
+	if err != nil {
+		return nil, err
 	}
+	n.setGivenHash(hash)
 
-	n, ok := ts.interceptedNodes.Get(hash)
-	if ok {
-		return trieNode(n)
+	return n, nil
+}
+
+func (ts *trieSyncer) getReceivedNode(hash []byte) (*syncedNode, bool) {
+	val, ok := ts.interceptedNodes.Get(hash)
+	if !ok {
+		return nil, false
+	}
+
+	interceptedNode, ok := val.(*InterceptedTrieNode)
+	if !ok {
+		return nil, false
+	}
+	if !bytes.Equal(interceptedNode.Hash(), hash) {
+		log.Debug("trie syncer: received node hash mismatch", "requested", hash, "received", interceptedNode.Hash())
+		return nil, false
 	}
 
-	return nil, ErrNodeNotFound
+	return &syncedNode{
+		hash:    hash,
+		node:    interceptedNode.node,
+		encNode: interceptedNode.encNode,
+	}, true
 }
 
 func trieNode(data interface{}) (node, error) {
@@ -243,41 +318,59 @@ func trieNode(data interface{}) (node, error) {
 	return n.node, nil
 }
 
-func (ts *trieSyncer) requestNodes() uint32 {
-	ts.mutOperation.RLock()
-	numUnResolvedNodes := uint32(len(ts.nodesForTrie))
-	hashes := make([][]byte, 0)
-	for hash, nodeInfo := range ts.nodesForTrie {
-		if !nodeInfo.received {
-			hashes = append(hashes, []byte(hash))
-		}
+func (ts *trieSyncer) requestMissingNodes() {
+	hashes := make([][]byte, 0, len(ts.missingNodes))
+	for hash := range ts.missingNodes {
+		hashes = append(hashes, []byte(hash))
 	}
-	ts.requestHandler.RequestTrieNodes(ts.shardId, hashes, ts.topic)
-	ts.mutOperation.RUnlock()
 
-	return numUnResolvedNodes
-}
+	wg := sync.WaitGroup{}
+	for start := 0; start < len(hashes); start += maxHashesPerRequest {
+		end := start + maxHashesPerRequest
+		if end > len(hashes) {
+			end = len(hashes)
+		}
 
-func (ts *trieSyncer) trieNodeIntercepted(hash []byte, val interface{}) {
-	ts.mutOperation.Lock()
-	defer ts.mutOperation.Unlock()
+		wg.Add(1)
+		go func(batch [][]byte) {
+			ts.requestHandler.RequestTrieNodes(ts.shardId, batch, ts.topic)
+			wg.Done()
+		}(hashes[start:end])
+	}
 
-	log.Trace("trie node intercepted", "hash", hash)
+	wg.Wait()
+}
 
-	n, ok := ts.nodesForTrie[string(hash)]
-	if !ok || n.received {
-		return
+// commitRoot persists the root node, which is written last so a partially synced trie is never considered complete
+func (ts *trieSyncer) commitRoot() error {
+	if ts.rootNode == nil {
+		return ErrNodeNotFound
 	}
 
-	node, err := trieNode(val)
+	err := ts.trie.Database().Put(ts.rootHash, ts.rootNode.encNode)
 	if err != nil {
-		return
+		return err
 	}
 
-	ts.nodesForTrie[string(hash)] = trieNodeInfo{
-		trieNode: node,
-		received: true,
+	rootNode, err := ts.getNodeFromDb(ts.rootHash)
+	if err != nil {
+		return err
 	}
+
+	ts.setTrieRoot(rootNode)
+
+	return nil
+}
+
+func (ts *trieSyncer) setTrieRoot(rootNode node) {
+	ts.trie.mutOperation.Lock()
+	ts.trie.root = rootNode
+	ts.trie.mutOperation.Unlock()
+}
+
+// Trie returns the synced trie
+func (ts *trieSyncer) Trie() data.Trie {
+	return ts.trie
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,67 @@
+package trie
+
+import (
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+var _ SyncStatisticsHandler = (*syncStatistics)(nil)
+
+type syncStatistics struct {
+	numSynced        uint64
+	numPending       int64
+	numBytesReceived uint64
+	appStatusHandler core.AppStatusHandler
+}
+
+// NewSyncStatistics creates a new instance of the trie sync statistics. The same instance can be shared
+// between multiple trie syncers so the reported metrics reflect the overall progress
+func NewSyncStatistics(appStatusHandler core.AppStatusHandler) (*syncStatistics, error) {
+	if check.IfNil(appStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+
+	return &syncStatistics{
+		appStatusHandler: appStatusHandler,
+	}, nil
+}
+
+// AddNumSynced adds the provided value to the number of synced trie nodes
+func (ss *syncStatistics) AddNumSynced(value int) {
+	numSynced := atomic.AddUint64(&ss.numSynced, uint64(value))
+	ss.appStatusHandler.SetUInt64Value(core.MetricTrieSyncNumSyncedNodes, numSynced)
+}
+
+// AddNumPending adds the provided delta to the number of trie nodes awaited from the network
+func (ss *syncStatistics) AddNumPending(delta int) {
+	numPending := atomic.AddInt64(&ss.numPending, int64(delta))
+	ss.appStatusHandler.SetInt64Value(core.MetricTrieSyncNumPendingNodes, numPending)
+}
+
+// AddNumBytesReceived adds the provided value to the number of bytes received while syncing
+func (ss *syncStatistics) AddNumBytesReceived(bytes uint64) {
+	numBytesReceived := atomic.AddUint64(&ss.numBytesReceived, bytes)
+	ss.appStatusHandler.SetUInt64Value(core.MetricTrieSyncNumReceivedBytes, numBytesReceived)
+}
+
+// NumSynced returns the number of synced trie nodes
+func (ss *syncStatistics) NumSynced() uint64 {
+	return atomic.LoadUint64(&ss.numSynced)
+}
+
+// NumPending returns the number of trie nodes awaited from the network
+func (ss *syncStatistics) NumPending() int64 {
+	return atomic.LoadInt64(&ss.numPending)
+}
+
+// NumBytesReceived returns the number of bytes received while syncing
+func (ss *syncStatistics) NumBytesReceived() uint64 {
+	return atomic.LoadUint64(&ss.numBytesReceived)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *syncStatistics) IsInterfaceNil() bool {
+	return ss == nil
+}
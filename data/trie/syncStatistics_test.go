@@ -0,0 +1,61 @@
+package trie_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSyncStatistics_NilAppStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ss, err := trie.NewSyncStatistics(nil)
+	assert.Nil(t, ss)
+	assert.Equal(t, trie.ErrNilAppStatusHandler, err)
+}
+
+func TestSyncStatistics_AddShouldUpdateCountersAndMetrics(t *testing.T) {
+	t.Parallel()
+
+	mutMetrics := sync.Mutex{}
+	uint64Metrics := make(map[string]uint64)
+	int64Metrics := make(map[string]int64)
+	ss, _ := trie.NewSyncStatistics(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			mutMetrics.Lock()
+			uint64Metrics[key] = value
+			mutMetrics.Unlock()
+		},
+		SetInt64ValueHandler: func(key string, value int64) {
+			mutMetrics.Lock()
+			int64Metrics[key] = value
+			mutMetrics.Unlock()
+		},
+	})
+	assert.False(t, ss.IsInterfaceNil())
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			ss.AddNumSynced(1)
+			ss.AddNumPending(2)
+			ss.AddNumBytesReceived(10)
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	ss.AddNumPending(-50)
+
+	assert.Equal(t, uint64(numCalls), ss.NumSynced())
+	assert.Equal(t, int64(2*numCalls-50), ss.NumPending())
+	assert.Equal(t, uint64(10*numCalls), ss.NumBytesReceived())
+
+	assert.Equal(t, int64(2*numCalls-50), int64Metrics[core.MetricTrieSyncNumPendingNodes])
+	assert.Equal(t, uint64(10*numCalls), uint64Metrics[core.MetricTrieSyncNumReceivedBytes])
+}
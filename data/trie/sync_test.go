@@ -1,37 +1,288 @@
 package trie
 
 import (
+	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestTrieSync_InterceptedNodeShouldNotBeAddedToNodesForTrieIfNodeReceived(t *testing.T) {
-	t.Parallel()
+func createMockSyncStatistics() *syncStatistics {
+	ss, _ := NewSyncStatistics(&mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {},
+		SetInt64ValueHandler:  func(key string, value int64) {},
+	})
+
+	return ss
+}
 
+func createTrieForSync() *patriciaMerkleTrie {
 	marsh, hasher := getTestMarshAndHasher()
-	ts, err := NewTrieSyncer(&mock.RequestHandlerStub{}, testscommon.NewCacherMock(), &patriciaMerkleTrie{}, 0, "trieNodes")
+	trieStorage, _ := NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	tr, _ := NewTrie(trieStorage, marsh, hasher, 5)
+
+	return tr
+}
+
+func createSourceTrieForSync(numLeaves int) (*patriciaMerkleTrie, []byte) {
+	tr := createTrieForSync()
+	for i := 0; i < numLeaves; i++ {
+		key := tr.hasher.Compute(fmt.Sprintf("key%d", i))
+		_ = tr.Update(key, []byte(fmt.Sprintf("value%d", i)))
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+
+	return tr, rootHash
+}
+
+func createMockArgTrieSyncer() ArgTrieSyncer {
+	return ArgTrieSyncer{
+		RequestHandler:     &mock.RequestHandlerStub{},
+		InterceptedNodes:   testscommon.NewCacherMock(),
+		Trie:               createTrieForSync(),
+		ShardId:            0,
+		Topic:              "trieNodes",
+		TrieSyncStatistics: createMockSyncStatistics(),
+	}
+}
+
+func createRequestHandlerFromSourceTrie(
+	source *patriciaMerkleTrie,
+	interceptedNodes storage.Cacher,
+	requested map[string]int,
+	mutRequested *sync.Mutex,
+) *mock.RequestHandlerStub {
+	return &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			for _, hash := range hashes {
+				mutRequested.Lock()
+				requested[string(hash)]++
+				mutRequested.Unlock()
+
+				encNode, err := source.Database().Get(hash)
+				if err != nil {
+					continue
+				}
+
+				interceptedNode, err := NewInterceptedTrieNode(encNode, source.marshalizer, source.hasher)
+				if err != nil {
+					continue
+				}
+				interceptedNodes.Put(hash, interceptedNode, 0)
+			}
+		},
+	}
+}
+
+func TestNewTrieSyncer_NilRequestHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieSyncer()
+	arg.RequestHandler = nil
+
+	ts, err := NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilRequestHandler, err)
+}
+
+func TestNewTrieSyncer_NilInterceptedNodesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieSyncer()
+	arg.InterceptedNodes = nil
+
+	ts, err := NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, data.ErrNilCacher, err)
+}
+
+func TestNewTrieSyncer_NilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieSyncer()
+	arg.Trie = nil
+
+	ts, err := NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilTrie, err)
+}
+
+func TestNewTrieSyncer_EmptyTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieSyncer()
+	arg.Topic = ""
+
+	ts, err := NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrInvalidTrieTopic, err)
+}
+
+func TestNewTrieSyncer_NilTrieSyncStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieSyncer()
+	arg.TrieSyncStatistics = nil
+
+	ts, err := NewTrieSyncer(arg)
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilTrieSyncStatistics, err)
+}
+
+func TestNewTrieSyncer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ts, err := NewTrieSyncer(createMockArgTrieSyncer())
+	assert.Nil(t, err)
+	assert.False(t, ts.IsInterfaceNil())
+}
+
+func TestTrieSync_StartSyncingEmptyRootHashShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieSyncer(createMockArgTrieSyncer())
+
+	err := ts.StartSyncing(EmptyTrieHash, context.Background())
 	assert.Nil(t, err)
-	assert.NotNil(t, ts)
+}
+
+func TestTrieSync_StartSyncingNilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTrieSyncer(createMockArgTrieSyncer())
+
+	err := ts.StartSyncing([]byte("root hash"), nil)
+	assert.Equal(t, ErrNilContext, err)
+}
+
+func TestTrieSync_StartSyncingMissingNodesShouldTimeout(t *testing.T) {
+	t.Parallel()
 
-	bn, collapsedBn := getBnAndCollapsedBn(marsh, hasher)
-	encodedNode, err := collapsedBn.getEncodedNode()
+	ts, _ := NewTrieSyncer(createMockArgTrieSyncer())
+	ts.waitTimeBetweenRequests = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	err := ts.StartSyncing([]byte("root hash"), ctx)
+	assert.Equal(t, ErrTimeIsOut, err)
+}
+
+func TestTrieSync_StartSyncingShouldSyncAllNodes(t *testing.T) {
+	t.Parallel()
+
+	source, rootHash := createSourceTrieForSync(200)
+	expectedLeaves, _ := source.GetAllLeaves()
+
+	arg := createMockArgTrieSyncer()
+	requested := make(map[string]int)
+	mutRequested := &sync.Mutex{}
+	arg.RequestHandler = createRequestHandlerFromSourceTrie(source, arg.InterceptedNodes, requested, mutRequested)
+	statistics := createMockSyncStatistics()
+	arg.TrieSyncStatistics = statistics
+
+	ts, _ := NewTrieSyncer(arg)
+	ts.waitTimeBetweenRequests = time.Millisecond
+
+	err := ts.StartSyncing(rootHash, context.Background())
 	assert.Nil(t, err)
 
-	interceptedNode, err := NewInterceptedTrieNode(encodedNode, marsh, hasher)
+	syncedRootHash, _ := ts.Trie().Root()
+	assert.Equal(t, rootHash, syncedRootHash)
+
+	leaves, err := ts.Trie().GetAllLeaves()
 	assert.Nil(t, err)
+	assert.Equal(t, expectedLeaves, leaves)
+
+	assert.Equal(t, uint64(len(requested)), statistics.NumSynced())
+	assert.Equal(t, int64(0), statistics.NumPending())
+	assert.True(t, statistics.NumBytesReceived() > 0)
+}
 
-	hash := "nodeHash"
-	ts.nodesForTrie[hash] = trieNodeInfo{
-		trieNode: bn,
-		received: true,
+func TestTrieSync_StartSyncingShouldNotRequestNodesAlreadyPersisted(t *testing.T) {
+	t.Parallel()
+
+	source, rootHash := createSourceTrieForSync(200)
+
+	arg := createMockArgTrieSyncer()
+	destination := arg.Trie.(*patriciaMerkleTrie)
+	persistedHashes := make([][]byte, 0)
+	for _, hash := range getNodeHashesFromDb(source, rootHash) {
+		if string(hash) == string(rootHash) {
+			continue
+		}
+		if len(persistedHashes) >= 10 {
+			break
+		}
+
+		encNode, _ := source.Database().Get(hash)
+		_ = destination.Database().Put(hash, encNode)
+		persistedHashes = append(persistedHashes, hash)
+	}
+
+	requested := make(map[string]int)
+	mutRequested := &sync.Mutex{}
+	arg.RequestHandler = createRequestHandlerFromSourceTrie(source, arg.InterceptedNodes, requested, mutRequested)
+
+	ts, _ := NewTrieSyncer(arg)
+	ts.waitTimeBetweenRequests = time.Millisecond
+
+	err := ts.StartSyncing(rootHash, context.Background())
+	assert.Nil(t, err)
+
+	for _, hash := range persistedHashes {
+		_, wasRequested := requested[string(hash)]
+		assert.False(t, wasRequested)
 	}
 
-	ts.trieNodeIntercepted([]byte(hash), interceptedNode)
+	syncedRootHash, _ := ts.Trie().Root()
+	assert.Equal(t, rootHash, syncedRootHash)
+}
+
+func TestTrieSync_StartSyncingRootAlreadyPersistedShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	source, rootHash := createSourceTrieForSync(10)
+
+	arg := createMockArgTrieSyncer()
+	arg.Trie = source
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Fail(t, "should have not requested trie nodes")
+		},
+	}
+
+	ts, _ := NewTrieSyncer(arg)
+
+	err := ts.StartSyncing(rootHash, context.Background())
+	assert.Nil(t, err)
+}
+
+func getNodeHashesFromDb(tr *patriciaMerkleTrie, rootHash []byte) [][]byte {
+	hashes := make([][]byte, 0)
+	level := [][]byte{rootHash}
+	for len(level) > 0 {
+		nextLevel := make([][]byte, 0)
+		for _, hash := range level {
+			n, err := getNodeFromDBAndDecode(hash, tr.Database(), tr.marshalizer, tr.hasher)
+			if err != nil {
+				continue
+			}
+
+			hashes = append(hashes, hash)
+			childrenHashes, _ := getChildrenHashes(n)
+			nextLevel = append(nextLevel, childrenHashes...)
+		}
+		level = nextLevel
+	}
 
-	nodeInfo, ok := ts.nodesForTrie[hash]
-	assert.True(t, ok)
-	assert.Equal(t, bn, nodeInfo.trieNode)
+	return hashes
 }
@@ -0,0 +1,35 @@
+package data
+
+// LeafChangeType defines how a trie leaf was modified between two root hashes
+type LeafChangeType byte
+
+const (
+	// LeafInserted marks a leaf that exists only in the new trie
+	LeafInserted LeafChangeType = 0
+	// LeafUpdated marks a leaf that exists in both tries but with different values
+	LeafUpdated LeafChangeType = 1
+	// LeafDeleted marks a leaf that exists only in the old trie
+	LeafDeleted LeafChangeType = 2
+)
+
+// String returns the human readable form of the leaf change type
+func (lct LeafChangeType) String() string {
+	switch lct {
+	case LeafInserted:
+		return "inserted"
+	case LeafUpdated:
+		return "updated"
+	case LeafDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// LeafChange holds a trie leaf modification found between two root hashes
+type LeafChange struct {
+	Type     LeafChangeType
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
@@ -176,6 +176,18 @@ var ErrRequestIntervalTooSmall = errors.New("request interval is too small")
 // ErrNilResolverDebugHandler signals that a nil resolver debug handler has been provided
 var ErrNilResolverDebugHandler = errors.New("nil resolver debug handler")
 
+// ErrNilPeersRatingHandler signals that a nil peers rating handler has been provided
+var ErrNilPeersRatingHandler = errors.New("nil peers rating handler")
+
+// ErrNilPeerHonestyScoreProvider signals that a nil peer honesty score provider has been provided
+var ErrNilPeerHonestyScoreProvider = errors.New("nil peer honesty score provider")
+
+// ErrNilCacher signals that a nil cacher has been provided
+var ErrNilCacher = errors.New("nil cacher")
+
+// ErrNilPeerShardMapper signals that a nil peer shard mapper has been provided
+var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
+
 // ErrMissingData signals that the required data is missing
 var ErrMissingData = errors.New("missing data")
 
@@ -184,3 +196,6 @@ var ErrNilConfig = errors.New("nil config provided")
 
 // ErrNilEconomicsData signals that a nil economics data handler has been provided
 var ErrNilEconomicsData = errors.New("nil economics data provided")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
@@ -39,6 +39,10 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case UserAccountsUnit:
+		return "UserAccountsUnit"
+	case PeerAccountsUnit:
+		return "PeerAccountsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -77,6 +81,10 @@ const (
 	TransactionHistoryUnit UnitType = 12
 	// EpochByHashUnit is the epoch by hash storage unit identifier
 	EpochByHashUnit UnitType = 13
+	// UserAccountsUnit is the identifier of the user accounts trie storage, which is not part of the chain storer
+	UserAccountsUnit UnitType = 14
+	// PeerAccountsUnit is the identifier of the peer accounts trie storage, which is not part of the chain storer
+	PeerAccountsUnit UnitType = 15
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -99,6 +107,7 @@ type Resolver interface {
 	RequestDataFromHash(hash []byte, epoch uint32) error
 	ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
 	SetResolverDebugHandler(handler ResolverDebugHandler) error
+	SetPeersRatingHandler(handler PeersRatingHandler) error
 	SetNumPeersToQuery(intra int, cross int)
 	NumPeersToQuery() (int, int)
 	IsInterfaceNil() bool
@@ -133,6 +142,7 @@ type TopicResolverSender interface {
 	SetNumPeersToQuery(intra int, cross int)
 	SetResolverDebugHandler(handler ResolverDebugHandler) error
 	ResolverDebugHandler() ResolverDebugHandler
+	SetPeersRatingHandler(handler PeersRatingHandler) error
 	NumPeersToQuery() (int, int)
 	IsInterfaceNil() bool
 }
@@ -338,6 +348,27 @@ type WhiteListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// PeersRatingHandler defines the behavior of a component able to rate the peers based on how they answer to the
+// requests sent on a topic
+type PeersRatingHandler interface {
+	RequestSent(pid core.PeerID, topic string)
+	ResponseReceived(pid core.PeerID, topic string, isValid bool)
+	SortPeersByRating(pids []core.PeerID) []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// PeerHonestyScoreProvider defines the behavior of a component able to provide the honesty score of a public key
+type PeerHonestyScoreProvider interface {
+	GetScore(pk string) float64
+	IsInterfaceNil() bool
+}
+
+// PeerShardMapper can return the public key of a provided peer ID
+type PeerShardMapper interface {
+	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
+	IsInterfaceNil() bool
+}
+
 // ResolverDebugHandler defines an interface for debugging the reqested-resolved data
 type ResolverDebugHandler interface {
 	LogRequestedData(topic string, hashes [][]byte, numReqIntra int, numReqCross int)
@@ -0,0 +1,147 @@
+package journal
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// unitsReplayedBeforeBootstrap are the units read by the epoch start bootstrapper, before the storage service exists
+var unitsReplayedBeforeBootstrap = []dataRetriever.UnitType{
+	dataRetriever.BootstrapUnit,
+	dataRetriever.UserAccountsUnit,
+	dataRetriever.PeerAccountsUnit,
+}
+
+// UnitPersisterArgs holds the arguments needed to open the persister of a unit replayed before the bootstrap
+type UnitPersisterArgs struct {
+	PersisterFactory storage.PersisterFactory
+	PathForEpoch     func(epoch uint32) string
+}
+
+// ArgsBootstrapReplay holds the arguments needed to replay the writes read by the epoch start bootstrapper
+type ArgsBootstrapReplay struct {
+	DirectoryPath  string
+	UnitPersisters map[dataRetriever.UnitType]UnitPersisterArgs
+}
+
+// ReplayBootstrapWrites applies again, in order, the writes of the kept journal records done on the boot storage and
+// on the trie storages. These units are read by the epoch start bootstrapper before the storage service is created,
+// so the writes are applied directly on the persisters of the epochs in which they were done
+func ReplayBootstrapWrites(args ArgsBootstrapReplay) error {
+	if len(args.DirectoryPath) == 0 {
+		return ErrEmptyDirectoryPath
+	}
+	for _, unitType := range unitsReplayedBeforeBootstrap {
+		unitPersister, ok := args.UnitPersisters[unitType]
+		if !ok || check.IfNil(unitPersister.PersisterFactory) || unitPersister.PathForEpoch == nil {
+			return fmt.Errorf("%w for unit %s", ErrMissingUnitPersister, unitType.String())
+		}
+	}
+
+	_, err := os.Stat(args.DirectoryPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	sequences, err := getRecordsSequences(args.DirectoryPath)
+	if err != nil {
+		return err
+	}
+
+	persisters := make(map[string]storage.Persister)
+	defer closePersisters(persisters)
+
+	for _, sequence := range sequences {
+		record, errRead := readRecordFile(args.DirectoryPath, sequence)
+		if errRead != nil {
+			log.Debug("journal: skipping incomplete commit", "sequence", sequence, "error", errRead.Error())
+			continue
+		}
+
+		log.Debug("journal: replaying the bootstrap writes of commit",
+			"sequence", sequence,
+			"epoch", record.Epoch,
+			"nonce", record.Nonce,
+			"hash", record.HeaderHash)
+		for _, write := range record.Writes {
+			if !isReplayedBeforeBootstrap(write.Unit) {
+				continue
+			}
+
+			persister, errOpen := getPersister(persisters, args.UnitPersisters[write.Unit], record.Epoch)
+			if errOpen != nil {
+				return fmt.Errorf("%w while opening unit %s", errOpen, write.Unit.String())
+			}
+
+			err = persister.Put(write.Key, write.Value)
+			if err != nil {
+				return fmt.Errorf("%w for unit %s, key %s", err, write.Unit.String(), hex.EncodeToString(write.Key))
+			}
+		}
+	}
+
+	return nil
+}
+
+func getPersister(
+	persisters map[string]storage.Persister,
+	unitPersister UnitPersisterArgs,
+	epoch uint32,
+) (storage.Persister, error) {
+	path := unitPersister.PathForEpoch(epoch)
+	persister, ok := persisters[path]
+	if ok {
+		return persister, nil
+	}
+
+	persister, err := unitPersister.PersisterFactory.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persister.Init()
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	persisters[path] = persister
+
+	return persister, nil
+}
+
+func closePersisters(persisters map[string]storage.Persister) {
+	for path, persister := range persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("journal: cannot close persister", "path", path, "error", err.Error())
+		}
+	}
+}
+
+func isReplayedBeforeBootstrap(unitType dataRetriever.UnitType) bool {
+	for _, replayedUnit := range unitsReplayedBeforeBootstrap {
+		if replayedUnit == unitType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getWritesNotReplayedBeforeBootstrap(writes []journalWrite) []journalWrite {
+	remainingWrites := make([]journalWrite, 0, len(writes))
+	for _, write := range writes {
+		if isReplayedBeforeBootstrap(write.Unit) {
+			continue
+		}
+		remainingWrites = append(remainingWrites, write)
+	}
+
+	return remainingWrites
+}
@@ -0,0 +1,162 @@
+package journal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBootstrapReplay(directoryPath string, persisterFactory storage.PersisterFactory) ArgsBootstrapReplay {
+	return ArgsBootstrapReplay{
+		DirectoryPath: directoryPath,
+		UnitPersisters: map[dataRetriever.UnitType]UnitPersisterArgs{
+			dataRetriever.BootstrapUnit: {
+				PersisterFactory: persisterFactory,
+				PathForEpoch: func(epoch uint32) string {
+					return fmt.Sprintf("boot_%d", epoch)
+				},
+			},
+			dataRetriever.UserAccountsUnit: {
+				PersisterFactory: persisterFactory,
+				PathForEpoch: func(_ uint32) string {
+					return "user_accounts"
+				},
+			},
+			dataRetriever.PeerAccountsUnit: {
+				PersisterFactory: persisterFactory,
+				PathForEpoch: func(_ uint32) string {
+					return "peer_accounts"
+				},
+			},
+		},
+	}
+}
+
+func TestReplayBootstrapWrites_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBootstrapReplay("journal", &mock.PersisterFactoryStub{})
+	args.DirectoryPath = ""
+	err := ReplayBootstrapWrites(args)
+	assert.Equal(t, ErrEmptyDirectoryPath, err)
+
+	args = createMockArgsBootstrapReplay("journal", &mock.PersisterFactoryStub{})
+	delete(args.UnitPersisters, dataRetriever.PeerAccountsUnit)
+	err = ReplayBootstrapWrites(args)
+	assert.True(t, errors.Is(err, ErrMissingUnitPersister))
+
+	args = createMockArgsBootstrapReplay("journal", nil)
+	err = ReplayBootstrapWrites(args)
+	assert.True(t, errors.Is(err, ErrMissingUnitPersister))
+}
+
+func TestReplayBootstrapWrites_MissingDirectoryShouldNotReplay(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	persisterFactory := &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			assert.Fail(t, "should have not opened a persister")
+			return nil, nil
+		},
+	}
+	err := ReplayBootstrapWrites(createMockArgsBootstrapReplay(filepath.Join(dir, "missing"), persisterFactory))
+	assert.Nil(t, err)
+}
+
+func TestReplayBootstrapWrites_ShouldApplyOnlyTheBootstrapUnitsInTheRecordEpoch(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	records := []*journalRecord{
+		{
+			Nonce: 1,
+			Epoch: 1,
+			Writes: []journalWrite{
+				{Unit: dataRetriever.BootstrapUnit, Key: []byte("round1"), Value: []byte("boot1")},
+				{Unit: dataRetriever.BlockHeaderUnit, Key: []byte("hash1"), Value: []byte("header1")},
+				{Unit: dataRetriever.UserAccountsUnit, Key: []byte("node1"), Value: []byte("user1")},
+			},
+		},
+		{
+			Nonce: 2,
+			Epoch: 2,
+			Writes: []journalWrite{
+				{Unit: dataRetriever.BootstrapUnit, Key: []byte("round2"), Value: []byte("boot2")},
+				{Unit: dataRetriever.PeerAccountsUnit, Key: []byte("node2"), Value: []byte("peer2")},
+				{Unit: dataRetriever.UserAccountsUnit, Key: []byte("node1"), Value: []byte("user2")},
+			},
+		},
+	}
+	for i, record := range records {
+		err := writeRecordFile(dir, uint64(i+1), record)
+		require.Nil(t, err)
+	}
+
+	persisters := make(map[string]storage.Persister)
+	persisterFactory := &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			persister := memorydb.New()
+			persisters[path] = persister
+			return persister, nil
+		},
+	}
+	err := ReplayBootstrapWrites(createMockArgsBootstrapReplay(dir, persisterFactory))
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(persisters))
+
+	value, _ := persisters["boot_1"].Get([]byte("round1"))
+	assert.Equal(t, []byte("boot1"), value)
+	value, _ = persisters["boot_2"].Get([]byte("round2"))
+	assert.Equal(t, []byte("boot2"), value)
+	value, _ = persisters["user_accounts"].Get([]byte("node1"))
+	assert.Equal(t, []byte("user2"), value)
+	value, _ = persisters["peer_accounts"].Get([]byte("node2"))
+	assert.Equal(t, []byte("peer2"), value)
+	for _, persister := range persisters {
+		assert.NotNil(t, persister.Has([]byte("hash1")))
+	}
+}
+
+func TestReplayBootstrapWrites_PersisterCreateErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	record := &journalRecord{
+		Writes: []journalWrite{
+			{Unit: dataRetriever.BootstrapUnit, Key: []byte("round"), Value: []byte("boot")},
+		},
+	}
+	err := writeRecordFile(dir, 1, record)
+	require.Nil(t, err)
+
+	expectedErr := errors.New("expected error")
+	persisterFactory := &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return nil, expectedErr
+		},
+	}
+	err = ReplayBootstrapWrites(createMockArgsBootstrapReplay(dir, persisterFactory))
+	assert.True(t, errors.Is(err, expectedErr))
+}
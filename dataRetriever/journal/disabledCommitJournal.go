@@ -0,0 +1,40 @@
+package journal
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+type disabledCommitJournal struct {
+}
+
+// NewDisabledCommitJournal returns a commit journal implementation that does nothing, used when journaling is disabled
+func NewDisabledCommitJournal() *disabledCommitJournal {
+	return &disabledCommitJournal{}
+}
+
+// BeginCommit does nothing
+func (dcj *disabledCommitJournal) BeginCommit() {
+}
+
+// FinishCommit does nothing
+func (dcj *disabledCommitJournal) FinishCommit(_ uint64, _ []byte) error {
+	return nil
+}
+
+// AbortCommit does nothing
+func (dcj *disabledCommitJournal) AbortCommit() {
+}
+
+// JournalTrieStorage returns the provided trie storage manager
+func (dcj *disabledCommitJournal) JournalTrieStorage(
+	_ dataRetriever.UnitType,
+	storageManager data.StorageManager,
+) data.StorageManager {
+	return storageManager
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcj *disabledCommitJournal) IsInterfaceNil() bool {
+	return dcj == nil
+}
@@ -0,0 +1,21 @@
+package journal
+
+import "errors"
+
+// ErrEmptyDirectoryPath signals that an empty journal directory path has been provided
+var ErrEmptyDirectoryPath = errors.New("empty journal directory path")
+
+// ErrInvalidNumRecordsToKeep signals that an invalid number of journal records to keep has been provided
+var ErrInvalidNumRecordsToKeep = errors.New("invalid number of journal records to keep")
+
+// ErrCommitNotStarted signals that a commit was finished without being started
+var ErrCommitNotStarted = errors.New("commit not started")
+
+// ErrCorruptedJournalRecord signals that a journal record could not be verified, meaning it was not completely written
+var ErrCorruptedJournalRecord = errors.New("corrupted journal record")
+
+// ErrCannotPersistJournalRecord signals that the journal record of a commit could not be persisted
+var ErrCannotPersistJournalRecord = errors.New("cannot persist journal record")
+
+// ErrMissingUnitPersister signals that the persister of a unit replayed before the bootstrap has not been provided
+var ErrMissingUnitPersister = errors.New("missing unit persister")
@@ -0,0 +1,192 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+const recordFileExtension = ".journal"
+const tempFileExtension = ".tmp"
+const checksumLength = 4
+
+// rw for owner only
+const rwOwner = 0600
+
+// rwx for owner only
+const rwxOwner = 0700
+
+// journalWrite holds a single write operation recorded while committing a block
+type journalWrite struct {
+	Unit  dataRetriever.UnitType
+	Key   []byte
+	Value []byte
+}
+
+// journalRecord holds all the write operations of a block commit
+type journalRecord struct {
+	Nonce      uint64
+	Epoch      uint32
+	HeaderHash []byte
+	Writes     []journalWrite
+}
+
+// recordKeys holds, for each unit, the keys written by a kept journal record, so the records holding a removed key
+// are found without reading the record files again
+type recordKeys struct {
+	nonce uint64
+	keys  map[dataRetriever.UnitType]map[string]struct{}
+}
+
+func newRecordKeys(record *journalRecord) *recordKeys {
+	rk := &recordKeys{
+		nonce: record.Nonce,
+		keys:  make(map[dataRetriever.UnitType]map[string]struct{}),
+	}
+	for _, write := range record.Writes {
+		unitKeys, ok := rk.keys[write.Unit]
+		if !ok {
+			unitKeys = make(map[string]struct{})
+			rk.keys[write.Unit] = unitKeys
+		}
+		unitKeys[string(write.Key)] = struct{}{}
+	}
+
+	return rk
+}
+
+func (rk *recordKeys) hasKey(unitType dataRetriever.UnitType, key []byte) bool {
+	_, ok := rk.keys[unitType][string(key)]
+
+	return ok
+}
+
+func removeWritesOfKey(writes []journalWrite, unitType dataRetriever.UnitType, key []byte) []journalWrite {
+	remainingWrites := make([]journalWrite, 0, len(writes))
+	for _, write := range writes {
+		if write.Unit == unitType && bytes.Equal(write.Key, key) {
+			continue
+		}
+		remainingWrites = append(remainingWrites, write)
+	}
+
+	return remainingWrites
+}
+
+func recordFileName(directoryPath string, sequence uint64) string {
+	return filepath.Join(directoryPath, fmt.Sprintf("%020d%s", sequence, recordFileExtension))
+}
+
+// writeRecordFile durably writes the record in a temporary file which is afterwards renamed, so a record file
+// is either complete or missing. The content is prefixed by a checksum as an additional integrity guard
+func writeRecordFile(directoryPath string, sequence uint64, record *journalRecord) error {
+	buff, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	checksum := make([]byte, checksumLength)
+	binary.BigEndian.PutUint32(checksum, crc32.ChecksumIEEE(buff))
+
+	fileName := recordFileName(directoryPath, sequence)
+	tempFileName := fileName + tempFileExtension
+	file, err := os.OpenFile(tempFileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, rwOwner)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(checksum, buff...))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFileName, fileName)
+}
+
+func readRecordFile(directoryPath string, sequence uint64) (*journalRecord, error) {
+	buff, err := ioutil.ReadFile(recordFileName(directoryPath, sequence))
+	if err != nil {
+		return nil, err
+	}
+	if len(buff) < checksumLength {
+		return nil, ErrCorruptedJournalRecord
+	}
+
+	checksum := binary.BigEndian.Uint32(buff[:checksumLength])
+	content := buff[checksumLength:]
+	if crc32.ChecksumIEEE(content) != checksum {
+		return nil, ErrCorruptedJournalRecord
+	}
+
+	record := &journalRecord{}
+	err = json.Unmarshal(content, record)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCorruptedJournalRecord, err.Error())
+	}
+
+	return record, nil
+}
+
+func removeRecordFile(directoryPath string, sequence uint64) {
+	err := os.Remove(recordFileName(directoryPath, sequence))
+	if err != nil && !os.IsNotExist(err) {
+		log.Debug("journal: remove record", "sequence", sequence, "error", err.Error())
+	}
+}
+
+// getRecordsSequences returns the sequences of the record files found in the journal directory, in ascending order.
+// Leftover temporary files, belonging to records which were not completely written, are removed
+func getRecordsSequences(directoryPath string) ([]uint64, error) {
+	files, err := ioutil.ReadDir(directoryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]uint64, 0, len(files))
+	for _, file := range files {
+		name := file.Name()
+		if strings.HasSuffix(name, tempFileExtension) {
+			log.Debug("journal: removing incomplete record", "file", name)
+			_ = os.Remove(filepath.Join(directoryPath, name))
+			continue
+		}
+		if !strings.HasSuffix(name, recordFileExtension) {
+			continue
+		}
+
+		sequence, errParse := strconv.ParseUint(strings.TrimSuffix(name, recordFileExtension), 10, 64)
+		if errParse != nil {
+			continue
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	sort.Slice(sequences, func(i, j int) bool {
+		return sequences[i] < sequences[j]
+	})
+
+	return sequences, nil
+}
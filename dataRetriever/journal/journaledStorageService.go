@@ -0,0 +1,474 @@
+package journal
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ dataRetriever.StorageService = (*journaledStorageService)(nil)
+
+var log = logger.GetOrCreate("dataRetriever/journal")
+
+// ArgsJournaledStorageService holds the arguments needed to create a journaled storage service
+type ArgsJournaledStorageService struct {
+	StorageService   dataRetriever.StorageService
+	DirectoryPath    string
+	NumRecordsToKeep uint32
+}
+
+// journaledStorageService wraps a storage service so the writes done while committing a block are first recorded
+// in a journal and only afterwards applied on the storage units. The journal records of the last commits are kept
+// and replayed on startup, as the storage units persist their writes in batches that can be lost on a crash. The
+// writes done on the trie storages while committing the state are recorded in the same journal records
+type journaledStorageService struct {
+	dataRetriever.StorageService
+	directoryPath    string
+	numRecordsToKeep uint32
+
+	mutCommit     sync.RWMutex
+	commitStarted bool
+	pendingWrites []journalWrite
+	pendingValues map[dataRetriever.UnitType]map[string][]byte
+	lastSequence  uint64
+	epoch         uint32
+	trieStorers   map[dataRetriever.UnitType]data.DBWriteCacher
+	recordsKeys   map[uint64]*recordKeys
+}
+
+// NewJournaledStorageService creates a new journaled storage service
+func NewJournaledStorageService(args ArgsJournaledStorageService) (*journaledStorageService, error) {
+	if check.IfNil(args.StorageService) {
+		return nil, dataRetriever.ErrNilStore
+	}
+	if len(args.DirectoryPath) == 0 {
+		return nil, ErrEmptyDirectoryPath
+	}
+	if args.NumRecordsToKeep == 0 {
+		return nil, ErrInvalidNumRecordsToKeep
+	}
+
+	err := os.MkdirAll(args.DirectoryPath, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences, err := getRecordsSequences(args.DirectoryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	jss := &journaledStorageService{
+		StorageService:   args.StorageService,
+		directoryPath:    args.DirectoryPath,
+		numRecordsToKeep: args.NumRecordsToKeep,
+		pendingValues:    make(map[dataRetriever.UnitType]map[string][]byte),
+		trieStorers:      make(map[dataRetriever.UnitType]data.DBWriteCacher),
+		recordsKeys:      make(map[uint64]*recordKeys),
+	}
+	if len(sequences) > 0 {
+		jss.lastSequence = sequences[len(sequences)-1]
+	}
+	jss.loadRecordsKeys(sequences)
+
+	return jss, nil
+}
+
+// loadRecordsKeys indexes the keys written by the kept journal records. The records that can not be read are
+// skipped, as they are discarded when the pending commits are replayed
+func (jss *journaledStorageService) loadRecordsKeys(sequences []uint64) {
+	for _, sequence := range sequences {
+		record, err := readRecordFile(jss.directoryPath, sequence)
+		if err != nil {
+			continue
+		}
+
+		jss.recordsKeys[sequence] = newRecordKeys(record)
+	}
+}
+
+// BeginCommit starts recording the writes done on the storage units
+func (jss *journaledStorageService) BeginCommit() {
+	jss.mutCommit.Lock()
+	defer jss.mutCommit.Unlock()
+
+	if jss.commitStarted {
+		log.Debug("journal: a commit was started without finishing the previous one")
+		jss.applyPendingWrites()
+	}
+
+	jss.resetPendingWrites()
+	jss.commitStarted = true
+}
+
+// FinishCommit persists a journal record holding the writes done since the commit was started and then applies them
+// on the storage units. If the record can not be persisted, the commit is aborted without applying the writes, so
+// the caller can revert the block instead of ending up with units that can not be recovered after a crash. If the
+// writes can not be applied, the record is removed, as the caller reverts the block and the commit must not be
+// replayed on the next startup
+func (jss *journaledStorageService) FinishCommit(nonce uint64, headerHash []byte) error {
+	jss.mutCommit.Lock()
+	defer jss.mutCommit.Unlock()
+
+	if !jss.commitStarted {
+		return ErrCommitNotStarted
+	}
+
+	writes := jss.pendingWrites
+	jss.resetPendingWrites()
+	jss.commitStarted = false
+
+	record := &journalRecord{
+		Nonce:      nonce,
+		Epoch:      jss.epoch,
+		HeaderHash: headerHash,
+		Writes:     writes,
+	}
+
+	sequence := jss.lastSequence + 1
+	err := writeRecordFile(jss.directoryPath, sequence, record)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCannotPersistJournalRecord, err.Error())
+	}
+	jss.lastSequence = sequence
+
+	err = jss.applyWrites(writes)
+	if err != nil {
+		log.Debug("journal: removing the record of the failed commit", "sequence", sequence, "nonce", nonce)
+		jss.removeRecord(sequence)
+		return err
+	}
+
+	jss.recordsKeys[sequence] = newRecordKeys(record)
+	jss.removeOldRecords()
+
+	return nil
+}
+
+// AbortCommit stops recording the writes. The already recorded ones are directly applied on the storage units
+func (jss *journaledStorageService) AbortCommit() {
+	jss.mutCommit.Lock()
+	defer jss.mutCommit.Unlock()
+
+	if !jss.commitStarted {
+		return
+	}
+
+	jss.applyPendingWrites()
+	jss.resetPendingWrites()
+	jss.commitStarted = false
+}
+
+// ReplayPendingCommits applies again, in order, the writes of the kept journal records on the units of the storage
+// service. The writes on the units read by the epoch start bootstrapper are skipped, as they are replayed by
+// ReplayBootstrapWrites before the storage service is created. Records that were not completely written are
+// discarded, as none of their writes were applied on the storage units. The replay stops at the first write that can
+// not be applied, so the records are kept for the next startup
+func (jss *journaledStorageService) ReplayPendingCommits() error {
+	jss.mutCommit.Lock()
+	defer jss.mutCommit.Unlock()
+
+	sequences, err := getRecordsSequences(jss.directoryPath)
+	if err != nil {
+		return err
+	}
+
+	for _, sequence := range sequences {
+		record, errRead := readRecordFile(jss.directoryPath, sequence)
+		if errRead != nil {
+			log.Warn("journal: discarding incomplete commit", "sequence", sequence, "error", errRead.Error())
+			jss.removeRecord(sequence)
+			continue
+		}
+
+		log.Debug("journal: replaying commit",
+			"sequence", sequence,
+			"epoch", record.Epoch,
+			"nonce", record.Nonce,
+			"hash", record.HeaderHash,
+			"num writes", len(record.Writes))
+		err = jss.applyWrites(getWritesNotReplayedBeforeBootstrap(record.Writes))
+		if err != nil {
+			return fmt.Errorf("%w while replaying the commit of nonce %d", err, record.Nonce)
+		}
+	}
+
+	return nil
+}
+
+// GetStorer returns the storer from the chain map, wrapped so the writes recorded for the unit are also visible
+func (jss *journaledStorageService) GetStorer(unitType dataRetriever.UnitType) storage.Storer {
+	return jss.getJournaledStorer(unitType, false)
+}
+
+// SetEpochForPutOperation sets the epoch used by the storage units for the put operations. The epoch is also saved
+// in the journal records, as the units persist the writes of each epoch separately
+func (jss *journaledStorageService) SetEpochForPutOperation(epoch uint32) {
+	jss.mutCommit.Lock()
+	jss.epoch = epoch
+	jss.mutCommit.Unlock()
+
+	jss.StorageService.SetEpochForPutOperation(epoch)
+}
+
+// JournalTrieStorage returns the trie storage manager whose writes done while committing a block are recorded in the
+// journal, under the given unit
+func (jss *journaledStorageService) JournalTrieStorage(
+	unitType dataRetriever.UnitType,
+	storageManager data.StorageManager,
+) data.StorageManager {
+	if check.IfNil(storageManager) {
+		return storageManager
+	}
+
+	jss.mutCommit.Lock()
+	jss.trieStorers[unitType] = storageManager.Database()
+	jss.mutCommit.Unlock()
+
+	return &journaledTrieStorageManager{
+		StorageManager: storageManager,
+		database: &journaledTrieStorer{
+			DBWriteCacher: storageManager.Database(),
+			unitType:      unitType,
+			journal:       jss,
+		},
+	}
+}
+
+// CommitStorageService returns the view of the storage service used while committing blocks. Only the writes done
+// through this view are recorded in the journal, the ones done directly through the storage service, for example
+// by the interceptors or by the resolvers, are applied right away
+func (jss *journaledStorageService) CommitStorageService() dataRetriever.StorageService {
+	return &commitStorageService{
+		journaledStorageService: jss,
+	}
+}
+
+// Has returns true if the key is found in the selected unit or in the writes recorded for it
+func (jss *journaledStorageService) Has(unitType dataRetriever.UnitType, key []byte) error {
+	_, ok := jss.getPendingValue(unitType, key)
+	if ok {
+		return nil
+	}
+
+	return jss.StorageService.Has(unitType, key)
+}
+
+// Get returns the value for the given key from the writes recorded for the selected unit or from the unit itself
+func (jss *journaledStorageService) Get(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	value, ok := jss.getPendingValue(unitType, key)
+	if ok {
+		return value, nil
+	}
+
+	return jss.StorageService.Get(unitType, key)
+}
+
+// GetAll gets all the elements with keys in the keys array, from the recorded writes or from the selected unit
+func (jss *journaledStorageService) GetAll(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(keys))
+	missingKeys := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, ok := jss.getPendingValue(unitType, key)
+		if ok {
+			values[string(key)] = value
+			continue
+		}
+
+		missingKeys = append(missingKeys, key)
+	}
+	if len(missingKeys) == 0 {
+		return values, nil
+	}
+
+	storedValues, err := jss.StorageService.GetAll(unitType, missingKeys)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range storedValues {
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+func (jss *journaledStorageService) getJournaledStorer(unitType dataRetriever.UnitType, recordWrites bool) storage.Storer {
+	storer := jss.StorageService.GetStorer(unitType)
+	if check.IfNil(storer) {
+		return storer
+	}
+
+	return &journaledStorer{
+		Storer:       storer,
+		unitType:     unitType,
+		journal:      jss,
+		recordWrites: recordWrites,
+	}
+}
+
+func (jss *journaledStorageService) recordWrite(unitType dataRetriever.UnitType, key []byte, value []byte) bool {
+	jss.mutCommit.Lock()
+	defer jss.mutCommit.Unlock()
+
+	if !jss.commitStarted {
+		return false
+	}
+
+	jss.pendingWrites = append(jss.pendingWrites, journalWrite{
+		Unit:  unitType,
+		Key:   key,
+		Value: value,
+	})
+
+	unitValues, ok := jss.pendingValues[unitType]
+	if !ok {
+		unitValues = make(map[string][]byte)
+		jss.pendingValues[unitType] = unitValues
+	}
+	unitValues[string(key)] = value
+
+	return true
+}
+
+func (jss *journaledStorageService) getPendingValue(unitType dataRetriever.UnitType, key []byte) ([]byte, bool) {
+	jss.mutCommit.RLock()
+	defer jss.mutCommit.RUnlock()
+
+	value, ok := jss.pendingValues[unitType][string(key)]
+
+	return value, ok
+}
+
+// removeKey drops the key from the recorded writes and also removes the kept journal records holding a write of the
+// key. Removals are done when reverting or rolling back blocks, so those records must not be replayed anymore
+func (jss *journaledStorageService) removeKey(unitType dataRetriever.UnitType, key []byte) {
+	jss.mutCommit.Lock()
+	defer jss.mutCommit.Unlock()
+
+	jss.dropPendingWrite(unitType, key)
+
+	for sequence, keys := range jss.recordsKeys {
+		if !keys.hasKey(unitType, key) {
+			continue
+		}
+
+		log.Debug("journal: dropping record holding a removed key",
+			"sequence", sequence,
+			"nonce", keys.nonce,
+			"unit", unitType,
+			"key", key)
+		jss.removeRecord(sequence)
+	}
+}
+
+func (jss *journaledStorageService) removePendingWrite(unitType dataRetriever.UnitType, key []byte) {
+	jss.mutCommit.Lock()
+	jss.dropPendingWrite(unitType, key)
+	jss.mutCommit.Unlock()
+}
+
+func (jss *journaledStorageService) dropPendingWrite(unitType dataRetriever.UnitType, key []byte) {
+	_, ok := jss.pendingValues[unitType][string(key)]
+	if !ok {
+		return
+	}
+
+	delete(jss.pendingValues[unitType], string(key))
+	jss.pendingWrites = removeWritesOfKey(jss.pendingWrites, unitType, key)
+}
+
+func (jss *journaledStorageService) applyPendingWrites() {
+	err := jss.applyWrites(jss.pendingWrites)
+	if err != nil {
+		log.Warn("journal: cannot apply the pending writes", "error", err.Error())
+	}
+}
+
+// applyWrites puts the writes on the storage units or on the trie storages, returning the first error encountered
+func (jss *journaledStorageService) applyWrites(writes []journalWrite) error {
+	for _, write := range writes {
+		err := jss.applyWrite(write)
+		if err != nil {
+			return fmt.Errorf("%w for unit %s, key %s", err, write.Unit.String(), hex.EncodeToString(write.Key))
+		}
+	}
+
+	return nil
+}
+
+func (jss *journaledStorageService) applyWrite(write journalWrite) error {
+	trieStorer, ok := jss.trieStorers[write.Unit]
+	if ok {
+		return trieStorer.Put(write.Key, write.Value)
+	}
+
+	return jss.StorageService.Put(write.Unit, write.Key, write.Value)
+}
+
+func (jss *journaledStorageService) resetPendingWrites() {
+	jss.pendingWrites = make([]journalWrite, 0)
+	jss.pendingValues = make(map[dataRetriever.UnitType]map[string][]byte)
+}
+
+func (jss *journaledStorageService) removeOldRecords() {
+	if jss.lastSequence <= uint64(jss.numRecordsToKeep) {
+		return
+	}
+
+	sequences, err := getRecordsSequences(jss.directoryPath)
+	if err != nil {
+		log.Debug("journal: cannot read records", "error", err.Error())
+		return
+	}
+
+	oldestSequenceToKeep := jss.lastSequence - uint64(jss.numRecordsToKeep) + 1
+	for _, sequence := range sequences {
+		if sequence >= oldestSequenceToKeep {
+			break
+		}
+
+		jss.removeRecord(sequence)
+	}
+}
+
+func (jss *journaledStorageService) removeRecord(sequence uint64) {
+	removeRecordFile(jss.directoryPath, sequence)
+	delete(jss.recordsKeys, sequence)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jss *journaledStorageService) IsInterfaceNil() bool {
+	return jss == nil
+}
+
+// commitStorageService is the view of the journaled storage service which records the writes while a commit is
+// in progress
+type commitStorageService struct {
+	*journaledStorageService
+}
+
+// GetStorer returns the storer from the chain map, wrapped so its writes are recorded while committing a block
+func (css *commitStorageService) GetStorer(unitType dataRetriever.UnitType) storage.Storer {
+	return css.getJournaledStorer(unitType, true)
+}
+
+// Put records the key, value pair if a commit was started, otherwise it stores it in the selected unit
+func (css *commitStorageService) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	if css.recordWrite(unitType, key, value) {
+		return nil
+	}
+
+	return css.StorageService.Put(unitType, key, value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (css *commitStorageService) IsInterfaceNil() bool {
+	return css == nil || css.journaledStorageService == nil
+}
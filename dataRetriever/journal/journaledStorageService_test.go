@@ -0,0 +1,440 @@
+package journal
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStorageService() (dataRetriever.StorageService, *mock.StorerMock) {
+	storer := mock.NewStorerMock()
+	store := dataRetriever.NewChainStorer()
+	store.AddStorer(dataRetriever.BlockHeaderUnit, storer)
+
+	return store, storer
+}
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "journal")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func createMockArgs(t *testing.T) ArgsJournaledStorageService {
+	store, _ := createStorageService()
+
+	return ArgsJournaledStorageService{
+		StorageService:   store,
+		DirectoryPath:    createTempDir(t),
+		NumRecordsToKeep: 2,
+	}
+}
+
+func TestNewJournaledStorageService_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	args.StorageService = nil
+
+	jss, err := NewJournaledStorageService(args)
+	assert.Nil(t, jss)
+	assert.Equal(t, dataRetriever.ErrNilStore, err)
+}
+
+func TestNewJournaledStorageService_EmptyDirectoryPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	args.DirectoryPath = ""
+
+	jss, err := NewJournaledStorageService(args)
+	assert.Nil(t, jss)
+	assert.Equal(t, ErrEmptyDirectoryPath, err)
+}
+
+func TestNewJournaledStorageService_InvalidNumRecordsToKeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	args.NumRecordsToKeep = 0
+
+	jss, err := NewJournaledStorageService(args)
+	assert.Nil(t, jss)
+	assert.Equal(t, ErrInvalidNumRecordsToKeep, err)
+}
+
+func TestNewJournaledStorageService_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+
+	jss, err := NewJournaledStorageService(args)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(jss))
+}
+
+func TestJournaledStorageService_PutOutsideCommitShouldWriteDirectly(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+
+	err := jss.GetStorer(dataRetriever.BlockHeaderUnit).Put([]byte("key"), []byte("value"))
+	assert.Nil(t, err)
+
+	value, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestJournaledStorageService_PutDuringCommitShouldBufferUntilFinish(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+
+	commitStore := jss.CommitStorageService()
+	jss.BeginCommit()
+	err := commitStore.GetStorer(dataRetriever.BlockHeaderUnit).Put([]byte("key1"), []byte("value1"))
+	assert.Nil(t, err)
+	err = commitStore.Put(dataRetriever.BlockHeaderUnit, []byte("key2"), []byte("value2"))
+	assert.Nil(t, err)
+
+	_, err = storer.Get([]byte("key1"))
+	assert.NotNil(t, err)
+
+	value, err := jss.GetStorer(dataRetriever.BlockHeaderUnit).Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	value, err = jss.Get(dataRetriever.BlockHeaderUnit, []byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	err = jss.FinishCommit(1, []byte("hash"))
+	assert.Nil(t, err)
+
+	value, err = storer.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	value, err = storer.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{1}, sequences)
+}
+
+func TestJournaledStorageService_FinishCommitNotStartedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	jss, _ := NewJournaledStorageService(args)
+
+	err := jss.FinishCommit(1, []byte("hash"))
+	assert.Equal(t, ErrCommitNotStarted, err)
+}
+
+func TestJournaledStorageService_AbortCommitShouldApplyWritesWithoutRecord(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+
+	jss.BeginCommit()
+	_ = jss.CommitStorageService().Put(dataRetriever.BlockHeaderUnit, []byte("key"), []byte("value"))
+	jss.AbortCommit()
+
+	value, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, 0, len(sequences))
+}
+
+func TestJournaledStorageService_FinishCommitShouldKeepOnlyTheLastRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	jss, _ := NewJournaledStorageService(args)
+
+	for i := uint64(1); i <= 5; i++ {
+		jss.BeginCommit()
+		_ = jss.CommitStorageService().Put(dataRetriever.BlockHeaderUnit, []byte("key"), []byte("value"))
+		err := jss.FinishCommit(i, []byte("hash"))
+		assert.Nil(t, err)
+	}
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{4, 5}, sequences)
+}
+
+func TestJournaledStorageService_ReplayPendingCommitsShouldApplyValidRecordsAndDiscardCorrupted(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+
+	record := &journalRecord{
+		Nonce:      1,
+		HeaderHash: []byte("hash"),
+		Writes: []journalWrite{
+			{Unit: dataRetriever.BlockHeaderUnit, Key: []byte("key1"), Value: []byte("value1")},
+		},
+	}
+	err := writeRecordFile(args.DirectoryPath, 1, record)
+	require.Nil(t, err)
+	err = ioutil.WriteFile(recordFileName(args.DirectoryPath, 2), []byte("corrupted record"), rwOwner)
+	require.Nil(t, err)
+	err = ioutil.WriteFile(recordFileName(args.DirectoryPath, 3)+tempFileExtension, []byte("partial"), rwOwner)
+	require.Nil(t, err)
+
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+
+	err = jss.ReplayPendingCommits()
+	assert.Nil(t, err)
+
+	value, err := storer.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{1}, sequences)
+	_, err = os.Stat(recordFileName(args.DirectoryPath, 3) + tempFileExtension)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestJournaledStorageService_NewShouldContinueSequenceFromExistingRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+
+	err := writeRecordFile(args.DirectoryPath, 7, &journalRecord{})
+	require.Nil(t, err)
+
+	jss, _ := NewJournaledStorageService(args)
+	jss.BeginCommit()
+	_ = jss.FinishCommit(1, []byte("hash"))
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{7, 8}, sequences)
+}
+
+func TestJournaledStorageService_PutDuringCommitOutsideTheCommitViewShouldWriteDirectly(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+
+	jss.BeginCommit()
+	err := jss.GetStorer(dataRetriever.BlockHeaderUnit).Put([]byte("key1"), []byte("value1"))
+	assert.Nil(t, err)
+	err = jss.Put(dataRetriever.BlockHeaderUnit, []byte("key2"), []byte("value2"))
+	assert.Nil(t, err)
+
+	value, err := storer.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	value, err = storer.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	err = jss.FinishCommit(1, []byte("hash"))
+	assert.Nil(t, err)
+
+	record, err := readRecordFile(args.DirectoryPath, 1)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(record.Writes))
+}
+
+func TestJournaledStorageService_RemoveShouldDropPendingWriteAndRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+	commitStore := jss.CommitStorageService()
+
+	jss.BeginCommit()
+	_ = commitStore.Put(dataRetriever.BlockHeaderUnit, []byte("key1"), []byte("value1"))
+	_ = jss.FinishCommit(1, []byte("hash"))
+
+	jss.BeginCommit()
+	_ = commitStore.Put(dataRetriever.BlockHeaderUnit, []byte("key2"), []byte("value2"))
+	err := jss.GetStorer(dataRetriever.BlockHeaderUnit).Remove([]byte("key2"))
+	assert.Nil(t, err)
+	_ = jss.FinishCommit(2, []byte("hash"))
+
+	_, err = storer.Get([]byte("key2"))
+	assert.NotNil(t, err)
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{1, 2}, sequences)
+	record, err := readRecordFile(args.DirectoryPath, 2)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(record.Writes))
+
+	err = jss.GetStorer(dataRetriever.BlockHeaderUnit).Remove([]byte("key1"))
+	assert.Nil(t, err)
+
+	sequences, _ = getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{2}, sequences)
+}
+
+func TestJournaledStorageService_FinishCommitRecordNotPersistedShouldNotApplyTheWrites(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	store, storer := createStorageService()
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+
+	jss.BeginCommit()
+	_ = jss.CommitStorageService().Put(dataRetriever.BlockHeaderUnit, []byte("key"), []byte("value"))
+	err := os.RemoveAll(args.DirectoryPath)
+	require.Nil(t, err)
+
+	err = jss.FinishCommit(1, []byte("hash"))
+	assert.True(t, errors.Is(err, ErrCannotPersistJournalRecord))
+
+	_, err = storer.Get([]byte("key"))
+	assert.NotNil(t, err)
+	_, err = jss.Get(dataRetriever.BlockHeaderUnit, []byte("key"))
+	assert.NotNil(t, err)
+
+	jss.AbortCommit()
+	_, err = storer.Get([]byte("key"))
+	assert.NotNil(t, err)
+}
+
+func TestJournaledStorageService_FinishCommitApplyFailedShouldRemoveTheRecord(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	expectedErr := errors.New("expected error")
+	args.StorageService = &mock.ChainStorerMock{
+		PutCalled: func(_ dataRetriever.UnitType, _ []byte, _ []byte) error {
+			return expectedErr
+		},
+	}
+	jss, _ := NewJournaledStorageService(args)
+
+	jss.BeginCommit()
+	_ = jss.CommitStorageService().Put(dataRetriever.BlockHeaderUnit, []byte("key"), []byte("value"))
+	err := jss.FinishCommit(1, []byte("hash"))
+	assert.True(t, errors.Is(err, expectedErr))
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, 0, len(sequences))
+
+	store, storer := createStorageService()
+	args.StorageService = store
+	restartedJss, _ := NewJournaledStorageService(args)
+
+	err = restartedJss.ReplayPendingCommits()
+	assert.Nil(t, err)
+
+	_, err = storer.Get([]byte("key"))
+	assert.NotNil(t, err)
+
+	restartedJss.BeginCommit()
+	err = restartedJss.FinishCommit(2, []byte("hash"))
+	assert.Nil(t, err)
+
+	sequences, _ = getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{1}, sequences)
+}
+
+func TestJournaledStorageService_RemoveShouldDropRecordsKeptBeforeRestart(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+
+	record := &journalRecord{
+		Nonce:      1,
+		HeaderHash: []byte("hash"),
+		Writes: []journalWrite{
+			{Unit: dataRetriever.BlockHeaderUnit, Key: []byte("key1"), Value: []byte("value1")},
+		},
+	}
+	err := writeRecordFile(args.DirectoryPath, 1, record)
+	require.Nil(t, err)
+	record.Nonce = 2
+	record.Writes[0].Key = []byte("key2")
+	err = writeRecordFile(args.DirectoryPath, 2, record)
+	require.Nil(t, err)
+
+	jss, _ := NewJournaledStorageService(args)
+
+	err = jss.GetStorer(dataRetriever.BlockHeaderUnit).Remove([]byte("key1"))
+	assert.Nil(t, err)
+
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{2}, sequences)
+}
@@ -0,0 +1,98 @@
+package journal
+
+import (
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Storer = (*journaledStorer)(nil)
+
+// journaledStorer is the storer of a unit, as returned by the journaled storage service. Only the storers of the
+// commit view record their writes
+type journaledStorer struct {
+	storage.Storer
+	unitType     dataRetriever.UnitType
+	journal      *journaledStorageService
+	recordWrites bool
+}
+
+// Put records the key, value pair if it belongs to a started commit, otherwise it stores it in the unit
+func (js *journaledStorer) Put(key, data []byte) error {
+	if js.recordWrites && js.journal.recordWrite(js.unitType, key, data) {
+		return nil
+	}
+
+	return js.Storer.Put(key, data)
+}
+
+// Get returns the value for the given key from the recorded writes or from the unit
+func (js *journaledStorer) Get(key []byte) ([]byte, error) {
+	value, ok := js.journal.getPendingValue(js.unitType, key)
+	if ok {
+		return value, nil
+	}
+
+	return js.Storer.Get(key)
+}
+
+// Has checks if the key is in the recorded writes or in the unit
+func (js *journaledStorer) Has(key []byte) error {
+	_, ok := js.journal.getPendingValue(js.unitType, key)
+	if ok {
+		return nil
+	}
+
+	return js.Storer.Has(key)
+}
+
+// SearchFirst returns the value for the given key from the recorded writes or searches it in the unit
+func (js *journaledStorer) SearchFirst(key []byte) ([]byte, error) {
+	value, ok := js.journal.getPendingValue(js.unitType, key)
+	if ok {
+		return value, nil
+	}
+
+	return js.Storer.SearchFirst(key)
+}
+
+// GetFromEpoch returns the value for the given key from the recorded writes or from the unit, in the given epoch
+func (js *journaledStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
+	value, ok := js.journal.getPendingValue(js.unitType, key)
+	if ok {
+		return value, nil
+	}
+
+	return js.Storer.GetFromEpoch(key, epoch)
+}
+
+// HasInEpoch checks if the key is in the recorded writes or in the unit, in the given epoch
+func (js *journaledStorer) HasInEpoch(key []byte, epoch uint32) error {
+	_, ok := js.journal.getPendingValue(js.unitType, key)
+	if ok {
+		return nil
+	}
+
+	return js.Storer.HasInEpoch(key, epoch)
+}
+
+// Remove removes the key from the recorded writes and from the unit
+func (js *journaledStorer) Remove(key []byte) error {
+	js.journal.removeKey(js.unitType, key)
+
+	return js.Storer.Remove(key)
+}
+
+// SetEpochForPutOperation will set the epoch to be used for the put operation, if the unit supports it
+func (js *journaledStorer) SetEpochForPutOperation(epoch uint32) {
+	storerWithPutInEpoch, ok := js.Storer.(storage.StorerWithPutInEpoch)
+	if !ok {
+		return
+	}
+
+	storerWithPutInEpoch.SetEpochForPutOperation(epoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (js *journaledStorer) IsInterfaceNil() bool {
+	return js == nil
+}
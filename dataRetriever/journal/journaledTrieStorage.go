@@ -0,0 +1,65 @@
+package journal
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ data.StorageManager = (*journaledTrieStorageManager)(nil)
+var _ data.DBWriteCacher = (*journaledTrieStorer)(nil)
+
+// journaledTrieStorageManager is the trie storage manager returned by the journaled storage service. The tries
+// created on it write their nodes through the journaled database
+type journaledTrieStorageManager struct {
+	data.StorageManager
+	database *journaledTrieStorer
+}
+
+// Database returns the journaled database of the trie storage
+func (jtsm *journaledTrieStorageManager) Database() data.DBWriteCacher {
+	return jtsm.database
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jtsm *journaledTrieStorageManager) IsInterfaceNil() bool {
+	return jtsm == nil
+}
+
+// journaledTrieStorer records the trie nodes written while committing a block
+type journaledTrieStorer struct {
+	data.DBWriteCacher
+	unitType dataRetriever.UnitType
+	journal  *journaledStorageService
+}
+
+// Put records the trie node if a commit was started, otherwise it stores it in the trie storage
+func (jts *journaledTrieStorer) Put(key, val []byte) error {
+	if jts.journal.recordWrite(jts.unitType, key, val) {
+		return nil
+	}
+
+	return jts.DBWriteCacher.Put(key, val)
+}
+
+// Get returns the trie node from the recorded writes or from the trie storage
+func (jts *journaledTrieStorer) Get(key []byte) ([]byte, error) {
+	value, ok := jts.journal.getPendingValue(jts.unitType, key)
+	if ok {
+		return value, nil
+	}
+
+	return jts.DBWriteCacher.Get(key)
+}
+
+// Remove removes the trie node from the recorded writes and from the trie storage. Unlike the storage units, the
+// kept journal records are not changed, as replaying a pruned trie node only leaves an unreferenced node in storage
+func (jts *journaledTrieStorer) Remove(key []byte) error {
+	jts.journal.removePendingWrite(jts.unitType, key)
+
+	return jts.DBWriteCacher.Remove(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jts *journaledTrieStorer) IsInterfaceNil() bool {
+	return jts == nil
+}
@@ -0,0 +1,171 @@
+package journal
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxTrieLevelInMemory = 5
+
+func createJournaledTrie(t *testing.T, jss *journaledStorageService, db data.DBWriteCacher) data.Trie {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+
+	journaledStorageManager := jss.JournalTrieStorage(dataRetriever.UserAccountsUnit, storageManager)
+	tr, err := trie.NewTrie(journaledStorageManager, &marshal.GogoProtoMarshalizer{}, &mock.HasherMock{}, maxTrieLevelInMemory)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func TestJournaledStorageService_TrieCommitShouldBeRecordedAndAppliedOnFinish(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	jss, _ := NewJournaledStorageService(args)
+	db := memorydb.New()
+	tr := createJournaledTrie(t, jss, db)
+
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	jss.BeginCommit()
+	err := tr.Commit()
+	require.Nil(t, err)
+	rootHash, _ := tr.Root()
+
+	_, err = db.Get(rootHash)
+	assert.NotNil(t, err)
+	value, err := tr.Database().Get(rootHash)
+	assert.Nil(t, err)
+	assert.NotNil(t, value)
+
+	err = jss.FinishCommit(1, []byte("hash"))
+	assert.Nil(t, err)
+
+	_, err = db.Get(rootHash)
+	assert.Nil(t, err)
+	record, err := readRecordFile(args.DirectoryPath, 1)
+	require.Nil(t, err)
+	require.True(t, len(record.Writes) > 0)
+	for _, write := range record.Writes {
+		assert.Equal(t, dataRetriever.UserAccountsUnit, write.Unit)
+	}
+}
+
+func TestJournaledStorageService_TrieRemoveShouldNotDropTheRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+	jss, _ := NewJournaledStorageService(args)
+	db := memorydb.New()
+	tr := createJournaledTrie(t, jss, db)
+
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	jss.BeginCommit()
+	_ = tr.Commit()
+	_ = jss.FinishCommit(1, []byte("hash"))
+	rootHash, _ := tr.Root()
+
+	jss.BeginCommit()
+	_ = tr.Database().Put([]byte("key"), []byte("value"))
+	err := tr.Database().Remove([]byte("key"))
+	assert.Nil(t, err)
+	err = tr.Database().Remove(rootHash)
+	assert.Nil(t, err)
+	_ = jss.FinishCommit(2, []byte("hash"))
+
+	_, err = db.Get([]byte("key"))
+	assert.NotNil(t, err)
+	sequences, _ := getRecordsSequences(args.DirectoryPath)
+	assert.Equal(t, []uint64{1, 2}, sequences)
+	record, _ := readRecordFile(args.DirectoryPath, 2)
+	assert.Equal(t, 0, len(record.Writes))
+}
+
+// The node crashes after the journal record of a commit is persisted, before the storage units and the trie storage
+// flush their batches. On restart, the units read by the bootstrapper and the ones of the storage service are
+// restored from the journal
+func TestJournaledStorageService_CrashAfterRecordPersistedShouldRecoverAllUnitsOnReplay(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	defer func() {
+		_ = os.RemoveAll(args.DirectoryPath)
+	}()
+
+	store, _ := createStorageService()
+	store.AddStorer(dataRetriever.BootstrapUnit, mock.NewStorerMock())
+	args.StorageService = store
+	jss, _ := NewJournaledStorageService(args)
+	tr := createJournaledTrie(t, jss, memorydb.New())
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+
+	jss.SetEpochForPutOperation(3)
+	jss.BeginCommit()
+	commitStore := jss.CommitStorageService()
+	_ = commitStore.Put(dataRetriever.BlockHeaderUnit, []byte("header hash"), []byte("header"))
+	_ = commitStore.GetStorer(dataRetriever.BootstrapUnit).Put([]byte("round"), []byte("boot data"))
+	err := tr.Commit()
+	require.Nil(t, err)
+	rootHash, _ := tr.Root()
+	err = jss.FinishCommit(7, []byte("header hash"))
+	require.Nil(t, err)
+
+	// restart with all the data written by the previous run lost, except the journal
+	persisters := make(map[string]storage.Persister)
+	persisterFactory := &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			persister := memorydb.New()
+			persisters[path] = persister
+			return persister, nil
+		},
+	}
+	argsReplay := createMockArgsBootstrapReplay(args.DirectoryPath, persisterFactory)
+	err = ReplayBootstrapWrites(argsReplay)
+	require.Nil(t, err)
+
+	bootValue, err := persisters["boot_3"].Get([]byte("round"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("boot data"), bootValue)
+
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(persisters["user_accounts"])
+	emptyTrie, _ := trie.NewTrie(storageManager, &marshal.GogoProtoMarshalizer{}, &mock.HasherMock{}, maxTrieLevelInMemory)
+	recoveredTrie, err := emptyTrie.Recreate(rootHash)
+	require.Nil(t, err)
+	value, err := recoveredTrie.Get([]byte("dog"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("puppy"), value)
+	value, err = recoveredTrie.Get([]byte("doe"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("reindeer"), value)
+
+	restartedStore, headersStorer := createStorageService()
+	bootStorer := mock.NewStorerMock()
+	restartedStore.AddStorer(dataRetriever.BootstrapUnit, bootStorer)
+	args.StorageService = restartedStore
+	restartedJss, _ := NewJournaledStorageService(args)
+	err = restartedJss.ReplayPendingCommits()
+	require.Nil(t, err)
+
+	header, err := headersStorer.Get([]byte("header hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("header"), header)
+	_, err = bootStorer.Get([]byte("round"))
+	assert.NotNil(t, err)
+}
@@ -68,6 +68,11 @@ func (hsrs *HashSliceResolverStub) SetResolverDebugHandler(handler dataRetriever
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (hsrs *HashSliceResolverStub) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hsrs *HashSliceResolverStub) IsInterfaceNil() bool {
 	return hsrs == nil
@@ -0,0 +1,20 @@
+package mock
+
+// PeerHonestyScoreProviderStub -
+type PeerHonestyScoreProviderStub struct {
+	GetScoreCalled func(pk string) float64
+}
+
+// GetScore -
+func (phsps *PeerHonestyScoreProviderStub) GetScore(pk string) float64 {
+	if phsps.GetScoreCalled != nil {
+		return phsps.GetScoreCalled(pk)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (phsps *PeerHonestyScoreProviderStub) IsInterfaceNil() bool {
+	return phsps == nil
+}
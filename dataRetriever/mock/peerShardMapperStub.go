@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// PeerShardMapperStub -
+type PeerShardMapperStub struct {
+	GetPeerInfoCalled func(pid core.PeerID) core.P2PPeerInfo
+}
+
+// GetPeerInfo -
+func (psms *PeerShardMapperStub) GetPeerInfo(pid core.PeerID) core.P2PPeerInfo {
+	if psms.GetPeerInfoCalled != nil {
+		return psms.GetPeerInfoCalled(pid)
+	}
+
+	return core.P2PPeerInfo{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psms *PeerShardMapperStub) IsInterfaceNil() bool {
+	return psms == nil
+}
@@ -0,0 +1,38 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// PeersRatingHandlerStub -
+type PeersRatingHandlerStub struct {
+	RequestSentCalled       func(pid core.PeerID, topic string)
+	ResponseReceivedCalled  func(pid core.PeerID, topic string, isValid bool)
+	SortPeersByRatingCalled func(pids []core.PeerID) []core.PeerID
+}
+
+// RequestSent -
+func (prhs *PeersRatingHandlerStub) RequestSent(pid core.PeerID, topic string) {
+	if prhs.RequestSentCalled != nil {
+		prhs.RequestSentCalled(pid, topic)
+	}
+}
+
+// ResponseReceived -
+func (prhs *PeersRatingHandlerStub) ResponseReceived(pid core.PeerID, topic string, isValid bool) {
+	if prhs.ResponseReceivedCalled != nil {
+		prhs.ResponseReceivedCalled(pid, topic, isValid)
+	}
+}
+
+// SortPeersByRating -
+func (prhs *PeersRatingHandlerStub) SortPeersByRating(pids []core.PeerID) []core.PeerID {
+	if prhs.SortPeersByRatingCalled != nil {
+		return prhs.SortPeersByRatingCalled(pids)
+	}
+
+	return pids
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prhs *PeersRatingHandlerStub) IsInterfaceNil() bool {
+	return prhs == nil
+}
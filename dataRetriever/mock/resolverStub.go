@@ -50,6 +50,11 @@ func (rs *ResolverStub) SetResolverDebugHandler(handler dataRetriever.ResolverDe
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (rs *ResolverStub) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rs *ResolverStub) IsInterfaceNil() bool {
 	return rs == nil
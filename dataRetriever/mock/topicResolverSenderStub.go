@@ -80,6 +80,11 @@ func (trss *TopicResolverSenderStub) SetResolverDebugHandler(handler dataRetriev
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (trss *TopicResolverSenderStub) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (trss *TopicResolverSenderStub) IsInterfaceNil() bool {
 	return trss == nil
@@ -0,0 +1,29 @@
+package peersRating
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+type disabledPeersRatingHandler struct {
+}
+
+// NewDisabledPeersRatingHandler returns a disabled instance of the peers rating handler
+func NewDisabledPeersRatingHandler() *disabledPeersRatingHandler {
+	return &disabledPeersRatingHandler{}
+}
+
+// RequestSent does nothing
+func (dprh *disabledPeersRatingHandler) RequestSent(_ core.PeerID, _ string) {
+}
+
+// ResponseReceived does nothing
+func (dprh *disabledPeersRatingHandler) ResponseReceived(_ core.PeerID, _ string, _ bool) {
+}
+
+// SortPeersByRating returns the provided peers, unchanged
+func (dprh *disabledPeersRatingHandler) SortPeersByRating(pids []core.PeerID) []core.PeerID {
+	return pids
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dprh *disabledPeersRatingHandler) IsInterfaceNil() bool {
+	return dprh == nil
+}
@@ -0,0 +1,9 @@
+package peersRating
+
+import "time"
+
+func (prh *peersRatingHandler) SetTimeHandler(handler func() time.Time) {
+	prh.mut.Lock()
+	prh.getTimeHandler = handler
+	prh.mut.Unlock()
+}
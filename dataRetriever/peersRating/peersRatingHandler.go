@@ -0,0 +1,315 @@
+package peersRating
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ dataRetriever.PeersRatingHandler = (*peersRatingHandler)(nil)
+
+// smoothingFactor is the weight of the newest sample in the exponentially weighted moving averages kept for each peer
+const smoothingFactor = 0.2
+
+// neutralRate is the initial value of the response and validity rates. It allows a new peer to be preferred over a
+// peer that is known to fail, but not over a peer that is known to answer correctly
+const neutralRate = 0.5
+
+// maxPendingRequestsPerTopic bounds the number of requests waiting for a response from a peer on a topic
+const maxPendingRequestsPerTopic = 100
+
+// ArgPeersRatingHandler is the argument structure used to create a new peers rating handler
+type ArgPeersRatingHandler struct {
+	Config                   config.PeersRatingConfig
+	PeerHonestyConfig        config.PeerHonestyConfig
+	Cache                    storage.Cacher
+	PeerHonestyScoreProvider dataRetriever.PeerHonestyScoreProvider
+	PeerShardMapper          dataRetriever.PeerShardMapper
+}
+
+type peerRating struct {
+	pendingRequests map[string][]time.Time
+	responseRate    float64
+	validityRate    float64
+	latency         time.Duration
+	hasLatency      bool
+}
+
+type peersRatingHandler struct {
+	mut                      sync.Mutex
+	cache                    storage.Cacher
+	peerHonestyScoreProvider dataRetriever.PeerHonestyScoreProvider
+	peerShardMapper          dataRetriever.PeerShardMapper
+	responseTimeout          time.Duration
+	referenceLatency         time.Duration
+	responseRateWeight       float64
+	validityWeight           float64
+	latencyWeight            float64
+	honestyWeight            float64
+	minHonestyScore          float64
+	maxHonestyScore          float64
+	getTimeHandler           func() time.Time
+}
+
+// NewPeersRatingHandler creates a component able to rate the peers based on their response rate, on the validity of
+// the data they send and on their response latency, combined with the peer honesty score
+func NewPeersRatingHandler(arg ArgPeersRatingHandler) (*peersRatingHandler, error) {
+	err := checkArgs(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peersRatingHandler{
+		cache:                    arg.Cache,
+		peerHonestyScoreProvider: arg.PeerHonestyScoreProvider,
+		peerShardMapper:          arg.PeerShardMapper,
+		responseTimeout:          time.Duration(arg.Config.ResponseTimeoutInMilliseconds) * time.Millisecond,
+		referenceLatency:         time.Duration(arg.Config.ReferenceLatencyInMilliseconds) * time.Millisecond,
+		responseRateWeight:       arg.Config.ResponseRateWeight,
+		validityWeight:           arg.Config.ValidityWeight,
+		latencyWeight:            arg.Config.LatencyWeight,
+		honestyWeight:            arg.Config.HonestyWeight,
+		minHonestyScore:          arg.PeerHonestyConfig.MinScore,
+		maxHonestyScore:          arg.PeerHonestyConfig.MaxScore,
+		getTimeHandler:           time.Now,
+	}, nil
+}
+
+func checkArgs(arg ArgPeersRatingHandler) error {
+	if check.IfNil(arg.Cache) {
+		return dataRetriever.ErrNilCacher
+	}
+	if check.IfNil(arg.PeerHonestyScoreProvider) {
+		return dataRetriever.ErrNilPeerHonestyScoreProvider
+	}
+	if check.IfNil(arg.PeerShardMapper) {
+		return dataRetriever.ErrNilPeerShardMapper
+	}
+	if arg.Config.ResponseTimeoutInMilliseconds == 0 {
+		return fmt.Errorf("%w for ResponseTimeoutInMilliseconds", dataRetriever.ErrInvalidValue)
+	}
+	if arg.Config.ReferenceLatencyInMilliseconds == 0 {
+		return fmt.Errorf("%w for ReferenceLatencyInMilliseconds", dataRetriever.ErrInvalidValue)
+	}
+
+	weights := []float64{
+		arg.Config.ResponseRateWeight,
+		arg.Config.ValidityWeight,
+		arg.Config.LatencyWeight,
+		arg.Config.HonestyWeight,
+	}
+	sumWeights := 0.0
+	for _, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("%w, the peers rating weights should not be negative", dataRetriever.ErrInvalidValue)
+		}
+		sumWeights += weight
+	}
+	if sumWeights == 0 {
+		return fmt.Errorf("%w, at least one peers rating weight should be positive", dataRetriever.ErrInvalidValue)
+	}
+	if arg.PeerHonestyConfig.MaxScore <= arg.PeerHonestyConfig.MinScore {
+		return fmt.Errorf("%w, the peer honesty max score should be greater than the min score", dataRetriever.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+// RequestSent records that a request was sent to the provided peer on the provided topic
+func (prh *peersRatingHandler) RequestSent(pid core.PeerID, topic string) {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	now := prh.getTimeHandler()
+	pr := prh.getOrCreatePeerRating(pid)
+	prh.resolveTimedOutRequests(pr, now)
+
+	pending := append(pr.pendingRequests[topic], now)
+	if len(pending) > maxPendingRequestsPerTopic {
+		pending = pending[1:]
+		pr.responseRate = updateRate(pr.responseRate, false)
+	}
+	pr.pendingRequests[topic] = pending
+}
+
+// ResponseReceived records the response of the provided peer on the provided topic. The messages that do not
+// correspond to a pending request are ignored
+func (prh *peersRatingHandler) ResponseReceived(pid core.PeerID, topic string, isValid bool) {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	pr, found := prh.getPeerRating(pid)
+	if !found {
+		return
+	}
+
+	now := prh.getTimeHandler()
+	prh.resolveTimedOutRequests(pr, now)
+
+	pending := pr.pendingRequests[topic]
+	if len(pending) == 0 {
+		return
+	}
+	sentTime := pending[0]
+	pr.pendingRequests[topic] = pending[1:]
+
+	// an invalid response is as useless as a missing one, so it should neither improve the response rate nor the latency
+	pr.responseRate = updateRate(pr.responseRate, isValid)
+	pr.validityRate = updateRate(pr.validityRate, isValid)
+	if !isValid {
+		return
+	}
+
+	latency := now.Sub(sentTime)
+	if !pr.hasLatency {
+		pr.latency = latency
+		pr.hasLatency = true
+		return
+	}
+	pr.latency = time.Duration(float64(pr.latency)*(1-smoothingFactor) + float64(latency)*smoothingFactor)
+}
+
+// GetRating returns the rating of the provided peer, in the [0, 1] interval
+func (prh *peersRatingHandler) GetRating(pid core.PeerID) float64 {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	return prh.computeRating(pid)
+}
+
+// SortPeersByRating returns a copy of the provided peers, sorted from the best rated to the worst rated. The relative
+// order of the equally rated peers is kept
+func (prh *peersRatingHandler) SortPeersByRating(pids []core.PeerID) []core.PeerID {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	ratings := make(map[core.PeerID]float64, len(pids))
+	for _, pid := range pids {
+		ratings[pid] = prh.computeRating(pid)
+	}
+
+	sortedPids := make([]core.PeerID, len(pids))
+	copy(sortedPids, pids)
+	sort.SliceStable(sortedPids, func(i, j int) bool {
+		return ratings[sortedPids[i]] > ratings[sortedPids[j]]
+	})
+
+	return sortedPids
+}
+
+func (prh *peersRatingHandler) computeRating(pid core.PeerID) float64 {
+	responseRate := neutralRate
+	validityRate := neutralRate
+	latency := prh.referenceLatency
+
+	pr, found := prh.getPeerRating(pid)
+	if found {
+		prh.resolveTimedOutRequests(pr, prh.getTimeHandler())
+		responseRate = pr.responseRate
+		validityRate = pr.validityRate
+		if pr.hasLatency {
+			latency = pr.latency
+		}
+	}
+
+	latencyRate := 1 / (1 + float64(latency)/float64(prh.referenceLatency))
+	honestyRate := prh.computeHonestyRate(pid)
+
+	weightedSum := prh.responseRateWeight*responseRate +
+		prh.validityWeight*validityRate +
+		prh.latencyWeight*latencyRate +
+		prh.honestyWeight*honestyRate
+	sumWeights := prh.responseRateWeight + prh.validityWeight + prh.latencyWeight + prh.honestyWeight
+
+	return weightedSum / sumWeights
+}
+
+// computeHonestyRate maps the peer honesty score of the public key behind the provided peer in the [0, 1] interval.
+// Peers with unknown public keys get the rate of a 0 honesty score
+func (prh *peersRatingHandler) computeHonestyRate(pid core.PeerID) float64 {
+	score := 0.0
+	pkBytes := prh.peerShardMapper.GetPeerInfo(pid).PkBytes
+	if len(pkBytes) > 0 {
+		score = prh.peerHonestyScoreProvider.GetScore(string(pkBytes))
+	}
+
+	if score < prh.minHonestyScore {
+		score = prh.minHonestyScore
+	}
+	if score > prh.maxHonestyScore {
+		score = prh.maxHonestyScore
+	}
+
+	return (score - prh.minHonestyScore) / (prh.maxHonestyScore - prh.minHonestyScore)
+}
+
+// resolveTimedOutRequests counts as unanswered all the pending requests older than the response timeout
+func (prh *peersRatingHandler) resolveTimedOutRequests(pr *peerRating, now time.Time) {
+	for topic, pending := range pr.pendingRequests {
+		numTimedOut := 0
+		for _, sentTime := range pending {
+			if now.Sub(sentTime) < prh.responseTimeout {
+				break
+			}
+			numTimedOut++
+		}
+
+		for i := 0; i < numTimedOut; i++ {
+			pr.responseRate = updateRate(pr.responseRate, false)
+		}
+
+		if numTimedOut == len(pending) {
+			delete(pr.pendingRequests, topic)
+			continue
+		}
+		pr.pendingRequests[topic] = pending[numTimedOut:]
+	}
+}
+
+func (prh *peersRatingHandler) getPeerRating(pid core.PeerID) (*peerRating, bool) {
+	obj, found := prh.cache.Get(pid.Bytes())
+	if !found {
+		return nil, false
+	}
+
+	pr, ok := obj.(*peerRating)
+
+	return pr, ok
+}
+
+func (prh *peersRatingHandler) getOrCreatePeerRating(pid core.PeerID) *peerRating {
+	pr, found := prh.getPeerRating(pid)
+	if found {
+		return pr
+	}
+
+	pr = &peerRating{
+		pendingRequests: make(map[string][]time.Time),
+		responseRate:    neutralRate,
+		validityRate:    neutralRate,
+	}
+	prh.cache.Put(pid.Bytes(), pr, 0)
+
+	return pr
+}
+
+func updateRate(rate float64, isSuccess bool) float64 {
+	sample := 0.0
+	if isSuccess {
+		sample = 1
+	}
+
+	return rate*(1-smoothingFactor) + sample*smoothingFactor
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prh *peersRatingHandler) IsInterfaceNil() bool {
+	return prh == nil
+}
@@ -0,0 +1,260 @@
+package peersRating_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersRating"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTopic = "topic"
+
+func createMockArgPeersRatingHandler() peersRating.ArgPeersRatingHandler {
+	cache, _ := lrucache.NewCache(100)
+
+	return peersRating.ArgPeersRatingHandler{
+		Config: config.PeersRatingConfig{
+			Enabled:                        true,
+			ResponseTimeoutInMilliseconds:  1000,
+			ReferenceLatencyInMilliseconds: 100,
+			ResponseRateWeight:             0.4,
+			ValidityWeight:                 0.3,
+			LatencyWeight:                  0.2,
+			HonestyWeight:                  0.1,
+		},
+		PeerHonestyConfig: config.PeerHonestyConfig{
+			MinScore: -100,
+			MaxScore: 100,
+		},
+		Cache:                    cache,
+		PeerHonestyScoreProvider: &mock.PeerHonestyScoreProviderStub{},
+		PeerShardMapper:          &mock.PeerShardMapperStub{},
+	}
+}
+
+func TestNewPeersRatingHandler_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modifier    func(arg *peersRating.ArgPeersRatingHandler)
+		expectedErr error
+	}{
+		{
+			name:        "nil cache",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.Cache = nil },
+			expectedErr: dataRetriever.ErrNilCacher,
+		},
+		{
+			name:        "nil peer honesty score provider",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.PeerHonestyScoreProvider = nil },
+			expectedErr: dataRetriever.ErrNilPeerHonestyScoreProvider,
+		},
+		{
+			name:        "nil peer shard mapper",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.PeerShardMapper = nil },
+			expectedErr: dataRetriever.ErrNilPeerShardMapper,
+		},
+		{
+			name:        "zero response timeout",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.Config.ResponseTimeoutInMilliseconds = 0 },
+			expectedErr: dataRetriever.ErrInvalidValue,
+		},
+		{
+			name:        "zero reference latency",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.Config.ReferenceLatencyInMilliseconds = 0 },
+			expectedErr: dataRetriever.ErrInvalidValue,
+		},
+		{
+			name:        "negative weight",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.Config.LatencyWeight = -1 },
+			expectedErr: dataRetriever.ErrInvalidValue,
+		},
+		{
+			name: "all weights zero",
+			modifier: func(arg *peersRating.ArgPeersRatingHandler) {
+				arg.Config.ResponseRateWeight = 0
+				arg.Config.ValidityWeight = 0
+				arg.Config.LatencyWeight = 0
+				arg.Config.HonestyWeight = 0
+			},
+			expectedErr: dataRetriever.ErrInvalidValue,
+		},
+		{
+			name:        "invalid honesty scores",
+			modifier:    func(arg *peersRating.ArgPeersRatingHandler) { arg.PeerHonestyConfig.MinScore = 100 },
+			expectedErr: dataRetriever.ErrInvalidValue,
+		},
+	}
+
+	for _, tt := range tests {
+		arg := createMockArgPeersRatingHandler()
+		tt.modifier(&arg)
+
+		prh, err := peersRating.NewPeersRatingHandler(arg)
+		assert.True(t, check.IfNil(prh), tt.name)
+		assert.True(t, errors.Is(err, tt.expectedErr), tt.name)
+	}
+}
+
+func TestNewPeersRatingHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	prh, err := peersRating.NewPeersRatingHandler(createMockArgPeersRatingHandler())
+
+	assert.False(t, check.IfNil(prh))
+	assert.Nil(t, err)
+}
+
+func TestPeersRatingHandler_ResponsivePeerShouldBeRatedBetterThanSilentPeer(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeersRatingHandler()
+	prh, _ := peersRating.NewPeersRatingHandler(arg)
+	currentTime := time.Now()
+	prh.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	responsivePeer := core.PeerID("responsive")
+	silentPeer := core.PeerID("silent")
+	unknownPeer := core.PeerID("unknown")
+	for i := 0; i < 5; i++ {
+		prh.RequestSent(responsivePeer, testTopic)
+		prh.RequestSent(silentPeer, testTopic)
+		prh.ResponseReceived(responsivePeer, testTopic, true)
+	}
+
+	currentTime = currentTime.Add(time.Duration(arg.Config.ResponseTimeoutInMilliseconds) * time.Millisecond)
+
+	assert.True(t, prh.GetRating(responsivePeer) > prh.GetRating(unknownPeer))
+	assert.True(t, prh.GetRating(unknownPeer) > prh.GetRating(silentPeer))
+}
+
+func TestPeersRatingHandler_PeerSendingInvalidDataShouldBeRatedWorse(t *testing.T) {
+	t.Parallel()
+
+	prh, _ := peersRating.NewPeersRatingHandler(createMockArgPeersRatingHandler())
+
+	validPeer := core.PeerID("valid")
+	invalidPeer := core.PeerID("invalid")
+	for i := 0; i < 5; i++ {
+		prh.RequestSent(validPeer, testTopic)
+		prh.RequestSent(invalidPeer, testTopic)
+		prh.ResponseReceived(validPeer, testTopic, true)
+		prh.ResponseReceived(invalidPeer, testTopic, false)
+	}
+
+	assert.True(t, prh.GetRating(validPeer) > prh.GetRating(invalidPeer))
+}
+
+func TestPeersRatingHandler_FasterPeerShouldBeRatedBetter(t *testing.T) {
+	t.Parallel()
+
+	prh, _ := peersRating.NewPeersRatingHandler(createMockArgPeersRatingHandler())
+	currentTime := time.Now()
+	prh.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	fastPeer := core.PeerID("fast")
+	slowPeer := core.PeerID("slow")
+	prh.RequestSent(fastPeer, testTopic)
+	prh.RequestSent(slowPeer, testTopic)
+
+	currentTime = currentTime.Add(10 * time.Millisecond)
+	prh.ResponseReceived(fastPeer, testTopic, true)
+	currentTime = currentTime.Add(500 * time.Millisecond)
+	prh.ResponseReceived(slowPeer, testTopic, true)
+
+	assert.True(t, prh.GetRating(fastPeer) > prh.GetRating(slowPeer))
+}
+
+func TestPeersRatingHandler_UnrequestedResponsesShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	prh, _ := peersRating.NewPeersRatingHandler(createMockArgPeersRatingHandler())
+
+	pid := core.PeerID("pid")
+	initialRating := prh.GetRating(pid)
+
+	prh.ResponseReceived(pid, testTopic, false)
+	assert.Equal(t, initialRating, prh.GetRating(pid))
+
+	prh.RequestSent(pid, testTopic)
+	prh.ResponseReceived(pid, "other topic", false)
+	assert.Equal(t, initialRating, prh.GetRating(pid))
+}
+
+func TestPeersRatingHandler_HonestyScoreShouldBeTakenIntoAccount(t *testing.T) {
+	t.Parallel()
+
+	honestPid := core.PeerID("honest")
+	dishonestPid := core.PeerID("dishonest")
+	arg := createMockArgPeersRatingHandler()
+	arg.PeerShardMapper = &mock.PeerShardMapperStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return core.P2PPeerInfo{
+				PkBytes: []byte("pk " + pid),
+			}
+		},
+	}
+	arg.PeerHonestyScoreProvider = &mock.PeerHonestyScoreProviderStub{
+		GetScoreCalled: func(pk string) float64 {
+			if pk == "pk "+string(honestPid) {
+				return 1000
+			}
+
+			return -1000
+		},
+	}
+	prh, _ := peersRating.NewPeersRatingHandler(arg)
+
+	assert.True(t, prh.GetRating(honestPid) > prh.GetRating(dishonestPid))
+	assert.True(t, prh.GetRating(honestPid) <= 1)
+	assert.True(t, prh.GetRating(dishonestPid) >= 0)
+}
+
+func TestPeersRatingHandler_SortPeersByRatingShouldWork(t *testing.T) {
+	t.Parallel()
+
+	prh, _ := peersRating.NewPeersRatingHandler(createMockArgPeersRatingHandler())
+
+	goodPeer := core.PeerID("good")
+	badPeer := core.PeerID("bad")
+	newPeer1 := core.PeerID("new1")
+	newPeer2 := core.PeerID("new2")
+	for i := 0; i < 5; i++ {
+		prh.RequestSent(goodPeer, testTopic)
+		prh.RequestSent(badPeer, testTopic)
+		prh.ResponseReceived(goodPeer, testTopic, true)
+		prh.ResponseReceived(badPeer, testTopic, false)
+	}
+
+	pids := []core.PeerID{badPeer, newPeer1, goodPeer, newPeer2}
+	sortedPids := prh.SortPeersByRating(pids)
+
+	assert.Equal(t, []core.PeerID{goodPeer, newPeer1, newPeer2, badPeer}, sortedPids)
+	assert.Equal(t, []core.PeerID{badPeer, newPeer1, goodPeer, newPeer2}, pids)
+}
+
+func TestDisabledPeersRatingHandler_ShouldNotChangeThePeersOrder(t *testing.T) {
+	t.Parallel()
+
+	dprh := peersRating.NewDisabledPeersRatingHandler()
+	assert.False(t, check.IfNil(dprh))
+
+	pids := []core.PeerID{"pid2", "pid1", "pid3"}
+	dprh.RequestSent("pid1", testTopic)
+	dprh.ResponseReceived("pid1", testTopic, true)
+
+	assert.Equal(t, pids, dprh.SortPeersByRating(pids))
+}
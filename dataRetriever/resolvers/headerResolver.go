@@ -290,6 +290,11 @@ func (hdrRes *HeaderResolver) SetResolverDebugHandler(handler dataRetriever.Reso
 	return hdrRes.TopicResolverSender.SetResolverDebugHandler(handler)
 }
 
+// SetPeersRatingHandler will set the component used to rate the peers
+func (hdrRes *HeaderResolver) SetPeersRatingHandler(handler dataRetriever.PeersRatingHandler) error {
+	return hdrRes.TopicResolverSender.SetPeersRatingHandler(handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hdrRes *HeaderResolver) IsInterfaceNil() bool {
 	return hdrRes == nil
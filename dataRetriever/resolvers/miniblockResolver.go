@@ -235,6 +235,11 @@ func (mbRes *miniblockResolver) SetResolverDebugHandler(handler dataRetriever.Re
 	return mbRes.TopicResolverSender.SetResolverDebugHandler(handler)
 }
 
+// SetPeersRatingHandler will set the component used to rate the peers
+func (mbRes *miniblockResolver) SetPeersRatingHandler(handler dataRetriever.PeersRatingHandler) error {
+	return mbRes.TopicResolverSender.SetPeersRatingHandler(handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mbRes *miniblockResolver) IsInterfaceNil() bool {
 	return mbRes == nil
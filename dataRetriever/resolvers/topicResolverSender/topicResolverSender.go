@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/random"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersRating"
 	resolverDebug "github.com/ElrondNetwork/elrond-go/debug/resolver"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -47,6 +48,8 @@ type topicResolverSender struct {
 	numCrossShardPeers      int
 	mutResolverDebugHandler sync.RWMutex
 	resolverDebugHandler    dataRetriever.ResolverDebugHandler
+	mutPeersRatingHandler   sync.RWMutex
+	peersRatingHandler      dataRetriever.PeersRatingHandler
 }
 
 // NewTopicResolverSender returns a new topic resolver instance
@@ -91,6 +94,7 @@ func NewTopicResolverSender(arg ArgTopicResolverSender) (*topicResolverSender, e
 		numCrossShardPeers: arg.NumCrossShardPeers,
 	}
 	resolver.resolverDebugHandler = resolverDebug.NewDisabledInterceptorResolver()
+	resolver.peersRatingHandler = peersRating.NewDisabledPeersRatingHandler()
 
 	return resolver, nil
 }
@@ -147,16 +151,24 @@ func (trs *topicResolverSender) sendOnTopic(peerList []core.PeerID, topicToSendR
 
 	indexes := createIndexList(len(peerList))
 	shuffledIndexes := random.FisherYatesShuffle(indexes, trs.randomizer)
+	shuffledPeers := make([]core.PeerID, 0, len(shuffledIndexes))
+	for _, idx := range shuffledIndexes {
+		shuffledPeers = append(shuffledPeers, peerList[idx])
+	}
 
-	msgSentCounter := 0
-	for idx := range shuffledIndexes {
-		peer := peerList[idx]
+	trs.mutPeersRatingHandler.RLock()
+	defer trs.mutPeersRatingHandler.RUnlock()
+
+	sortedPeers := trs.peersRatingHandler.SortPeersByRating(shuffledPeers)
 
+	msgSentCounter := 0
+	for _, peer := range sortedPeers {
 		err := trs.sendToConnectedPeer(topicToSendRequest, buff, peer)
 		if err != nil {
 			continue
 		}
 
+		trs.peersRatingHandler.RequestSent(peer, trs.topicName)
 		msgSentCounter++
 		if msgSentCounter == maxToSend {
 			break
@@ -212,6 +224,19 @@ func (trs *topicResolverSender) SetResolverDebugHandler(handler dataRetriever.Re
 	return nil
 }
 
+// SetPeersRatingHandler sets the component used to rate the peers and to choose the best rated ones when requesting data
+func (trs *topicResolverSender) SetPeersRatingHandler(handler dataRetriever.PeersRatingHandler) error {
+	if check.IfNil(handler) {
+		return dataRetriever.ErrNilPeersRatingHandler
+	}
+
+	trs.mutPeersRatingHandler.Lock()
+	trs.peersRatingHandler = handler
+	trs.mutPeersRatingHandler.Unlock()
+
+	return nil
+}
+
 // RequestTopic returns the topic with the request suffix used for sending requests
 func (trs *topicResolverSender) RequestTopic() string {
 	return trs.topicName + topicRequestSuffix
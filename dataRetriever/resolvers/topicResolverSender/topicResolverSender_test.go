@@ -450,6 +450,102 @@ func TestTopicResolverSender_SetResolverDebugHandlerNilShouldErr(t *testing.T) {
 	assert.Equal(t, dataRetriever.ErrNilResolverDebugHandler, err)
 }
 
+func TestTopicResolverSender_SetPeersRatingHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTopicResolverSender()
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SetPeersRatingHandler(nil)
+	assert.Equal(t, dataRetriever.ErrNilPeersRatingHandler, err)
+}
+
+func TestTopicResolverSender_SendOnRequestTopicShouldPreferBestRatedPeers(t *testing.T) {
+	t.Parallel()
+
+	pIDs := []core.PeerID{"pid1", "pid2", "pid3", "pid4", "pid5"}
+	bestPeers := []core.PeerID{"pid4", "pid2"}
+
+	sentPeers := make([]core.PeerID, 0)
+	requestedPeers := make([]core.PeerID, 0)
+	arg := createMockArgTopicResolverSender()
+	arg.NumIntraShardPeers = 0
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sentPeers = append(sentPeers, peerID)
+
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		PeerListCalled: func() []core.PeerID {
+			return pIDs
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return make([]core.PeerID, 0)
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SetPeersRatingHandler(&mock.PeersRatingHandlerStub{
+		SortPeersByRatingCalled: func(pids []core.PeerID) []core.PeerID {
+			assert.Equal(t, len(pIDs), len(pids))
+
+			return append(bestPeers, pids...)
+		},
+		RequestSentCalled: func(pid core.PeerID, topic string) {
+			assert.Equal(t, arg.TopicName, topic)
+			requestedPeers = append(requestedPeers, pid)
+		},
+	})
+	assert.Nil(t, err)
+
+	err = trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, bestPeers, sentPeers)
+	assert.Equal(t, bestPeers, requestedPeers)
+}
+
+func TestTopicResolverSender_SendOnRequestTopicShouldNotRecordFailedSends(t *testing.T) {
+	t.Parallel()
+
+	pID1 := core.PeerID("pid1")
+	pID2 := core.PeerID("pid2")
+
+	requestedPeers := make([]core.PeerID, 0)
+	arg := createMockArgTopicResolverSender()
+	arg.NumIntraShardPeers = 0
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			if peerID == pID1 {
+				return errors.New("expected error")
+			}
+
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		PeerListCalled: func() []core.PeerID {
+			return []core.PeerID{pID1, pID2}
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return make([]core.PeerID, 0)
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+	_ = trs.SetPeersRatingHandler(&mock.PeersRatingHandlerStub{
+		RequestSentCalled: func(pid core.PeerID, topic string) {
+			requestedPeers = append(requestedPeers, pid)
+		},
+	})
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []core.PeerID{pID2}, requestedPeers)
+}
+
 func TestTopicResolverSender_NumPeersToQueryr(t *testing.T) {
 	t.Parallel()
 
@@ -247,6 +247,11 @@ func (txRes *TxResolver) SetResolverDebugHandler(handler dataRetriever.ResolverD
 	return txRes.TopicResolverSender.SetResolverDebugHandler(handler)
 }
 
+// SetPeersRatingHandler will set the component used to rate the peers
+func (txRes *TxResolver) SetPeersRatingHandler(handler dataRetriever.PeersRatingHandler) error {
+	return txRes.TopicResolverSender.SetPeersRatingHandler(handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txRes *TxResolver) IsInterfaceNil() bool {
 	return txRes == nil
@@ -194,6 +194,11 @@ func (tnRes *TrieNodeResolver) SetResolverDebugHandler(handler dataRetriever.Res
 	return tnRes.TopicResolverSender.SetResolverDebugHandler(handler)
 }
 
+// SetPeersRatingHandler will set the component used to rate the peers
+func (tnRes *TrieNodeResolver) SetPeersRatingHandler(handler dataRetriever.PeersRatingHandler) error {
+	return tnRes.TopicResolverSender.SetPeersRatingHandler(handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tnRes *TrieNodeResolver) IsInterfaceNil() bool {
 	return tnRes == nil
@@ -0,0 +1,7 @@
+package timedStorage
+
+import "time"
+
+func (tss *timedStorageService) SetTimeHandler(handler func() time.Time) {
+	tss.getTimeHandler = handler
+}
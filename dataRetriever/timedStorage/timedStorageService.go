@@ -0,0 +1,104 @@
+package timedStorage
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ dataRetriever.StorageService = (*timedStorageService)(nil)
+
+const (
+	getOperation    = "get"
+	putOperation    = "put"
+	hasOperation    = "has"
+	getAllOperation = "getAll"
+)
+
+// timedStorageService wraps a storage service so the latency of the get, put and has operations done on its storage
+// units is reported to the app status handler, labeled with the unit and the operation name
+type timedStorageService struct {
+	dataRetriever.StorageService
+	appStatusHandler core.AppStatusHandler
+	getTimeHandler   func() time.Time
+}
+
+// NewTimedStorageService creates a new timed storage service
+func NewTimedStorageService(
+	storageService dataRetriever.StorageService,
+	appStatusHandler core.AppStatusHandler,
+) (*timedStorageService, error) {
+	if check.IfNil(storageService) {
+		return nil, dataRetriever.ErrNilStore
+	}
+	if check.IfNil(appStatusHandler) {
+		return nil, dataRetriever.ErrNilAppStatusHandler
+	}
+
+	return &timedStorageService{
+		StorageService:   storageService,
+		appStatusHandler: appStatusHandler,
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+// GetStorer returns the timed storer of the provided unit
+func (tss *timedStorageService) GetStorer(unitType dataRetriever.UnitType) storage.Storer {
+	storer := tss.StorageService.GetStorer(unitType)
+	if check.IfNil(storer) {
+		return storer
+	}
+
+	return &timedStorer{
+		Storer:   storer,
+		unitType: unitType,
+		service:  tss,
+	}
+}
+
+// Has checks if the key is in the provided unit
+func (tss *timedStorageService) Has(unitType dataRetriever.UnitType, key []byte) error {
+	defer tss.saveLatency(unitType, hasOperation, tss.getTimeHandler())
+
+	return tss.StorageService.Has(unitType, key)
+}
+
+// Get returns the value for the given key from the provided unit
+func (tss *timedStorageService) Get(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+	defer tss.saveLatency(unitType, getOperation, tss.getTimeHandler())
+
+	return tss.StorageService.Get(unitType, key)
+}
+
+// Put stores the key, value pair in the provided unit
+func (tss *timedStorageService) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	defer tss.saveLatency(unitType, putOperation, tss.getTimeHandler())
+
+	return tss.StorageService.Put(unitType, key, value)
+}
+
+// GetAll gets all the elements with keys in the keys array, from the provided unit
+func (tss *timedStorageService) GetAll(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error) {
+	defer tss.saveLatency(unitType, getAllOperation, tss.getTimeHandler())
+
+	return tss.StorageService.GetAll(unitType, keys)
+}
+
+func (tss *timedStorageService) saveLatency(unitType dataRetriever.UnitType, operation string, startTime time.Time) {
+	latency := tss.getTimeHandler().Sub(startTime)
+	metric := core.MetricWithLabels(
+		core.MetricStorageOperationLatency,
+		core.MetricLabelUnit, unitType.String(),
+		core.MetricLabelOperation, operation,
+	)
+
+	tss.appStatusHandler.SetUInt64Value(metric, uint64(latency.Microseconds()))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tss *timedStorageService) IsInterfaceNil() bool {
+	return tss == nil
+}
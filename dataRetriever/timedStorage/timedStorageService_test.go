@@ -0,0 +1,130 @@
+package timedStorage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/timedStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSteppingTimeHandler(step time.Duration) func() time.Time {
+	current := time.Unix(0, 0)
+	return func() time.Time {
+		current = current.Add(step)
+		return current
+	}
+}
+
+func createRecordingAppStatusHandler(values map[string]uint64) *mock.AppStatusHandlerStub {
+	return &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			values[key] = value
+		},
+	}
+}
+
+func TestNewTimedStorageService_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tss, err := timedStorage.NewTimedStorageService(nil, &mock.AppStatusHandlerStub{})
+
+	assert.True(t, check.IfNil(tss))
+	assert.Equal(t, dataRetriever.ErrNilStore, err)
+}
+
+func TestNewTimedStorageService_NilAppStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tss, err := timedStorage.NewTimedStorageService(&mock.ChainStorerMock{}, nil)
+
+	assert.True(t, check.IfNil(tss))
+	assert.Equal(t, dataRetriever.ErrNilAppStatusHandler, err)
+}
+
+func TestNewTimedStorageService_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tss, err := timedStorage.NewTimedStorageService(&mock.ChainStorerMock{}, &mock.AppStatusHandlerStub{})
+
+	assert.False(t, check.IfNil(tss))
+	assert.Nil(t, err)
+}
+
+func TestTimedStorageService_GetShouldDelegateAndReportLatency(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := []byte("value")
+	getCalled := false
+	storageService := &mock.ChainStorerMock{
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			getCalled = true
+			assert.Equal(t, dataRetriever.TransactionUnit, unitType)
+			return expectedValue, nil
+		},
+	}
+	values := make(map[string]uint64)
+	tss, _ := timedStorage.NewTimedStorageService(storageService, createRecordingAppStatusHandler(values))
+	tss.SetTimeHandler(createSteppingTimeHandler(time.Millisecond))
+
+	value, err := tss.Get(dataRetriever.TransactionUnit, []byte("key"))
+
+	assert.Nil(t, err)
+	assert.True(t, getCalled)
+	assert.Equal(t, expectedValue, value)
+	metric := core.MetricWithLabels(
+		core.MetricStorageOperationLatency,
+		core.MetricLabelUnit, dataRetriever.TransactionUnit.String(),
+		core.MetricLabelOperation, "get",
+	)
+	assert.Equal(t, map[string]uint64{metric: 1000}, values)
+}
+
+func TestTimedStorageService_GetStorerShouldReportTheStorerLatency(t *testing.T) {
+	t.Parallel()
+
+	putCalled := false
+	storer := &mock.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			putCalled = true
+			return nil
+		},
+	}
+	storageService := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return storer
+		},
+	}
+	values := make(map[string]uint64)
+	tss, _ := timedStorage.NewTimedStorageService(storageService, createRecordingAppStatusHandler(values))
+	tss.SetTimeHandler(createSteppingTimeHandler(time.Microsecond * 5))
+
+	err := tss.GetStorer(dataRetriever.MetaBlockUnit).Put([]byte("key"), []byte("value"))
+
+	assert.Nil(t, err)
+	assert.True(t, putCalled)
+	metric := core.MetricWithLabels(
+		core.MetricStorageOperationLatency,
+		core.MetricLabelUnit, dataRetriever.MetaBlockUnit.String(),
+		core.MetricLabelOperation, "put",
+	)
+	assert.Equal(t, map[string]uint64{metric: 5}, values)
+}
+
+func TestTimedStorageService_GetStorerMissingUnitShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	storageService := &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return nil
+		},
+	}
+	tss, _ := timedStorage.NewTimedStorageService(storageService, &mock.AppStatusHandlerStub{})
+
+	assert.True(t, check.IfNil(tss.GetStorer(dataRetriever.MetaBlockUnit)))
+}
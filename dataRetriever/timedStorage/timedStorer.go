@@ -0,0 +1,72 @@
+package timedStorage
+
+import (
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Storer = (*timedStorer)(nil)
+
+// timedStorer is the storer of a unit, as returned by the timed storage service
+type timedStorer struct {
+	storage.Storer
+	unitType dataRetriever.UnitType
+	service  *timedStorageService
+}
+
+// Put adds data to the unit
+func (ts *timedStorer) Put(key, data []byte) error {
+	defer ts.service.saveLatency(ts.unitType, putOperation, ts.service.getTimeHandler())
+
+	return ts.Storer.Put(key, data)
+}
+
+// Get returns the value for the given key from the unit
+func (ts *timedStorer) Get(key []byte) ([]byte, error) {
+	defer ts.service.saveLatency(ts.unitType, getOperation, ts.service.getTimeHandler())
+
+	return ts.Storer.Get(key)
+}
+
+// Has checks if the key is in the unit
+func (ts *timedStorer) Has(key []byte) error {
+	defer ts.service.saveLatency(ts.unitType, hasOperation, ts.service.getTimeHandler())
+
+	return ts.Storer.Has(key)
+}
+
+// SearchFirst returns the first value found for the given key in the unit
+func (ts *timedStorer) SearchFirst(key []byte) ([]byte, error) {
+	defer ts.service.saveLatency(ts.unitType, getOperation, ts.service.getTimeHandler())
+
+	return ts.Storer.SearchFirst(key)
+}
+
+// GetFromEpoch returns the value for the given key from the unit, in the given epoch
+func (ts *timedStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
+	defer ts.service.saveLatency(ts.unitType, getOperation, ts.service.getTimeHandler())
+
+	return ts.Storer.GetFromEpoch(key, epoch)
+}
+
+// HasInEpoch checks if the key is in the unit, in the given epoch
+func (ts *timedStorer) HasInEpoch(key []byte, epoch uint32) error {
+	defer ts.service.saveLatency(ts.unitType, hasOperation, ts.service.getTimeHandler())
+
+	return ts.Storer.HasInEpoch(key, epoch)
+}
+
+// SetEpochForPutOperation will set the epoch to be used for the put operation, if the unit supports it
+func (ts *timedStorer) SetEpochForPutOperation(epoch uint32) {
+	storerWithPutInEpoch, ok := ts.Storer.(storage.StorerWithPutInEpoch)
+	if !ok {
+		return
+	}
+
+	storerWithPutInEpoch.SetEpochForPutOperation(epoch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *timedStorer) IsInterfaceNil() bool {
+	return ts == nil
+}
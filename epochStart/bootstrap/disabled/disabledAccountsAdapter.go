@@ -94,6 +94,31 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte) (map[string]data.Trie, erro
 	return nil, nil
 }
 
+// IsArchiveModeEnabled -
+func (a *accountsAdapter) IsArchiveModeEnabled() bool {
+	return false
+}
+
+// ArchiveRootHash -
+func (a *accountsAdapter) ArchiveRootHash(_ uint64, _ uint32, _ []byte) error {
+	return nil
+}
+
+// GetArchivedRootHash -
+func (a *accountsAdapter) GetArchivedRootHash(_ uint64) ([]byte, uint32, error) {
+	return nil, 0, nil
+}
+
+// GetLastArchivedNonceInEpoch -
+func (a *accountsAdapter) GetLastArchivedNonceInEpoch(_ uint32) (uint64, error) {
+	return 0, nil
+}
+
+// RecreateAtBlock -
+func (a *accountsAdapter) RecreateAtBlock(_ uint64) error {
+	return nil
+}
+
 // GetNumCheckpoints -
 func (a *accountsAdapter) GetNumCheckpoints() uint32 {
 	return 0
@@ -52,6 +52,11 @@ func (m *multiSigner) VerifySignatureShare(_ uint16, _ []byte, _ []byte, _ []byt
 	return nil
 }
 
+// VerifySignatureShares -
+func (m *multiSigner) VerifySignatureShares(_ []uint16, _ [][]byte, _ []byte, _ []byte) ([]uint16, error) {
+	return nil, nil
+}
+
 // AggregateSigs -
 func (m *multiSigner) AggregateSigs(_ []byte) ([]byte, error) {
 	return nil, nil
@@ -71,6 +71,7 @@ func (e *epochStartBootstrap) getShardIDForLatestEpoch() (uint32, bool, error) {
 		return 0, false, err
 	}
 	e.baseData.numberOfShards = uint32(len(e.epochStartMeta.EpochStart.LastFinalizedHeaders))
+	e.baseData.numberOfShards = numberOfShardsInEpoch(e.nodesConfig, e.baseData.lastEpoch, e.baseData.numberOfShards)
 
 	newShardId, isShuffledOut := e.checkIfShuffledOut(pubKey, e.nodesConfig)
 	modifiedShardId := e.applyShardIDAsObserverIfNeeded(newShardId)
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/syncer"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
@@ -667,9 +668,42 @@ func (e *epochStartBootstrap) processNodesConfig(pubKey []byte) error {
 	}
 
 	e.nodesConfig, e.baseData.shardId, err = e.nodesConfigHandler.NodesConfigFromMetaBlock(e.epochStartMeta, e.prevEpochStartMeta)
+	if err != nil {
+		return err
+	}
+
+	// the shard coordinator created after the nodes config must know the shards the nodes were split or merged into
+	e.baseData.numberOfShards = numberOfShardsInEpoch(e.nodesConfig, e.baseData.lastEpoch, e.baseData.numberOfShards)
 	e.baseData.shardId = e.applyShardIDAsObserverIfNeeded(e.baseData.shardId)
 
-	return err
+	return nil
+}
+
+// numberOfShardsInEpoch returns the number of shards set by the nodes shuffler for the given epoch. It differs from
+// the number of shards notarized in the epoch start block when the shards were split or merged at that epoch start
+func numberOfShardsInEpoch(
+	nodesConfig *sharding.NodesCoordinatorRegistry,
+	epoch uint32,
+	defaultNumberOfShards uint32,
+) uint32 {
+	if nodesConfig == nil {
+		return defaultNumberOfShards
+	}
+
+	epochConfig, ok := nodesConfig.EpochsConfig[fmt.Sprint(epoch)]
+	if !ok || epochConfig == nil || len(epochConfig.EligibleValidators) < 2 {
+		return defaultNumberOfShards
+	}
+
+	numberOfShards := uint32(len(epochConfig.EligibleValidators) - 1)
+	if numberOfShards != defaultNumberOfShards {
+		log.Info("number of shards changed at epoch start",
+			"epoch", epoch,
+			"previous number of shards", defaultNumberOfShards,
+			"new number of shards", numberOfShards)
+	}
+
+	return numberOfShards
 }
 
 func (e *epochStartBootstrap) requestAndProcessForMeta() error {
@@ -825,6 +859,11 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 		return err
 	}
 
+	trieSyncStatistics, err := trie.NewSyncStatistics(e.statusHandler)
+	if err != nil {
+		return err
+	}
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:               e.hasher,
@@ -834,6 +873,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			WaitTime:             trieSyncWaitTime,
 			Cacher:               e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+			TrieSyncStatistics:   trieSyncStatistics,
 		},
 		ShardId:   e.shardCoordinator.SelfId(),
 		Throttler: thr,
@@ -870,7 +910,7 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 	userStorageManager, userAccountTrie, err := trieFactory.Create(
 		e.generalConfig.AccountsTrieStorage,
 		core.GetShardIDString(shardId),
-		e.generalConfig.StateTriesConfig.AccountsStatePruningEnabled,
+		e.generalConfig.StateTriesConfig.AccountsStatePruningEnabled && !e.generalConfig.StateTriesConfig.ArchiveModeEnabled,
 		e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 	)
 	if err != nil {
@@ -883,7 +923,7 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 	peerStorageManager, peerAccountsTrie, err := trieFactory.Create(
 		e.generalConfig.PeerAccountsTrieStorage,
 		core.GetShardIDString(shardId),
-		e.generalConfig.StateTriesConfig.PeerStatePruningEnabled,
+		e.generalConfig.StateTriesConfig.PeerStatePruningEnabled && !e.generalConfig.StateTriesConfig.ArchiveModeEnabled,
 		e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 	)
 	if err != nil {
@@ -897,6 +937,11 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 }
 
 func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
+	trieSyncStatistics, err := trie.NewSyncStatistics(e.statusHandler)
+	if err != nil {
+		return err
+	}
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:               e.hasher,
@@ -906,6 +951,7 @@ func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
 			WaitTime:             trieSyncWaitTime,
 			Cacher:               e.dataPool.TrieNodes(),
 			MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
+			TrieSyncStatistics:   trieSyncStatistics,
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
@@ -585,3 +585,25 @@ func TestEpochStartBootstrap_WithDisabledShardIDAsOBserver(t *testing.T) {
 	err = epochStartProvider.processNodesConfig([]byte("something"))
 	assert.Nil(t, err)
 }
+
+func TestNumberOfShardsInEpoch(t *testing.T) {
+	t.Parallel()
+
+	defaultNumberOfShards := uint32(2)
+	nodesConfig := &sharding.NodesCoordinatorRegistry{
+		EpochsConfig: map[string]*sharding.EpochValidators{
+			"1": {
+				EligibleValidators: map[string][]*sharding.SerializableValidator{
+					"0":          {},
+					"1":          {},
+					"2":          {},
+					"4294967295": {},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, defaultNumberOfShards, numberOfShardsInEpoch(nil, 1, defaultNumberOfShards))
+	assert.Equal(t, defaultNumberOfShards, numberOfShardsInEpoch(nodesConfig, 2, defaultNumberOfShards))
+	assert.Equal(t, uint32(3), numberOfShardsInEpoch(nodesConfig, 1, defaultNumberOfShards))
+}
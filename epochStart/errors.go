@@ -220,3 +220,12 @@ var ErrProtocolSustainabilityAddressInMetachain = errors.New("protocol sustainab
 
 // ErrNilGenesisTotalSupply signals that nil genesis total supply has been provided
 var ErrNilGenesisTotalSupply = errors.New("nil genesis total supply")
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrInvalidGenesisRoundDuration signals that an invalid genesis round duration has been provided
+var ErrInvalidGenesisRoundDuration = errors.New("invalid genesis round duration")
+
+// ErrRoundsScheduleDoesNotMatch signals that the rounds schedule from the epoch start block does not match
+var ErrRoundsScheduleDoesNotMatch = errors.New("rounds schedule does not match")
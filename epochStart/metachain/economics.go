@@ -22,30 +22,32 @@ var _ process.EndOfEpochEconomics = (*economics)(nil)
 
 const numberOfDaysInYear = 365.0
 const numberOfSecondsInDay = 86400
+const numberOfMillisecondsInDay = numberOfSecondsInDay * 1000
+const numberOfMillisecondsInYear = numberOfDaysInYear * numberOfMillisecondsInDay
 
 type economics struct {
-	marshalizer        marshal.Marshalizer
-	hasher             hashing.Hasher
-	store              dataRetriever.StorageService
-	shardCoordinator   sharding.Coordinator
-	rewardsHandler     process.RewardsHandler
-	roundTime          process.RoundTimeDurationHandler
-	genesisEpoch       uint32
-	genesisNonce       uint64
-	genesisTotalSupply *big.Int
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	store                    dataRetriever.StorageService
+	shardCoordinator         sharding.Coordinator
+	rewardsHandler           process.RewardsHandler
+	genesisRoundDurationInMs uint64
+	genesisEpoch             uint32
+	genesisNonce             uint64
+	genesisTotalSupply       *big.Int
 }
 
 // ArgsNewEpochEconomics is the argument for the economics constructor
 type ArgsNewEpochEconomics struct {
-	Marshalizer        marshal.Marshalizer
-	Hasher             hashing.Hasher
-	Store              dataRetriever.StorageService
-	ShardCoordinator   sharding.Coordinator
-	RewardsHandler     process.RewardsHandler
-	RoundTime          process.RoundTimeDurationHandler
-	GenesisEpoch       uint32
-	GenesisNonce       uint64
-	GenesisTotalSupply *big.Int
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	Store                    dataRetriever.StorageService
+	ShardCoordinator         sharding.Coordinator
+	RewardsHandler           process.RewardsHandler
+	GenesisRoundDurationInMs uint64
+	GenesisEpoch             uint32
+	GenesisNonce             uint64
+	GenesisTotalSupply       *big.Int
 }
 
 // NewEndOfEpochEconomicsDataCreator creates a new end of epoch economics data creator object
@@ -65,23 +67,23 @@ func NewEndOfEpochEconomicsDataCreator(args ArgsNewEpochEconomics) (*economics,
 	if check.IfNil(args.RewardsHandler) {
 		return nil, epochStart.ErrNilRewardsHandler
 	}
-	if check.IfNil(args.RoundTime) {
-		return nil, process.ErrNilRounder
+	if args.GenesisRoundDurationInMs == 0 {
+		return nil, epochStart.ErrInvalidGenesisRoundDuration
 	}
 	if args.GenesisTotalSupply == nil {
 		return nil, epochStart.ErrNilGenesisTotalSupply
 	}
 
 	e := &economics{
-		marshalizer:        args.Marshalizer,
-		hasher:             args.Hasher,
-		store:              args.Store,
-		shardCoordinator:   args.ShardCoordinator,
-		rewardsHandler:     args.RewardsHandler,
-		roundTime:          args.RoundTime,
-		genesisEpoch:       args.GenesisEpoch,
-		genesisNonce:       args.GenesisNonce,
-		genesisTotalSupply: big.NewInt(0).Set(args.GenesisTotalSupply),
+		marshalizer:              args.Marshalizer,
+		hasher:                   args.Hasher,
+		store:                    args.Store,
+		shardCoordinator:         args.ShardCoordinator,
+		rewardsHandler:           args.RewardsHandler,
+		genesisRoundDurationInMs: args.GenesisRoundDurationInMs,
+		genesisEpoch:             args.GenesisEpoch,
+		genesisNonce:             args.GenesisNonce,
+		genesisTotalSupply:       big.NewInt(0).Set(args.GenesisTotalSupply),
 	}
 
 	return e, nil
@@ -115,12 +117,16 @@ func (e *economics) ComputeEndOfEpochEconomics(
 		return nil, err
 	}
 
+	// the rounds of the epoch followed the rounds schedule carried by the previous epoch start block, so every node
+	// computes the same durations regardless of the round duration its rounder currently uses
+	roundsSchedule := prevEpochStart.EpochStart.RoundsSchedule
 	roundsPassedInEpoch := metaBlock.GetRound() - prevEpochStart.GetRound()
+	epochDurationInMs := e.computeRoundsDurationInMs(roundsSchedule, prevEpochStart.GetRound(), metaBlock.GetRound())
 	maxBlocksInEpoch := core.MaxUint64(1, roundsPassedInEpoch*uint64(e.shardCoordinator.NumberOfShards()+1))
 	totalNumBlocksInEpoch := e.computeNumOfTotalCreatedBlocks(noncesPerShardPrevEpoch, noncesPerShardCurrEpoch)
 
-	inflationRate := e.computeInflationRate(metaBlock.GetRound())
-	rwdPerBlock := e.computeRewardsPerBlock(e.genesisTotalSupply, maxBlocksInEpoch, inflationRate)
+	inflationRate := e.computeInflationRate(roundsSchedule, metaBlock.GetRound())
+	rwdPerBlock := e.computeRewardsPerBlock(e.genesisTotalSupply, maxBlocksInEpoch, epochDurationInMs, inflationRate)
 	totalRewardsToBeDistributed := big.NewInt(0).Mul(rwdPerBlock, big.NewInt(0).SetUint64(totalNumBlocksInEpoch))
 
 	newTokens := big.NewInt(0).Sub(totalRewardsToBeDistributed, metaBlock.AccumulatedFeesInEpoch)
@@ -270,25 +276,55 @@ func (e *economics) adjustRewardsPerBlockWithLeaderPercentage(
 }
 
 // compute inflation rate from genesisTotalSupply and economics settings for that year
-func (e *economics) computeInflationRate(currentRound uint64) float64 {
-	roundsPerDay := numberOfSecondsInDay / uint64(e.roundTime.TimeDuration().Seconds())
-	roundsPerYear := numberOfDaysInYear * roundsPerDay
-	yearsIndex := uint32(currentRound/roundsPerYear) + 1
+func (e *economics) computeInflationRate(roundsSchedule []block.RoundDurationChange, currentRound uint64) float64 {
+	durationSinceGenesisInMs := e.computeRoundsDurationInMs(roundsSchedule, 0, currentRound)
+	yearsIndex := uint32(durationSinceGenesisInMs/numberOfMillisecondsInYear) + 1
 	return e.rewardsHandler.MaxInflationRate(yearsIndex)
 }
 
+// computeRoundsDurationInMs returns the time the rounds in [startRound, endRound) lasted, following the round
+// durations from the provided rounds schedule. Before the first change, the rounds lasted the genesis round duration
+func (e *economics) computeRoundsDurationInMs(
+	roundsSchedule []block.RoundDurationChange,
+	startRound uint64,
+	endRound uint64,
+) uint64 {
+	durationInMs := uint64(0)
+	intervalStart := uint64(0)
+	intervalRoundDurationInMs := e.genesisRoundDurationInMs
+	for _, roundDurationChange := range roundsSchedule {
+		durationInMs += roundsInInterval(intervalStart, roundDurationChange.StartRound, startRound, endRound) * intervalRoundDurationInMs
+		intervalStart = roundDurationChange.StartRound
+		intervalRoundDurationInMs = roundDurationChange.RoundDurationInMs
+	}
+	durationInMs += roundsInInterval(intervalStart, endRound, startRound, endRound) * intervalRoundDurationInMs
+
+	return durationInMs
+}
+
+func roundsInInterval(intervalStart uint64, intervalEnd uint64, startRound uint64, endRound uint64) uint64 {
+	first := core.MaxUint64(intervalStart, startRound)
+	last := endRound
+	if intervalEnd < last {
+		last = intervalEnd
+	}
+	if last <= first {
+		return 0
+	}
+
+	return last - first
+}
+
 // compute rewards per block from according to inflation rate and total supply from previous block and maxBlocksPerEpoch
 func (e *economics) computeRewardsPerBlock(
 	prevTotalSupply *big.Int,
 	maxBlocksInEpoch uint64,
+	epochDurationInMs uint64,
 	inflationRate float64,
 ) *big.Int {
 
 	inflationRatePerDay := inflationRate / numberOfDaysInYear
-	roundsPerDay := numberOfSecondsInDay / uint64(e.roundTime.TimeDuration().Seconds())
-	maxBlocksInADay := core.MaxUint64(1, roundsPerDay*uint64(e.shardCoordinator.NumberOfShards()+1))
-
-	inflationRateForEpoch := inflationRatePerDay * (float64(maxBlocksInEpoch) / float64(maxBlocksInADay))
+	inflationRateForEpoch := inflationRatePerDay * (float64(epochDurationInMs) / numberOfMillisecondsInDay)
 
 	rewardsPerBlock := big.NewInt(0).Div(prevTotalSupply, big.NewInt(0).SetUint64(maxBlocksInEpoch))
 	rewardsPerBlock = core.GetPercentageOfValue(rewardsPerBlock, inflationRateForEpoch)
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
-	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -24,13 +23,13 @@ func createMockEpochEconomicsArguments() ArgsNewEpochEconomics {
 	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
 
 	argsNewEpochEconomics := ArgsNewEpochEconomics{
-		Hasher:             &mock.HasherMock{},
-		Marshalizer:        &mock.MarshalizerMock{},
-		Store:              createMetaStore(),
-		ShardCoordinator:   shardCoordinator,
-		RewardsHandler:     &mock.RewardsHandlerStub{},
-		RoundTime:          &mock.RoundTimeDurationHandler{},
-		GenesisTotalSupply: big.NewInt(2000000),
+		Hasher:                   &mock.HasherMock{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		Store:                    createMetaStore(),
+		ShardCoordinator:         shardCoordinator,
+		RewardsHandler:           &mock.RewardsHandlerStub{},
+		GenesisRoundDurationInMs: 4000,
+		GenesisTotalSupply:       big.NewInt(2000000),
 	}
 	return argsNewEpochEconomics
 }
@@ -79,15 +78,15 @@ func TestEpochEconomics_NewEndOfEpochEconomicsDataCreatorNilRewardsHandler(t *te
 	require.Equal(t, epochStart.ErrNilRewardsHandler, err)
 }
 
-func TestEpochEconomics_NewEndOfEpochEconomicsDataCreatorNilRounder(t *testing.T) {
+func TestEpochEconomics_NewEndOfEpochEconomicsDataCreatorZeroGenesisRoundDuration(t *testing.T) {
 	t.Parallel()
 
 	arguments := createMockEpochEconomicsArguments()
-	arguments.RoundTime = nil
+	arguments.GenesisRoundDurationInMs = 0
 
 	esd, err := NewEndOfEpochEconomicsDataCreator(arguments)
 	require.Nil(t, esd)
-	require.Equal(t, epochStart.ErrNilRounder, err)
+	require.Equal(t, epochStart.ErrInvalidGenesisRoundDuration, err)
 }
 
 func TestEpochEconomics_NewEndOfEpochEconomicsDataCreatorShouldWork(t *testing.T) {
@@ -144,15 +143,15 @@ func TestNewEndOfEpochEconomicsDataCreator_NilRewardsHandler(t *testing.T) {
 	assert.Equal(t, epochStart.ErrNilRewardsHandler, err)
 }
 
-func TestNewEndOfEpochEconomicsDataCreator_NilRoundTimeDurationHandler(t *testing.T) {
+func TestNewEndOfEpochEconomicsDataCreator_ZeroGenesisRoundDuration(t *testing.T) {
 	t.Parallel()
 
 	args := getArguments()
-	args.RoundTime = nil
+	args.GenesisRoundDurationInMs = 0
 	eoeedc, err := NewEndOfEpochEconomicsDataCreator(args)
 
 	assert.True(t, check.IfNil(eoeedc))
-	assert.Equal(t, epochStart.ErrNilRounder, err)
+	assert.Equal(t, epochStart.ErrInvalidGenesisRoundDuration, err)
 }
 
 func TestNewEndOfEpochEconomicsDataCreator_ShouldWork(t *testing.T) {
@@ -299,23 +298,23 @@ func TestEconomics_ComputeInflationRate(t *testing.T) {
 	}
 	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
 
-	rate := ec.computeInflationRate(1)
+	rate := ec.computeInflationRate(nil, 1)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year1inflation)
 
-	rate = ec.computeInflationRate(50000)
+	rate = ec.computeInflationRate(nil, 50000)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year1inflation)
 
-	rate = ec.computeInflationRate(7884000)
+	rate = ec.computeInflationRate(nil, 7884000)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year2inflation)
 
-	rate = ec.computeInflationRate(8884000)
+	rate = ec.computeInflationRate(nil, 8884000)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, year2inflation)
 
-	rate = ec.computeInflationRate(38884000)
+	rate = ec.computeInflationRate(nil, 38884000)
 	assert.Nil(t, errFound)
 	assert.Equal(t, rate, lateYearInflation)
 }
@@ -385,11 +384,7 @@ func TestEconomics_VerifyRewardsPerBlock_DifferentHitRates(t *testing.T) {
 			return 0.1
 		},
 	}
-	args.RoundTime = &mock.RoundTimeDurationHandler{
-		TimeDurationCalled: func() time.Duration {
-			return time.Duration(roundDur) * time.Second
-		},
-	}
+	args.GenesisRoundDurationInMs = uint64(roundDur * 1000)
 	newTotalSupply := big.NewInt(0).Add(totalSupply, totalSupply)
 	hdrPrevEpochStart := block.MetaBlock{
 		Round: 0,
@@ -551,11 +546,7 @@ func createArgsForComputeEndOfEpochEconomics(
 			return 0.1
 		},
 	}
-	args.RoundTime = &mock.RoundTimeDurationHandler{
-		TimeDurationCalled: func() time.Duration {
-			return time.Duration(roundDuration) * time.Second
-		},
-	}
+	args.GenesisRoundDurationInMs = uint64(roundDuration * 1000)
 	hdrPrevEpochStart := block.MetaBlock{
 		Round: 0,
 		Nonce: 0,
@@ -657,12 +648,108 @@ func denomination(value *big.Int) string {
 
 func getArguments() ArgsNewEpochEconomics {
 	return ArgsNewEpochEconomics{
-		Marshalizer:        &mock.MarshalizerMock{},
-		Hasher:             mock.HasherMock{},
-		Store:              &mock.ChainStorerStub{},
-		ShardCoordinator:   mock.NewMultipleShardsCoordinatorMock(),
-		RewardsHandler:     &mock.RewardsHandlerStub{},
-		RoundTime:          &mock.RoundTimeDurationHandler{},
-		GenesisTotalSupply: big.NewInt(200000000),
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   mock.HasherMock{},
+		Store:                    &mock.ChainStorerStub{},
+		ShardCoordinator:         mock.NewMultipleShardsCoordinatorMock(),
+		RewardsHandler:           &mock.RewardsHandlerStub{},
+		GenesisRoundDurationInMs: 4000,
+		GenesisTotalSupply:       big.NewInt(200000000),
+	}
+}
+
+func TestEconomics_ComputeRoundsDurationInMs(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.GenesisRoundDurationInMs = 4000
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	roundsSchedule := []block.RoundDurationChange{
+		{StartRound: 100, RoundDurationInMs: 2000},
+		{StartRound: 200, RoundDurationInMs: 500},
+	}
+
+	assert.Equal(t, uint64(0), ec.computeRoundsDurationInMs(roundsSchedule, 50, 50))
+	assert.Equal(t, uint64(50*4000), ec.computeRoundsDurationInMs(nil, 50, 100))
+	assert.Equal(t, uint64(50*4000), ec.computeRoundsDurationInMs(roundsSchedule, 50, 100))
+	assert.Equal(t, uint64(50*4000+100*2000+50*500), ec.computeRoundsDurationInMs(roundsSchedule, 50, 250))
+	assert.Equal(t, uint64(10*500), ec.computeRoundsDurationInMs(roundsSchedule, 300, 310))
+}
+
+func TestEconomics_ComputeInflationRateShouldFollowTheRoundsSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := getArguments()
+	args.GenesisRoundDurationInMs = 4000
+	args.RewardsHandler = &mock.RewardsHandlerStub{
+		MaxInflationRateCalled: func(year uint32) float64 {
+			return float64(year)
+		},
 	}
+	ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+	roundsPerYearAt4s := uint64(numberOfMillisecondsInYear / 4000)
+	roundsSchedule := []block.RoundDurationChange{
+		{StartRound: roundsPerYearAt4s / 2, RoundDurationInMs: 2000},
+	}
+
+	// half a year at 4 seconds per round and the other half at 2 seconds per round
+	assert.Equal(t, 2.0, ec.computeInflationRate(nil, roundsPerYearAt4s))
+	assert.Equal(t, 1.0, ec.computeInflationRate(roundsSchedule, roundsPerYearAt4s))
+	assert.Equal(t, 1.0, ec.computeInflationRate(roundsSchedule, roundsPerYearAt4s*3/2-1))
+	assert.Equal(t, 2.0, ec.computeInflationRate(roundsSchedule, roundsPerYearAt4s*3/2))
+}
+
+func TestComputeEndOfEpochEconomics_RoundsScheduleChangedInTheMiddleOfTheEpoch(t *testing.T) {
+	t.Parallel()
+
+	totalSupply, _ := big.NewInt(0).SetString("20000000000000000000000000000", 10) // 20 Billions ERD
+	nodePrice, _ := big.NewInt(0).SetString("1000000000000000000000", 10)          // 1000 ERD
+	roundsPerEpoch := uint64(numberOfSecondsInDay / 4)
+
+	computeNewlyMinted := func(roundsSchedule []block.RoundDurationChange) *big.Int {
+		args := createArgsForComputeEndOfEpochEconomics(4, totalSupply, nodePrice)
+		prevEpochStartBytes, _ := args.Store.GetStorer(dataRetriever.MetaBlockUnit).Get(nil)
+		hdrPrevEpochStart := &block.MetaBlock{}
+		_ = json.Unmarshal(prevEpochStartBytes, hdrPrevEpochStart)
+		hdrPrevEpochStart.EpochStart.RoundsSchedule = roundsSchedule
+		args.Store = &mock.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return &mock.StorerStub{GetCalled: func(key []byte) ([]byte, error) {
+					return json.Marshal(hdrPrevEpochStart)
+				}}
+			},
+		}
+		ec, _ := NewEndOfEpochEconomicsDataCreator(args)
+
+		economicsBlock, err := ec.ComputeEndOfEpochEconomics(&block.MetaBlock{
+			AccumulatedFeesInEpoch: big.NewInt(0),
+			DevFeesInEpoch:         big.NewInt(0),
+			Epoch:                  1,
+			Round:                  roundsPerEpoch,
+			Nonce:                  roundsPerEpoch,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{
+					{ShardID: 0, Round: roundsPerEpoch, Nonce: roundsPerEpoch},
+					{ShardID: 1, Round: roundsPerEpoch, Nonce: roundsPerEpoch},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		return economicsBlock.TotalNewlyMinted
+	}
+
+	newlyMintedWithoutChange := computeNewlyMinted(nil)
+	// the second half of the epoch had rounds twice as short, so the epoch lasted 3/4 of a day
+	newlyMintedWithChange := computeNewlyMinted([]block.RoundDurationChange{
+		{StartRound: roundsPerEpoch / 2, RoundDurationInMs: 2000},
+	})
+
+	ratio, _ := big.NewFloat(0).Quo(
+		big.NewFloat(0).SetInt(newlyMintedWithChange),
+		big.NewFloat(0).SetInt(newlyMintedWithoutChange),
+	).Float64()
+	assert.InDelta(t, 0.75, ratio, 0.000001)
 }
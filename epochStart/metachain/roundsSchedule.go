@@ -0,0 +1,160 @@
+package metachain
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+)
+
+var _ process.EpochStartRoundsScheduleCreator = (*roundsSchedule)(nil)
+
+// roundsScheduleActivationDelay is the number of rounds after the epoch start block from which a newly voted round
+// duration applies, so that every node has processed the epoch start block before its round index changes
+const roundsScheduleActivationDelay = 100
+
+type roundsSchedule struct {
+	marshalizer              marshal.Marshalizer
+	store                    dataRetriever.StorageService
+	scQuery                  process.SCQueryService
+	genesisRoundDurationInMs uint64
+	genesisEpoch             uint32
+}
+
+// ArgsNewRoundsSchedule defines the input parameters for the rounds schedule creator
+type ArgsNewRoundsSchedule struct {
+	Marshalizer              marshal.Marshalizer
+	Store                    dataRetriever.StorageService
+	SCQuery                  process.SCQueryService
+	GenesisRoundDurationInMs uint64
+	GenesisEpoch             uint32
+}
+
+// NewRoundsScheduleCreator creates the component which adds to the epoch start blocks the round durations voted
+// through the governance smart contract
+func NewRoundsScheduleCreator(args ArgsNewRoundsSchedule) (*roundsSchedule, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, epochStart.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Store) {
+		return nil, epochStart.ErrNilStorage
+	}
+	if check.IfNil(args.SCQuery) {
+		return nil, epochStart.ErrNilSCQueryService
+	}
+	if args.GenesisRoundDurationInMs == 0 {
+		return nil, epochStart.ErrInvalidGenesisRoundDuration
+	}
+
+	rs := &roundsSchedule{
+		marshalizer:              args.Marshalizer,
+		store:                    args.Store,
+		scQuery:                  args.SCQuery,
+		genesisRoundDurationInMs: args.GenesisRoundDurationInMs,
+		genesisEpoch:             args.GenesisEpoch,
+	}
+
+	return rs, nil
+}
+
+// CreateRoundsSchedule returns the rounds schedule of the previous epoch start block, to which it appends the round
+// duration voted through governance if it differs from the one currently in use
+func (rs *roundsSchedule) CreateRoundsSchedule(metaBlock *block.MetaBlock) ([]block.RoundDurationChange, error) {
+	if check.IfNil(metaBlock) {
+		return nil, epochStart.ErrNilHeaderHandler
+	}
+	if !metaBlock.IsStartOfEpochBlock() || metaBlock.Epoch < rs.genesisEpoch+1 {
+		return nil, epochStart.ErrNotEpochStartBlock
+	}
+
+	epochStartIdentifier := core.EpochStartIdentifier(metaBlock.Epoch - 1)
+	prevEpochStart, err := process.GetMetaHeaderFromStorage([]byte(epochStartIdentifier), rs.marshalizer, rs.store)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make([]block.RoundDurationChange, len(prevEpochStart.EpochStart.RoundsSchedule))
+	copy(schedule, prevEpochStart.EpochStart.RoundsSchedule)
+
+	votedRoundDuration, err := rs.getVotedRoundDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	currentRoundDuration := rs.genesisRoundDurationInMs
+	startRound := metaBlock.Round + roundsScheduleActivationDelay
+	if len(schedule) > 0 {
+		lastRoundDurationChange := schedule[len(schedule)-1]
+		currentRoundDuration = lastRoundDurationChange.RoundDurationInMs
+		startRound = core.MaxUint64(startRound, lastRoundDurationChange.StartRound+1)
+	}
+
+	if votedRoundDuration == 0 || votedRoundDuration == currentRoundDuration {
+		return schedule, nil
+	}
+
+	log.Debug("round duration changed through governance",
+		"epoch", metaBlock.Epoch,
+		"start round", startRound,
+		"round duration in ms", votedRoundDuration,
+	)
+
+	schedule = append(schedule, block.RoundDurationChange{
+		StartRound:        startRound,
+		RoundDurationInMs: votedRoundDuration,
+	})
+
+	return schedule, nil
+}
+
+// VerifyRoundsSchedule checks that the rounds schedule from the epoch start block is the one it should have
+func (rs *roundsSchedule) VerifyRoundsSchedule(metaBlock *block.MetaBlock) error {
+	if !metaBlock.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	computedSchedule, err := rs.CreateRoundsSchedule(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	receivedSchedule := metaBlock.EpochStart.RoundsSchedule
+	if len(computedSchedule) != len(receivedSchedule) {
+		return epochStart.ErrRoundsScheduleDoesNotMatch
+	}
+	for i := range computedSchedule {
+		if computedSchedule[i] != receivedSchedule[i] {
+			return epochStart.ErrRoundsScheduleDoesNotMatch
+		}
+	}
+
+	return nil
+}
+
+func (rs *roundsSchedule) getVotedRoundDuration() (uint64, error) {
+	query := process.SCQuery{
+		ScAddress: factory.GovernanceSCAddress,
+		FuncName:  "getRoundDuration",
+	}
+	vmOutput, err := rs.scQuery.ExecuteQuery(&query)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(vmOutput.ReturnData) == 0 {
+		return 0, nil
+	}
+
+	return big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).Uint64(), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *roundsSchedule) IsInterfaceNil() bool {
+	return rs == nil
+}
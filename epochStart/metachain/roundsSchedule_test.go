@@ -0,0 +1,192 @@
+package metachain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockRoundsScheduleArguments(votedRoundDuration uint64) ArgsNewRoundsSchedule {
+	return ArgsNewRoundsSchedule{
+		Marshalizer: &mock.MarshalizerMock{},
+		Store:       createMetaStore(),
+		SCQuery: &mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				if votedRoundDuration == 0 {
+					return &vmcommon.VMOutput{}, nil
+				}
+				return &vmcommon.VMOutput{ReturnData: [][]byte{big.NewInt(0).SetUint64(votedRoundDuration).Bytes()}}, nil
+			},
+		},
+		GenesisRoundDurationInMs: 6000,
+	}
+}
+
+func savePrevEpochStart(t *testing.T, args ArgsNewRoundsSchedule, epoch uint32, schedule []block.RoundDurationChange) {
+	prevEpochStart := &block.MetaBlock{
+		Epoch:      epoch,
+		EpochStart: block.EpochStart{RoundsSchedule: schedule},
+	}
+	prevEpochStartBytes, _ := args.Marshalizer.Marshal(prevEpochStart)
+	err := args.Store.Put(dataRetriever.MetaBlockUnit, []byte(core.EpochStartIdentifier(epoch)), prevEpochStartBytes)
+	require.Nil(t, err)
+}
+
+func createEpochStartMetaBlock(epoch uint32, round uint64) *block.MetaBlock {
+	return &block.MetaBlock{
+		Epoch: epoch,
+		Round: round,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+		},
+	}
+}
+
+func TestNewRoundsScheduleCreator_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(0)
+	args.Marshalizer = nil
+
+	rs, err := NewRoundsScheduleCreator(args)
+	require.Nil(t, rs)
+	require.Equal(t, epochStart.ErrNilMarshalizer, err)
+}
+
+func TestNewRoundsScheduleCreator_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(0)
+	args.Store = nil
+
+	rs, err := NewRoundsScheduleCreator(args)
+	require.Nil(t, rs)
+	require.Equal(t, epochStart.ErrNilStorage, err)
+}
+
+func TestNewRoundsScheduleCreator_NilSCQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(0)
+	args.SCQuery = nil
+
+	rs, err := NewRoundsScheduleCreator(args)
+	require.Nil(t, rs)
+	require.Equal(t, epochStart.ErrNilSCQueryService, err)
+}
+
+func TestNewRoundsScheduleCreator_ZeroGenesisRoundDurationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(0)
+	args.GenesisRoundDurationInMs = 0
+
+	rs, err := NewRoundsScheduleCreator(args)
+	require.Nil(t, rs)
+	require.Equal(t, epochStart.ErrInvalidGenesisRoundDuration, err)
+}
+
+func TestRoundsSchedule_CreateRoundsScheduleNotEpochStartShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rs, _ := NewRoundsScheduleCreator(createMockRoundsScheduleArguments(0))
+
+	schedule, err := rs.CreateRoundsSchedule(&block.MetaBlock{Epoch: 1})
+	require.Nil(t, schedule)
+	require.Equal(t, epochStart.ErrNotEpochStartBlock, err)
+}
+
+func TestRoundsSchedule_CreateRoundsScheduleQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockRoundsScheduleArguments(0)
+	args.SCQuery = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, factory.GovernanceSCAddress, query.ScAddress)
+			require.Equal(t, "getRoundDuration", query.FuncName)
+			return nil, expectedErr
+		},
+	}
+	savePrevEpochStart(t, args, 0, nil)
+	rs, _ := NewRoundsScheduleCreator(args)
+
+	schedule, err := rs.CreateRoundsSchedule(createEpochStartMetaBlock(1, 500))
+	require.Nil(t, schedule)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestRoundsSchedule_CreateRoundsScheduleNothingVotedShouldKeepTheSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(0)
+	prevSchedule := []block.RoundDurationChange{{StartRound: 300, RoundDurationInMs: 5000}}
+	savePrevEpochStart(t, args, 1, prevSchedule)
+	rs, _ := NewRoundsScheduleCreator(args)
+
+	schedule, err := rs.CreateRoundsSchedule(createEpochStartMetaBlock(2, 1000))
+	require.Nil(t, err)
+	require.Equal(t, prevSchedule, schedule)
+}
+
+func TestRoundsSchedule_CreateRoundsScheduleSameDurationShouldKeepTheSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(6000)
+	savePrevEpochStart(t, args, 0, nil)
+	rs, _ := NewRoundsScheduleCreator(args)
+
+	schedule, err := rs.CreateRoundsSchedule(createEpochStartMetaBlock(1, 500))
+	require.Nil(t, err)
+	require.Equal(t, 0, len(schedule))
+}
+
+func TestRoundsSchedule_CreateRoundsScheduleShouldAppendTheVotedDuration(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(4000)
+	prevSchedule := []block.RoundDurationChange{{StartRound: 300, RoundDurationInMs: 5000}}
+	savePrevEpochStart(t, args, 1, prevSchedule)
+	rs, _ := NewRoundsScheduleCreator(args)
+
+	schedule, err := rs.CreateRoundsSchedule(createEpochStartMetaBlock(2, 1000))
+	require.Nil(t, err)
+	expectedSchedule := []block.RoundDurationChange{
+		{StartRound: 300, RoundDurationInMs: 5000},
+		{StartRound: 1000 + roundsScheduleActivationDelay, RoundDurationInMs: 4000},
+	}
+	require.Equal(t, expectedSchedule, schedule)
+}
+
+func TestRoundsSchedule_VerifyRoundsSchedule(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRoundsScheduleArguments(4000)
+	savePrevEpochStart(t, args, 0, nil)
+	rs, _ := NewRoundsScheduleCreator(args)
+
+	metaBlock := createEpochStartMetaBlock(1, 500)
+	err := rs.VerifyRoundsSchedule(metaBlock)
+	require.Equal(t, epochStart.ErrRoundsScheduleDoesNotMatch, err)
+
+	metaBlock.EpochStart.RoundsSchedule = []block.RoundDurationChange{{StartRound: 500, RoundDurationInMs: 4000}}
+	err = rs.VerifyRoundsSchedule(metaBlock)
+	require.Equal(t, epochStart.ErrRoundsScheduleDoesNotMatch, err)
+
+	metaBlock.EpochStart.RoundsSchedule = []block.RoundDurationChange{{StartRound: 500 + roundsScheduleActivationDelay, RoundDurationInMs: 4000}}
+	err = rs.VerifyRoundsSchedule(metaBlock)
+	require.Nil(t, err)
+
+	err = rs.VerifyRoundsSchedule(&block.MetaBlock{Epoch: 1})
+	require.Nil(t, err)
+}
@@ -27,6 +27,7 @@ type TrieStub struct {
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesCalled          func() (map[string][]byte, error)
 	GetAllHashesCalled          func() ([][]byte, error)
+	DiffCalled                  func(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error)
 	IsPruningEnabledCalled      func() bool
 	ClosePersisterCalled        func() error
 	GetAllLeavesOnChannelCalled func() chan core.KeyValueHolder
@@ -224,3 +225,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
+
+// Diff -
+func (ts *TrieStub) Diff(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error) {
+	if ts.DiffCalled != nil {
+		return ts.DiffCalled(oldRootHash, newRootHash)
+	}
+
+	return nil, nil
+}
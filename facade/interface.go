@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -42,6 +43,9 @@ type NodeHandler interface {
 	//  about the account corelated with provided address
 	GetAccount(address string) (state.UserAccountHandler, error)
 
+	// GetAccountAtBlock returns the account correlated with provided address as it was at the provided block nonce
+	GetAccountAtBlock(address string, nonce uint64) (state.UserAccountHandler, error)
+
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
@@ -58,9 +62,15 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersTraffic() ([]core.QueryP2PPeerTrafficInfo, error)
+	GetKnownPeers() ([]core.QueryP2PPeerStoreInfo, error)
+	BanPeer(pid string, durationInSec uint32, reason string) error
+	UnbanPeer(pid string) error
 
 	GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetFinalityInfo(fromIndex uint64) (*block.APIFinality, error)
+	GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error)
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -25,6 +26,7 @@ type NodeStub struct {
 	GetTransactionHandler                          func(hash string) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountAtBlockHandler                       func(address string, nonce uint64) (state.UserAccountHandler, error)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
@@ -35,8 +37,14 @@ type NodeStub struct {
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeersTrafficCalled                          func() ([]core.QueryP2PPeerTrafficInfo, error)
+	GetKnownPeersCalled                            func() ([]core.QueryP2PPeerStoreInfo, error)
+	BanPeerCalled                                  func(pid string, durationInSec uint32, reason string) error
+	UnbanPeerCalled                                func(pid string) error
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.APIBlock, error)
+	GetFinalityInfoCalled                          func(fromIndex uint64) (*block.APIFinality, error)
+	GetConsensusGroupCalled                        func(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error)
 }
 
 // GetValueForKey -
@@ -63,6 +71,24 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetConsensusGroup -
+func (ns *NodeStub) GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error) {
+	if ns.GetConsensusGroupCalled != nil {
+		return ns.GetConsensusGroupCalled(shardID, epoch, round)
+	}
+
+	return nil, nil
+}
+
+// GetFinalityInfo -
+func (ns *NodeStub) GetFinalityInfo(fromIndex uint64) (*block.APIFinality, error) {
+	if ns.GetFinalityInfoCalled != nil {
+		return ns.GetFinalityInfoCalled(fromIndex)
+	}
+
+	return &block.APIFinality{}, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -105,6 +131,11 @@ func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error)
 	return ns.GetAccountHandler(address)
 }
 
+// GetAccountAtBlock -
+func (ns *NodeStub) GetAccountAtBlock(address string, nonce uint64) (state.UserAccountHandler, error) {
+	return ns.GetAccountAtBlockHandler(address, nonce)
+}
+
 // GetHeartbeats -
 func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return ns.GetHeartbeatsHandler()
@@ -143,6 +174,42 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetPeersTraffic -
+func (ns *NodeStub) GetPeersTraffic() ([]core.QueryP2PPeerTrafficInfo, error) {
+	if ns.GetPeersTrafficCalled != nil {
+		return ns.GetPeersTrafficCalled()
+	}
+
+	return make([]core.QueryP2PPeerTrafficInfo, 0), nil
+}
+
+// GetKnownPeers -
+func (ns *NodeStub) GetKnownPeers() ([]core.QueryP2PPeerStoreInfo, error) {
+	if ns.GetKnownPeersCalled != nil {
+		return ns.GetKnownPeersCalled()
+	}
+
+	return make([]core.QueryP2PPeerStoreInfo, 0), nil
+}
+
+// BanPeer -
+func (ns *NodeStub) BanPeer(pid string, durationInSec uint32, reason string) error {
+	if ns.BanPeerCalled != nil {
+		return ns.BanPeerCalled(pid, durationInSec, reason)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (ns *NodeStub) UnbanPeer(pid string) error {
+	if ns.UnbanPeerCalled != nil {
+		return ns.UnbanPeerCalled(pid)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
@@ -304,6 +304,12 @@ func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, erro
 	return nf.node.GetAccount(address)
 }
 
+// GetAccountAtBlock returns the account correlated with provided address as it was after the block with the provided
+// nonce was committed. It needs the archive mode to be enabled
+func (nf *nodeFacade) GetAccountAtBlock(address string, nonce uint64) (state.UserAccountHandler, error) {
+	return nf.node.GetAccountAtBlock(address, nonce)
+}
+
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
 func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	hbStatus := nf.node.GetHeartbeats()
@@ -359,6 +365,26 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetPeersTraffic returns the traffic accounted for each connected peer
+func (nf *nodeFacade) GetPeersTraffic() ([]core.QueryP2PPeerTrafficInfo, error) {
+	return nf.node.GetPeersTraffic()
+}
+
+// GetKnownPeers returns the peers recorded in the peer store
+func (nf *nodeFacade) GetKnownPeers() ([]core.QueryP2PPeerStoreInfo, error) {
+	return nf.node.GetKnownPeers()
+}
+
+// BanPeer bans the provided peer for the given duration
+func (nf *nodeFacade) BanPeer(pid string, durationInSec uint32, reason string) error {
+	return nf.node.BanPeer(pid, durationInSec, reason)
+}
+
+// UnbanPeer removes the ban of the provided peer
+func (nf *nodeFacade) UnbanPeer(pid string) error {
+	return nf.node.UnbanPeer(pid)
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -377,6 +403,16 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlo
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetConsensusGroup returns the consensus group and the leader of the given shard for a past epoch and round
+func (nf *nodeFacade) GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error) {
+	return nf.node.GetConsensusGroup(shardID, epoch, round)
+}
+
+// GetFinalityInfo returns the last final block of each shard and the finality events starting from the given index
+func (nf *nodeFacade) GetFinalityInfo(fromIndex uint64) (*block.APIFinality, error) {
+	return nf.node.GetFinalityInfo(fromIndex)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
@@ -289,6 +289,26 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	assert.Equal(t, called, 1)
 }
 
+func TestNodeFacade_GetAccountAtBlock(t *testing.T) {
+	t.Parallel()
+
+	expectedAccount, _ := state.NewUserAccount([]byte("test"))
+	node := &mock.NodeStub{}
+	node.GetAccountAtBlockHandler = func(address string, nonce uint64) (state.UserAccountHandler, error) {
+		assert.Equal(t, "test", address)
+		assert.Equal(t, uint64(7), nonce)
+		return expectedAccount, nil
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	account, err := nf.GetAccountAtBlock("test", 7)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedAccount, account)
+}
+
 func TestNodeFacade_GetHeartbeatsReturnsNilShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -581,6 +601,46 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
 }
 
+func TestNodeFacade_GetPeersTraffic(t *testing.T) {
+	t.Parallel()
+
+	peer := core.QueryP2PPeerTrafficInfo{
+		Pid: "pid",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetPeersTrafficCalled: func() ([]core.QueryP2PPeerTrafficInfo, error) {
+			return []core.QueryP2PPeerTrafficInfo{peer}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetPeersTraffic()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []core.QueryP2PPeerTrafficInfo{peer}, val)
+}
+
+func TestNodeFacade_GetKnownPeers(t *testing.T) {
+	t.Parallel()
+
+	peer := core.QueryP2PPeerStoreInfo{
+		Pid: "pid",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetKnownPeersCalled: func() ([]core.QueryP2PPeerStoreInfo, error) {
+			return []core.QueryP2PPeerStoreInfo{peer}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetKnownPeers()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []core.QueryP2PPeerStoreInfo{peer}, val)
+}
+
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
 	t.Parallel()
 
@@ -133,7 +133,7 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 
 func (ccf *cryptoComponentsFactory) createSingleSigner() (crypto.SingleSigner, error) {
 	switch ccf.config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PbftConsensusType:
 		return &mclsig.BlsSingleSigner{}, nil
 	default:
 		return nil, ErrMissingConsensusConfig
@@ -141,7 +141,7 @@ func (ccf *cryptoComponentsFactory) createSingleSigner() (crypto.SingleSigner, e
 }
 
 func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hasher, error) {
-	if ccf.config.Consensus.Type == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
+	if ccf.isBlsSignedConsensus() && ccf.config.MultisigHasher.Type != "blake2b" {
 		return nil, ErrMultiSigHasherMissmatch
 	}
 
@@ -149,7 +149,7 @@ func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hashe
 	case "sha256":
 		return sha256.Sha256{}, nil
 	case "blake2b":
-		if ccf.config.Consensus.Type == consensus.BlsConsensusType {
+		if ccf.isBlsSignedConsensus() {
 			return &blake2b.Blake2b{HashSize: multisig.BlsHashSize}, nil
 		}
 		return &blake2b.Blake2b{}, nil
@@ -158,6 +158,11 @@ func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hashe
 	return nil, ErrMissingMultiHasherConfig
 }
 
+func (ccf *cryptoComponentsFactory) isBlsSignedConsensus() bool {
+	return ccf.config.Consensus.Type == consensus.BlsConsensusType ||
+		ccf.config.Consensus.Type == consensus.PbftConsensusType
+}
+
 func (ccf *cryptoComponentsFactory) createMultiSigner(
 	hasher hashing.Hasher,
 	pubKeys []string,
@@ -170,7 +175,7 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 	// public keys in their initial order.
 
 	switch ccf.config.Consensus.Type {
-	case consensus.BlsConsensusType:
+	case consensus.BlsConsensusType, consensus.PbftConsensusType:
 		blsSigner := &mclmultisig.BlsMultiSigner{Hasher: hasher}
 		return multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
 	default:
@@ -81,6 +81,31 @@ func TestCryptoComponentsFactory_Create(t *testing.T) {
 	require.NotNil(t, cc)
 }
 
+func TestCryptoComponentsFactory_CreateWithPbftConsensusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.Consensus.Type = "pbft"
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, cc)
+}
+
+func TestCryptoComponentsFactory_CreateWithPbftConsensusWrongMultisigHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getCryptoArgs()
+	args.Config.Consensus.Type = "pbft"
+	args.Config.MultisigHasher.Type = "sha256"
+	ccf, _ := factory.NewCryptoComponentsFactory(args)
+
+	cc, err := ccf.Create()
+	require.Equal(t, factory.ErrMultiSigHasherMissmatch, err)
+	require.Nil(t, cc)
+}
+
 func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
 	return factory.CryptoComponentsFactoryArgs{
 		Config: config.Config{
@@ -10,12 +10,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	dataRetrieverFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/journal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/timedStorage"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
 )
 
+const commitJournalDirectory = "CommitJournal"
+
 // DataComponentsFactoryArgs holds the arguments needed for creating a data components factory
 type DataComponentsFactoryArgs struct {
 	Config             config.Config
@@ -27,6 +32,13 @@ type DataComponentsFactoryArgs struct {
 	CurrentEpoch       uint32
 }
 
+// ArgsCommitJournalBootstrapReplay holds the arguments needed to replay the commit journal before the bootstrap
+type ArgsCommitJournalBootstrapReplay struct {
+	Config           config.Config
+	PathManager      storage.PathManagerHandler
+	ShardCoordinator sharding.Coordinator
+}
+
 type dataComponentsFactory struct {
 	config             config.Config
 	economicsData      *economics.EconomicsData
@@ -79,6 +91,21 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		return nil, err
 	}
 
+	store, commitStore, commitJournal, trieStorageJournal, err := dcf.createCommitJournal(store)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err = dcf.createTimedStorage(store)
+	if err != nil {
+		return nil, err
+	}
+
+	commitStore, err = dcf.createTimedStorage(commitStore)
+	if err != nil {
+		return nil, err
+	}
+
 	dataPoolArgs := dataRetrieverFactory.ArgsDataPool{
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
@@ -90,9 +117,12 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 	}
 
 	return &DataComponents{
-		Blkc:     blkc,
-		Store:    store,
-		Datapool: datapool,
+		Blkc:               blkc,
+		Store:              store,
+		CommitStore:        commitStore,
+		Datapool:           datapool,
+		CommitJournal:      commitJournal,
+		TrieStorageJournal: trieStorageJournal,
 	}, nil
 }
 
@@ -139,3 +169,110 @@ func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.Sto
 	}
 	return nil, ErrDataStoreCreation
 }
+
+// createCommitJournal returns the storage service, the view of it used while committing blocks, the commit journal
+// and the trie storage journal. The kept journal records are replayed on the storage units before returning
+func (dcf *dataComponentsFactory) createCommitJournal(
+	store dataRetriever.StorageService,
+) (dataRetriever.StorageService, dataRetriever.StorageService, process.CommitJournalHandler, TrieStorageJournal, error) {
+	if !dcf.config.CommitJournal.Enabled {
+		disabledJournal := journal.NewDisabledCommitJournal()
+		return store, store, disabledJournal, disabledJournal, nil
+	}
+
+	shardID := core.GetShardIDString(dcf.shardCoordinator.SelfId())
+	argsJournal := journal.ArgsJournaledStorageService{
+		StorageService:   store,
+		DirectoryPath:    dcf.pathManager.PathForStatic(shardID, commitJournalDirectory),
+		NumRecordsToKeep: dcf.config.CommitJournal.NumRecordsToKeep,
+	}
+	journaledStore, err := journal.NewJournaledStorageService(argsJournal)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: %s", ErrDataStoreCreation, err.Error())
+	}
+
+	err = journaledStore.ReplayPendingCommits()
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("%w: %s", ErrDataStoreCreation, err.Error())
+	}
+
+	return journaledStore, journaledStore.CommitStorageService(), journaledStore, journaledStore, nil
+}
+
+func (dcf *dataComponentsFactory) createTimedStorage(store dataRetriever.StorageService) (dataRetriever.StorageService, error) {
+	measureStorageLatency := dcf.config.Prometheus.Enabled && dcf.config.Prometheus.MeasureStorageLatency
+	if !measureStorageLatency {
+		return store, nil
+	}
+
+	timedStore, err := timedStorage.NewTimedStorageService(store, dcf.core.StatusHandler)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDataStoreCreation, err.Error())
+	}
+
+	return timedStore, nil
+}
+
+// ReplayCommitJournalForBootstrap replays the writes of the kept commit journal records on the boot storage and on
+// the trie storages of all shards. It has to be called before the epoch start bootstrapper reads these units, as the
+// own shard is not known yet and the storage service is created only afterwards
+func ReplayCommitJournalForBootstrap(args ArgsCommitJournalBootstrapReplay) error {
+	if check.IfNil(args.PathManager) {
+		return ErrNilPathManager
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if !args.Config.CommitJournal.Enabled {
+		return nil
+	}
+
+	shardIDs := make([]uint32, 0, args.ShardCoordinator.NumberOfShards()+1)
+	for shardID := uint32(0); shardID < args.ShardCoordinator.NumberOfShards(); shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	for _, shardID := range shardIDs {
+		argsReplay := createArgsBootstrapReplay(args, core.GetShardIDString(shardID))
+		err := journal.ReplayBootstrapWrites(argsReplay)
+		if err != nil {
+			return fmt.Errorf("%w while replaying the commit journal of shard %d", err, shardID)
+		}
+	}
+
+	return nil
+}
+
+func createArgsBootstrapReplay(args ArgsCommitJournalBootstrapReplay, shardID string) journal.ArgsBootstrapReplay {
+	generalConfig := args.Config
+	pathManager := args.PathManager
+
+	return journal.ArgsBootstrapReplay{
+		DirectoryPath: pathManager.PathForStatic(shardID, commitJournalDirectory),
+		UnitPersisters: map[dataRetriever.UnitType]journal.UnitPersisterArgs{
+			dataRetriever.BootstrapUnit: {
+				PersisterFactory: factory.NewPersisterFactory(generalConfig.BootstrapStorage.DB),
+				PathForEpoch: func(epoch uint32) string {
+					if !generalConfig.StoragePruning.Enabled {
+						epoch = 0
+					}
+
+					return pathManager.PathForEpoch(shardID, epoch, generalConfig.BootstrapStorage.DB.FilePath)
+				},
+			},
+			dataRetriever.UserAccountsUnit: {
+				PersisterFactory: factory.NewPersisterFactory(generalConfig.AccountsTrieStorage.DB),
+				PathForEpoch: func(_ uint32) string {
+					return pathManager.PathForStatic(shardID, generalConfig.AccountsTrieStorage.DB.FilePath)
+				},
+			},
+			dataRetriever.PeerAccountsUnit: {
+				PersisterFactory: factory.NewPersisterFactory(generalConfig.PeerAccountsTrieStorage.DB),
+				PathForEpoch: func(_ uint32) string {
+					return pathManager.PathForStatic(shardID, generalConfig.PeerAccountsTrieStorage.DB.FilePath)
+				},
+			},
+		},
+	}
+}
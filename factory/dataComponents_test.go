@@ -1,6 +1,9 @@
 package factory_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -116,6 +119,73 @@ func TestDataComponentsFactory_CreateForMetaShouldWork(t *testing.T) {
 	require.NotNil(t, dc)
 }
 
+func TestReplayCommitJournalForBootstrap_NilPathManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getCommitJournalBootstrapReplayArgs()
+	args.PathManager = nil
+
+	err := factory.ReplayCommitJournalForBootstrap(args)
+	require.Equal(t, factory.ErrNilPathManager, err)
+}
+
+func TestReplayCommitJournalForBootstrap_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getCommitJournalBootstrapReplayArgs()
+	args.ShardCoordinator = nil
+
+	err := factory.ReplayCommitJournalForBootstrap(args)
+	require.Equal(t, factory.ErrNilShardCoordinator, err)
+}
+
+func TestReplayCommitJournalForBootstrap_DisabledJournalShouldNotReadTheJournal(t *testing.T) {
+	t.Parallel()
+
+	args := getCommitJournalBootstrapReplayArgs()
+	args.Config.CommitJournal.Enabled = false
+	args.PathManager = &mock.PathManagerStub{
+		PathForStaticCalled: func(shardId string, identifier string) string {
+			require.Fail(t, "should have not read the journal")
+			return ""
+		},
+	}
+
+	err := factory.ReplayCommitJournalForBootstrap(args)
+	require.Nil(t, err)
+}
+
+func TestReplayCommitJournalForBootstrap_MissingJournalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "commitJournal")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := getCommitJournalBootstrapReplayArgs()
+	args.PathManager = &mock.PathManagerStub{
+		PathForStaticCalled: func(shardId string, identifier string) string {
+			return filepath.Join(dir, shardId, identifier)
+		},
+	}
+
+	err = factory.ReplayCommitJournalForBootstrap(args)
+	require.Nil(t, err)
+}
+
+func getCommitJournalBootstrapReplayArgs() factory.ArgsCommitJournalBootstrapReplay {
+	generalConfig := testscommon.GetGeneralConfig()
+	generalConfig.CommitJournal.Enabled = true
+
+	return factory.ArgsCommitJournalBootstrapReplay{
+		Config:           generalConfig,
+		PathManager:      &mock.PathManagerStub{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+	}
+}
+
 func getDataArgs() factory.DataComponentsFactoryArgs {
 	testEconomics := &economics.TestEconomicsData{EconomicsData: &economics.EconomicsData{}}
 	testEconomics.SetMinGasPrice(200000000000)
@@ -22,6 +22,7 @@ type StateComponents struct {
 	ValidatorPubkeyConverter core.PubkeyConverter
 	PeerAccounts             state.AccountsAdapter
 	AccountsAdapter          state.AccountsAdapter
+	ArchiveAccountsAdapter   state.AccountsAdapter
 	InBalanceForShard        map[string]*big.Int
 }
 
@@ -33,6 +34,7 @@ type CoreComponents struct {
 	TxSignMarshalizer        marshal.Marshalizer
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	StatusHandler            core.AppStatusHandler
+	Tracer                   core.Tracer
 	ChainID                  []byte
 	MinTransactionVersion    uint32
 }
@@ -46,11 +48,15 @@ type CryptoParams struct {
 	PublicKeyString string
 }
 
-// DataComponents struct holds the data components
+// DataComponents struct holds the data components. The CommitStore is the view of the Store used while committing
+// blocks, whose writes are recorded by the CommitJournal together with the ones done on the journaled trie storages
 type DataComponents struct {
-	Blkc     data.ChainHandler
-	Store    dataRetriever.StorageService
-	Datapool dataRetriever.PoolsHolder
+	Blkc               data.ChainHandler
+	Store              dataRetriever.StorageService
+	CommitStore        dataRetriever.StorageService
+	Datapool           dataRetriever.PoolsHolder
+	CommitJournal      process.CommitJournalHandler
+	TrieStorageJournal TrieStorageJournal
 }
 
 // TriesComponents holds the tries components
@@ -76,6 +82,7 @@ type NetworkComponents struct {
 	NetMessenger           p2p.Messenger
 	InputAntifloodHandler  P2PAntifloodHandler
 	OutputAntifloodHandler P2PAntifloodHandler
-	PeerBlackListHandler   process.PeerBlackListCacher
+	PeerBlackListHandler   process.PeerBanHandler
 	PkTimeCache            process.TimeCacher
+	PeerStore              p2p.PeerStore
 }
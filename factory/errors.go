@@ -82,3 +82,6 @@ var ErrNilStatusHandler = errors.New("nil status handler provided")
 
 // ErrWrongTypeAssertion signals that a wrong type assertion occurred
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilTrieStorageJournal signals that a nil trie storage journal has been provided
+var ErrNilTrieStorageJournal = errors.New("nil trie storage journal")
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -19,6 +20,12 @@ type EpochStartNotifier interface {
 	IsInterfaceNil() bool
 }
 
+// TrieStorageJournal defines the component able to journal the writes done on a trie storage while committing blocks
+type TrieStorageJournal interface {
+	JournalTrieStorage(unitType dataRetriever.UnitType, storageManager data.StorageManager) data.StorageManager
+	IsInterfaceNil() bool
+}
+
 // NodesSetupHandler defines which actions should be done for handling initial nodes setup
 type NodesSetupHandler interface {
 	InitialNodesPubKeys() map[uint32][]string
@@ -34,10 +41,12 @@ type P2PAntifloodHandler interface {
 	ResetForTopic(topic string)
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
 	SetDebugger(debugger process.AntifloodDebugger) error
+	SetAntifloodHitsRecorder(recorder process.AntifloodHitsRecorder) error
 	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
 	SetTopicsForAll(topics ...string)
 	ApplyConsensusSize(size int)
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error
+	SetTrustedPeers(pids []core.PeerID)
 	IsInterfaceNil() bool
 }
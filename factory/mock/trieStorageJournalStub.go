@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// TrieStorageJournalStub -
+type TrieStorageJournalStub struct {
+	JournalTrieStorageCalled func(unitType dataRetriever.UnitType, storageManager data.StorageManager) data.StorageManager
+}
+
+// JournalTrieStorage -
+func (tsjs *TrieStorageJournalStub) JournalTrieStorage(unitType dataRetriever.UnitType, storageManager data.StorageManager) data.StorageManager {
+	if tsjs.JournalTrieStorageCalled != nil {
+		return tsjs.JournalTrieStorageCalled(unitType, storageManager)
+	}
+
+	return storageManager
+}
+
+// IsInterfaceNil -
+func (tsjs *TrieStorageJournalStub) IsInterfaceNil() bool {
+	return tsjs == nil
+}
@@ -21,6 +21,7 @@ type networkComponentsFactory struct {
 	listenAddress string
 	marshalizer   marshal.Marshalizer
 	syncer        p2p.SyncTimer
+	peerStore     p2p.PeerStore
 }
 
 // NewNetworkComponentsFactory returns a new instance of a network components factory
@@ -30,6 +31,7 @@ func NewNetworkComponentsFactory(
 	statusHandler core.AppStatusHandler,
 	marshalizer marshal.Marshalizer,
 	syncer p2p.SyncTimer,
+	peerStore p2p.PeerStore,
 ) (*networkComponentsFactory, error) {
 	if check.IfNil(statusHandler) {
 		return nil, ErrNilStatusHandler
@@ -37,6 +39,9 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(marshalizer) {
 		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", ErrNilMarshalizer)
 	}
+	if check.IfNil(peerStore) {
+		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", p2p.ErrNilPeerStore)
+	}
 
 	return &networkComponentsFactory{
 		p2pConfig:     p2pConfig,
@@ -45,6 +50,7 @@ func NewNetworkComponentsFactory(
 		statusHandler: statusHandler,
 		listenAddress: libp2p.ListenAddrWithIp4AndTcp,
 		syncer:        syncer,
+		peerStore:     peerStore,
 	}, nil
 }
 
@@ -55,6 +61,7 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		ListenAddress: ncf.listenAddress,
 		P2pConfig:     ncf.p2pConfig,
 		SyncTimer:     ncf.syncer,
+		PeerStore:     ncf.peerStore,
 	}
 
 	netMessenger, err := libp2p.NewNetworkMessenger(arg)
@@ -66,6 +73,7 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		ncf.mainConfig,
 		ncf.statusHandler,
 		netMessenger.ID(),
+		ncf.peerStore,
 	)
 	if errNewAntiflood != nil {
 		return nil, errNewAntiflood
@@ -89,6 +97,22 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		return nil, fmt.Errorf("%w when casting input antiflood handler to structs/P2PAntifloodHandler", ErrWrongTypeAssertion)
 	}
 
+	trustedPeers, err := decodePeerIDs(ncf.p2pConfig.PeerAccess.TrustedPeers)
+	if err != nil {
+		return nil, fmt.Errorf("%w when decoding the trusted peers", err)
+	}
+	inputAntifloodHandler.SetTrustedPeers(trustedPeers)
+
+	err = inputAntifloodHandler.SetAntifloodHitsRecorder(netMessenger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = netMessenger.SetAntifloodHandler(inputAntifloodHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	outAntifloodHandler, errOutputAntiflood := antifloodFactory.NewP2POutputAntiFlood(ncf.mainConfig)
 	if errOutputAntiflood != nil {
 		return nil, errOutputAntiflood
@@ -105,5 +129,20 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		OutputAntifloodHandler: outputAntifloodHandler,
 		PeerBlackListHandler:   peerIdBlackList,
 		PkTimeCache:            pkTimeCache,
+		PeerStore:              ncf.peerStore,
 	}, nil
 }
+
+func decodePeerIDs(prettyPids []string) ([]core.PeerID, error) {
+	pids := make([]core.PeerID, 0, len(prettyPids))
+	for _, prettyPid := range prettyPids {
+		pid, err := core.NewPeerIDFromPretty(prettyPid)
+		if err != nil {
+			return nil, err
+		}
+
+		pids = append(pids, pid)
+	}
+
+	return pids, nil
+}
@@ -5,9 +5,12 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	factoryState "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -16,19 +19,21 @@ import (
 
 // StateComponentsFactoryArgs holds the arguments needed for creating a state components factory
 type StateComponentsFactoryArgs struct {
-	Config           config.Config
-	ShardCoordinator sharding.Coordinator
-	Core             *CoreComponents
-	Tries            *TriesComponents
-	PathManager      storage.PathManagerHandler
+	Config             config.Config
+	ShardCoordinator   sharding.Coordinator
+	Core               *CoreComponents
+	Tries              *TriesComponents
+	PathManager        storage.PathManagerHandler
+	TrieStorageJournal TrieStorageJournal
 }
 
 type stateComponentsFactory struct {
-	config           config.Config
-	shardCoordinator sharding.Coordinator
-	core             *CoreComponents
-	tries            *TriesComponents
-	pathManager      storage.PathManagerHandler
+	config             config.Config
+	shardCoordinator   sharding.Coordinator
+	core               *CoreComponents
+	tries              *TriesComponents
+	pathManager        storage.PathManagerHandler
+	trieStorageJournal TrieStorageJournal
 }
 
 // NewStateComponentsFactory will return a new instance of stateComponentsFactory
@@ -45,13 +50,17 @@ func NewStateComponentsFactory(args StateComponentsFactoryArgs) (*stateComponent
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, ErrNilShardCoordinator
 	}
+	if check.IfNil(args.TrieStorageJournal) {
+		return nil, ErrNilTrieStorageJournal
+	}
 
 	return &stateComponentsFactory{
-		config:           args.Config,
-		core:             args.Core,
-		tries:            args.Tries,
-		pathManager:      args.PathManager,
-		shardCoordinator: args.ShardCoordinator,
+		config:             args.Config,
+		core:               args.Core,
+		tries:              args.Tries,
+		pathManager:        args.PathManager,
+		shardCoordinator:   args.ShardCoordinator,
+		trieStorageJournal: args.TrieStorageJournal,
 	}, nil
 }
 
@@ -68,23 +77,123 @@ func (scf *stateComponentsFactory) Create() (*StateComponents, error) {
 	}
 
 	accountFactory := factoryState.NewAccountCreator()
-	merkleTrie := scf.tries.TriesContainer.Get([]byte(factory.UserAccountTrie))
+	merkleTrie, err := scf.createJournaledTrie(
+		factory.UserAccountTrie,
+		dataRetriever.UserAccountsUnit,
+		scf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAccountsAdapterCreation, err.Error())
+	}
 	accountsAdapter, err := state.NewAccountsDB(merkleTrie, scf.core.Hasher, scf.core.InternalMarshalizer, accountFactory)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", ErrAccountsAdapterCreation, err.Error())
 	}
+	if scf.config.StateTriesConfig.ArchiveModeEnabled {
+		err = accountsAdapter.EnableArchiveMode()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrAccountsAdapterCreation, err.Error())
+		}
+	}
+	archiveAccountsAdapter, err := scf.createArchiveAccountsAdapter(merkleTrie, accountFactory)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAccountsAdapterCreation, err.Error())
+	}
 
 	accountFactory = factoryState.NewPeerAccountCreator()
-	merkleTrie = scf.tries.TriesContainer.Get([]byte(factory.PeerAccountTrie))
+	merkleTrie, err = scf.createJournaledTrie(
+		factory.PeerAccountTrie,
+		dataRetriever.PeerAccountsUnit,
+		scf.config.StateTriesConfig.MaxPeerTrieLevelInMemory,
+	)
+	if err != nil {
+		return nil, err
+	}
 	peerAdapter, err := state.NewPeerAccountsDB(merkleTrie, scf.core.Hasher, scf.core.InternalMarshalizer, accountFactory)
 	if err != nil {
 		return nil, err
 	}
+	if scf.config.StateTriesConfig.ArchiveModeEnabled {
+		err = peerAdapter.EnableArchiveMode()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &StateComponents{
 		PeerAccounts:             peerAdapter,
 		AddressPubkeyConverter:   processPubkeyConverter,
 		ValidatorPubkeyConverter: validatorPubkeyConverter,
 		AccountsAdapter:          accountsAdapter,
+		ArchiveAccountsAdapter:   archiveAccountsAdapter,
 	}, nil
 }
+
+// createArchiveAccountsAdapter creates the user accounts adapter used to query the state at a past block. It works on
+// its own trie over the same storage, as recreating the state at a past block must not alter the state used for
+// processing
+func (scf *stateComponentsFactory) createArchiveAccountsAdapter(
+	merkleTrie data.Trie,
+	accountFactory state.AccountFactory,
+) (*state.AccountsDB, error) {
+	rootHash, err := merkleTrie.Root()
+	if err != nil {
+		return nil, err
+	}
+
+	archiveTrie, err := merkleTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveAccountsAdapter, err := state.NewAccountsDB(archiveTrie, scf.core.Hasher, scf.core.InternalMarshalizer, accountFactory)
+	if err != nil {
+		return nil, err
+	}
+	if scf.config.StateTriesConfig.ArchiveModeEnabled {
+		err = archiveAccountsAdapter.EnableArchiveMode()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return archiveAccountsAdapter, nil
+}
+
+// createJournaledTrie replaces the trie from the container with one created on the journaled trie storage manager,
+// so the trie nodes written while committing blocks are recorded by the commit journal
+func (scf *stateComponentsFactory) createJournaledTrie(
+	trieID string,
+	unitType dataRetriever.UnitType,
+	maxTrieLevelInMemory uint,
+) (data.Trie, error) {
+	containerTrie := scf.tries.TriesContainer.Get([]byte(trieID))
+	storageManager, ok := scf.tries.TrieStorageManagers[trieID]
+	if !ok || check.IfNil(containerTrie) {
+		return containerTrie, nil
+	}
+
+	journaledStorageManager := scf.trieStorageJournal.JournalTrieStorage(unitType, storageManager)
+	if journaledStorageManager == storageManager {
+		return containerTrie, nil
+	}
+
+	rootHash, err := containerTrie.Root()
+	if err != nil {
+		return nil, err
+	}
+
+	emptyTrie, err := trie.NewTrie(journaledStorageManager, scf.core.InternalMarshalizer, scf.core.Hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	journaledTrie, err := emptyTrie.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	scf.tries.TriesContainer.Replace([]byte(trieID), journaledTrie)
+
+	return journaledTrie, nil
+}
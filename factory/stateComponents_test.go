@@ -5,6 +5,11 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
@@ -43,6 +48,17 @@ func TestNewStateComponentsFactory_NilCoreComponents(t *testing.T) {
 	require.Equal(t, factory.ErrNilCoreComponents, err)
 }
 
+func TestNewStateComponentsFactory_NilTrieStorageJournalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getStateArgs()
+	args.TrieStorageJournal = nil
+
+	scf, err := factory.NewStateComponentsFactory(args)
+	require.Nil(t, scf)
+	require.Equal(t, factory.ErrNilTrieStorageJournal, err)
+}
+
 func TestNewStateComponentsFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -105,10 +121,11 @@ func getStateArgs() factory.StateComponentsFactoryArgs {
 				SignatureLength: 0,
 			},
 		},
-		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
-		PathManager:      &mock.PathManagerStub{},
-		Core:             getCoreComponents(),
-		Tries:            getTriesComponents(),
+		ShardCoordinator:   mock.NewMultiShardsCoordinatorMock(2),
+		PathManager:        &mock.PathManagerStub{},
+		Core:               getCoreComponents(),
+		Tries:              getTriesComponents(),
+		TrieStorageJournal: &mock.TrieStorageJournalStub{},
 	}
 }
 
@@ -123,3 +140,59 @@ func getTriesComponents() *factory.TriesComponents {
 	tc, _ := tcf.Create()
 	return tc
 }
+
+func TestStateComponentsFactory_Create_ShouldUseTheJournaledTrieStorage(t *testing.T) {
+	t.Parallel()
+
+	args := getStateArgs()
+	args.Config.StateTriesConfig = config.StateTriesConfig{
+		MaxStateTrieLevelInMemory: 5,
+		MaxPeerTrieLevelInMemory:  5,
+	}
+	journaledUnits := make([]dataRetriever.UnitType, 0)
+	journaledManagers := make(map[dataRetriever.UnitType]data.StorageManager)
+	args.TrieStorageJournal = &mock.TrieStorageJournalStub{
+		JournalTrieStorageCalled: func(unitType dataRetriever.UnitType, storageManager data.StorageManager) data.StorageManager {
+			journaledUnits = append(journaledUnits, unitType)
+			journaledManager, _ := trie.NewTrieStorageManagerWithoutPruning(storageManager.Database())
+			journaledManagers[unitType] = journaledManager
+			return journaledManager
+		},
+	}
+
+	scf, _ := factory.NewStateComponentsFactory(args)
+	res, err := scf.Create()
+	require.NoError(t, err)
+	require.Equal(t, []dataRetriever.UnitType{dataRetriever.UserAccountsUnit, dataRetriever.PeerAccountsUnit}, journaledUnits)
+
+	userTrie := args.Tries.TriesContainer.Get([]byte(trieFactory.UserAccountTrie))
+	require.Equal(t, journaledManagers[dataRetriever.UserAccountsUnit].Database(), userTrie.Database())
+	peerTrie := args.Tries.TriesContainer.Get([]byte(trieFactory.PeerAccountTrie))
+	require.Equal(t, journaledManagers[dataRetriever.PeerAccountsUnit].Database(), peerTrie.Database())
+	require.NotNil(t, res)
+}
+
+func TestStateComponentsFactory_Create_ShouldCreateASeparateArchiveAccountsAdapter(t *testing.T) {
+	t.Parallel()
+
+	args := getStateArgs()
+	args.Config.StateTriesConfig = config.StateTriesConfig{
+		MaxStateTrieLevelInMemory: 5,
+		MaxPeerTrieLevelInMemory:  5,
+		ArchiveModeEnabled:        true,
+	}
+
+	scf, _ := factory.NewStateComponentsFactory(args)
+	res, err := scf.Create()
+	require.NoError(t, err)
+	require.False(t, check.IfNil(res.ArchiveAccountsAdapter))
+	require.True(t, res.ArchiveAccountsAdapter != res.AccountsAdapter)
+	require.True(t, res.AccountsAdapter.IsArchiveModeEnabled())
+	require.True(t, res.ArchiveAccountsAdapter.IsArchiveModeEnabled())
+
+	err = res.AccountsAdapter.ArchiveRootHash(1, 0, []byte("root hash"))
+	require.NoError(t, err)
+	rootHash, _, err := res.ArchiveAccountsAdapter.GetArchivedRootHash(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("root hash"), rootHash)
+}
@@ -78,7 +78,7 @@ func (tcf *triesComponentsFactory) Create() (*TriesComponents, error) {
 	userStorageManager, userAccountTrie, err := trieFactoryObj.Create(
 		tcf.config.AccountsTrieStorage,
 		shardIDString,
-		tcf.config.StateTriesConfig.AccountsStatePruningEnabled,
+		tcf.config.StateTriesConfig.AccountsStatePruningEnabled && !tcf.config.StateTriesConfig.ArchiveModeEnabled,
 		tcf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
 	)
 	if err != nil {
@@ -90,7 +90,7 @@ func (tcf *triesComponentsFactory) Create() (*TriesComponents, error) {
 	peerStorageManager, peerAccountsTrie, err := trieFactoryObj.Create(
 		tcf.config.PeerAccountsTrieStorage,
 		shardIDString,
-		tcf.config.StateTriesConfig.PeerStatePruningEnabled,
+		tcf.config.StateTriesConfig.PeerStatePruningEnabled && !tcf.config.StateTriesConfig.ArchiveModeEnabled,
 		tcf.config.StateTriesConfig.MaxPeerTrieLevelInMemory,
 	)
 	if err != nil {
@@ -64,11 +64,13 @@ func createMockArgument(
 				OwnerAddress:    "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     nodePrice.Text(10),
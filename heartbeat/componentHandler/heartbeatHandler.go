@@ -36,6 +36,8 @@ type ArgHeartbeat struct {
 	Storer                   storage.Storer
 	ValidatorStatistics      heartbeat.ValidatorStatisticsProcessor
 	PeerSignatureHandler     crypto.PeerSignatureHandler
+	KeyGenerator             crypto.KeyGenerator
+	SingleSigner             crypto.SingleSigner
 	PrivKey                  crypto.PrivateKey
 	HardforkTrigger          heartbeat.HardforkTrigger
 	AntifloodHandler         heartbeat.P2PAntifloodHandler
@@ -48,6 +50,10 @@ type ArgHeartbeat struct {
 	PeerShardMapper          heartbeat.NetworkShardingCollector
 	SizeCheckDelta           uint32
 	ValidatorsProvider       peerProcess.ValidatorsProvider
+	BlockChain               heartbeat.BlockChainHandler
+	ForkDetector             heartbeat.ForkDetector
+	Rounder                  heartbeat.Rounder
+	Capabilities             uint32
 }
 
 // HeartbeatHandler is the struct used to manage heartbeat subsystem consisting of a heartbeat sender and monitor
@@ -112,6 +118,7 @@ func (hbh *HeartbeatHandler) create() error {
 	argSender := process.ArgHeartbeatSender{
 		PeerMessenger:        arg.Messenger,
 		PeerSignatureHandler: arg.PeerSignatureHandler,
+		SingleSigner:         arg.SingleSigner,
 		PrivKey:              arg.PrivKey,
 		Marshalizer:          arg.Marshalizer,
 		Topic:                core.HeartbeatTopic,
@@ -122,6 +129,9 @@ func (hbh *HeartbeatHandler) create() error {
 		NodeDisplayName:      arg.PrefsConfig.NodeDisplayName,
 		KeyBaseIdentity:      arg.PrefsConfig.Identity,
 		HardforkTrigger:      arg.HardforkTrigger,
+		BlockChain:           arg.BlockChain,
+		ForkDetector:         arg.ForkDetector,
+		Capabilities:         arg.Capabilities,
 	}
 
 	hbh.sender, err = process.NewSender(argSender)
@@ -133,6 +143,8 @@ func (hbh *HeartbeatHandler) create() error {
 
 	heartBeatMsgProcessor, err := process.NewMessageProcessor(
 		arg.PeerSignatureHandler,
+		arg.KeyGenerator,
+		arg.SingleSigner,
 		arg.Marshalizer,
 		arg.PeerShardMapper,
 	)
@@ -171,8 +183,10 @@ func (hbh *HeartbeatHandler) create() error {
 		AntifloodHandler:                   arg.AntifloodHandler,
 		HardforkTrigger:                    arg.HardforkTrigger,
 		ValidatorPubkeyConverter:           arg.ValidatorPubkeyConverter,
+		Rounder:                            arg.Rounder,
 		HeartbeatRefreshIntervalInSec:      arg.HeartbeatConfig.HeartbeatRefreshIntervalInSec,
 		HideInactiveValidatorIntervalInSec: arg.HeartbeatConfig.HideInactiveValidatorIntervalInSec,
+		MaxNoncesBehindToConsiderStale:     arg.HeartbeatConfig.MaxNoncesBehindToConsiderStale,
 	}
 	hbh.monitor, err = process.NewMonitor(argMonitor)
 	if err != nil {
@@ -255,6 +269,9 @@ func (hbh *HeartbeatHandler) checkConfigParams(config config.HeartbeatConfig) er
 	if config.DurationToConsiderUnresponsiveInSec <= config.MaxTimeToWaitBetweenBroadcastsInSec {
 		return fmt.Errorf("%w for DurationToConsiderUnresponsiveInSec", heartbeat.ErrWrongValues)
 	}
+	if config.MaxNoncesBehindToConsiderStale == 0 {
+		return heartbeat.ErrZeroMaxNoncesBehindToConsiderStale
+	}
 
 	return nil
 }
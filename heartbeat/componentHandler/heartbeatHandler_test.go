@@ -21,6 +21,7 @@ func createMockArgument() ArgHeartbeat {
 			DurationToConsiderUnresponsiveInSec: 10,
 			HeartbeatRefreshIntervalInSec:       1,
 			HideInactiveValidatorIntervalInSec:  20,
+			MaxNoncesBehindToConsiderStale:      20,
 		},
 		PrefsConfig: config.PreferencesConfig{
 			DestinationShardAsObserver: "0",
@@ -35,6 +36,8 @@ func createMockArgument() ArgHeartbeat {
 		Storer:                   mock.NewStorerMock(),
 		ValidatorStatistics:      &mock.ValidatorStatisticsStub{},
 		PeerSignatureHandler:     &mock.PeerSignatureHandler{},
+		KeyGenerator:             &mock.KeyGenMock{},
+		SingleSigner:             &mock.SinglesignMock{},
 		PrivKey:                  &mock.PrivateKeyStub{},
 		HardforkTrigger:          &mock.HardforkTriggerStub{},
 		AntifloodHandler:         &mock.P2PAntifloodHandlerStub{},
@@ -47,6 +50,9 @@ func createMockArgument() ArgHeartbeat {
 		PeerShardMapper:          &mock.NetworkShardingCollectorStub{},
 		SizeCheckDelta:           0,
 		ValidatorsProvider:       &mock.ValidatorsProviderStub{},
+		BlockChain:               &mock.BlockChainStub{},
+		ForkDetector:             &mock.ForkDetectorStub{},
+		Rounder:                  &mock.RounderStub{},
 	}
 
 	return arg
@@ -111,6 +117,17 @@ func TestNewHeartbeatHandler_InvalidDurationToConsiderUnresponsiveInSec(t *testi
 	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
 }
 
+func TestNewHeartbeatHandler_ZeroMaxNoncesBehindToConsiderStale(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.MaxNoncesBehindToConsiderStale = 0
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.Equal(t, heartbeat.ErrZeroMaxNoncesBehindToConsiderStale, err)
+}
+
 func TestNewHeartbeatHandler_NilMessenger(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,18 @@
+package heartbeat
+
+// HeartbeatV1 is the version of the heartbeat messages that only describe the node. The messages sent by the nodes
+// that do not know about the heartbeat versions do not set the version field and are treated as HeartbeatV1 messages
+const HeartbeatV1 = uint32(1)
+
+// HeartbeatV2 is the version of the heartbeat messages that also carry the state reported by the node (nonce, round,
+// epoch, sync state and capabilities) signed with the node's block signing key
+const HeartbeatV2 = uint32(2)
+
+// CurrentHeartbeatVersion is the version of the heartbeat messages sent by this node
+const CurrentHeartbeatVersion = HeartbeatV2
+
+// CapabilityFullArchive signals that the node keeps the data of all the epochs
+const CapabilityFullArchive = uint32(1 << 0)
+
+// CapabilityFullHistory signals that the node keeps the full history of the transactions
+const CapabilityFullHistory = uint32(1 << 1)
@@ -27,14 +27,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Heartbeat represents the heartbeat message that is sent between peers
 type Heartbeat struct {
-	Payload         []byte `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
-	Pubkey          []byte `protobuf:"bytes,2,opt,name=Pubkey,proto3" json:"Pubkey,omitempty"`
-	Signature       []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	ShardID         uint32 `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
-	VersionNumber   string `protobuf:"bytes,5,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
-	NodeDisplayName string `protobuf:"bytes,6,opt,name=NodeDisplayName,proto3" json:"NodeDisplayName,omitempty"`
-	Identity        string `protobuf:"bytes,7,opt,name=Identity,proto3" json:"Identity,omitempty"`
-	Pid             []byte `protobuf:"bytes,8,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Payload              []byte `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Pubkey               []byte `protobuf:"bytes,2,opt,name=Pubkey,proto3" json:"Pubkey,omitempty"`
+	Signature            []byte `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	ShardID              uint32 `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	VersionNumber        string `protobuf:"bytes,5,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
+	NodeDisplayName      string `protobuf:"bytes,6,opt,name=NodeDisplayName,proto3" json:"NodeDisplayName,omitempty"`
+	Identity             string `protobuf:"bytes,7,opt,name=Identity,proto3" json:"Identity,omitempty"`
+	Pid                  []byte `protobuf:"bytes,8,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	HeartbeatVersion     uint32 `protobuf:"varint,9,opt,name=HeartbeatVersion,proto3" json:"HeartbeatVersion,omitempty"`
+	Nonce                uint64 `protobuf:"varint,10,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round                uint64 `protobuf:"varint,11,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch                uint32 `protobuf:"varint,12,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	ProbableHighestNonce uint64 `protobuf:"varint,13,opt,name=ProbableHighestNonce,proto3" json:"ProbableHighestNonce,omitempty"`
+	IsSyncing            bool   `protobuf:"varint,14,opt,name=IsSyncing,proto3" json:"IsSyncing,omitempty"`
+	Capabilities         uint32 `protobuf:"varint,15,opt,name=Capabilities,proto3" json:"Capabilities,omitempty"`
+	PayloadSignature     []byte `protobuf:"bytes,16,opt,name=PayloadSignature,proto3" json:"PayloadSignature,omitempty"`
 }
 
 func (m *Heartbeat) Reset()      { *m = Heartbeat{} }
@@ -125,6 +133,62 @@ func (m *Heartbeat) GetPid() []byte {
 	return nil
 }
 
+func (m *Heartbeat) GetHeartbeatVersion() uint32 {
+	if m != nil {
+		return m.HeartbeatVersion
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetProbableHighestNonce() uint64 {
+	if m != nil {
+		return m.ProbableHighestNonce
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetIsSyncing() bool {
+	if m != nil {
+		return m.IsSyncing
+	}
+	return false
+}
+
+func (m *Heartbeat) GetCapabilities() uint32 {
+	if m != nil {
+		return m.Capabilities
+	}
+	return 0
+}
+
+func (m *Heartbeat) GetPayloadSignature() []byte {
+	if m != nil {
+		return m.PayloadSignature
+	}
+	return nil
+}
+
 // HeartbeatDTO is the struct used for handling DB operations for heartbeatMessageInfo struct
 type HeartbeatDTO struct {
 	MaxDurationPeerUnresponsive int64  `protobuf:"varint,1,opt,name=MaxDurationPeerUnresponsive,proto3" json:"MaxDurationPeerUnresponsive,omitempty"`
@@ -333,40 +397,46 @@ func init() {
 func init() { proto.RegisterFile("heartbeat.proto", fileDescriptor_3c667767fb9826a9) }
 
 var fileDescriptor_3c667767fb9826a9 = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbb, 0x8e, 0x13, 0x3f,
-	0x14, 0xc6, 0xc7, 0xff, 0xc9, 0xe6, 0xe2, 0x24, 0xff, 0x20, 0x17, 0xc8, 0x02, 0x64, 0x45, 0x11,
-	0x45, 0x24, 0xa4, 0x6d, 0xe8, 0xa8, 0xb8, 0x8c, 0x04, 0x91, 0xd8, 0x10, 0x4d, 0x92, 0x2d, 0xe8,
-	0x9c, 0x9d, 0x23, 0xd6, 0x22, 0x33, 0x1e, 0x8d, 0x9d, 0xb0, 0xe9, 0x78, 0x04, 0x1e, 0x83, 0x47,
-	0xa1, 0xa0, 0x48, 0xb9, 0x25, 0x99, 0x34, 0x94, 0x2b, 0xf1, 0x02, 0xc8, 0x27, 0x9b, 0xdb, 0x70,
-	0x11, 0x95, 0xf3, 0x7d, 0xe7, 0x93, 0x73, 0xfc, 0xfd, 0x34, 0xb4, 0x75, 0x09, 0x32, 0xb3, 0x13,
-	0x90, 0xf6, 0x34, 0xcd, 0xb4, 0xd5, 0xec, 0x04, 0x8f, 0xce, 0x0f, 0x42, 0x6b, 0xaf, 0xb6, 0x23,
-	0xc6, 0x69, 0x65, 0x20, 0x17, 0x53, 0x2d, 0x23, 0x4e, 0xda, 0xa4, 0xdb, 0x08, 0xb7, 0x92, 0xdd,
-	0xa5, 0xe5, 0xc1, 0x6c, 0xf2, 0x1e, 0x16, 0xfc, 0x3f, 0x1c, 0xdc, 0x2a, 0xf6, 0x80, 0xd6, 0x86,
-	0xea, 0x5d, 0x22, 0xed, 0x2c, 0x03, 0xee, 0xe3, 0x68, 0x6f, 0xb8, 0xfb, 0x86, 0x97, 0x32, 0x8b,
-	0x7a, 0x01, 0x2f, 0xb5, 0x49, 0xb7, 0x19, 0x6e, 0x25, 0x7b, 0x48, 0x9b, 0xe7, 0x90, 0x19, 0xa5,
-	0x93, 0xfe, 0x2c, 0x9e, 0x40, 0xc6, 0x4f, 0xda, 0xa4, 0x5b, 0x0b, 0x8f, 0x4d, 0xd6, 0xa5, 0xad,
-	0xbe, 0x8e, 0x20, 0x50, 0x26, 0x9d, 0xca, 0x45, 0x5f, 0xc6, 0xc0, 0xcb, 0x98, 0x2b, 0xda, 0xec,
-	0x1e, 0xad, 0xf6, 0x22, 0x48, 0xac, 0xb2, 0x0b, 0x5e, 0xc1, 0xc8, 0x4e, 0xb3, 0x3b, 0xd4, 0x1f,
-	0xa8, 0x88, 0x57, 0x71, 0x3b, 0xf7, 0xb3, 0xf3, 0xb5, 0x44, 0x1b, 0xbb, 0x57, 0x07, 0xa3, 0x37,
-	0xec, 0x29, 0xbd, 0x7f, 0x26, 0xaf, 0x82, 0x59, 0x26, 0xad, 0xd2, 0xc9, 0x00, 0x20, 0x1b, 0x27,
-	0x19, 0x98, 0x54, 0x27, 0x46, 0xcd, 0x01, 0xcb, 0xf0, 0xc3, 0xbf, 0x45, 0xdc, 0xaa, 0x67, 0xf2,
-	0xaa, 0x97, 0xc8, 0x0b, 0xab, 0xe6, 0x30, 0x52, 0x31, 0x60, 0x53, 0x7e, 0x58, 0xb4, 0x59, 0x9b,
-	0xd6, 0x47, 0xda, 0xca, 0xe9, 0x38, 0xc5, 0x94, 0x8f, 0xa9, 0x43, 0xcb, 0x95, 0x83, 0x32, 0xd0,
-	0x1f, 0x12, 0xcc, 0x94, 0x30, 0x73, 0x6c, 0xba, 0xea, 0xdd, 0x39, 0xb4, 0x32, 0x4e, 0xb1, 0x3e,
-	0x3f, 0xdc, 0x1b, 0x58, 0x88, 0x79, 0x86, 0xff, 0x8a, 0x9d, 0x55, 0xc3, 0x9d, 0x76, 0xbb, 0x86,
-	0x70, 0x01, 0x6a, 0x0e, 0xd1, 0x16, 0x4f, 0x05, 0xf1, 0x14, 0x6d, 0x97, 0x7c, 0xa1, 0xe3, 0x74,
-	0x66, 0xf7, 0xc9, 0xea, 0x26, 0x59, 0xb0, 0x7f, 0x05, 0x5a, 0xfb, 0x47, 0xa0, 0xf4, 0x8f, 0x40,
-	0x5d, 0xc7, 0xa3, 0x45, 0x0a, 0xbc, 0xbe, 0x01, 0xba, 0xd5, 0x47, 0xb0, 0x1b, 0x05, 0xd8, 0x6d,
-	0x5a, 0xef, 0x99, 0x73, 0x39, 0x55, 0x91, 0xb4, 0x3a, 0xe3, 0x4d, 0x7c, 0xfa, 0xa1, 0xc5, 0x4e,
-	0x29, 0x7b, 0x2d, 0x8d, 0x1d, 0xa7, 0x56, 0xc5, 0xe0, 0xda, 0x74, 0x27, 0xff, 0x1f, 0x0b, 0xfc,
-	0xcd, 0xc4, 0xdd, 0xf8, 0x12, 0x12, 0x30, 0xca, 0x20, 0x8b, 0xd6, 0x86, 0xd7, 0x81, 0xd5, 0x79,
-	0x44, 0xeb, 0xc1, 0x64, 0x5f, 0xfd, 0x2d, 0x18, 0x83, 0x60, 0xc8, 0x1e, 0x0c, 0x1a, 0xcf, 0x9f,
-	0x2c, 0x57, 0xc2, 0xbb, 0x5e, 0x09, 0xef, 0x66, 0x25, 0xc8, 0xc7, 0x5c, 0x90, 0xcf, 0xb9, 0x20,
-	0x5f, 0x72, 0x41, 0x96, 0xb9, 0x20, 0xdf, 0x72, 0x41, 0xbe, 0xe7, 0xc2, 0xbb, 0xc9, 0x05, 0xf9,
-	0xb4, 0x16, 0xde, 0x72, 0x2d, 0xbc, 0xeb, 0xb5, 0xf0, 0xde, 0x96, 0x22, 0x69, 0xe5, 0xa4, 0x8c,
-	0x1f, 0xed, 0xe3, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47, 0x90, 0xd6, 0x20, 0xce, 0x03, 0x00,
-	0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbd, 0x6e, 0x13, 0x41,
+	0x14, 0x85, 0xbd, 0xd8, 0x49, 0xec, 0xb1, 0x83, 0xa3, 0x51, 0x84, 0x46, 0x80, 0x46, 0x2b, 0x8b,
+	0xc2, 0x02, 0x29, 0x05, 0x74, 0x54, 0x40, 0x8c, 0x88, 0x25, 0x62, 0xac, 0xb1, 0x93, 0x82, 0x6e,
+	0xd6, 0x7b, 0x15, 0x8f, 0xb0, 0x67, 0x56, 0x3b, 0xe3, 0x10, 0x77, 0x48, 0xbc, 0x00, 0x8f, 0xc1,
+	0xa3, 0x50, 0x50, 0xa4, 0x4c, 0x49, 0x36, 0x0d, 0x65, 0x1e, 0x01, 0xcd, 0xf5, 0xcf, 0xc6, 0x4e,
+	0x40, 0x54, 0xe3, 0xf3, 0xdd, 0xe3, 0xf9, 0xb9, 0xf7, 0x68, 0x49, 0x7d, 0x08, 0x32, 0x75, 0x11,
+	0x48, 0xb7, 0x97, 0xa4, 0xc6, 0x19, 0xba, 0x81, 0x4b, 0xe3, 0x6b, 0x89, 0x54, 0x0e, 0x16, 0x25,
+	0xca, 0xc8, 0x56, 0x57, 0x4e, 0x47, 0x46, 0xc6, 0x2c, 0x08, 0x83, 0x66, 0x4d, 0x2c, 0x24, 0x7d,
+	0x40, 0x36, 0xbb, 0x93, 0xe8, 0x13, 0x4c, 0xd9, 0x3d, 0x2c, 0xcc, 0x15, 0x7d, 0x4c, 0x2a, 0x3d,
+	0x75, 0xa2, 0xa5, 0x9b, 0xa4, 0xc0, 0x8a, 0x58, 0xca, 0x81, 0xdf, 0xaf, 0x37, 0x94, 0x69, 0xdc,
+	0x6e, 0xb1, 0x52, 0x18, 0x34, 0xb7, 0xc5, 0x42, 0xd2, 0x27, 0x64, 0xfb, 0x18, 0x52, 0xab, 0x8c,
+	0xee, 0x4c, 0xc6, 0x11, 0xa4, 0x6c, 0x23, 0x0c, 0x9a, 0x15, 0xb1, 0x0a, 0x69, 0x93, 0xd4, 0x3b,
+	0x26, 0x86, 0x96, 0xb2, 0xc9, 0x48, 0x4e, 0x3b, 0x72, 0x0c, 0x6c, 0x13, 0x7d, 0xeb, 0x98, 0x3e,
+	0x24, 0xe5, 0x76, 0x0c, 0xda, 0x29, 0x37, 0x65, 0x5b, 0x68, 0x59, 0x6a, 0xba, 0x43, 0x8a, 0x5d,
+	0x15, 0xb3, 0x32, 0xde, 0xce, 0xff, 0xa4, 0x4f, 0xc9, 0xce, 0xf2, 0xd1, 0xf3, 0x13, 0x59, 0x05,
+	0x2f, 0x78, 0x8b, 0xd3, 0x5d, 0xb2, 0xd1, 0x31, 0x7a, 0x00, 0x8c, 0x84, 0x41, 0xb3, 0x24, 0x66,
+	0xc2, 0x53, 0x61, 0x26, 0x3a, 0x66, 0xd5, 0x19, 0x45, 0xe1, 0xe9, 0xdb, 0xc4, 0x0c, 0x86, 0xac,
+	0x86, 0x9b, 0xcd, 0x04, 0x7d, 0x4e, 0x76, 0xbb, 0xa9, 0x89, 0x64, 0x34, 0x82, 0x03, 0x75, 0x32,
+	0x04, 0xeb, 0x66, 0x1b, 0x6e, 0xe3, 0x5f, 0xef, 0xac, 0xf9, 0xbe, 0xb6, 0x6d, 0x6f, 0xaa, 0x07,
+	0x4a, 0x9f, 0xb0, 0xfb, 0x61, 0xd0, 0x2c, 0x8b, 0x1c, 0xd0, 0x06, 0xa9, 0xed, 0xcb, 0x44, 0x46,
+	0x6a, 0xa4, 0x9c, 0x02, 0xcb, 0xea, 0x78, 0xdc, 0x0a, 0xf3, 0x6f, 0x9c, 0x0f, 0x2f, 0x1f, 0xd0,
+	0x0e, 0xb6, 0xe0, 0x16, 0x6f, 0xfc, 0x2c, 0x91, 0xda, 0xf2, 0xe1, 0xad, 0xfe, 0x07, 0xfa, 0x8a,
+	0x3c, 0x3a, 0x94, 0x67, 0xad, 0x49, 0x2a, 0x9d, 0x32, 0xba, 0x0b, 0x90, 0x1e, 0xe9, 0x14, 0x6c,
+	0x62, 0xb4, 0x55, 0xa7, 0x80, 0xe1, 0x28, 0x8a, 0x7f, 0x59, 0xfc, 0xe8, 0x0e, 0xe5, 0x59, 0x5b,
+	0xcb, 0x81, 0x53, 0xa7, 0xd0, 0x57, 0x63, 0xc0, 0xe4, 0x14, 0xc5, 0x3a, 0xa6, 0x21, 0xa9, 0xf6,
+	0x8d, 0x93, 0xa3, 0xa3, 0x04, 0x5d, 0x45, 0x74, 0xdd, 0x44, 0x3e, 0x2c, 0x28, 0x5b, 0xe6, 0xb3,
+	0x46, 0x4f, 0x09, 0x3d, 0xab, 0xd0, 0xb7, 0xcc, 0xaf, 0x3d, 0x27, 0xc7, 0x09, 0xc6, 0xa9, 0x28,
+	0x72, 0x80, 0x01, 0xb1, 0xaf, 0xf1, 0x54, 0xcc, 0x50, 0x59, 0x2c, 0xb5, 0xbf, 0xab, 0x80, 0x01,
+	0xa8, 0x53, 0x88, 0x17, 0x71, 0xdd, 0xc2, 0x8e, 0xae, 0x63, 0xef, 0xdc, 0x37, 0xe3, 0x64, 0xe2,
+	0x72, 0x67, 0x79, 0xe6, 0x5c, 0xc3, 0xb7, 0x03, 0x5e, 0xf9, 0xcf, 0x80, 0x93, 0xbf, 0x06, 0xdc,
+	0xf7, 0xb8, 0x3f, 0x4d, 0x00, 0x33, 0x57, 0x11, 0x4b, 0xbd, 0x12, 0xfe, 0xda, 0x5a, 0xf8, 0x43,
+	0x52, 0x6d, 0xdb, 0x63, 0x39, 0x52, 0xb1, 0x74, 0x26, 0xc5, 0xcc, 0x95, 0xc5, 0x4d, 0x44, 0xf7,
+	0x08, 0x7d, 0x2f, 0xad, 0x3b, 0x4a, 0x9c, 0x1a, 0x83, 0xef, 0xa6, 0x5f, 0x31, 0x73, 0x45, 0x71,
+	0x47, 0xc5, 0xef, 0xf8, 0x0e, 0x34, 0x58, 0x65, 0x71, 0x16, 0xf5, 0xd9, 0xbc, 0x6e, 0xa0, 0xc6,
+	0x33, 0x52, 0x6d, 0x45, 0x79, 0xeb, 0xe7, 0x83, 0xb1, 0x5e, 0xcc, 0xa3, 0x93, 0x83, 0x37, 0x2f,
+	0xcf, 0x2f, 0x79, 0xe1, 0xe2, 0x92, 0x17, 0xae, 0x2f, 0x79, 0xf0, 0x25, 0xe3, 0xc1, 0xf7, 0x8c,
+	0x07, 0x3f, 0x32, 0x1e, 0x9c, 0x67, 0x3c, 0xf8, 0x95, 0xf1, 0xe0, 0x77, 0xc6, 0x0b, 0xd7, 0x19,
+	0x0f, 0xbe, 0x5d, 0xf1, 0xc2, 0xf9, 0x15, 0x2f, 0x5c, 0x5c, 0xf1, 0xc2, 0xc7, 0x52, 0x2c, 0x9d,
+	0x8c, 0x36, 0xf1, 0x23, 0xf6, 0xe2, 0xcf, 0x00, 0xc6, 0xe1, 0xa8, 0xda, 0xde, 0x04, 0x00, 0x00,
 }
 
 func (this *Heartbeat) Equal(that interface{}) bool {
@@ -412,6 +482,30 @@ func (this *Heartbeat) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Pid, that1.Pid) {
 		return false
 	}
+	if this.HeartbeatVersion != that1.HeartbeatVersion {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ProbableHighestNonce != that1.ProbableHighestNonce {
+		return false
+	}
+	if this.IsSyncing != that1.IsSyncing {
+		return false
+	}
+	if this.Capabilities != that1.Capabilities {
+		return false
+	}
+	if !bytes.Equal(this.PayloadSignature, that1.PayloadSignature) {
+		return false
+	}
 	return true
 }
 func (this *HeartbeatDTO) Equal(that interface{}) bool {
@@ -508,7 +602,7 @@ func (this *Heartbeat) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 20)
 	s = append(s, "&data.Heartbeat{")
 	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
 	s = append(s, "Pubkey: "+fmt.Sprintf("%#v", this.Pubkey)+",\n")
@@ -518,6 +612,14 @@ func (this *Heartbeat) GoString() string {
 	s = append(s, "NodeDisplayName: "+fmt.Sprintf("%#v", this.NodeDisplayName)+",\n")
 	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
 	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "HeartbeatVersion: "+fmt.Sprintf("%#v", this.HeartbeatVersion)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "ProbableHighestNonce: "+fmt.Sprintf("%#v", this.ProbableHighestNonce)+",\n")
+	s = append(s, "IsSyncing: "+fmt.Sprintf("%#v", this.IsSyncing)+",\n")
+	s = append(s, "Capabilities: "+fmt.Sprintf("%#v", this.Capabilities)+",\n")
+	s = append(s, "PayloadSignature: "+fmt.Sprintf("%#v", this.PayloadSignature)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -583,6 +685,55 @@ func (m *Heartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PayloadSignature) > 0 {
+		i -= len(m.PayloadSignature)
+		copy(dAtA[i:], m.PayloadSignature)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.PayloadSignature)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Capabilities != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Capabilities))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.IsSyncing {
+		i--
+		if m.IsSyncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ProbableHighestNonce != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.ProbableHighestNonce))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Round != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HeartbeatVersion != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.HeartbeatVersion))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Pid) > 0 {
 		i -= len(m.Pid)
 		copy(dAtA[i:], m.Pid)
@@ -832,6 +983,31 @@ func (m *Heartbeat) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHeartbeat(uint64(l))
 	}
+	if m.HeartbeatVersion != 0 {
+		n += 1 + sovHeartbeat(uint64(m.HeartbeatVersion))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Epoch))
+	}
+	if m.ProbableHighestNonce != 0 {
+		n += 1 + sovHeartbeat(uint64(m.ProbableHighestNonce))
+	}
+	if m.IsSyncing {
+		n += 2
+	}
+	if m.Capabilities != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Capabilities))
+	}
+	l = len(m.PayloadSignature)
+	if l > 0 {
+		n += 2 + l + sovHeartbeat(uint64(l))
+	}
 	return n
 }
 
@@ -924,6 +1100,14 @@ func (this *Heartbeat) String() string {
 		`NodeDisplayName:` + fmt.Sprintf("%v", this.NodeDisplayName) + `,`,
 		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
 		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`HeartbeatVersion:` + fmt.Sprintf("%v", this.HeartbeatVersion) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`ProbableHighestNonce:` + fmt.Sprintf("%v", this.ProbableHighestNonce) + `,`,
+		`IsSyncing:` + fmt.Sprintf("%v", this.IsSyncing) + `,`,
+		`Capabilities:` + fmt.Sprintf("%v", this.Capabilities) + `,`,
+		`PayloadSignature:` + fmt.Sprintf("%v", this.PayloadSignature) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1250,6 +1434,174 @@ func (m *Heartbeat) Unmarshal(dAtA []byte) error {
 				m.Pid = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatVersion", wireType)
+			}
+			m.HeartbeatVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbableHighestNonce", wireType)
+			}
+			m.ProbableHighestNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProbableHighestNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSyncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSyncing = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			m.Capabilities = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capabilities |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadSignature = append(m.PayloadSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadSignature == nil {
+				m.PayloadSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHeartbeat(dAtA[iNdEx:])
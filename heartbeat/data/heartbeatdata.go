@@ -21,6 +21,17 @@ type PubKeyHeartbeat struct {
 	NodeDisplayName string    `json:"nodeDisplayName"`
 	Identity        string    `json:"identity"`
 	PeerType        string    `json:"peerType"`
+
+	HeartbeatVersion     uint32 `json:"heartbeatVersion"`
+	Nonce                uint64 `json:"nonce"`
+	Round                uint64 `json:"round"`
+	Epoch                uint32 `json:"epoch"`
+	ProbableHighestNonce uint64 `json:"probableHighestNonce"`
+	IsSyncing            bool   `json:"isSyncing"`
+	Capabilities         uint32 `json:"capabilities"`
+	SyncLag              uint64 `json:"syncLag"`
+	IsStale              bool   `json:"isStale"`
+	IsInconsistent       bool   `json:"isInconsistent"`
 }
 
 // Duration is a wrapper of the original Duration struct
@@ -98,3 +98,24 @@ var ErrValidatorAlreadySet = errors.New("topic validator has already been set")
 
 // ErrNilPeerSignatureHandler signals that a nil peerSignatureHandler object has been provided
 var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHandler")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilForkDetector signals that a nil fork detector has been provided
+var ErrNilForkDetector = errors.New("nil fork detector")
+
+// ErrNilRounder signals that a nil rounder has been provided
+var ErrNilRounder = errors.New("nil rounder")
+
+// ErrInvalidPayloadSignature signals that the payload signature of a heartbeat message is invalid
+var ErrInvalidPayloadSignature = errors.New("invalid heartbeat payload signature")
+
+// ErrZeroMaxNoncesBehindToConsiderStale signals that a zero value was provided for the MaxNoncesBehindToConsiderStale
+var ErrZeroMaxNoncesBehindToConsiderStale = errors.New("zero maxNoncesBehindToConsiderStale")
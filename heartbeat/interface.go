@@ -5,8 +5,9 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/state"
-	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -29,7 +30,7 @@ type P2PMessenger interface {
 
 // MessageHandler defines what a message processor for heartbeat should do
 type MessageHandler interface {
-	CreateHeartbeatFromP2PMessage(message p2p.MessageP2P) (*data.Heartbeat, error)
+	CreateHeartbeatFromP2PMessage(message p2p.MessageP2P) (*heartbeatData.Heartbeat, error)
 	IsInterfaceNil() bool
 }
 
@@ -50,8 +51,8 @@ type Timer interface {
 type HeartbeatStorageHandler interface {
 	LoadGenesisTime() (time.Time, error)
 	UpdateGenesisTime(genesisTime time.Time) error
-	LoadHeartBeatDTO(pubKey string) (*data.HeartbeatDTO, error)
-	SavePubkeyData(pubkey []byte, heartbeat *data.HeartbeatDTO) error
+	LoadHeartBeatDTO(pubKey string) (*heartbeatData.HeartbeatDTO, error)
+	SavePubkeyData(pubkey []byte, heartbeat *heartbeatData.HeartbeatDTO) error
 	LoadKeys() ([][]byte, error)
 	SaveKeys(peersSlice [][]byte) error
 	IsInterfaceNil() bool
@@ -105,3 +106,21 @@ type ValidatorStatisticsProcessor interface {
 	GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
 	IsInterfaceNil() bool
 }
+
+// BlockChainHandler defines what a component able to provide the current block header should do
+type BlockChainHandler interface {
+	GetCurrentBlockHeader() data.HeaderHandler
+	IsInterfaceNil() bool
+}
+
+// ForkDetector defines what a component able to provide the probable highest nonce should do
+type ForkDetector interface {
+	ProbableHighestNonce() uint64
+	IsInterfaceNil() bool
+}
+
+// Rounder defines what a component able to provide the current round should do
+type Rounder interface {
+	Index() int64
+	IsInterfaceNil() bool
+}
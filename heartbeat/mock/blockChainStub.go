@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// BlockChainStub -
+type BlockChainStub struct {
+	GetCurrentBlockHeaderCalled func() data.HeaderHandler
+}
+
+// GetCurrentBlockHeader -
+func (bcs *BlockChainStub) GetCurrentBlockHeader() data.HeaderHandler {
+	if bcs.GetCurrentBlockHeaderCalled != nil {
+		return bcs.GetCurrentBlockHeaderCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (bcs *BlockChainStub) IsInterfaceNil() bool {
+	return bcs == nil
+}
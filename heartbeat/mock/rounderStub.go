@@ -0,0 +1,20 @@
+package mock
+
+// RounderStub -
+type RounderStub struct {
+	IndexCalled func() int64
+}
+
+// Index -
+func (rs *RounderStub) Index() int64 {
+	if rs.IndexCalled != nil {
+		return rs.IndexCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (rs *RounderStub) IsInterfaceNil() bool {
+	return rs == nil
+}
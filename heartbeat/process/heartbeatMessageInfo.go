@@ -9,6 +9,30 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 )
 
+// reportedState holds the state reported by a node through its heartbeat messages
+type reportedState struct {
+	heartbeatVersion     uint32
+	nonce                uint64
+	round                uint64
+	epoch                uint32
+	probableHighestNonce uint64
+	isSyncing            bool
+	capabilities         uint32
+	isInconsistent       bool
+}
+
+// hasNodeState returns true if the state was reported through a heartbeat message version able to carry it
+func (rs reportedState) hasNodeState() bool {
+	return isNodeStateVerifiable(rs.heartbeatVersion)
+}
+
+// isNodeStateVerifiable returns true if the heartbeat messages with the provided version carry a node state whose
+// payload signature this node is able to verify. The messages with a newer version might contain unknown fields so
+// only the liveness information is taken from them
+func isNodeStateVerifiable(heartbeatVersion uint32) bool {
+	return heartbeatVersion >= heartbeat.HeartbeatV2 && heartbeatVersion <= heartbeat.CurrentHeartbeatVersion
+}
+
 // heartbeatMessageInfo retain the message info received from another node (identified by a public key)
 type heartbeatMessageInfo struct {
 	maxDurationPeerUnresponsive time.Duration
@@ -28,6 +52,9 @@ type heartbeatMessageInfo struct {
 	updateMutex        sync.Mutex
 	getTimeHandler     func() time.Time
 	isActive           bool
+	reportedState      reportedState
+	syncLag            uint64
+	isStale            bool
 }
 
 // newHeartbeatMessageInfo returns a new instance of a heartbeatMessageInfo
@@ -60,6 +87,7 @@ func newHeartbeatMessageInfo(
 		peerType:                    peerType,
 		genesisTime:                 genesisTime,
 		getTimeHandler:              timer.Now,
+		reportedState:               reportedState{heartbeatVersion: heartbeat.HeartbeatV1},
 	}
 
 	return hbmi, nil
@@ -180,6 +208,31 @@ func (hbmi *heartbeatMessageInfo) UpdateShardAndPeerType(
 	hbmi.peerType = peerType
 }
 
+// SetReportedState updates the state reported by the peer in its last heartbeat message
+func (hbmi *heartbeatMessageInfo) SetReportedState(state reportedState) {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	hbmi.reportedState = state
+}
+
+// GetReportedState returns the state reported by the peer in its last heartbeat message
+func (hbmi *heartbeatMessageInfo) GetReportedState() reportedState {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	return hbmi.reportedState
+}
+
+// SetSyncLag updates the number of nonces the peer is behind the other peers from its shard
+func (hbmi *heartbeatMessageInfo) SetSyncLag(syncLag uint64, isStale bool) {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	hbmi.syncLag = syncLag
+	hbmi.isStale = isStale
+}
+
 func (hbmi *heartbeatMessageInfo) updateMaxInactiveTimeDuration(currentTime time.Time) {
 	crtDuration := currentTime.Sub(hbmi.timeStamp)
 	crtDuration = maxDuration(0, crtDuration)
@@ -208,6 +261,14 @@ func (hbmi *heartbeatMessageInfo) GetIsActive() bool {
 	return isActive
 }
 
+// GetReceivedShardID will return the shard ID reported by the peer
+func (hbmi *heartbeatMessageInfo) GetReceivedShardID() uint32 {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	return hbmi.receivedShardID
+}
+
 // GetIsValidator will return true is the peer is a validator
 func (hbmi *heartbeatMessageInfo) GetIsValidator() bool {
 	hbmi.updateMutex.Lock()
@@ -40,6 +40,11 @@ func verifyLengths(heartbeat *data.Heartbeat) error {
 		return err
 	}
 
+	err = VerifyHeartbeatProperyLen("PayloadSignature", heartbeat.PayloadSignature)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package process
 
 import (
+	"fmt"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -14,6 +16,8 @@ import (
 // heartbeatMessageInfo and HeartbeatDTO
 type MessageProcessor struct {
 	peerSignatureHandler     crypto.PeerSignatureHandler
+	keyGen                   crypto.KeyGenerator
+	singleSigner             crypto.SingleSigner
 	marshalizer              marshal.Marshalizer
 	networkShardingCollector heartbeat.NetworkShardingCollector
 }
@@ -21,12 +25,20 @@ type MessageProcessor struct {
 // NewMessageProcessor will return a new instance of MessageProcessor
 func NewMessageProcessor(
 	peerSignatureHandler crypto.PeerSignatureHandler,
+	keyGen crypto.KeyGenerator,
+	singleSigner crypto.SingleSigner,
 	marshalizer marshal.Marshalizer,
 	networkShardingCollector heartbeat.NetworkShardingCollector,
 ) (*MessageProcessor, error) {
 	if check.IfNil(peerSignatureHandler) {
 		return nil, heartbeat.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(keyGen) {
+		return nil, heartbeat.ErrNilKeyGenerator
+	}
+	if check.IfNil(singleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
 	if check.IfNil(marshalizer) {
 		return nil, heartbeat.ErrNilMarshalizer
 	}
@@ -36,6 +48,8 @@ func NewMessageProcessor(
 
 	return &MessageProcessor{
 		peerSignatureHandler:     peerSignatureHandler,
+		keyGen:                   keyGen,
+		singleSigner:             singleSigner,
 		marshalizer:              marshalizer,
 		networkShardingCollector: networkShardingCollector,
 	}, nil
@@ -67,6 +81,11 @@ func (mp *MessageProcessor) CreateHeartbeatFromP2PMessage(message p2p.MessageP2P
 		return nil, err
 	}
 
+	err = mp.verifyPayloadSignature(hbRecv)
+	if err != nil {
+		return nil, err
+	}
+
 	mp.networkShardingCollector.UpdatePeerIdPublicKey(message.Peer(), hbRecv.Pubkey)
 	//add into the last failsafe map. Useful for observers.
 	mp.networkShardingCollector.UpdatePeerIdShardId(message.Peer(), hbRecv.ShardID)
@@ -74,6 +93,34 @@ func (mp *MessageProcessor) CreateHeartbeatFromP2PMessage(message p2p.MessageP2P
 	return hbRecv, nil
 }
 
+// verifyPayloadSignature checks that the state reported in a HeartbeatV2 message was signed with the same key that
+// signed the peer ID. The messages with a newer version can not be verified as they might contain unknown fields, so
+// the monitor will not use the state reported by them
+func (mp *MessageProcessor) verifyPayloadSignature(hb *data.Heartbeat) error {
+	if !isNodeStateVerifiable(hb.HeartbeatVersion) {
+		return nil
+	}
+
+	pubKey, err := mp.keyGen.PublicKeyFromByteArray(hb.Pubkey)
+	if err != nil {
+		return fmt.Errorf("%w: %s", heartbeat.ErrInvalidPayloadSignature, err.Error())
+	}
+
+	hbCopy := *hb
+	hbCopy.PayloadSignature = nil
+	buffToVerify, err := mp.marshalizer.Marshal(&hbCopy)
+	if err != nil {
+		return err
+	}
+
+	err = mp.singleSigner.Verify(pubKey, buffToVerify, hb.PayloadSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", heartbeat.ErrInvalidPayloadSignature, err.Error())
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mp *MessageProcessor) IsInterfaceNil() bool {
 	return mp == nil
@@ -1,14 +1,19 @@
 package process_test
 
 import (
+	"bytes"
 	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,6 +34,8 @@ func TestNewMessageProcessor_PeerSignatureHandlerNilShouldErr(t *testing.T) {
 
 	mon, err := process.NewMessageProcessor(
 		nil,
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		&mock.MarshalizerStub{},
 		&mock.NetworkShardingCollectorStub{},
 	)
@@ -37,11 +44,43 @@ func TestNewMessageProcessor_PeerSignatureHandlerNilShouldErr(t *testing.T) {
 	assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
 }
 
+func TestNewMessageProcessor_KeyGeneratorNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mon, err := process.NewMessageProcessor(
+		&mock.PeerSignatureHandler{},
+		nil,
+		&mock.SinglesignMock{},
+		&mock.MarshalizerStub{},
+		&mock.NetworkShardingCollectorStub{},
+	)
+
+	assert.Nil(t, mon)
+	assert.Equal(t, heartbeat.ErrNilKeyGenerator, err)
+}
+
+func TestNewMessageProcessor_SingleSignerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mon, err := process.NewMessageProcessor(
+		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		nil,
+		&mock.MarshalizerStub{},
+		&mock.NetworkShardingCollectorStub{},
+	)
+
+	assert.Nil(t, mon)
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+}
+
 func TestNewMessageProcessor_MarshalizerNilShouldErr(t *testing.T) {
 	t.Parallel()
 
 	mon, err := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		nil,
 		&mock.NetworkShardingCollectorStub{},
 	)
@@ -55,6 +94,8 @@ func TestNewMessageProcessor_NetworkShardingCollectorNilShouldErr(t *testing.T)
 
 	mon, err := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		&mock.MarshalizerStub{},
 		nil,
 	)
@@ -68,6 +109,8 @@ func TestNewMessageProcessor_ShouldWork(t *testing.T) {
 
 	mon, err := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		&mock.MarshalizerStub{},
 		&mock.NetworkShardingCollectorStub{},
 	)
@@ -230,6 +273,8 @@ func TestNewMessageProcessor_CreateHeartbeatFromP2PMessage(t *testing.T) {
 	updatePidShardIdCalled := false
 	mon, err := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		marshalizer,
 		&mock.NetworkShardingCollectorStub{
 			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {
@@ -275,6 +320,8 @@ func TestNewMessageProcessor_CreateHeartbeatFromP2pMessageWithNilDataShouldErr(t
 
 	mon, _ := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		&mock.MarshalizerStub{},
 		&mock.NetworkShardingCollectorStub{
 			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
@@ -304,6 +351,8 @@ func TestNewMessageProcessor_CreateHeartbeatFromP2pMessageWithUnmarshaliableData
 
 	mon, _ := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		&mock.MarshalizerStub{
 			UnmarshalHandler: func(obj interface{}, buff []byte) error {
 				return expectedErr
@@ -355,6 +404,8 @@ func TestNewMessageProcessor_CreateHeartbeatFromP2PMessageWithTooLongLengthsShou
 
 	mon, err := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		marshalizer,
 		&mock.NetworkShardingCollectorStub{
 			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
@@ -383,6 +434,8 @@ func TestNewMessageProcessor_CreateHeartbeatFromP2pNilMessageShouldErr(t *testin
 
 	mon, _ := process.NewMessageProcessor(
 		&mock.PeerSignatureHandler{},
+		&mock.KeyGenMock{},
+		&mock.SinglesignMock{},
 		&mock.MarshalizerStub{},
 		&mock.NetworkShardingCollectorStub{
 			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
@@ -394,3 +447,95 @@ func TestNewMessageProcessor_CreateHeartbeatFromP2pNilMessageShouldErr(t *testin
 	assert.Nil(t, ret)
 	assert.Equal(t, heartbeat.ErrNilMessage, err)
 }
+
+func createHeartbeatV2Message(t *testing.T, marshalizer marshal.Marshalizer, version uint32) (*data.Heartbeat, p2p.MessageP2P) {
+	hb := &data.Heartbeat{
+		Payload:          []byte("Payload"),
+		Pubkey:           []byte("PubKey"),
+		Signature:        []byte("signed"),
+		VersionNumber:    "VersionNumber",
+		NodeDisplayName:  "NodeDisplayName",
+		HeartbeatVersion: version,
+		Nonce:            10,
+		Round:            11,
+	}
+
+	buffToSign, err := marshalizer.Marshal(hb)
+	assert.Nil(t, err)
+
+	hb.PayloadSignature = sha256.Sha256{}.Compute(string(buffToSign))
+	buff, err := marshalizer.Marshal(hb)
+	assert.Nil(t, err)
+
+	return hb, &mock.P2PMessageStub{DataField: buff}
+}
+
+func createMessageProcessorVerifyingPayload(marshalizer marshal.Marshalizer) *process.MessageProcessor {
+	mp, _ := process.NewMessageProcessor(
+		&mock.PeerSignatureHandler{Signer: &mock.SinglesignMock{}},
+		&mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.PublicKeyMock{}, nil
+			},
+		},
+		&mock.SinglesignStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				if !bytes.Equal(sha256.Sha256{}.Compute(string(msg)), sig) {
+					return crypto.ErrSigNotValid
+				}
+
+				return nil
+			},
+		},
+		marshalizer,
+		&mock.NetworkShardingCollectorStub{
+			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
+			UpdatePeerIdShardIdCalled:   func(pid core.PeerID, shardId uint32) {},
+		},
+	)
+
+	return mp
+}
+
+func TestNewMessageProcessor_CreateHeartbeatFromP2PMessageV2ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	mp := createMessageProcessorVerifyingPayload(marshalizer)
+	hb, message := createHeartbeatV2Message(t, marshalizer, heartbeat.HeartbeatV2)
+
+	ret, err := mp.CreateHeartbeatFromP2PMessage(message)
+
+	assert.Nil(t, err)
+	assert.Equal(t, hb, ret)
+}
+
+func TestNewMessageProcessor_CreateHeartbeatFromP2PMessageV2AlteredStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	mp := createMessageProcessorVerifyingPayload(marshalizer)
+	hb, _ := createHeartbeatV2Message(t, marshalizer, heartbeat.HeartbeatV2)
+	hb.Nonce++
+	buff, _ := marshalizer.Marshal(hb)
+
+	ret, err := mp.CreateHeartbeatFromP2PMessage(&mock.P2PMessageStub{DataField: buff})
+
+	assert.Nil(t, ret)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidPayloadSignature))
+}
+
+func TestNewMessageProcessor_CreateHeartbeatFromP2PMessageNewerVersionShouldNotVerifyPayload(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	mp := createMessageProcessorVerifyingPayload(marshalizer)
+	hb, _ := createHeartbeatV2Message(t, marshalizer, heartbeat.CurrentHeartbeatVersion+1)
+	hb.PayloadSignature = []byte("unverifiable signature")
+	buff, _ := marshalizer.Marshal(hb)
+
+	ret, err := mp.CreateHeartbeatFromP2PMessage(&mock.P2PMessageStub{DataField: buff})
+
+	assert.Nil(t, err)
+	assert.Equal(t, hb, ret)
+}
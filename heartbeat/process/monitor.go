@@ -20,6 +20,10 @@ import (
 
 var log = logger.GetOrCreate("heartbeat/process")
 
+// maxRoundsAheadTolerance is the number of rounds a reported block can be ahead of the current round, covering the
+// small clock differences between the nodes
+const maxRoundsAheadTolerance = 1
+
 // ArgHeartbeatMonitor represents the arguments for the heartbeat monitor
 type ArgHeartbeatMonitor struct {
 	Marshalizer                        marshal.Marshalizer
@@ -33,8 +37,10 @@ type ArgHeartbeatMonitor struct {
 	AntifloodHandler                   heartbeat.P2PAntifloodHandler
 	HardforkTrigger                    heartbeat.HardforkTrigger
 	ValidatorPubkeyConverter           core.PubkeyConverter
+	Rounder                            heartbeat.Rounder
 	HeartbeatRefreshIntervalInSec      uint32
 	HideInactiveValidatorIntervalInSec uint32
+	MaxNoncesBehindToConsiderStale     uint64
 }
 
 // Monitor represents the heartbeat component that processes received heartbeat messages
@@ -56,8 +62,10 @@ type Monitor struct {
 	antifloodHandler                   heartbeat.P2PAntifloodHandler
 	hardforkTrigger                    heartbeat.HardforkTrigger
 	validatorPubkeyConverter           core.PubkeyConverter
+	rounder                            heartbeat.Rounder
 	heartbeatRefreshIntervalInSec      uint32
 	hideInactiveValidatorIntervalInSec uint32
+	maxNoncesBehindToConsiderStale     uint64
 }
 
 // NewMonitor returns a new monitor instance
@@ -89,12 +97,18 @@ func NewMonitor(arg ArgHeartbeatMonitor) (*Monitor, error) {
 	if check.IfNil(arg.ValidatorPubkeyConverter) {
 		return nil, heartbeat.ErrNilPubkeyConverter
 	}
+	if check.IfNil(arg.Rounder) {
+		return nil, heartbeat.ErrNilRounder
+	}
 	if arg.HeartbeatRefreshIntervalInSec == 0 {
 		return nil, heartbeat.ErrZeroHeartbeatRefreshIntervalInSec
 	}
 	if arg.HideInactiveValidatorIntervalInSec == 0 {
 		return nil, heartbeat.ErrZeroHideInactiveValidatorIntervalInSec
 	}
+	if arg.MaxNoncesBehindToConsiderStale == 0 {
+		return nil, heartbeat.ErrZeroMaxNoncesBehindToConsiderStale
+	}
 
 	mon := &Monitor{
 		marshalizer:                        arg.Marshalizer,
@@ -109,8 +123,10 @@ func NewMonitor(arg ArgHeartbeatMonitor) (*Monitor, error) {
 		antifloodHandler:                   arg.AntifloodHandler,
 		hardforkTrigger:                    arg.HardforkTrigger,
 		validatorPubkeyConverter:           arg.ValidatorPubkeyConverter,
+		rounder:                            arg.Rounder,
 		heartbeatRefreshIntervalInSec:      arg.HeartbeatRefreshIntervalInSec,
 		hideInactiveValidatorIntervalInSec: arg.HideInactiveValidatorIntervalInSec,
+		maxNoncesBehindToConsiderStale:     arg.MaxNoncesBehindToConsiderStale,
 	}
 
 	err := mon.storer.UpdateGenesisTime(arg.GenesisTime)
@@ -322,6 +338,7 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *data.Heartbeat) {
 	peerType, computedShardID := m.computePeerTypeAndShardID(hb.Pubkey)
 
 	hbmi.HeartbeatReceived(computedShardID, hb.ShardID, hb.VersionNumber, hb.NodeDisplayName, hb.Identity, peerType)
+	hbmi.SetReportedState(m.createReportedState(hb))
 	hbDTO := m.convertToExportedStruct(hbmi)
 
 	err := m.storer.SavePubkeyData(hb.Pubkey, &hbDTO)
@@ -331,6 +348,51 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *data.Heartbeat) {
 	m.addPeerToFullPeersSlice(hb.Pubkey)
 }
 
+func (m *Monitor) createReportedState(hb *data.Heartbeat) reportedState {
+	state := reportedState{
+		heartbeatVersion: hb.HeartbeatVersion,
+	}
+	if state.heartbeatVersion < heartbeat.HeartbeatV1 {
+		state.heartbeatVersion = heartbeat.HeartbeatV1
+	}
+	if !state.hasNodeState() {
+		//the state reported by the legacy or by the newer, unverified, heartbeat versions is ignored: only the
+		// liveness information is kept for these peers
+		return state
+	}
+
+	state.nonce = hb.Nonce
+	state.round = hb.Round
+	state.epoch = hb.Epoch
+	state.probableHighestNonce = hb.ProbableHighestNonce
+	state.isSyncing = hb.IsSyncing
+	state.capabilities = hb.Capabilities
+	state.isInconsistent = m.isReportedStateInconsistent(state)
+	if state.isInconsistent {
+		log.Debug("monitor: inconsistent state reported in heartbeat",
+			"pk", m.validatorPubkeyConverter.Encode(hb.Pubkey),
+			"nonce", state.nonce,
+			"round", state.round,
+			"current round", m.rounder.Index(),
+		)
+	}
+
+	return state
+}
+
+// isReportedStateInconsistent returns true if the reported block is from a future round or if its nonce is greater
+// than its round, which can not happen as each block is proposed in a later round than its predecessor
+func (m *Monitor) isReportedStateInconsistent(state reportedState) bool {
+	currentRound := m.rounder.Index()
+	if currentRound < 0 {
+		currentRound = 0
+	}
+
+	isFromFutureRound := state.round > uint64(currentRound)+maxRoundsAheadTolerance
+
+	return isFromFutureRound || state.nonce > state.round
+}
+
 func (m *Monitor) addPeerToFullPeersSlice(pubKey []byte) {
 	m.mutFullPeersSlice.Lock()
 	defer m.mutFullPeersSlice.Unlock()
@@ -386,6 +448,8 @@ func (m *Monitor) computeAllHeartbeatMessages() {
 		}
 	}
 
+	m.computeSyncLags()
+
 	m.mutHeartbeatMessages.Unlock()
 	go m.SaveMultipleHeartbeatMessageInfos(hbChangedStateToInactiveMap)
 
@@ -395,6 +459,53 @@ func (m *Monitor) computeAllHeartbeatMessages() {
 	m.mutAppStatusHandler.Unlock()
 }
 
+// computeSyncLags computes, for each active peer, the number of nonces it is behind the median nonce reported by the
+// consistent validators from its shard. The median is used so that a minority of validators reporting inflated nonces
+// cannot mark the honest nodes as stale. It should be called under the heartbeat messages mutex
+func (m *Monitor) computeSyncLags() {
+	reportedNonces := make(map[uint32][]uint64)
+	for _, v := range m.heartbeatMessages {
+		state := v.GetReportedState()
+		isReference := v.GetIsActive() && v.GetIsValidator() && state.hasNodeState() && !state.isInconsistent
+		if !isReference {
+			continue
+		}
+
+		shardID := v.GetReceivedShardID()
+		reportedNonces[shardID] = append(reportedNonces[shardID], state.nonce)
+	}
+
+	referenceNonces := make(map[uint32]uint64, len(reportedNonces))
+	for shardID, nonces := range reportedNonces {
+		referenceNonces[shardID] = computeMedian(nonces)
+	}
+
+	for _, v := range m.heartbeatMessages {
+		state := v.GetReportedState()
+		if !v.GetIsActive() || !state.hasNodeState() {
+			v.SetSyncLag(0, false)
+			continue
+		}
+
+		syncLag := uint64(0)
+		referenceNonce := referenceNonces[v.GetReceivedShardID()]
+		if referenceNonce > state.nonce {
+			syncLag = referenceNonce - state.nonce
+		}
+
+		v.SetSyncLag(syncLag, syncLag > m.maxNoncesBehindToConsiderStale)
+	}
+}
+
+// computeMedian returns the median of the provided values, the higher one of the two middle values for an even count
+func computeMedian(values []uint64) uint64 {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	return values[len(values)/2]
+}
+
 func (m *Monitor) computeInactiveHeartbeatMessages() {
 	m.mutHeartbeatMessages.Lock()
 	inactiveHbChangedMap := make(map[string]*heartbeatMessageInfo)
@@ -454,6 +565,7 @@ func (m *Monitor) GetHeartbeats() []data.PubKeyHeartbeat {
 			Identity:        v.identity,
 			PeerType:        v.peerType,
 		}
+		m.setReportedStateInfo(&tmp, v)
 		status = append(status, tmp)
 	}
 	m.mutHeartbeatMessages.Unlock()
@@ -465,6 +577,22 @@ func (m *Monitor) GetHeartbeats() []data.PubKeyHeartbeat {
 	return status
 }
 
+func (m *Monitor) setReportedStateInfo(pkHeartbeat *data.PubKeyHeartbeat, v *heartbeatMessageInfo) {
+	v.updateMutex.Lock()
+	defer v.updateMutex.Unlock()
+
+	pkHeartbeat.HeartbeatVersion = v.reportedState.heartbeatVersion
+	pkHeartbeat.Nonce = v.reportedState.nonce
+	pkHeartbeat.Round = v.reportedState.round
+	pkHeartbeat.Epoch = v.reportedState.epoch
+	pkHeartbeat.ProbableHighestNonce = v.reportedState.probableHighestNonce
+	pkHeartbeat.IsSyncing = v.reportedState.isSyncing
+	pkHeartbeat.Capabilities = v.reportedState.capabilities
+	pkHeartbeat.IsInconsistent = v.reportedState.isInconsistent
+	pkHeartbeat.SyncLag = v.syncLag
+	pkHeartbeat.IsStale = v.isStale
+}
+
 func (m *Monitor) shouldSkipValidator(v *heartbeatMessageInfo) bool {
 	isInactiveObserver := !v.GetIsActive() &&
 		(v.peerType != string(core.EligibleList) &&
@@ -517,6 +645,7 @@ func (m *Monitor) convertFromExportedStruct(hbDTO data.HeartbeatDTO, maxDuration
 		nodeDisplayName:             hbDTO.NodeDisplayName,
 		identity:                    hbDTO.Identity,
 		peerType:                    hbDTO.PeerType,
+		reportedState:               reportedState{heartbeatVersion: heartbeat.HeartbeatV1},
 	}
 
 	hbmi.maxInactiveTime = time.Duration(hbDTO.MaxInactiveTime)
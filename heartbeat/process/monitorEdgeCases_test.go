@@ -33,8 +33,10 @@ func createMonitor(
 		AntifloodHandler:                   createMockP2PAntifloodHandler(),
 		HardforkTrigger:                    &mock.HardforkTriggerStub{},
 		ValidatorPubkeyConverter:           mock.NewPubkeyConverterMock(32),
+		Rounder:                            &mock.RounderStub{},
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		MaxNoncesBehindToConsiderStale:     20,
 	}
 	mon, _ := process.NewMonitor(arg)
 
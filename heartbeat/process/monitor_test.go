@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -72,8 +73,10 @@ func createMockArgHeartbeatMonitor() process.ArgHeartbeatMonitor {
 		AntifloodHandler:                   createMockP2PAntifloodHandler(),
 		HardforkTrigger:                    &mock.HardforkTriggerStub{},
 		ValidatorPubkeyConverter:           mock.NewPubkeyConverterMock(96),
+		Rounder:                            &mock.RounderStub{},
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		MaxNoncesBehindToConsiderStale:     20,
 	}
 }
 
@@ -528,8 +531,10 @@ func TestMonitor_RemoveInactiveValidatorsIfIntervalExceeded(t *testing.T) {
 		AntifloodHandler:                   createMockP2PAntifloodHandler(),
 		HardforkTrigger:                    &mock.HardforkTriggerStub{},
 		ValidatorPubkeyConverter:           mock.NewPubkeyConverterMock(32),
+		Rounder:                            &mock.RounderStub{},
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		MaxNoncesBehindToConsiderStale:     20,
 	}
 	mon, _ := process.NewMonitor(arg)
 	mon.SendHeartbeatMessage(&data.Heartbeat{Pubkey: []byte(pkValidator)})
@@ -615,3 +620,175 @@ func sendHbMessageFromPubKey(pubKey string, mon *process.Monitor) error {
 	err := mon.ProcessReceivedMessage(&mock.P2PMessageStub{DataField: buffToSend}, fromConnectedPeerId)
 	return err
 }
+
+func TestNewMonitor_NilRounderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.Rounder = nil
+	mon, err := process.NewMonitor(arg)
+
+	assert.Nil(t, mon)
+	assert.Equal(t, heartbeat.ErrNilRounder, err)
+}
+
+func TestNewMonitor_ZeroMaxNoncesBehindToConsiderStaleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxNoncesBehindToConsiderStale = 0
+	mon, err := process.NewMonitor(arg)
+
+	assert.Nil(t, mon)
+	assert.Equal(t, heartbeat.ErrZeroMaxNoncesBehindToConsiderStale, err)
+}
+
+func TestMonitor_ReportedStateShouldComputeSyncLagAndDetectStaleAndInconsistentNodes(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = time.Second * 1000
+	arg.PubKeysMap = map[uint32][]string{}
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			if strings.HasPrefix(string(pubKey), "observer") {
+				return core.ObserverList, 0, nil
+			}
+
+			return core.EligibleList, 0, nil
+		},
+	}
+	arg.Rounder = &mock.RounderStub{
+		IndexCalled: func() int64 {
+			return 100
+		},
+	}
+	mon, _ := process.NewMonitor(arg)
+
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("synced"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 90, Round: 95})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("behind"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 60, Round: 62, IsSyncing: true})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("observer"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 95, Round: 96})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("future round"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 150, Round: 150})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("nonce over round"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 99, Round: 98})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("legacy"), Nonce: 10, Round: 10})
+	mon.RefreshHeartbeatMessageInfo()
+
+	heartbeats := make(map[string]data.PubKeyHeartbeat)
+	for _, hb := range mon.GetHeartbeats() {
+		pk, _ := hex.DecodeString(hb.PublicKey)
+		heartbeats[string(pk)] = hb
+	}
+
+	synced := heartbeats["synced"]
+	assert.Equal(t, heartbeat.HeartbeatV2, synced.HeartbeatVersion)
+	assert.Equal(t, uint64(90), synced.Nonce)
+	assert.Equal(t, uint64(95), synced.Round)
+	assert.Equal(t, uint64(0), synced.SyncLag)
+	assert.False(t, synced.IsStale)
+	assert.False(t, synced.IsInconsistent)
+
+	behind := heartbeats["behind"]
+	assert.Equal(t, uint64(30), behind.SyncLag)
+	assert.True(t, behind.IsSyncing)
+	assert.True(t, behind.IsStale)
+	assert.False(t, behind.IsInconsistent)
+
+	observer := heartbeats["observer"]
+	assert.Equal(t, uint64(0), observer.SyncLag)
+	assert.False(t, observer.IsStale)
+
+	assert.True(t, heartbeats["future round"].IsInconsistent)
+	assert.True(t, heartbeats["nonce over round"].IsInconsistent)
+
+	legacy := heartbeats["legacy"]
+	assert.Equal(t, heartbeat.HeartbeatV1, legacy.HeartbeatVersion)
+	assert.Equal(t, uint64(0), legacy.Nonce)
+	assert.Equal(t, uint64(0), legacy.SyncLag)
+	assert.False(t, legacy.IsStale)
+}
+
+func TestMonitor_ReportedStateFromNewerHeartbeatVersionShouldNotAffectTheSyncLags(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = time.Second * 1000
+	arg.PubKeysMap = map[uint32][]string{}
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			return core.EligibleList, 0, nil
+		},
+	}
+	arg.Rounder = &mock.RounderStub{
+		IndexCalled: func() int64 {
+			return 200
+		},
+	}
+	mon, _ := process.NewMonitor(arg)
+
+	newerVersion := heartbeat.CurrentHeartbeatVersion + 1
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("honest"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 90, Round: 95})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("newer 1"), HeartbeatVersion: newerVersion, Nonce: 190, Round: 190})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("newer 2"), HeartbeatVersion: newerVersion, Nonce: 195, Round: 195})
+	mon.RefreshHeartbeatMessageInfo()
+
+	heartbeats := make(map[string]data.PubKeyHeartbeat)
+	for _, hb := range mon.GetHeartbeats() {
+		pk, _ := hex.DecodeString(hb.PublicKey)
+		heartbeats[string(pk)] = hb
+	}
+
+	honest := heartbeats["honest"]
+	assert.Equal(t, uint64(0), honest.SyncLag)
+	assert.False(t, honest.IsStale)
+
+	newer := heartbeats["newer 1"]
+	assert.True(t, newer.IsActive)
+	assert.Equal(t, newerVersion, newer.HeartbeatVersion)
+	assert.Equal(t, uint64(0), newer.Nonce)
+	assert.Equal(t, uint64(0), newer.Round)
+	assert.Equal(t, uint64(0), newer.SyncLag)
+	assert.False(t, newer.IsStale)
+	assert.False(t, newer.IsInconsistent)
+}
+
+func TestMonitor_ReportedStateInflatedByMinorityShouldNotMarkHonestNodesStale(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = time.Second * 1000
+	arg.PubKeysMap = map[uint32][]string{}
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			return core.EligibleList, 0, nil
+		},
+	}
+	arg.Rounder = &mock.RounderStub{
+		IndexCalled: func() int64 {
+			return 200
+		},
+	}
+	mon, _ := process.NewMonitor(arg)
+
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("honest 1"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 90, Round: 95})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("honest 2"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 90, Round: 95})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("honest 3"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 88, Round: 95})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("behind"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 50, Round: 95})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("inflated 1"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 190, Round: 190})
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: []byte("inflated 2"), HeartbeatVersion: heartbeat.HeartbeatV2, Nonce: 195, Round: 195})
+	mon.RefreshHeartbeatMessageInfo()
+
+	heartbeats := make(map[string]data.PubKeyHeartbeat)
+	for _, hb := range mon.GetHeartbeats() {
+		pk, _ := hex.DecodeString(hb.PublicKey)
+		heartbeats[string(pk)] = hb
+	}
+
+	assert.Equal(t, uint64(0), heartbeats["honest 1"].SyncLag)
+	assert.False(t, heartbeats["honest 1"].IsStale)
+	assert.Equal(t, uint64(0), heartbeats["honest 2"].SyncLag)
+	assert.Equal(t, uint64(2), heartbeats["honest 3"].SyncLag)
+	assert.False(t, heartbeats["honest 3"].IsStale)
+	assert.Equal(t, uint64(40), heartbeats["behind"].SyncLag)
+	assert.True(t, heartbeats["behind"].IsStale)
+	assert.Equal(t, uint64(0), heartbeats["inflated 1"].SyncLag)
+}
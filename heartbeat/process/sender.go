@@ -17,6 +17,7 @@ import (
 type ArgHeartbeatSender struct {
 	PeerMessenger        heartbeat.P2PMessenger
 	PeerSignatureHandler crypto.PeerSignatureHandler
+	SingleSigner         crypto.SingleSigner
 	PrivKey              crypto.PrivateKey
 	Marshalizer          marshal.Marshalizer
 	Topic                string
@@ -27,12 +28,16 @@ type ArgHeartbeatSender struct {
 	NodeDisplayName      string
 	KeyBaseIdentity      string
 	HardforkTrigger      heartbeat.HardforkTrigger
+	BlockChain           heartbeat.BlockChainHandler
+	ForkDetector         heartbeat.ForkDetector
+	Capabilities         uint32
 }
 
 // Sender periodically sends heartbeat messages on a pubsub topic
 type Sender struct {
 	peerMessenger        heartbeat.P2PMessenger
 	peerSignatureHandler crypto.PeerSignatureHandler
+	singleSigner         crypto.SingleSigner
 	privKey              crypto.PrivateKey
 	marshalizer          marshal.Marshalizer
 	shardCoordinator     sharding.Coordinator
@@ -43,6 +48,9 @@ type Sender struct {
 	nodeDisplayName      string
 	keyBaseIdentity      string
 	hardforkTrigger      heartbeat.HardforkTrigger
+	blockChain           heartbeat.BlockChainHandler
+	forkDetector         heartbeat.ForkDetector
+	capabilities         uint32
 }
 
 // NewSender will create a new sender instance
@@ -53,6 +61,9 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.PeerSignatureHandler) {
 		return nil, heartbeat.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(arg.SingleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
 	if check.IfNil(arg.PrivKey) {
 		return nil, heartbeat.ErrNilPrivateKey
 	}
@@ -71,6 +82,12 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.HardforkTrigger) {
 		return nil, heartbeat.ErrNilHardforkTrigger
 	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, heartbeat.ErrNilBlockChain
+	}
+	if check.IfNil(arg.ForkDetector) {
+		return nil, heartbeat.ErrNilForkDetector
+	}
 	err := VerifyHeartbeatProperyLen("application version string", []byte(arg.VersionNumber))
 	if err != nil {
 		return nil, err
@@ -79,6 +96,7 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	sender := &Sender{
 		peerMessenger:        arg.PeerMessenger,
 		peerSignatureHandler: arg.PeerSignatureHandler,
+		singleSigner:         arg.SingleSigner,
 		privKey:              arg.PrivKey,
 		marshalizer:          arg.Marshalizer,
 		topic:                arg.Topic,
@@ -89,6 +107,9 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 		nodeDisplayName:      arg.NodeDisplayName,
 		keyBaseIdentity:      arg.KeyBaseIdentity,
 		hardforkTrigger:      arg.HardforkTrigger,
+		blockChain:           arg.BlockChain,
+		forkDetector:         arg.ForkDetector,
+		capabilities:         arg.Capabilities,
 	}
 
 	return sender, nil
@@ -104,6 +125,7 @@ func (s *Sender) SendHeartbeat() error {
 		Identity:        s.keyBaseIdentity,
 		Pid:             s.peerMessenger.ID().Bytes(),
 	}
+	s.setReportedState(hb)
 
 	triggerMessage, isHardforkTriggered := s.hardforkTrigger.RecordedTriggerMessage()
 	if isHardforkTriggered {
@@ -136,6 +158,16 @@ func (s *Sender) SendHeartbeat() error {
 		return err
 	}
 
+	buffToSign, err := s.marshalizer.Marshal(hb)
+	if err != nil {
+		return err
+	}
+
+	hb.PayloadSignature, err = s.singleSigner.Sign(s.privKey, buffToSign)
+	if err != nil {
+		return err
+	}
+
 	buffToSend, err := s.marshalizer.Marshal(hb)
 	if err != nil {
 		return err
@@ -146,6 +178,21 @@ func (s *Sender) SendHeartbeat() error {
 	return nil
 }
 
+func (s *Sender) setReportedState(hb *data.Heartbeat) {
+	hb.HeartbeatVersion = heartbeat.CurrentHeartbeatVersion
+	hb.Capabilities = s.capabilities
+
+	currentHeader := s.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		hb.Nonce = currentHeader.GetNonce()
+		hb.Round = currentHeader.GetRound()
+		hb.Epoch = currentHeader.GetEpoch()
+	}
+
+	hb.ProbableHighestNonce = s.forkDetector.ProbableHighestNonce()
+	hb.IsSyncing = hb.ProbableHighestNonce > hb.Nonce
+}
+
 func (s *Sender) updateMetrics(hb *data.Heartbeat) {
 	result := s.computePeerList(hb.Pubkey)
 
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	nodeData "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
@@ -22,6 +24,7 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 			BroadcastCalled: func(topic string, buff []byte) {},
 		},
 		PeerSignatureHandler: &mock.PeerSignatureHandler{},
+		SingleSigner:         &mock.SinglesignMock{},
 		PrivKey:              &mock.PrivateKeyStub{},
 		Marshalizer: &mock.MarshalizerStub{
 			MarshalHandler: func(obj interface{}) (i []byte, e error) {
@@ -35,6 +38,8 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 		VersionNumber:    "v0.1",
 		NodeDisplayName:  "undefined",
 		HardforkTrigger:  &mock.HardforkTriggerStub{},
+		BlockChain:       &mock.BlockChainStub{},
+		ForkDetector:     &mock.ForkDetectorStub{},
 	}
 }
 
@@ -126,6 +131,39 @@ func TestNewSender_NilHardforkTriggerShouldErr(t *testing.T) {
 	assert.Equal(t, heartbeat.ErrNilHardforkTrigger, err)
 }
 
+func TestNewSender_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.SingleSigner = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+}
+
+func TestNewSender_NilBlockChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.BlockChain = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilBlockChain, err)
+}
+
+func TestNewSender_NilForkDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.ForkDetector = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilForkDetector, err)
+}
+
 func TestNewSender_PropertyTooLongShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -440,3 +478,99 @@ func TestSender_SendHeartbeatAfterTriggerWithRecorededPayloadShouldWork(t *testi
 	assert.True(t, genPubKeyClled)
 	assert.True(t, marshalCalled)
 }
+
+func TestSender_SendHeartbeatShouldSendTheSignedReportedState(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	payloadSignature := []byte("payload signature")
+	var signedBuff []byte
+	var broadcastBuff []byte
+
+	arg := createMockArgHeartbeatSender()
+	arg.Marshalizer = marshalizer
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return []byte("pub key"), nil
+				},
+			}
+		},
+	}
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastBuff = buff
+		},
+	}
+	arg.SingleSigner = &mock.SinglesignStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			signedBuff = msg
+			return payloadSignature, nil
+		},
+	}
+	arg.BlockChain = &mock.BlockChainStub{
+		GetCurrentBlockHeaderCalled: func() nodeData.HeaderHandler {
+			return &block.Header{Nonce: 10, Round: 12, Epoch: 2}
+		},
+	}
+	arg.ForkDetector = &mock.ForkDetectorStub{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 15
+		},
+	}
+	arg.Capabilities = heartbeat.CapabilityFullArchive | heartbeat.CapabilityFullHistory
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+	assert.Nil(t, err)
+
+	hb := &data.Heartbeat{}
+	err = marshalizer.Unmarshal(hb, broadcastBuff)
+	assert.Nil(t, err)
+	assert.Equal(t, heartbeat.CurrentHeartbeatVersion, hb.HeartbeatVersion)
+	assert.Equal(t, uint64(10), hb.Nonce)
+	assert.Equal(t, uint64(12), hb.Round)
+	assert.Equal(t, uint32(2), hb.Epoch)
+	assert.Equal(t, uint64(15), hb.ProbableHighestNonce)
+	assert.True(t, hb.IsSyncing)
+	assert.Equal(t, arg.Capabilities, hb.Capabilities)
+	assert.Equal(t, payloadSignature, hb.PayloadSignature)
+
+	hb.PayloadSignature = nil
+	expectedSignedBuff, _ := marshalizer.Marshal(hb)
+	assert.Equal(t, expectedSignedBuff, signedBuff)
+}
+
+func TestSender_SendHeartbeatPayloadSignErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgHeartbeatSender()
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return []byte("pub key"), nil
+				},
+			}
+		},
+	}
+	broadcastCalled := false
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+		},
+	}
+	arg.SingleSigner = &mock.SinglesignStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeat()
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, broadcastCalled)
+}
@@ -49,6 +49,7 @@ import (
 )
 
 const blsConsensusType = "bls"
+const pbftConsensusType = "pbft"
 const signatureSize = 48
 const publicKeySize = 96
 
@@ -227,7 +228,7 @@ func createCryptoParams(nodesPerShard int, nbMetaNodes int, nbShards int) *crypt
 }
 
 func createHasher(consensusType string) hashing.Hasher {
-	if consensusType == blsConsensusType {
+	if consensusType == blsConsensusType || consensusType == pbftConsensusType {
 		return &blake2b.Blake2b{HashSize: 32}
 	}
 	return &blake2b.Blake2b{}
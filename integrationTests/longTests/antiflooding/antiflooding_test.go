@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
@@ -124,6 +125,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 				createDisabledConfig(),
 				&mock.AppStatusHandlerStub{},
 				peers[i].ID(),
+				peerStore.NewDisabledPeerStore(),
 			)
 			log.LogIfError(err)
 		}
@@ -134,6 +136,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 				createWorkableConfig(),
 				statusHandler,
 				peers[i].ID(),
+				peerStore.NewDisabledPeerStore(),
 			)
 			log.LogIfError(err)
 		}
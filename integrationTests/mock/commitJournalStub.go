@@ -0,0 +1,35 @@
+package mock
+
+// CommitJournalStub -
+type CommitJournalStub struct {
+	BeginCommitCalled  func()
+	FinishCommitCalled func(nonce uint64, headerHash []byte) error
+	AbortCommitCalled  func()
+}
+
+// BeginCommit -
+func (cjs *CommitJournalStub) BeginCommit() {
+	if cjs.BeginCommitCalled != nil {
+		cjs.BeginCommitCalled()
+	}
+}
+
+// FinishCommit -
+func (cjs *CommitJournalStub) FinishCommit(nonce uint64, headerHash []byte) error {
+	if cjs.FinishCommitCalled != nil {
+		return cjs.FinishCommitCalled(nonce, headerHash)
+	}
+	return nil
+}
+
+// AbortCommit -
+func (cjs *CommitJournalStub) AbortCommit() {
+	if cjs.AbortCommitCalled != nil {
+		cjs.AbortCommitCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (cjs *CommitJournalStub) IsInterfaceNil() bool {
+	return cjs == nil
+}
@@ -83,6 +83,11 @@ func (hrm *HeaderResolverMock) SetResolverDebugHandler(handler dataRetriever.Res
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (hrm *HeaderResolverMock) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hrm *HeaderResolverMock) IsInterfaceNil() bool {
 	return hrm == nil
@@ -51,6 +51,11 @@ func (hrm *MiniBlocksResolverMock) SetResolverDebugHandler(_ dataRetriever.Resol
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (hrm *MiniBlocksResolverMock) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hrm *MiniBlocksResolverMock) IsInterfaceNil() bool {
 	return hrm == nil
@@ -11,6 +11,7 @@ type PeerBlackListCacherStub struct {
 	AddCalled    func(pid core.PeerID) error
 	UpsertCalled func(pid core.PeerID, span time.Duration) error
 	HasCalled    func(pid core.PeerID) bool
+	RemoveCalled func(pid core.PeerID)
 	SweepCalled  func()
 }
 
@@ -41,6 +42,15 @@ func (pblhs *PeerBlackListCacherStub) Has(pid core.PeerID) bool {
 	return pblhs.HasCalled(pid)
 }
 
+// Remove -
+func (pblhs *PeerBlackListCacherStub) Remove(pid core.PeerID) {
+	if pblhs.RemoveCalled == nil {
+		return
+	}
+
+	pblhs.RemoveCalled(pid)
+}
+
 // Sweep -
 func (pblhs *PeerBlackListCacherStub) Sweep() {
 	if pblhs.SweepCalled == nil {
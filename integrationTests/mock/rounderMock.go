@@ -1,6 +1,10 @@
 package mock
 
-import "time"
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
 
 // RounderMock -
 type RounderMock struct {
@@ -47,6 +51,16 @@ func (rm *RounderMock) RemainingTime(_ time.Time, _ time.Duration) time.Duration
 	return rm.RemainingTimeField
 }
 
+// TimeStampForRound -
+func (rm *RounderMock) TimeStampForRound(round int64) time.Time {
+	return rm.TimeStamp().Add(time.Duration(round-rm.Index()) * rm.TimeDuration())
+}
+
+// Schedule -
+func (rm *RounderMock) Schedule() []consensus.RoundDuration {
+	return nil
+}
+
 // IsInterfaceNil -
 func (rm *RounderMock) IsInterfaceNil() bool {
 	return rm == nil
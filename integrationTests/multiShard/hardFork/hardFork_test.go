@@ -310,11 +310,13 @@ func hardForkImport(
 					OwnerAddress:    "aaaaaa",
 				},
 				GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-					ProposalCost:     "500",
-					NumNodes:         100,
-					MinQuorum:        50,
-					MinPassThreshold: 50,
-					MinVetoThreshold: 50,
+					ProposalCost:         "500",
+					NumNodes:             100,
+					MinQuorum:            50,
+					MinPassThreshold:     50,
+					MinVetoThreshold:     50,
+					MinRoundDurationInMs: 4000,
+					MaxRoundDurationInMs: 10000,
 				},
 				StakingSystemSCConfig: config.StakingSystemSCConfig{
 					GenesisNodePrice:                     "1000",
@@ -453,6 +455,7 @@ func createHardForkExporter(
 			InputAntifloodHandler:    &mock.NilAntifloodHandler{},
 			Rounder:                  &mock.RounderMock{},
 			GenesisNodesSetupHandler: &mock.NodesSetupStub{},
+			AppStatusHandler:         &mock.AppStatusHandlerStub{},
 		}
 
 		exportHandler, err := factory.NewExportHandlerFactory(argsExportHandler)
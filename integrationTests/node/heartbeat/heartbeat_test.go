@@ -215,6 +215,7 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 	argSender := process.ArgHeartbeatSender{
 		PeerMessenger:        messenger,
 		PeerSignatureHandler: &mock2.PeerSignatureHandler{Signer: signer},
+		SingleSigner:         signer,
 		PrivKey:              sk,
 		Marshalizer:          integrationTests.TestMarshalizer,
 		Topic:                topic,
@@ -224,6 +225,8 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 		VersionNumber:        version,
 		NodeDisplayName:      nodeName,
 		HardforkTrigger:      &mock.HardforkTriggerStub{},
+		BlockChain:           &mock.BlockChainMock{},
+		ForkDetector:         &mock.ForkDetectorStub{},
 	}
 
 	sender, _ := process.NewSender(argSender)
@@ -238,6 +241,8 @@ func createMonitor(maxDurationPeerUnresponsive time.Duration) *process.Monitor {
 
 	mp, _ := process.NewMessageProcessor(
 		&mock2.PeerSignatureHandler{Signer: singlesigner, KeyGen: keyGen},
+		keyGen,
+		singlesigner,
 		marshalizer,
 		&mock.NetworkShardingCollectorStub{
 			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
@@ -276,8 +281,10 @@ func createMonitor(maxDurationPeerUnresponsive time.Duration) *process.Monitor {
 		},
 		HardforkTrigger:                    &mock.HardforkTriggerStub{},
 		ValidatorPubkeyConverter:           integrationTests.TestValidatorPubkeyConverter,
+		Rounder:                            &mock.RounderMock{},
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		MaxNoncesBehindToConsiderStale:     20,
 	}
 
 	monitor, _ := process.NewMonitor(argMonitor)
@@ -0,0 +1,121 @@
+package networkSimulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+const numOfShards = 2
+const nodesPerShard = 2
+const numMetaNodes = 3
+
+// TestHeadersDisseminationWithPartitionedMetachainNode tests that a metachain node isolated by a network partition
+// does not receive the headers broadcast by the shards while the partition is active and that it receives the
+// headers broadcast after healing. Running the same scenario twice with the same seed gives the same deliveries
+func TestHeadersDisseminationWithPartitionedMetachainNode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	firstRunStatistics := runPartitionedMetachainNodeScenario(t)
+	secondRunStatistics := runPartitionedMetachainNodeScenario(t)
+
+	assert.True(t, firstRunStatistics.NumPartitioned > 0)
+	assert.Equal(t, firstRunStatistics, secondRunStatistics)
+}
+
+func runPartitionedMetachainNodeScenario(t *testing.T) memp2p.SimulatorStatistics {
+	simulator, err := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{
+		Seed:      1,
+		StartTime: time.Unix(0, 0),
+		DefaultLink: memp2p.LinkConditions{
+			Latency: time.Millisecond * 100,
+			Jitter:  time.Millisecond * 50,
+		},
+	})
+	assert.Nil(t, err)
+
+	nodes := integrationTests.CreateNodesWithSimulatedNetwork(
+		numOfShards,
+		nodesPerShard,
+		numMetaNodes,
+		simulator,
+	)
+
+	defer func() {
+		for _, n := range nodes {
+			_ = n.Messenger.Close()
+		}
+	}()
+
+	shardNodes := nodes[:numOfShards*nodesPerShard]
+	metaNodes := nodes[numOfShards*nodesPerShard:]
+	isolatedNode := metaNodes[len(metaNodes)-1]
+	majority := make([]core.PeerID, 0, len(nodes)-1)
+	for _, n := range nodes[:len(nodes)-1] {
+		majority = append(majority, n.Messenger.ID())
+	}
+	simulator.Partition(majority, []core.PeerID{isolatedNode.Messenger.ID()})
+	simulator.ScheduleHeal(time.Second * 10)
+
+	shardTopics := make([]string, numOfShards)
+	for shardID := 0; shardID < numOfShards; shardID++ {
+		proposer := shardNodes[shardID*nodesPerShard]
+		shardTopics[shardID] = factory.ShardBlocksTopic + proposer.ShardCoordinator.CommunicationIdentifier(core.MetachainShardId)
+		broadcastProposedHeader(proposer, shardTopics[shardID])
+	}
+	simulator.Advance(time.Second)
+
+	for _, n := range shardNodes {
+		for shardID, topic := range shardTopics {
+			expectedCount := 0
+			if n.ShardCoordinator.SelfId() == uint32(shardID) {
+				expectedCount = 1
+			}
+			assert.Equal(t, expectedCount, messageCount(n, topic))
+		}
+	}
+	for _, n := range metaNodes[:len(metaNodes)-1] {
+		for _, topic := range shardTopics {
+			assert.Equal(t, 1, messageCount(n, topic))
+		}
+	}
+	for _, topic := range shardTopics {
+		assert.Equal(t, 0, messageCount(isolatedNode, topic))
+	}
+
+	simulator.Advance(time.Second * 10)
+	broadcastProposedHeader(metaNodes[0], factory.MetachainBlocksTopic)
+	simulator.RunUntilIdle()
+
+	for _, n := range nodes {
+		assert.Equal(t, 1, messageCount(n, factory.MetachainBlocksTopic))
+	}
+	for _, topic := range shardTopics {
+		assert.Equal(t, 0, messageCount(isolatedNode, topic))
+	}
+	assert.Equal(t, 0, simulator.NumPendingEvents())
+
+	return simulator.Statistics()
+}
+
+func broadcastProposedHeader(proposer *integrationTests.TestProcessorNode, topic string) {
+	_, header, _ := proposer.ProposeBlock(1, 1)
+	headerBytes, _ := integrationTests.TestMarshalizer.Marshal(header)
+	proposer.Messenger.Broadcast(topic, headerBytes)
+}
+
+func messageCount(n *integrationTests.TestProcessorNode, topic string) int {
+	messenger, ok := n.Messenger.(*integrationTests.SimulatedNetworkMessenger)
+	if !ok {
+		return 0
+	}
+
+	return messenger.MessageCount(topic)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/libp2p/go-libp2p-core/peer"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/assert"
@@ -53,6 +54,7 @@ func testPeerDisconnectionWithOneAdvertiser(t *testing.T, p2pConfig config.P2PCo
 	argSeeder := libp2p.ArgsNetworkMessenger{
 		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
 		P2pConfig:     p2pConfigSeeder,
+		PeerStore:     peerStore.NewDisabledPeerStore(),
 	}
 	//Step 1. Create advertiser
 	advertiser, _ := libp2p.NewMockMessenger(argSeeder, netw)
@@ -64,6 +66,7 @@ func testPeerDisconnectionWithOneAdvertiser(t *testing.T, p2pConfig config.P2PCo
 		arg := libp2p.ArgsNetworkMessenger{
 			ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
 			P2pConfig:     p2pConfig,
+			PeerStore:     peerStore.NewDisabledPeerStore(),
 		}
 		node, _ := libp2p.NewMockMessenger(arg, netw)
 		peers[i] = node
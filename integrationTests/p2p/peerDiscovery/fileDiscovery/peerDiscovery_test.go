@@ -0,0 +1,74 @@
+package fileDiscovery
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerDiscoveryFromFileShouldConnectToAllListedPeers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numOfPeers := 5
+
+	//Step 1. Create numOfPeers messengers with no discovery
+	peers := make([]p2p.Messenger, numOfPeers)
+	addresses := make([]string, 0, numOfPeers)
+	for i := 0; i < numOfPeers; i++ {
+		peers[i] = integrationTests.CreateMessengerWithNoDiscovery()
+		addresses = append(addresses, integrationTests.GetConnectableAddress(peers[i]))
+	}
+
+	//Step 2. Write their addresses in the peers file
+	workingDir, _ := ioutil.TempDir("", "filediscovery_temp")
+	filePath := filepath.Join(workingDir, "peers.txt")
+	err := ioutil.WriteFile(filePath, []byte(strings.Join(addresses, "\n")), os.ModePerm)
+	assert.Nil(t, err)
+
+	//Step 3. Create the messenger that uses the file based discovery
+	p2pConfig := config.P2PConfig{
+		Node: config.NodeConfig{
+			Port: "0",
+		},
+		FilePeerDiscovery: config.FilePeerDiscoveryConfig{
+			Enabled:              true,
+			FilePath:             filePath,
+			RefreshIntervalInSec: 1,
+		},
+		Sharding: config.ShardingConfig{
+			Type: p2p.NilListSharder,
+		},
+	}
+	discoverer := integrationTests.CreateMessengerFromConfig(p2pConfig)
+
+	//cleanup function that closes all messengers and removes the peers file
+	defer func() {
+		for i := 0; i < numOfPeers; i++ {
+			if peers[i] != nil {
+				_ = peers[i].Close()
+			}
+		}
+
+		if discoverer != nil {
+			_ = discoverer.Close()
+		}
+
+		_ = os.RemoveAll(workingDir)
+	}()
+
+	err = discoverer.Bootstrap()
+	assert.Nil(t, err)
+
+	integrationTests.WaitForBootstrapAndShowConnected([]p2p.Messenger{discoverer}, integrationTests.P2pBootstrapDelay)
+
+	assert.Equal(t, numOfPeers, len(discoverer.ConnectedPeers()))
+}
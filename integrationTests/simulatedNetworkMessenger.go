@@ -0,0 +1,85 @@
+package integrationTests
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+)
+
+var _ p2p.Messenger = (*SimulatedNetworkMessenger)(nil)
+
+// SimulatedNetworkMessenger is a memp2p messenger connected to a simulated network which, as the libp2p messenger,
+// accepts message processors on topics it did not create. It also counts, for each topic, the messages its message
+// processor handled without error
+type SimulatedNetworkMessenger struct {
+	*memp2p.Messenger
+	mutMessagesCount sync.RWMutex
+	messagesCount    map[string]int
+}
+
+// NewSimulatedNetworkMessenger creates a new messenger connected to the network of the provided simulator
+func NewSimulatedNetworkMessenger(simulator *memp2p.NetworkSimulator) (*SimulatedNetworkMessenger, error) {
+	messenger, err := memp2p.NewMessenger(simulator.Network())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimulatedNetworkMessenger{
+		Messenger:     messenger,
+		messagesCount: make(map[string]int),
+	}, nil
+}
+
+// RegisterMessageProcessor creates the topic, if it does not exist, and registers the provided message processor
+func (snm *SimulatedNetworkMessenger) RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error {
+	if !snm.HasTopic(topic) {
+		err := snm.CreateTopic(topic, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return snm.Messenger.RegisterMessageProcessor(topic, &countingMessageProcessor{
+		MessageProcessor: handler,
+		messenger:        snm,
+	})
+}
+
+// MessageCount returns the number of messages handled without error on the provided topic
+func (snm *SimulatedNetworkMessenger) MessageCount(topic string) int {
+	snm.mutMessagesCount.RLock()
+	defer snm.mutMessagesCount.RUnlock()
+
+	return snm.messagesCount[topic]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (snm *SimulatedNetworkMessenger) IsInterfaceNil() bool {
+	return snm == nil
+}
+
+type countingMessageProcessor struct {
+	p2p.MessageProcessor
+	messenger *SimulatedNetworkMessenger
+}
+
+// ProcessReceivedMessage passes the message to the wrapped message processor and counts it if handled without error
+func (cmp *countingMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := cmp.MessageProcessor.ProcessReceivedMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	cmp.messenger.mutMessagesCount.Lock()
+	cmp.messenger.messagesCount[message.Topics()[0]]++
+	cmp.messenger.mutMessagesCount.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cmp *countingMessageProcessor) IsInterfaceNil() bool {
+	return cmp == nil
+}
@@ -0,0 +1,230 @@
+package reshard
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodesPerShard = 20
+const numAccounts = 200
+
+type testAccount struct {
+	balance *big.Int
+	code    []byte
+	value   []byte
+}
+
+func TestReshard_SplitTwoToThreeAndMergeBack(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	shuffler := sharding.NewHashValidatorsShuffler(nodesPerShard, nodesPerShard, 0, true, true)
+	eligible := createValidatorsMap(nodesPerShard, 2)
+	waiting := createValidatorsMap(nodesPerShard/2, 2)
+	numNodes := countValidators(eligible) + countValidators(waiting)
+
+	accountsPerShard := createAccountsPerShard(2)
+	accounts := fillAccounts(t, accountsPerShard, 2)
+
+	// 2 -> 3: enough new nodes joined to sustain a third shard
+	newNodes := createValidatorsList(nodesPerShard / 4)
+	numNodes += len(newNodes)
+	res, err := shuffler.UpdateNodeLists(sharding.ArgsUpdateNodes{
+		Eligible: eligible,
+		Waiting:  waiting,
+		NewNodes: newNodes,
+		Rand:     []byte("split randomness"),
+		NbShards: 2,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 4, len(res.Eligible))
+	checkShardsConfiguration(t, res, 3, numNodes)
+
+	accountsPerShard[2] = createAccountsDB()
+	migrateAccounts(t, accountsPerShard, 3)
+	checkAccounts(t, accountsPerShard, accounts, 3)
+
+	// 3 -> 2: validators left and a shard can not be sustained anymore
+	leaving := res.Eligible[2][:nodesPerShard-nodesPerShard/5]
+	numNodes -= len(leaving)
+	res, err = shuffler.UpdateNodeLists(sharding.ArgsUpdateNodes{
+		Eligible:       res.Eligible,
+		Waiting:        res.Waiting,
+		UnStakeLeaving: leaving,
+		Rand:           []byte("merge randomness"),
+		NbShards:       3,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 3, len(res.Eligible))
+	checkShardsConfiguration(t, res, 2, numNodes)
+
+	migrateAccounts(t, accountsPerShard, 2)
+	delete(accountsPerShard, 2)
+	checkAccounts(t, accountsPerShard, accounts, 2)
+}
+
+func createValidatorsList(numValidators int) []sharding.Validator {
+	validators := make([]sharding.Validator, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		v, _ := sharding.NewValidator(integrationTests.CreateRandomBytes(96), 1, 0)
+		validators = append(validators, v)
+	}
+
+	return validators
+}
+
+func createValidatorsMap(numPerShard int, numShards uint32) map[uint32][]sharding.Validator {
+	validatorsMap := make(map[uint32][]sharding.Validator)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		validatorsMap[shardID] = createValidatorsList(numPerShard)
+	}
+	validatorsMap[core.MetachainShardId] = createValidatorsList(numPerShard)
+
+	return validatorsMap
+}
+
+func countValidators(validatorsMap map[uint32][]sharding.Validator) int {
+	numValidators := 0
+	for _, validators := range validatorsMap {
+		numValidators += len(validators)
+	}
+
+	return numValidators
+}
+
+func checkShardsConfiguration(t *testing.T, res *sharding.ResUpdateNodes, numShards uint32, numNodes int) {
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		assert.Equal(t, nodesPerShard, len(res.Eligible[shardID]), fmt.Sprintf("eligible in shard %d", shardID))
+	}
+	assert.Equal(t, nodesPerShard, len(res.Eligible[core.MetachainShardId]))
+	assert.Equal(t, numNodes, countValidators(res.Eligible)+countValidators(res.Waiting))
+}
+
+func createAccountsDB() *state.AccountsDB {
+	trieStorageManager, _ := integrationTests.CreateTrieStorageManager()
+	adb, _ := integrationTests.CreateAccountsDB(integrationTests.UserAccount, trieStorageManager)
+
+	return adb
+}
+
+func createAccountsPerShard(numShards uint32) map[uint32]*state.AccountsDB {
+	accountsPerShard := make(map[uint32]*state.AccountsDB)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		accountsPerShard[shardID] = createAccountsDB()
+	}
+
+	return accountsPerShard
+}
+
+func fillAccounts(t *testing.T, accountsPerShard map[uint32]*state.AccountsDB, numShards uint32) map[string]*testAccount {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(numShards, 0)
+	accounts := make(map[string]*testAccount)
+	for i := 0; i < numAccounts; i++ {
+		address := integrationTests.CreateRandomAddress()
+		adb := accountsPerShard[shardCoordinator.ComputeId(address)]
+
+		expected := &testAccount{balance: big.NewInt(int64(i + 1))}
+		acc, err := adb.LoadAccount(address)
+		require.Nil(t, err)
+		userAcc := acc.(state.UserAccountHandler)
+		_ = userAcc.AddToBalance(expected.balance)
+		if i%10 == 0 {
+			expected.code = []byte(fmt.Sprintf("code %d", i))
+			expected.value = []byte(fmt.Sprintf("value %d", i))
+			userAcc.SetCode(expected.code)
+			userAcc.DataTrieTracker().SaveKeyValue([]byte("key"), expected.value)
+		}
+		require.Nil(t, adb.SaveAccount(userAcc))
+
+		accounts[string(address)] = expected
+	}
+
+	for _, adb := range accountsPerShard {
+		_, err := adb.Commit()
+		require.Nil(t, err)
+	}
+
+	return accounts
+}
+
+func migrateAccounts(t *testing.T, accountsPerShard map[uint32]*state.AccountsDB, newNumShards uint32) {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(newNumShards, 0)
+
+	// the shards exchange accounts in both directions, so the committed states are the migration sources
+	rootHashes := make(map[uint32][]byte)
+	for shardID, adb := range accountsPerShard {
+		rootHash, err := adb.RootHash()
+		require.Nil(t, err)
+		rootHashes[shardID] = rootHash
+	}
+
+	for sourceShardID, source := range accountsPerShard {
+		rootHash := rootHashes[sourceShardID]
+		for destShardID, destination := range accountsPerShard {
+			if destShardID == sourceShardID || destShardID >= newNumShards {
+				continue
+			}
+
+			shardID := destShardID
+			_, err := source.MigrateAccounts(rootHash, destination, func(address []byte) bool {
+				return shardCoordinator.ComputeId(address) == shardID
+			})
+			require.Nil(t, err)
+		}
+	}
+
+	for _, adb := range accountsPerShard {
+		_, err := adb.Commit()
+		require.Nil(t, err)
+	}
+}
+
+func checkAccounts(
+	t *testing.T,
+	accountsPerShard map[uint32]*state.AccountsDB,
+	accounts map[string]*testAccount,
+	numShards uint32,
+) {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(numShards, 0)
+
+	numFound := 0
+	for shardID, adb := range accountsPerShard {
+		rootHash, err := adb.RootHash()
+		require.Nil(t, err)
+		leaves, err := adb.GetAllLeaves(rootHash)
+		require.Nil(t, err)
+
+		for key := range leaves {
+			expected, isAccount := accounts[key]
+			if !isAccount {
+				continue
+			}
+
+			address := []byte(key)
+			assert.Equal(t, shardID, shardCoordinator.ComputeId(address))
+
+			acc, err := adb.GetExistingAccount(address)
+			require.Nil(t, err)
+			userAcc := acc.(state.UserAccountHandler)
+			assert.Equal(t, expected.balance, userAcc.GetBalance())
+			assert.Equal(t, expected.code, userAcc.GetCode())
+			if len(expected.value) > 0 {
+				value, errRetrieve := userAcc.DataTrieTracker().RetrieveValue([]byte("key"))
+				assert.Nil(t, errRetrieve)
+				assert.Equal(t, expected.value, value)
+			}
+			numFound++
+		}
+	}
+
+	assert.Equal(t, len(accounts), numFound)
+}
@@ -84,7 +84,16 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 	)
 
 	waitTime := 100 * time.Second
-	trieSyncer, _ := trie.NewTrieSyncer(requestHandler, nRequester.DataPool.TrieNodes(), requesterTrie, shardID, factory.AccountTrieNodesTopic)
+	trieSyncStatistics, _ := trie.NewSyncStatistics(&mock.AppStatusHandlerStub{})
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     requestHandler,
+		InterceptedNodes:   nRequester.DataPool.TrieNodes(),
+		Trie:               requesterTrie,
+		ShardId:            shardID,
+		Topic:              factory.AccountTrieNodesTopic,
+		TrieSyncStatistics: trieSyncStatistics,
+	}
+	trieSyncer, _ := trie.NewTrieSyncer(arg)
 	ctx, cancel := context.WithTimeout(context.Background(), waitTime)
 	defer cancel()
 
@@ -166,6 +175,7 @@ func TestMultipleDataTriesSync(t *testing.T) {
 	)
 
 	thr, _ := throttler.NewNumGoRoutinesThrottler(50)
+	trieSyncStatistics, _ := trie.NewSyncStatistics(&mock.AppStatusHandlerStub{})
 	syncerArgs := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:               integrationTests.TestHasher,
@@ -175,6 +185,7 @@ func TestMultipleDataTriesSync(t *testing.T) {
 			WaitTime:             time.Second * 300,
 			Cacher:               nRequester.DataPool.TrieNodes(),
 			MaxTrieLevelInMemory: 5,
+			TrieSyncStatistics:   trieSyncStatistics,
 		},
 		ShardId:   shardID,
 		Throttler: thr,
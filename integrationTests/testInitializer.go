@@ -46,6 +46,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	procFactory "github.com/ElrondNetwork/elrond-go/process/factory"
@@ -136,6 +138,7 @@ func CreateMessengerWithKadDht(initialAddr string) p2p.Messenger {
 		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
 		P2pConfig:     createP2PConfig(initialAddresses),
 		SyncTimer:     &libp2p.LocalSyncTimer{},
+		PeerStore:     peerStore.NewDisabledPeerStore(),
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(arg)
@@ -159,6 +162,7 @@ func CreateMessengerWithKadDhtAndProtocolID(initialAddr string, protocolID strin
 		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
 		P2pConfig:     p2pConfig,
 		SyncTimer:     &libp2p.LocalSyncTimer{},
+		PeerStore:     peerStore.NewDisabledPeerStore(),
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(arg)
@@ -176,6 +180,7 @@ func CreateMessengerFromConfig(p2pConfig config.P2PConfig) p2p.Messenger {
 		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
 		P2pConfig:     p2pConfig,
 		SyncTimer:     &libp2p.LocalSyncTimer{},
+		PeerStore:     peerStore.NewDisabledPeerStore(),
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(arg)
@@ -206,6 +211,7 @@ func CreateMessengerWithNoDiscovery() p2p.Messenger {
 		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
 		P2pConfig:     p2pConfig,
 		SyncTimer:     &libp2p.LocalSyncTimer{},
+		PeerStore:     peerStore.NewDisabledPeerStore(),
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(arg)
@@ -555,11 +561,13 @@ func CreateFullGenesisBlocks(
 				OwnerAddress:    "aaaaaa",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
@@ -638,11 +646,13 @@ func CreateGenesisMetaBlock(
 				OwnerAddress:    "aaaaaa",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
@@ -1126,6 +1136,36 @@ func CreateNodes(
 	return nodes
 }
 
+// CreateNodesWithSimulatedNetwork creates multiple nodes in different shards, all connected through a
+// SimulatedNetworkMessenger to the in-memory network controlled by the provided simulator
+func CreateNodesWithSimulatedNetwork(
+	numOfShards int,
+	nodesPerShard int,
+	numMetaChainNodes int,
+	simulator *memp2p.NetworkSimulator,
+) []*TestProcessorNode {
+	nodes := make([]*TestProcessorNode, numOfShards*nodesPerShard+numMetaChainNodes)
+
+	idx := 0
+	for shardId := uint32(0); shardId < uint32(numOfShards); shardId++ {
+		for j := 0; j < nodesPerShard; j++ {
+			messenger, _ := NewSimulatedNetworkMessenger(simulator)
+			n := NewTestProcessorNodeWithMessenger(uint32(numOfShards), shardId, shardId, messenger)
+			nodes[idx] = n
+			idx++
+		}
+	}
+
+	for i := 0; i < numMetaChainNodes; i++ {
+		messenger, _ := NewSimulatedNetworkMessenger(simulator)
+		metaNode := NewTestProcessorNodeWithMessenger(uint32(numOfShards), core.MetachainShardId, 0, messenger)
+		idx = i + numOfShards*nodesPerShard
+		nodes[idx] = metaNode
+	}
+
+	return nodes
+}
+
 // CreateNodesWithFullGenesis creates multiple nodes in different shards
 func CreateNodesWithFullGenesis(
 	numOfShards int,
@@ -172,6 +172,13 @@ func (tP2pNode *TestP2PNode) initNode() {
 		node.WithValidatorsProvider(&mock.ValidatorsProviderStub{}),
 		node.WithPeerHonestyHandler(&mock.PeerHonestyHandlerStub{}),
 		node.WithPeerSignatureHandler(psh),
+		node.WithBlockChain(&mock.BlockChainMock{}),
+		node.WithForkDetector(&mock.ForkDetectorStub{
+			ProbableHighestNonceCalled: func() uint64 {
+				return 0
+			},
+		}),
+		node.WithRounder(&mock.RounderMock{}),
 	)
 	log.LogIfError(err)
 
@@ -181,8 +188,9 @@ func (tP2pNode *TestP2PNode) initNode() {
 		DurationToConsiderUnresponsiveInSec: 60,
 		HeartbeatRefreshIntervalInSec:       5,
 		HideInactiveValidatorIntervalInSec:  600,
+		MaxNoncesBehindToConsiderStale:      20,
 	}
-	err = tP2pNode.Node.StartHeartbeat(hbConfig, "test", config.PreferencesConfig{})
+	err = tP2pNode.Node.StartHeartbeat(hbConfig, "test", config.PreferencesConfig{}, 0)
 	log.LogIfError(err)
 }
 
@@ -278,7 +278,7 @@ func newBaseTestProcessorNode(
 	maxShards uint32,
 	nodeShardId uint32,
 	txSignPrivKeyShardId uint32,
-	initialNodeAddr string,
+	messenger p2p.Messenger,
 ) *TestProcessorNode {
 	shardCoordinator, _ := sharding.NewMultiShardCoordinator(maxShards, nodeShardId)
 
@@ -331,7 +331,6 @@ func newBaseTestProcessorNode(
 		},
 	}
 
-	messenger := CreateMessengerWithKadDht(initialNodeAddr)
 	headerIntegrityVerifier, _ := headerCheck.NewHeaderIntegrityVerifier(ChainID)
 	tpn := &TestProcessorNode{
 		ShardCoordinator:        shardCoordinator,
@@ -366,7 +365,22 @@ func NewTestProcessorNode(
 	initialNodeAddr string,
 ) *TestProcessorNode {
 
-	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId, initialNodeAddr)
+	messenger := CreateMessengerWithKadDht(initialNodeAddr)
+	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
+	tpn.initTestNode()
+
+	return tpn
+}
+
+// NewTestProcessorNodeWithMessenger returns a new TestProcessorNode instance that uses the provided messenger
+func NewTestProcessorNodeWithMessenger(
+	maxShards uint32,
+	nodeShardId uint32,
+	txSignPrivKeyShardId uint32,
+	messenger p2p.Messenger,
+) *TestProcessorNode {
+
+	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
 	tpn.initTestNode()
 
 	return tpn
@@ -382,7 +396,8 @@ func NewTestProcessorNodeWithFullGenesis(
 	smartContractParser genesis.InitialSmartContractParser,
 ) *TestProcessorNode {
 
-	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId, initialNodeAddr)
+	messenger := CreateMessengerWithKadDht(initialNodeAddr)
+	tpn := newBaseTestProcessorNode(maxShards, nodeShardId, txSignPrivKeyShardId, messenger)
 	tpn.initChainHandler()
 	tpn.initHeaderValidator()
 	tpn.initRounder()
@@ -1137,11 +1152,13 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 				OwnerAddress:    "aaaaaa",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
@@ -1292,6 +1309,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		TpsBenchmark:           &testscommon.TpsBenchmarkMock{},
 		Version:                string(SoftwareVersion),
 		HistoryRepository:      tpn.HistoryRepository,
+		CommitJournal:          &mock.CommitJournalStub{},
 	}
 
 	if check.IfNil(tpn.EpochStartNotifier) {
@@ -1344,16 +1362,24 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		epochStartDataCreator, _ := metachain.NewEpochStartData(argsEpochStartData)
 
 		argsEpochEconomics := metachain.ArgsNewEpochEconomics{
-			Marshalizer:        TestMarshalizer,
-			Hasher:             TestHasher,
-			Store:              tpn.Storage,
-			ShardCoordinator:   tpn.ShardCoordinator,
-			RewardsHandler:     tpn.EconomicsData,
-			RoundTime:          tpn.Rounder,
-			GenesisTotalSupply: tpn.EconomicsData.GenesisTotalSupply(),
+			Marshalizer:              TestMarshalizer,
+			Hasher:                   TestHasher,
+			Store:                    tpn.Storage,
+			ShardCoordinator:         tpn.ShardCoordinator,
+			RewardsHandler:           tpn.EconomicsData,
+			GenesisRoundDurationInMs: uint64(tpn.Rounder.TimeDuration().Milliseconds()),
+			GenesisTotalSupply:       tpn.EconomicsData.GenesisTotalSupply(),
 		}
 		epochEconomics, _ := metachain.NewEndOfEpochEconomicsDataCreator(argsEpochEconomics)
 
+		argsRoundsSchedule := metachain.ArgsNewRoundsSchedule{
+			Marshalizer:              TestMarshalizer,
+			Store:                    tpn.Storage,
+			SCQuery:                  tpn.SCQueryService,
+			GenesisRoundDurationInMs: uint64(tpn.Rounder.TimeDuration().Milliseconds()),
+		}
+		epochRoundsScheduleCreator, _ := metachain.NewRoundsScheduleCreator(argsRoundsSchedule)
+
 		rewardsStorage := tpn.Storage.GetStorer(dataRetriever.RewardTransactionUnit)
 		miniBlockStorage := tpn.Storage.GetStorer(dataRetriever.MiniBlockUnit)
 		argsEpochRewards := metachain.ArgsNewRewardsCreator{
@@ -1386,6 +1412,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
 			EpochEconomics:               epochEconomics,
 			EpochStartDataCreator:        epochStartDataCreator,
+			EpochRoundsScheduleCreator:   epochRoundsScheduleCreator,
 			EpochRewardsCreator:          epochStartRewards,
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
@@ -186,6 +186,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		TpsBenchmark:           &testscommon.TpsBenchmarkMock{},
 		Version:                string(SoftwareVersion),
 		HistoryRepository:      tpn.HistoryRepository,
+		CommitJournal:          &mock.CommitJournalStub{},
 	}
 
 	if tpn.ShardCoordinator.SelfId() == core.MetachainShardId {
@@ -198,6 +199,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			SCToProtocol:                 &mock.SCToProtocolStub{},
 			PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
 			EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
+			EpochRoundsScheduleCreator:   &mock.EpochStartRoundsScheduleCreatorStub{},
 			EpochEconomics:               &mock.EpochEconomicsStub{},
 			EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
@@ -0,0 +1,19 @@
+package consensusGroupAPI
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsConsensusGroupProcessor is the structure that stores the components needed to create a consensus group processor
+type ArgsConsensusGroupProcessor struct {
+	Store                    dataRetriever.StorageService
+	Marshalizer              marshal.Marshalizer
+	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	NodesCoordinator         sharding.HistoricalConsensusGroupComputer
+	ValidatorPubkeyConverter core.PubkeyConverter
+	HighestNonceGetter       func(shardID uint32) (uint64, error)
+}
@@ -0,0 +1,273 @@
+package consensusGroupAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiValidator "github.com/ElrondNetwork/elrond-go/api/validator"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("node/consensusGroupAPI")
+
+type consensusGroupProcessor struct {
+	store                    dataRetriever.StorageService
+	marshalizer              marshal.Marshalizer
+	uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	nodesCoordinator         sharding.HistoricalConsensusGroupComputer
+	validatorPubkeyConverter core.PubkeyConverter
+	highestNonceGetter       func(shardID uint32) (uint64, error)
+}
+
+// NewConsensusGroupProcessor creates a component able to recompute the consensus groups of past rounds
+func NewConsensusGroupProcessor(args ArgsConsensusGroupProcessor) (*consensusGroupProcessor, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, ErrNilUint64ByteSliceConverter
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ValidatorPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if args.HighestNonceGetter == nil {
+		return nil, ErrNilHighestNonceGetter
+	}
+
+	return &consensusGroupProcessor{
+		store:                    args.Store,
+		marshalizer:              args.Marshalizer,
+		uint64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		nodesCoordinator:         args.NodesCoordinator,
+		validatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		highestNonceGetter:       args.HighestNonceGetter,
+	}, nil
+}
+
+// GetConsensusGroup returns the consensus group and the leader of the given shard for a past epoch and round. The
+// group is recomputed from the random seed of the last block committed before the round and from the nodes
+// coordinator registry saved for the epoch, so it is available even if no block has been proposed in that round
+func (cgp *consensusGroupProcessor) GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*apiValidator.APIConsensusGroup, error) {
+	if round == 0 {
+		return nil, ErrInvalidRound
+	}
+
+	highestNonce, err := cgp.highestNonceGetter(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousHeader, previousHash, err := cgp.getLastHeaderBeforeRound(shardID, round, highestNonce)
+	if err != nil {
+		return nil, err
+	}
+	if previousHeader.GetEpoch() > epoch {
+		return nil, fmt.Errorf("%w round=%d epoch=%d previous block epoch=%d",
+			ErrRoundNotInEpoch, round, epoch, previousHeader.GetEpoch())
+	}
+
+	result := &apiValidator.APIConsensusGroup{
+		ShardID:            shardID,
+		Epoch:              epoch,
+		Round:              round,
+		Randomness:         hex.EncodeToString(previousHeader.GetRandSeed()),
+		PreviousBlockNonce: previousHeader.GetNonce(),
+		PreviousBlockHash:  hex.EncodeToString(previousHash),
+	}
+
+	proposedHeader, proposedHash, err := cgp.getProposedHeader(shardID, round, previousHeader.GetNonce()+1, highestNonce)
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(proposedHeader) {
+		if proposedHeader.GetEpoch() != epoch {
+			return nil, fmt.Errorf("%w round=%d epoch=%d proposed block epoch=%d",
+				ErrRoundNotInEpoch, round, epoch, proposedHeader.GetEpoch())
+		}
+
+		result.ProposedBlockHash = hex.EncodeToString(proposedHash)
+	}
+
+	validators, err := cgp.nodesCoordinator.ComputeHistoricalConsensusGroup(
+		cgp.getRegistryKey(epoch),
+		previousHeader.GetRandSeed(),
+		round,
+		shardID,
+		epoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, ErrEmptyConsensusGroup
+	}
+
+	result.Validators = make([]string, 0, len(validators))
+	for _, v := range validators {
+		result.Validators = append(result.Validators, cgp.validatorPubkeyConverter.Encode(v.PubKey()))
+	}
+	result.ScheduledLeader = result.Validators[0]
+	result.Leader = result.ScheduledLeader
+	if !check.IfNil(proposedHeader) {
+		leaderIndex := proposedHeader.GetLeaderIndex()
+		if int(leaderIndex) >= len(result.Validators) {
+			return nil, fmt.Errorf("%w leader index=%d consensus group size=%d",
+				ErrInvalidLeaderIndex, leaderIndex, len(result.Validators))
+		}
+
+		result.Leader = result.Validators[leaderIndex]
+	}
+
+	return result, nil
+}
+
+// getLastHeaderBeforeRound searches the header with the highest round lower than the given one. As a block nonce
+// is never higher than its round, the search is done by nonce in the [0, min(round-1, highestNonce)] interval. All
+// the headers in this interval are expected to be in storage, so a missing one is reported instead of being skipped
+func (cgp *consensusGroupProcessor) getLastHeaderBeforeRound(
+	shardID uint32,
+	round uint64,
+	highestNonce uint64,
+) (data.HeaderHandler, []byte, error) {
+	var lastHeader data.HeaderHandler
+	var lastHash []byte
+
+	low := uint64(0)
+	high := round - 1
+	if highestNonce < high {
+		high = highestNonce
+	}
+	for low <= high {
+		nonce := low + (high-low)/2
+		header, hash, err := cgp.getHeaderByNonce(shardID, nonce)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w while searching the block before round %d, nonce=%d shard=%d",
+				err, round, nonce, shardID)
+		}
+		if header.GetRound() >= round {
+			if nonce == 0 {
+				break
+			}
+
+			high = nonce - 1
+			continue
+		}
+
+		lastHeader = header
+		lastHash = hash
+		low = nonce + 1
+	}
+
+	if check.IfNil(lastHeader) {
+		return nil, nil, fmt.Errorf("%w shard=%d round=%d", ErrPreviousBlockNotFound, shardID, round)
+	}
+
+	return lastHeader, lastHash, nil
+}
+
+// getProposedHeader returns the header proposed in the given round, if any. The header following the previous block
+// is looked up only if it has already been committed or notarized
+func (cgp *consensusGroupProcessor) getProposedHeader(
+	shardID uint32,
+	round uint64,
+	nonce uint64,
+	highestNonce uint64,
+) (data.HeaderHandler, []byte, error) {
+	if nonce > highestNonce {
+		return nil, nil, nil
+	}
+
+	header, hash, err := cgp.getHeaderByNonce(shardID, nonce)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while getting the block following round %d, nonce=%d shard=%d",
+			err, round, nonce, shardID)
+	}
+	if header.GetRound() != round {
+		return nil, nil, nil
+	}
+
+	return header, hash, nil
+}
+
+func (cgp *consensusGroupProcessor) getHeaderByNonce(shardID uint32, nonce uint64) (data.HeaderHandler, []byte, error) {
+	nonceUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+	headerUnit := dataRetriever.BlockHeaderUnit
+	var header data.HeaderHandler = &block.Header{}
+	if shardID == core.MetachainShardId {
+		nonceUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		headerUnit = dataRetriever.MetaBlockUnit
+		header = &block.MetaBlock{}
+	}
+
+	hash, err := cgp.searchInStorer(nonceUnit, cgp.uint64ByteSliceConverter.ToByteSlice(nonce))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	headerBytes, err := cgp.searchInStorer(headerUnit, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cgp.marshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, hash, nil
+}
+
+// getRegistryKey returns the key of the nodes coordinator registry holding the given epoch. The registry is saved
+// at each epoch start under the previous random seed of the epoch start metablock. The registry of the genesis
+// epoch is saved under a node specific key, but the genesis epoch is also part of the registry saved at the start
+// of the next epoch
+func (cgp *consensusGroupProcessor) getRegistryKey(epoch uint32) []byte {
+	registryEpoch := epoch
+	if registryEpoch == 0 {
+		registryEpoch = 1
+	}
+
+	metaBlockBytes, err := cgp.searchInStorer(dataRetriever.MetaBlockUnit, []byte(core.EpochStartIdentifier(registryEpoch)))
+	if err != nil {
+		log.Debug("epoch start metablock not found", "epoch", registryEpoch, "error", err.Error())
+		return nil
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = cgp.marshalizer.Unmarshal(metaBlock, metaBlockBytes)
+	if err != nil {
+		log.Debug("epoch start metablock unmarshal failed", "epoch", registryEpoch, "error", err.Error())
+		return nil
+	}
+
+	return metaBlock.GetPrevRandSeed()
+}
+
+// searchInStorer searches the key in all the persisters of the unit, as the requested data may belong to old epochs
+func (cgp *consensusGroupProcessor) searchInStorer(unit dataRetriever.UnitType, key []byte) ([]byte, error) {
+	storer := cgp.store.GetStorer(unit)
+	if check.IfNil(storer) {
+		return nil, fmt.Errorf("%w for unit %s", ErrMissingStorer, unit.String())
+	}
+
+	return storer.SearchFirst(key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cgp *consensusGroupProcessor) IsInterfaceNil() bool {
+	return cgp == nil
+}
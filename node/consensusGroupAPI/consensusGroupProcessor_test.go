@@ -0,0 +1,391 @@
+package consensusGroupAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNoStoredBlock = errors.New("no stored block")
+
+type storedUnits map[dataRetriever.UnitType]map[string][]byte
+
+func (su storedUnits) put(unit dataRetriever.UnitType, key []byte, value []byte) {
+	if su[unit] == nil {
+		su[unit] = make(map[string][]byte)
+	}
+	su[unit][string(key)] = value
+}
+
+func createMockStore(units storedUnits) dataRetriever.StorageService {
+	return &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &mock.StorerStub{
+				SearchFirstCalled: func(key []byte) ([]byte, error) {
+					value, ok := units[unitType][string(key)]
+					if !ok {
+						return nil, errors.New("key not found")
+					}
+
+					return value, nil
+				},
+			}
+		},
+	}
+}
+
+func addHeader(t *testing.T, units storedUnits, header data.HeaderHandler) {
+	marshalizer := &mock.MarshalizerFake{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	headerBytes, err := marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	hash := []byte(fmt.Sprintf("hash-%d-%d", header.GetShardID(), header.GetNonce()))
+	nonceUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(header.GetShardID())
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if header.GetShardID() == core.MetachainShardId {
+		nonceUnit = dataRetriever.MetaHdrNonceHashDataUnit
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	units.put(nonceUnit, converter.ToByteSlice(header.GetNonce()), hash)
+	units.put(headerUnit, hash, headerBytes)
+}
+
+func addEpochStartMetaBlock(t *testing.T, units storedUnits, epoch uint32, prevRandSeed []byte) {
+	metaBlockBytes, err := (&mock.MarshalizerFake{}).Marshal(&block.MetaBlock{Epoch: epoch, PrevRandSeed: prevRandSeed})
+	require.Nil(t, err)
+
+	units.put(dataRetriever.MetaBlockUnit, []byte(core.EpochStartIdentifier(epoch)), metaBlockBytes)
+}
+
+// createShardChain stores the blocks of shard 0 proposed in rounds 1, 2, 3, 6 and 7 of epoch 1, so rounds 4 and 5
+// have been missed
+func createShardChain(t *testing.T) storedUnits {
+	units := make(storedUnits)
+	for nonce, round := range []uint64{0, 1, 2, 3, 6, 7} {
+		addHeader(t, units, &block.Header{
+			Nonce:    uint64(nonce),
+			Round:    round,
+			Epoch:    1,
+			RandSeed: []byte(fmt.Sprintf("rand-%d", nonce)),
+		})
+	}
+	addEpochStartMetaBlock(t, units, 1, []byte("epoch 1 registry key"))
+
+	return units
+}
+
+func createMockArgsConsensusGroupProcessor(units storedUnits) ArgsConsensusGroupProcessor {
+	return ArgsConsensusGroupProcessor{
+		Store:                    createMockStore(units),
+		Marshalizer:              &mock.MarshalizerFake{},
+		Uint64ByteSliceConverter: uint64ByteSlice.NewBigEndianConverter(),
+		NodesCoordinator: &mock.HistoricalConsensusGroupComputerStub{
+			ComputeHistoricalConsensusGroupCalled: func(_ []byte, _ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+				return []sharding.Validator{
+					mock.NewValidatorMock([]byte("leader"), 1, 0),
+					mock.NewValidatorMock([]byte("validator"), 1, 1),
+				}, nil
+			},
+		},
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		HighestNonceGetter: func(shardID uint32) (uint64, error) {
+			return highestStoredNonce(units, shardID)
+		},
+	}
+}
+
+// highestStoredNonce returns the highest nonce of the consecutive headers stored for the shard
+func highestStoredNonce(units storedUnits, shardID uint32) (uint64, error) {
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	nonceUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+	if shardID == core.MetachainShardId {
+		nonceUnit = dataRetriever.MetaHdrNonceHashDataUnit
+	}
+
+	_, ok := units[nonceUnit][string(converter.ToByteSlice(0))]
+	if !ok {
+		return 0, errNoStoredBlock
+	}
+
+	nonce := uint64(0)
+	for {
+		_, ok = units[nonceUnit][string(converter.ToByteSlice(nonce+1))]
+		if !ok {
+			return nonce, nil
+		}
+		nonce++
+	}
+}
+
+func TestNewConsensusGroupProcessor_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[error]func(args *ArgsConsensusGroupProcessor){
+		ErrNilStorageService:           func(args *ArgsConsensusGroupProcessor) { args.Store = nil },
+		ErrNilMarshalizer:              func(args *ArgsConsensusGroupProcessor) { args.Marshalizer = nil },
+		ErrNilUint64ByteSliceConverter: func(args *ArgsConsensusGroupProcessor) { args.Uint64ByteSliceConverter = nil },
+		ErrNilNodesCoordinator:         func(args *ArgsConsensusGroupProcessor) { args.NodesCoordinator = nil },
+		ErrNilPubkeyConverter:          func(args *ArgsConsensusGroupProcessor) { args.ValidatorPubkeyConverter = nil },
+		ErrNilHighestNonceGetter:       func(args *ArgsConsensusGroupProcessor) { args.HighestNonceGetter = nil },
+	}
+
+	for expectedErr, modify := range testCases {
+		args := createMockArgsConsensusGroupProcessor(make(storedUnits))
+		modify(&args)
+		cgp, err := NewConsensusGroupProcessor(args)
+
+		assert.True(t, cgp.IsInterfaceNil())
+		assert.Equal(t, expectedErr, err)
+	}
+}
+
+func TestNewConsensusGroupProcessor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cgp, err := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(make(storedUnits)))
+
+	assert.Nil(t, err)
+	assert.False(t, cgp.IsInterfaceNil())
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupRoundZeroShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cgp, _ := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(createShardChain(t)))
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 0)
+
+	assert.Nil(t, consensusGroup)
+	assert.Equal(t, ErrInvalidRound, err)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupForMissedRound(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusGroupProcessor(createShardChain(t))
+	computeCalled := false
+	args.NodesCoordinator = &mock.HistoricalConsensusGroupComputerStub{
+		ComputeHistoricalConsensusGroupCalled: func(registryKey []byte, randomness []byte, round uint64, shardID uint32, epoch uint32) ([]sharding.Validator, error) {
+			computeCalled = true
+			assert.Equal(t, []byte("epoch 1 registry key"), registryKey)
+			assert.Equal(t, []byte("rand-3"), randomness)
+			assert.Equal(t, uint64(5), round)
+			assert.Equal(t, uint32(0), shardID)
+			assert.Equal(t, uint32(1), epoch)
+
+			return []sharding.Validator{
+				mock.NewValidatorMock([]byte("leader"), 1, 0),
+				mock.NewValidatorMock([]byte("validator"), 1, 1),
+			}, nil
+		},
+	}
+	cgp, _ := NewConsensusGroupProcessor(args)
+
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 5)
+	require.Nil(t, err)
+
+	assert.True(t, computeCalled)
+	assert.Equal(t, uint32(0), consensusGroup.ShardID)
+	assert.Equal(t, uint32(1), consensusGroup.Epoch)
+	assert.Equal(t, uint64(5), consensusGroup.Round)
+	assert.Equal(t, hex.EncodeToString([]byte("rand-3")), consensusGroup.Randomness)
+	assert.Equal(t, uint64(3), consensusGroup.PreviousBlockNonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash-0-3")), consensusGroup.PreviousBlockHash)
+	assert.Equal(t, hex.EncodeToString([]byte("leader")), consensusGroup.Leader)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("leader")), hex.EncodeToString([]byte("validator"))}, consensusGroup.Validators)
+	assert.Empty(t, consensusGroup.ProposedBlockHash)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupForProposedRound(t *testing.T) {
+	t.Parallel()
+
+	cgp, _ := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(createShardChain(t)))
+
+	for round, expectedPreviousNonce := range map[uint64]uint64{1: 0, 2: 1, 6: 3, 7: 4} {
+		consensusGroup, err := cgp.GetConsensusGroup(0, 1, round)
+		require.Nil(t, err)
+
+		assert.Equal(t, expectedPreviousNonce, consensusGroup.PreviousBlockNonce)
+		assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("rand-%d", expectedPreviousNonce))), consensusGroup.Randomness)
+		assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("hash-0-%d", expectedPreviousNonce+1))), consensusGroup.ProposedBlockHash)
+	}
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupAfterTheLastBlock(t *testing.T) {
+	t.Parallel()
+
+	cgp, _ := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(createShardChain(t)))
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 100)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(5), consensusGroup.PreviousBlockNonce)
+	assert.Empty(t, consensusGroup.ProposedBlockHash)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupNotCommittedBlockShouldNotBeProposed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsConsensusGroupProcessor(createShardChain(t))
+	args.HighestNonceGetter = func(_ uint32) (uint64, error) {
+		return 3, nil
+	}
+	cgp, _ := NewConsensusGroupProcessor(args)
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 6)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(3), consensusGroup.PreviousBlockNonce)
+	assert.Empty(t, consensusGroup.ProposedBlockHash)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupMissingPreviousBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cgp, _ := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(createShardChain(t)))
+	consensusGroup, err := cgp.GetConsensusGroup(1, 1, 5)
+
+	assert.Nil(t, consensusGroup)
+	assert.Equal(t, errNoStoredBlock, err)
+
+	args := createMockArgsConsensusGroupProcessor(createShardChain(t))
+	args.HighestNonceGetter = func(_ uint32) (uint64, error) {
+		return 0, nil
+	}
+	cgp, _ = NewConsensusGroupProcessor(args)
+	consensusGroup, err = cgp.GetConsensusGroup(1, 1, 5)
+
+	assert.Nil(t, consensusGroup)
+	assert.NotNil(t, err)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupStorageGapShouldErr(t *testing.T) {
+	t.Parallel()
+
+	units := createShardChain(t)
+	delete(units[dataRetriever.ShardHdrNonceHashDataUnit], string(uint64ByteSlice.NewBigEndianConverter().ToByteSlice(2)))
+	args := createMockArgsConsensusGroupProcessor(units)
+	args.HighestNonceGetter = func(_ uint32) (uint64, error) {
+		return 5, nil
+	}
+	cgp, _ := NewConsensusGroupProcessor(args)
+
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 5)
+	assert.Nil(t, consensusGroup)
+	assert.NotNil(t, err)
+
+	consensusGroup, err = cgp.GetConsensusGroup(0, 1, 3)
+	assert.Nil(t, consensusGroup)
+	assert.NotNil(t, err)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupShouldUseTheProposedBlockLeaderIndex(t *testing.T) {
+	t.Parallel()
+
+	units := createShardChain(t)
+	addHeader(t, units, &block.Header{
+		Nonce:       4,
+		Round:       6,
+		Epoch:       1,
+		LeaderIndex: 1,
+		RandSeed:    []byte("rand-4"),
+	})
+	cgp, _ := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(units))
+
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 6)
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString([]byte("hash-0-4")), consensusGroup.ProposedBlockHash)
+	assert.Equal(t, hex.EncodeToString([]byte("leader")), consensusGroup.ScheduledLeader)
+	assert.Equal(t, hex.EncodeToString([]byte("validator")), consensusGroup.Leader)
+
+	addHeader(t, units, &block.Header{
+		Nonce:       4,
+		Round:       6,
+		Epoch:       1,
+		LeaderIndex: 2,
+		RandSeed:    []byte("rand-4"),
+	})
+
+	consensusGroup, err = cgp.GetConsensusGroup(0, 1, 6)
+	assert.Nil(t, consensusGroup)
+	assert.True(t, errors.Is(err, ErrInvalidLeaderIndex))
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupWrongEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cgp, _ := NewConsensusGroupProcessor(createMockArgsConsensusGroupProcessor(createShardChain(t)))
+
+	consensusGroup, err := cgp.GetConsensusGroup(0, 0, 5)
+	assert.Nil(t, consensusGroup)
+	assert.True(t, errors.Is(err, ErrRoundNotInEpoch))
+
+	consensusGroup, err = cgp.GetConsensusGroup(0, 2, 6)
+	assert.Nil(t, consensusGroup)
+	assert.True(t, errors.Is(err, ErrRoundNotInEpoch))
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupForMetachain(t *testing.T) {
+	t.Parallel()
+
+	units := make(storedUnits)
+	addHeader(t, units, &block.MetaBlock{Nonce: 0, Round: 0, RandSeed: []byte("meta-rand-0")})
+	addHeader(t, units, &block.MetaBlock{Nonce: 1, Round: 2, RandSeed: []byte("meta-rand-1")})
+	addEpochStartMetaBlock(t, units, 1, []byte("epoch 1 registry key"))
+
+	args := createMockArgsConsensusGroupProcessor(units)
+	args.NodesCoordinator = &mock.HistoricalConsensusGroupComputerStub{
+		ComputeHistoricalConsensusGroupCalled: func(registryKey []byte, randomness []byte, _ uint64, shardID uint32, epoch uint32) ([]sharding.Validator, error) {
+			assert.Equal(t, []byte("epoch 1 registry key"), registryKey)
+			assert.Equal(t, []byte("meta-rand-1"), randomness)
+			assert.Equal(t, core.MetachainShardId, shardID)
+			assert.Equal(t, uint32(0), epoch)
+
+			return []sharding.Validator{mock.NewValidatorMock([]byte("meta leader"), 1, 0)}, nil
+		},
+	}
+	cgp, _ := NewConsensusGroupProcessor(args)
+
+	consensusGroup, err := cgp.GetConsensusGroup(core.MetachainShardId, 0, 3)
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString([]byte("meta leader")), consensusGroup.Leader)
+	assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("hash-%d-1", core.MetachainShardId))), consensusGroup.PreviousBlockHash)
+}
+
+func TestConsensusGroupProcessor_GetConsensusGroupNodesCoordinatorErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsConsensusGroupProcessor(createShardChain(t))
+	args.NodesCoordinator = &mock.HistoricalConsensusGroupComputerStub{
+		ComputeHistoricalConsensusGroupCalled: func(_ []byte, _ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return nil, expectedErr
+		},
+	}
+	cgp, _ := NewConsensusGroupProcessor(args)
+
+	consensusGroup, err := cgp.GetConsensusGroup(0, 1, 5)
+	assert.Nil(t, consensusGroup)
+	assert.Equal(t, expectedErr, err)
+
+	args.NodesCoordinator = &mock.HistoricalConsensusGroupComputerStub{}
+	cgp, _ = NewConsensusGroupProcessor(args)
+
+	consensusGroup, err = cgp.GetConsensusGroup(0, 1, 5)
+	assert.Nil(t, consensusGroup)
+	assert.Equal(t, ErrEmptyConsensusGroup, err)
+}
@@ -0,0 +1,39 @@
+package consensusGroupAPI
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilUint64ByteSliceConverter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64ByteSliceConverter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilNodesCoordinator signals that a nil nodes coordinator has been provided
+var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilHighestNonceGetter signals that a nil highest nonce getter has been provided
+var ErrNilHighestNonceGetter = errors.New("nil highest nonce getter")
+
+// ErrInvalidRound signals that a consensus group has been requested for an invalid round
+var ErrInvalidRound = errors.New("invalid round")
+
+// ErrPreviousBlockNotFound signals that the block committed before the requested round could not be found in storage
+var ErrPreviousBlockNotFound = errors.New("previous block not found")
+
+// ErrEmptyConsensusGroup signals that an empty consensus group has been computed
+var ErrEmptyConsensusGroup = errors.New("empty consensus group")
+
+// ErrRoundNotInEpoch signals that the requested round does not belong to the requested epoch
+var ErrRoundNotInEpoch = errors.New("round does not belong to the epoch")
+
+// ErrInvalidLeaderIndex signals that a block holds a leader index outside of its consensus group
+var ErrInvalidLeaderIndex = errors.New("invalid leader index")
+
+// ErrMissingStorer signals that the storer of a unit is missing
+var ErrMissingStorer = errors.New("missing storer")
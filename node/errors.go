@@ -106,6 +106,9 @@ var ErrSystemBusyGeneratingTransactions = errors.New("system busy while generati
 // ErrNilStatusHandler is returned when the status handler is nil
 var ErrNilStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilTracer is returned when the tracer is nil
+var ErrNilTracer = errors.New("nil tracer")
+
 // ErrNoTxToProcess signals that no transaction were sent for processing
 var ErrNoTxToProcess = errors.New("no transaction to process")
 
@@ -180,3 +183,21 @@ var ErrNilHistoryRepository = errors.New("history repository is nil")
 
 // ErrNilPeerSignatureHandler signals that a nil peerSignatureHandler object has been provided
 var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHandler")
+
+// ErrNilPeerBanHandler signals that a nil peer ban handler has been provided
+var ErrNilPeerBanHandler = errors.New("trying to set nil peer ban handler")
+
+// ErrInvalidBanDuration signals that an invalid ban duration has been provided
+var ErrInvalidBanDuration = errors.New("invalid ban duration")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilConsensusMessageRecorder signals that a nil consensus message recorder has been provided
+var ErrNilConsensusMessageRecorder = errors.New("nil consensus message recorder")
+
+// ErrNilFinalityTracker signals that a nil finality tracker has been provided
+var ErrNilFinalityTracker = errors.New("nil finality tracker")
+
+// ErrHistoricalConsensusGroupNotSupported signals that the nodes coordinator can not compute past consensus groups
+var ErrHistoricalConsensusGroupNotSupported = errors.New("nodes coordinator can not compute past consensus groups")
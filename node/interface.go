@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/finality"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -21,6 +22,7 @@ type P2PMessenger interface {
 	HasTopicValidator(name string) bool
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
 	PeerAddresses(pid core.PeerID) []string
+	GetPeersTraffic() []p2p.PeerTrafficInfo
 	IsConnectedToTheNetwork() bool
 	ID() core.PeerID
 	Peers() []core.PeerID
@@ -75,3 +77,11 @@ type Throttler interface {
 	EndProcessing()
 	IsInterfaceNil() bool
 }
+
+// FinalityTracker announces the blocks which became final or have been reverted
+type FinalityTracker interface {
+	RegisterHandler(handler func(event finality.Event))
+	GetLastFinalBlocks() []finality.Event
+	GetEventsFromIndex(index uint64) []finality.Event
+	IsInterfaceNil() bool
+}
@@ -9,23 +9,28 @@ import (
 
 // AccountsStub -
 type AccountsStub struct {
-	GetExistingAccountCalled func(addressContainer []byte) (state.AccountHandler, error)
-	LoadAccountCalled        func(container []byte) (state.AccountHandler, error)
-	SaveAccountCalled        func(account state.AccountHandler) error
-	RemoveAccountCalled      func(addressContainer []byte) error
-	CommitCalled             func() ([]byte, error)
-	JournalLenCalled         func() int
-	RevertToSnapshotCalled   func(snapshot int) error
-	RootHashCalled           func() ([]byte, error)
-	RecreateTrieCalled       func(rootHash []byte) error
-	PruneTrieCalled          func(rootHash []byte, identifier data.TriePruningIdentifier)
-	CancelPruneCalled        func(rootHash []byte, identifier data.TriePruningIdentifier)
-	SnapshotStateCalled      func(rootHash []byte)
-	SetStateCheckpointCalled func(rootHash []byte)
-	IsPruningEnabledCalled   func() bool
-	GetAllLeavesCalled       func(rootHash []byte) (map[string][]byte, error)
-	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
-	GetNumCheckpointsCalled  func() uint32
+	GetExistingAccountCalled          func(addressContainer []byte) (state.AccountHandler, error)
+	LoadAccountCalled                 func(container []byte) (state.AccountHandler, error)
+	SaveAccountCalled                 func(account state.AccountHandler) error
+	RemoveAccountCalled               func(addressContainer []byte) error
+	CommitCalled                      func() ([]byte, error)
+	JournalLenCalled                  func() int
+	RevertToSnapshotCalled            func(snapshot int) error
+	RootHashCalled                    func() ([]byte, error)
+	RecreateTrieCalled                func(rootHash []byte) error
+	PruneTrieCalled                   func(rootHash []byte, identifier data.TriePruningIdentifier)
+	CancelPruneCalled                 func(rootHash []byte, identifier data.TriePruningIdentifier)
+	SnapshotStateCalled               func(rootHash []byte)
+	SetStateCheckpointCalled          func(rootHash []byte)
+	IsPruningEnabledCalled            func() bool
+	GetAllLeavesCalled                func(rootHash []byte) (map[string][]byte, error)
+	RecreateAllTriesCalled            func(rootHash []byte) (map[string]data.Trie, error)
+	IsArchiveModeEnabledCalled        func() bool
+	ArchiveRootHashCalled             func(nonce uint64, epoch uint32, rootHash []byte) error
+	GetArchivedRootHashCalled         func(nonce uint64) ([]byte, uint32, error)
+	GetLastArchivedNonceInEpochCalled func(epoch uint32) (uint64, error)
+	RecreateAtBlockCalled             func(nonce uint64) error
+	GetNumCheckpointsCalled           func() uint32
 }
 
 // RecreateAllTries -
@@ -173,3 +178,48 @@ func (as *AccountsStub) GetNumCheckpoints() uint32 {
 func (as *AccountsStub) IsInterfaceNil() bool {
 	return as == nil
 }
+
+// IsArchiveModeEnabled -
+func (as *AccountsStub) IsArchiveModeEnabled() bool {
+	if as.IsArchiveModeEnabledCalled != nil {
+		return as.IsArchiveModeEnabledCalled()
+	}
+
+	return false
+}
+
+// ArchiveRootHash -
+func (as *AccountsStub) ArchiveRootHash(nonce uint64, epoch uint32, rootHash []byte) error {
+	if as.ArchiveRootHashCalled != nil {
+		return as.ArchiveRootHashCalled(nonce, epoch, rootHash)
+	}
+
+	return nil
+}
+
+// GetArchivedRootHash -
+func (as *AccountsStub) GetArchivedRootHash(nonce uint64) ([]byte, uint32, error) {
+	if as.GetArchivedRootHashCalled != nil {
+		return as.GetArchivedRootHashCalled(nonce)
+	}
+
+	return nil, 0, errNotImplemented
+}
+
+// GetLastArchivedNonceInEpoch -
+func (as *AccountsStub) GetLastArchivedNonceInEpoch(epoch uint32) (uint64, error) {
+	if as.GetLastArchivedNonceInEpochCalled != nil {
+		return as.GetLastArchivedNonceInEpochCalled(epoch)
+	}
+
+	return 0, errNotImplemented
+}
+
+// RecreateAtBlock -
+func (as *AccountsStub) RecreateAtBlock(nonce uint64) error {
+	if as.RecreateAtBlockCalled != nil {
+		return as.RecreateAtBlockCalled(nonce)
+	}
+
+	return errNotImplemented
+}
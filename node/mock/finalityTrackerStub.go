@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/finality"
+)
+
+// FinalityTrackerStub -
+type FinalityTrackerStub struct {
+	RegisterHandlerCalled    func(handler func(event finality.Event))
+	GetLastFinalBlocksCalled func() []finality.Event
+	GetEventsFromIndexCalled func(index uint64) []finality.Event
+}
+
+// RegisterHandler -
+func (fts *FinalityTrackerStub) RegisterHandler(handler func(event finality.Event)) {
+	if fts.RegisterHandlerCalled != nil {
+		fts.RegisterHandlerCalled(handler)
+	}
+}
+
+// GetLastFinalBlocks -
+func (fts *FinalityTrackerStub) GetLastFinalBlocks() []finality.Event {
+	if fts.GetLastFinalBlocksCalled != nil {
+		return fts.GetLastFinalBlocksCalled()
+	}
+
+	return nil
+}
+
+// GetEventsFromIndex -
+func (fts *FinalityTrackerStub) GetEventsFromIndex(index uint64) []finality.Event {
+	if fts.GetEventsFromIndexCalled != nil {
+		return fts.GetEventsFromIndexCalled(index)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (fts *FinalityTrackerStub) IsInterfaceNil() bool {
+	return fts == nil
+}
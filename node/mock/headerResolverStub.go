@@ -83,6 +83,11 @@ func (hrs *HeaderResolverStub) SetResolverDebugHandler(handler dataRetriever.Res
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (hrs *HeaderResolverStub) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hrs *HeaderResolverStub) IsInterfaceNil() bool {
 	return hrs == nil
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// HistoricalConsensusGroupComputerStub -
+type HistoricalConsensusGroupComputerStub struct {
+	ComputeHistoricalConsensusGroupCalled func(registryKey []byte, randomness []byte, round uint64, shardID uint32, epoch uint32) ([]sharding.Validator, error)
+}
+
+// ComputeHistoricalConsensusGroup -
+func (hcgcs *HistoricalConsensusGroupComputerStub) ComputeHistoricalConsensusGroup(
+	registryKey []byte,
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]sharding.Validator, error) {
+	if hcgcs.ComputeHistoricalConsensusGroupCalled != nil {
+		return hcgcs.ComputeHistoricalConsensusGroupCalled(registryKey, randomness, round, shardID, epoch)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (hcgcs *HistoricalConsensusGroupComputerStub) IsInterfaceNil() bool {
+	return hcgcs == nil
+}
@@ -26,6 +26,11 @@ func (is *InterceptorStub) SetInterceptedDebugHandler(handler process.Intercepte
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (is *InterceptorStub) SetPeersRatingHandler(_ process.PeersRatingHandler) error {
+	return nil
+}
+
 // RegisterHandler -
 func (is *InterceptorStub) RegisterHandler(_ func(topic string, hash []byte, data interface{})) {
 }
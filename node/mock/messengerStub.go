@@ -7,19 +7,21 @@ import (
 
 // MessengerStub -
 type MessengerStub struct {
-	IDCalled                         func() core.PeerID
-	CloseCalled                      func() error
-	CreateTopicCalled                func(name string, createChannelForTopic bool) error
-	HasTopicCalled                   func(name string) bool
-	HasTopicValidatorCalled          func(name string) bool
-	BroadcastOnChannelCalled         func(channel string, topic string, buff []byte)
-	BroadcastCalled                  func(topic string, buff []byte)
-	RegisterMessageProcessorCalled   func(topic string, handler p2p.MessageProcessor) error
-	BootstrapCalled                  func() error
-	PeerAddressesCalled              func(pid core.PeerID) []string
-	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
-	IsConnectedToTheNetworkCalled    func() bool
-	PeersCalled                      func() []core.PeerID
+	IDCalled                           func() core.PeerID
+	CloseCalled                        func() error
+	CreateTopicCalled                  func(name string, createChannelForTopic bool) error
+	HasTopicCalled                     func(name string) bool
+	HasTopicValidatorCalled            func(name string) bool
+	BroadcastOnChannelCalled           func(channel string, topic string, buff []byte)
+	BroadcastCalled                    func(topic string, buff []byte)
+	RegisterMessageProcessorCalled     func(topic string, handler p2p.MessageProcessor) error
+	BootstrapCalled                    func() error
+	PeerAddressesCalled                func(pid core.PeerID) []string
+	BroadcastOnChannelBlockingCalled   func(channel string, topic string, buff []byte) error
+	IsConnectedToTheNetworkCalled      func() bool
+	PeersCalled                        func() []core.PeerID
+	GetPeersTrafficCalled              func() []p2p.PeerTrafficInfo
+	TotalBytesSavedByCompressionCalled func() uint64
 }
 
 // ID -
@@ -98,6 +100,24 @@ func (ms *MessengerStub) Peers() []core.PeerID {
 	return make([]core.PeerID, 0)
 }
 
+// GetPeersTraffic -
+func (ms *MessengerStub) GetPeersTraffic() []p2p.PeerTrafficInfo {
+	if ms.GetPeersTrafficCalled != nil {
+		return ms.GetPeersTrafficCalled()
+	}
+
+	return make([]p2p.PeerTrafficInfo, 0)
+}
+
+// TotalBytesSavedByCompression -
+func (ms *MessengerStub) TotalBytesSavedByCompression() uint64 {
+	if ms.TotalBytesSavedByCompressionCalled != nil {
+		return ms.TotalBytesSavedByCompressionCalled()
+	}
+
+	return 0
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	return ms == nil
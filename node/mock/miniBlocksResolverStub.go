@@ -56,6 +56,11 @@ func (mbrs *MiniBlocksResolverStub) SetResolverDebugHandler(handler dataRetrieve
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (mbrs *MiniBlocksResolverStub) SetPeersRatingHandler(_ dataRetriever.PeersRatingHandler) error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (mbrs *MiniBlocksResolverStub) IsInterfaceNil() bool {
 	return mbrs == nil
@@ -73,6 +73,11 @@ func (mm *MultisignMock) VerifySignatureShare(_ uint16, _ []byte, _ []byte, _ []
 	panic("implement me")
 }
 
+// VerifySignatureShares -
+func (mm *MultisignMock) VerifySignatureShares(_ []uint16, _ [][]byte, _ []byte, _ []byte) ([]uint16, error) {
+	panic("implement me")
+}
+
 // SignatureShare -
 func (mm *MultisignMock) SignatureShare(_ uint16) ([]byte, error) {
 	panic("implement me")
@@ -0,0 +1,83 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeerBanHandlerStub -
+type PeerBanHandlerStub struct {
+	UpsertCalled        func(pid core.PeerID, span time.Duration) error
+	HasCalled           func(pid core.PeerID) bool
+	RemoveCalled        func(pid core.PeerID)
+	SweepCalled         func()
+	BanCalled           func(pid core.PeerID, duration time.Duration, reason string) error
+	UnbanCalled         func(pid core.PeerID) error
+	GetKnownPeersCalled func() []p2p.PeerStoreEntry
+}
+
+// Upsert -
+func (pbhs *PeerBanHandlerStub) Upsert(pid core.PeerID, span time.Duration) error {
+	if pbhs.UpsertCalled != nil {
+		return pbhs.UpsertCalled(pid, span)
+	}
+
+	return nil
+}
+
+// Has -
+func (pbhs *PeerBanHandlerStub) Has(pid core.PeerID) bool {
+	if pbhs.HasCalled != nil {
+		return pbhs.HasCalled(pid)
+	}
+
+	return false
+}
+
+// Remove -
+func (pbhs *PeerBanHandlerStub) Remove(pid core.PeerID) {
+	if pbhs.RemoveCalled != nil {
+		pbhs.RemoveCalled(pid)
+	}
+}
+
+// Sweep -
+func (pbhs *PeerBanHandlerStub) Sweep() {
+	if pbhs.SweepCalled != nil {
+		pbhs.SweepCalled()
+	}
+}
+
+// Ban -
+func (pbhs *PeerBanHandlerStub) Ban(pid core.PeerID, duration time.Duration, reason string) error {
+	if pbhs.BanCalled != nil {
+		return pbhs.BanCalled(pid, duration, reason)
+	}
+
+	return nil
+}
+
+// Unban -
+func (pbhs *PeerBanHandlerStub) Unban(pid core.PeerID) error {
+	if pbhs.UnbanCalled != nil {
+		return pbhs.UnbanCalled(pid)
+	}
+
+	return nil
+}
+
+// GetKnownPeers -
+func (pbhs *PeerBanHandlerStub) GetKnownPeers() []p2p.PeerStoreEntry {
+	if pbhs.GetKnownPeersCalled != nil {
+		return pbhs.GetKnownPeersCalled()
+	}
+
+	return make([]p2p.PeerStoreEntry, 0)
+}
+
+// IsInterfaceNil -
+func (pbhs *PeerBanHandlerStub) IsInterfaceNil() bool {
+	return pbhs == nil
+}
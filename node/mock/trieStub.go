@@ -19,6 +19,7 @@ type TrieStub struct {
 	AppendToOldHashesCalled     func([][]byte)
 	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
 	GetAllHashesCalled          func() ([][]byte, error)
+	DiffCalled                  func(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func() chan core.KeyValueHolder
 }
@@ -199,3 +200,12 @@ func (ts *TrieStub) GetAllHashes() ([][]byte, error) {
 func (ts *TrieStub) GetSnapshotDbBatchDelay() int {
 	return 0
 }
+
+// Diff -
+func (ts *TrieStub) Diff(oldRootHash []byte, newRootHash []byte) ([]data.LeafChange, error) {
+	if ts.DiffCalled != nil {
+		return ts.DiffCalled(oldRootHash, newRootHash)
+	}
+
+	return nil, nil
+}
@@ -13,9 +13,11 @@ import (
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -23,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/fullHistory"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -39,11 +42,13 @@ import (
 	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	heartbeatProcess "github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/consensusGroupAPI"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/slash/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
@@ -91,6 +96,7 @@ type Node struct {
 	interceptorsContainer         process.InterceptorsContainer
 	resolversFinder               dataRetriever.ResolversFinder
 	peerDenialEvaluator           p2p.PeerDenialEvaluator
+	peerBanHandler                process.PeerBanHandler
 	appStatusHandler              core.AppStatusHandler
 	validatorStatistics           process.ValidatorStatisticsProcessor
 	hardforkTrigger               HardforkTrigger
@@ -117,13 +123,19 @@ type Node struct {
 
 	networkShardingCollector NetworkShardingCollector
 
-	consensusTopic string
-	consensusType  string
+	consensusTopic           string
+	consensusType            string
+	backupLeaderTimeFraction float64
+	adaptiveSubroundsConfig  config.AdaptiveSubroundsConfig
 
 	currentSendingGoRoutines int32
 	bootstrapRoundIndex      uint64
 
 	indexer                 indexer.Indexer
+	tracer                  core.Tracer
+	equivocationDetector    consensus.EquivocationDetector
+	messageRecorder         consensus.MessageRecorder
+	finalityTracker         FinalityTracker
 	blocksBlackListHandler  process.TimeCacher
 	bootStorer              process.BootStorer
 	requestedItemsHandler   dataRetriever.RequestedItemsHandler
@@ -151,6 +163,9 @@ type Node struct {
 	mutQueryHandlers syncGo.RWMutex
 	queryHandlers    map[string]debug.QueryHandler
 
+	mutArchiveAccounts syncGo.Mutex
+	archiveAccounts    state.AccountsAdapter
+
 	heartbeatHandler   *componentHandler.HeartbeatHandler
 	peerHonestyHandler consensus.PeerHonestyHandler
 
@@ -175,6 +190,9 @@ func NewNode(opts ...Option) (*Node, error) {
 		ctx:                      context.Background(),
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		tracer:                   tracing.NewDisabledTracer(),
+		equivocationDetector:     disabled.NewDisabledEquivocationDetector(),
+		messageRecorder:          recorder.NewDisabledMessageRecorder(),
 		queryHandlers:            make(map[string]debug.QueryHandler),
 	}
 	for _, opt := range opts {
@@ -277,6 +295,11 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	broadcastMessenger, err = recorder.NewRecordingBroadcastMessenger(broadcastMessenger, n.messageRecorder)
+	if err != nil {
+		return err
+	}
+
 	netInputMarshalizer := n.internalMarshalizer
 	if n.sizeCheckDelta > 0 {
 		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(n.internalMarshalizer, n.sizeCheckDelta)
@@ -311,6 +334,16 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	err = worker.SetEquivocationDetector(n.equivocationDetector)
+	if err != nil {
+		return err
+	}
+
+	err = worker.SetMessageRecorder(n.messageRecorder)
+	if err != nil {
+		return err
+	}
+
 	worker.StartWorking()
 
 	n.dataPool.Headers().RegisterHandler(worker.ReceivedHeader)
@@ -356,8 +389,11 @@ func (n *Node) StartConsensus() error {
 		n.consensusType,
 		n.appStatusHandler,
 		n.indexer,
+		n.tracer,
 		n.chainID,
 		n.messenger.ID(),
+		n.backupLeaderTimeFraction,
+		n.adaptiveSubroundsConfig,
 	)
 	if err != nil {
 		return err
@@ -468,6 +504,11 @@ func (n *Node) createChronologyHandler(
 		return nil, err
 	}
 
+	err = chr.SetMessageRecorder(n.messageRecorder)
+	if err != nil {
+		return nil, err
+	}
+
 	return chr, nil
 }
 
@@ -970,7 +1011,37 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	return getUserAccount(n.accounts, addr)
+}
+
+// GetAccountAtBlock returns the account with the provided address as it was after the block with the provided nonce
+// was committed. The state is recreated from the root hash archived for that block, so the archive mode is needed
+func (n *Node) GetAccountAtBlock(address string, nonce uint64) (state.UserAccountHandler, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.archiveAccounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	addr, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	n.mutArchiveAccounts.Lock()
+	defer n.mutArchiveAccounts.Unlock()
+
+	err = n.archiveAccounts.RecreateAtBlock(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return getUserAccount(n.archiveAccounts, addr)
+}
+
+func getUserAccount(accounts state.AccountsAdapter, addr []byte) (state.UserAccountHandler, error) {
+	accWrp, err := accounts.GetExistingAccount(addr)
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
@@ -988,7 +1059,12 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 
 // StartHeartbeat starts the node's heartbeat processing/signaling module
 //TODO(next PR) remove the instantiation of the heartbeat component from here
-func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber string, prefsConfig config.PreferencesConfig) error {
+func (n *Node) StartHeartbeat(
+	hbConfig config.HeartbeatConfig,
+	versionNumber string,
+	prefsConfig config.PreferencesConfig,
+	capabilities uint32,
+) error {
 	arg := componentHandler.ArgHeartbeat{
 		HeartbeatConfig:          hbConfig,
 		PrefsConfig:              prefsConfig,
@@ -1000,6 +1076,8 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		Storer:                   n.store.GetStorer(dataRetriever.HeartbeatUnit),
 		ValidatorStatistics:      n.validatorStatistics,
 		PeerSignatureHandler:     n.peerSigHandler,
+		KeyGenerator:             n.keyGen,
+		SingleSigner:             n.singleSigner,
 		PrivKey:                  n.privKey,
 		HardforkTrigger:          n.hardforkTrigger,
 		AntifloodHandler:         n.inputAntifloodHandler,
@@ -1012,6 +1090,10 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		PeerShardMapper:          n.networkShardingCollector,
 		SizeCheckDelta:           n.sizeCheckDelta,
 		ValidatorsProvider:       n.validatorsProvider,
+		BlockChain:               n.blkc,
+		ForkDetector:             n.forkDetector,
+		Rounder:                  n.rounder,
+		Capabilities:             capabilities,
 	}
 
 	var err error
@@ -1038,6 +1120,56 @@ func (n *Node) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse,
 	return n.validatorsProvider.GetLatestValidators(), nil
 }
 
+// GetConsensusGroup returns the consensus group and the leader of the given shard for a past epoch and round
+func (n *Node) GetConsensusGroup(shardID uint32, epoch uint32, round uint64) (*validator.APIConsensusGroup, error) {
+	historicalComputer, ok := n.nodesCoordinator.(sharding.HistoricalConsensusGroupComputer)
+	if !ok {
+		return nil, ErrHistoricalConsensusGroupNotSupported
+	}
+
+	consensusGroupProcessor, err := consensusGroupAPI.NewConsensusGroupProcessor(consensusGroupAPI.ArgsConsensusGroupProcessor{
+		Store:                    n.store,
+		Marshalizer:              n.internalMarshalizer,
+		Uint64ByteSliceConverter: n.uint64ByteSliceConverter,
+		NodesCoordinator:         historicalComputer,
+		ValidatorPubkeyConverter: n.validatorPubkeyConverter,
+		HighestNonceGetter:       n.getHighestStoredNonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusGroupProcessor.GetConsensusGroup(shardID, epoch, round)
+}
+
+// getHighestStoredNonce returns the nonce of the last committed block for the self shard and the nonce of the last
+// cross notarized block for the other shards
+func (n *Node) getHighestStoredNonce(shardID uint32) (uint64, error) {
+	if shardID == n.shardCoordinator.SelfId() {
+		if check.IfNil(n.blkc) {
+			return 0, ErrNilBlockchain
+		}
+
+		currentHeader := n.blkc.GetCurrentBlockHeader()
+		if check.IfNil(currentHeader) {
+			return 0, nil
+		}
+
+		return currentHeader.GetNonce(), nil
+	}
+
+	if check.IfNil(n.blockTracker) {
+		return 0, ErrNilBlockTracker
+	}
+
+	lastNotarizedHeader, _, err := n.blockTracker.GetLastCrossNotarizedHeader(shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastNotarizedHeader.GetNonce(), nil
+}
+
 func (n *Node) getLatestValidators() (map[uint32][]*state.ValidatorInfo, map[string]*state.ValidatorApiResponse, error) {
 	latestHash, err := n.validatorStatistics.RootHash()
 	if err != nil {
@@ -1160,6 +1292,106 @@ func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
 	return result
 }
 
+// GetPeersTraffic returns, for each connected peer, the messages and bytes exchanged on each topic, the rejected
+// messages and the antiflood hits, together with the peer's shard, type and connection direction
+func (n *Node) GetPeersTraffic() ([]core.QueryP2PPeerTrafficInfo, error) {
+	peersTraffic := n.messenger.GetPeersTraffic()
+	result := make([]core.QueryP2PPeerTrafficInfo, 0, len(peersTraffic))
+	for _, peerTraffic := range peersTraffic {
+		peer := core.QueryP2PPeerTrafficInfo{
+			Pid:                 peerTraffic.Pid.Pretty(),
+			ShardID:             peerTraffic.ShardID,
+			PeerType:            peerTraffic.PeerType.String(),
+			ConnectionDirection: peerTraffic.ConnectionDirection,
+			Topics:              make([]core.QueryP2PPeerTopicTraffic, 0, len(peerTraffic.Topics)),
+		}
+		if !check.IfNil(n.networkShardingCollector) {
+			pkBytes := n.networkShardingCollector.GetPeerInfo(peerTraffic.Pid).PkBytes
+			if len(pkBytes) > 0 {
+				peer.Pk = n.validatorPubkeyConverter.Encode(pkBytes)
+			}
+		}
+
+		for _, topicTraffic := range peerTraffic.Topics {
+			peer.Topics = append(peer.Topics, core.QueryP2PPeerTopicTraffic{
+				Topic:            topicTraffic.Topic,
+				NumMessagesIn:    topicTraffic.NumMessagesIn,
+				NumBytesIn:       topicTraffic.NumBytesIn,
+				NumMessagesOut:   topicTraffic.NumMessagesOut,
+				NumBytesOut:      topicTraffic.NumBytesOut,
+				NumRejected:      topicTraffic.NumRejected,
+				NumAntifloodHits: topicTraffic.NumAntifloodHits,
+			})
+		}
+
+		result = append(result, peer)
+	}
+
+	return result, nil
+}
+
+// GetKnownPeers returns the peers recorded in the peer store, together with their ban status
+func (n *Node) GetKnownPeers() ([]core.QueryP2PPeerStoreInfo, error) {
+	if check.IfNil(n.peerBanHandler) {
+		return nil, ErrNilPeerBanHandler
+	}
+
+	entries := n.peerBanHandler.GetKnownPeers()
+	peers := make([]core.QueryP2PPeerStoreInfo, 0, len(entries))
+	for _, entry := range entries {
+		peer := core.QueryP2PPeerStoreInfo{
+			Pid:          entry.Pid.Pretty(),
+			Addresses:    entry.Addresses,
+			LastSeen:     entry.LastSeen,
+			ShardID:      entry.ShardID,
+			PeerType:     entry.PeerType.String(),
+			HonestyScore: entry.HonestyScore,
+			IsBanned:     entry.BanExpiry > 0,
+			BanExpiry:    entry.BanExpiry,
+			BanReason:    entry.BanReason,
+		}
+		if !peer.IsBanned && !check.IfNil(n.peerDenialEvaluator) && n.peerDenialEvaluator.IsDenied(entry.Pid) {
+			peer.IsBanned = true
+			peer.BanReason = "denied public key"
+		}
+
+		peers = append(peers, peer)
+	}
+
+	return peers, nil
+}
+
+// BanPeer bans the provided b58-encoded peer ID for the given duration, recording the provided reason
+func (n *Node) BanPeer(pid string, durationInSec uint32, reason string) error {
+	if check.IfNil(n.peerBanHandler) {
+		return ErrNilPeerBanHandler
+	}
+
+	p, err := core.NewPeerIDFromPretty(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s", err, pid)
+	}
+	if durationInSec == 0 {
+		return ErrInvalidBanDuration
+	}
+
+	return n.peerBanHandler.Ban(p, time.Duration(durationInSec)*time.Second, reason)
+}
+
+// UnbanPeer removes the ban of the provided b58-encoded peer ID
+func (n *Node) UnbanPeer(pid string) error {
+	if check.IfNil(n.peerBanHandler) {
+		return ErrNilPeerBanHandler
+	}
+
+	p, err := core.NewPeerIDFromPretty(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s", err, pid)
+	}
+
+	return n.peerBanHandler.Unban(p)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	return n == nil
@@ -5,8 +5,10 @@ import (
 
 	apiBlock "github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/process/finality"
 )
 
 // GetBlockByHash return the block for a given hash
@@ -27,6 +29,40 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*apiBlock.APIBlock,
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetFinalityInfo returns the last final block of each shard and the final or reverted blocks events starting
+// from the given index
+func (n *Node) GetFinalityInfo(fromIndex uint64) (*apiBlock.APIFinality, error) {
+	if check.IfNil(n.finalityTracker) {
+		return nil, ErrNilFinalityTracker
+	}
+
+	lastFinalBlocks := n.finalityTracker.GetLastFinalBlocks()
+	events := n.finalityTracker.GetEventsFromIndex(fromIndex)
+
+	return &apiBlock.APIFinality{
+		LastFinalBlocks: finalityEventsToAPI(lastFinalBlocks),
+		Events:          finalityEventsToAPI(events),
+	}, nil
+}
+
+func finalityEventsToAPI(events []finality.Event) []*apiBlock.APIFinalityEvent {
+	apiEvents := make([]*apiBlock.APIFinalityEvent, 0, len(events))
+	for _, event := range events {
+		apiEvents = append(apiEvents, &apiBlock.APIFinalityEvent{
+			Index:     event.Index,
+			Type:      string(event.Type),
+			ShardID:   event.ShardID,
+			Nonce:     event.Nonce,
+			Round:     event.Round,
+			Hash:      hex.EncodeToString(event.Hash),
+			MetaNonce: event.MetaNonce,
+			MetaHash:  hex.EncodeToString(event.MetaHash),
+		})
+	}
+
+	return apiEvents
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 	if n.shardCoordinator.SelfId() != core.MetachainShardId {
 		return blockAPI.NewShardApiBlockProcessor(
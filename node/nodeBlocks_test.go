@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/finality"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -263,3 +264,64 @@ func TestGetBlockByNonceFromNormalNode(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestGetFinalityInfo_NilFinalityTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	finalityInfo, err := n.GetFinalityInfo(0)
+	assert.Equal(t, node.ErrNilFinalityTracker, err)
+	assert.Nil(t, finalityInfo)
+}
+
+func TestGetFinalityInfo_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	finalEvent := finality.Event{
+		Index:     7,
+		Type:      finality.BlockFinal,
+		ShardID:   1,
+		Nonce:     10,
+		Round:     11,
+		Hash:      []byte("hash"),
+		MetaNonce: 5,
+		MetaHash:  []byte("metaHash"),
+	}
+	revertedEvent := finalEvent
+	revertedEvent.Index = 8
+	revertedEvent.Type = finality.BlockReverted
+
+	n, _ := node.NewNode(
+		node.WithFinalityTracker(&mock.FinalityTrackerStub{
+			GetLastFinalBlocksCalled: func() []finality.Event {
+				return []finality.Event{finalEvent}
+			},
+			GetEventsFromIndexCalled: func(index uint64) []finality.Event {
+				assert.Equal(t, uint64(7), index)
+				return []finality.Event{finalEvent, revertedEvent}
+			},
+		}),
+	)
+
+	expectedFinalEvent := &apiBlock.APIFinalityEvent{
+		Index:     7,
+		Type:      "final",
+		ShardID:   1,
+		Nonce:     10,
+		Round:     11,
+		Hash:      hex.EncodeToString([]byte("hash")),
+		MetaNonce: 5,
+		MetaHash:  hex.EncodeToString([]byte("metaHash")),
+	}
+	expectedRevertedEvent := *expectedFinalEvent
+	expectedRevertedEvent.Index = 8
+	expectedRevertedEvent.Type = "reverted"
+
+	finalityInfo, err := n.GetFinalityInfo(7)
+	assert.Nil(t, err)
+	assert.Equal(t, &apiBlock.APIFinality{
+		LastFinalBlocks: []*apiBlock.APIFinalityEvent{expectedFinalEvent},
+		Events:          []*apiBlock.APIFinalityEvent{expectedFinalEvent, &expectedRevertedEvent},
+	}, finalityInfo)
+}
@@ -23,9 +23,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
@@ -34,6 +37,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -908,6 +912,18 @@ func TestNode_ValidatorStatisticsApi(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestNode_GetConsensusGroupNodesCoordinatorWithoutHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithNodesCoordinator(&mock.NodesCoordinatorMock{}),
+	)
+
+	consensusGroup, err := n.GetConsensusGroup(0, 1, 10)
+	assert.Nil(t, consensusGroup)
+	assert.Equal(t, node.ErrHistoricalConsensusGroupNotSupported, err)
+}
+
 func TestNode_StartConsensusGenesisBlockNotInitializedShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1571,6 +1587,98 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	assert.Equal(t, accnt, recovAccnt)
 }
 
+func TestNode_GetAccountAtBlockWithNilArchiveAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+
+	recovAccnt, err := n.GetAccountAtBlock(createDummyHexAddress(64), 1)
+
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetAccountAtBlockRecreateFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	archiveAccounts := &mock.AccountsStub{
+		RecreateAtBlockCalled: func(nonce uint64) error {
+			return expectedErr
+		},
+		GetExistingAccountCalled: func(address []byte) (handler state.AccountHandler, e error) {
+			assert.Fail(t, "should not have read the account")
+			return nil, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithArchiveAccountsAdapter(archiveAccounts),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+	)
+
+	recovAccnt, err := n.GetAccountAtBlock(createDummyHexAddress(64), 1)
+
+	assert.Nil(t, recovAccnt)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetAccountAtBlockShouldReturnTheArchivedState(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := sha256.Sha256{}
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	accounts, _ := state.NewAccountsDB(tr, hasher, marshalizer, stateFactory.NewAccountCreator())
+	_ = accounts.EnableArchiveMode()
+	archiveTrie, _ := tr.Recreate(nil)
+	archiveAccounts, _ := state.NewAccountsDB(archiveTrie, hasher, marshalizer, stateFactory.NewAccountCreator())
+	_ = archiveAccounts.EnableArchiveMode()
+
+	pubkeyConverter := createMockPubkeyConverter()
+	address := createDummyHexAddress(64)
+	addr, _ := pubkeyConverter.Decode(address)
+	commitBlockWithBalanceIncrease := func(nonce uint64, value int64) {
+		acc, _ := accounts.LoadAccount(addr)
+		_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(value))
+		_ = accounts.SaveAccount(acc)
+		rootHash, _ := accounts.Commit()
+		_ = accounts.ArchiveRootHash(nonce, 0, rootHash)
+	}
+	commitBlockWithBalanceIncrease(1, 10)
+	commitBlockWithBalanceIncrease(2, 20)
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapter(accounts),
+		node.WithArchiveAccountsAdapter(archiveAccounts),
+		node.WithAddressPubkeyConverter(pubkeyConverter),
+	)
+
+	accountAtBlock, err := n.GetAccountAtBlock(address, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), accountAtBlock.GetBalance())
+
+	accountAtBlock, err = n.GetAccountAtBlock(address, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(30), accountAtBlock.GetBalance())
+
+	_, err = n.GetAccountAtBlock(address, 3)
+	assert.True(t, errors.Is(err, state.ErrRootHashNotArchived))
+
+	commitBlockWithBalanceIncrease(3, 5)
+	currentAccount, err := n.GetAccount(address)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(35), currentAccount.GetBalance())
+
+	accountAtBlock, err = n.GetAccountAtBlock(address, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), accountAtBlock.GetBalance())
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
@@ -2045,3 +2153,169 @@ func TestNode_ShouldWork(t *testing.T) {
 
 	assert.Equal(t, expected, vals)
 }
+
+func TestNode_GetPeersTrafficShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	n, _ := node.NewNode(
+		node.WithMessenger(&mock.MessengerStub{
+			GetPeersTrafficCalled: func() []p2p.PeerTrafficInfo {
+				return []p2p.PeerTrafficInfo{
+					{
+						Pid:                 pid1,
+						ShardID:             1,
+						PeerType:            core.ValidatorPeer,
+						ConnectionDirection: "Inbound",
+						Topics: []p2p.PeerTopicTraffic{
+							{Topic: "topic", NumMessagesIn: 2, NumBytesIn: 20, NumRejected: 1, NumAntifloodHits: 1},
+						},
+					},
+					{
+						Pid:                 pid2,
+						ConnectionDirection: "Outbound",
+					},
+				}
+			},
+		}),
+		node.WithNetworkShardingCollector(&mock.NetworkShardingCollectorStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				if pid == pid1 {
+					return core.P2PPeerInfo{PkBytes: pid.Bytes()}
+				}
+
+				return core.P2PPeerInfo{}
+			},
+		}),
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+	)
+
+	peers, err := n.GetPeersTraffic()
+	assert.Nil(t, err)
+
+	expected := []core.QueryP2PPeerTrafficInfo{
+		{
+			Pid:                 pid1.Pretty(),
+			Pk:                  hex.EncodeToString(pid1.Bytes()),
+			ShardID:             1,
+			PeerType:            core.ValidatorPeer.String(),
+			ConnectionDirection: "Inbound",
+			Topics: []core.QueryP2PPeerTopicTraffic{
+				{Topic: "topic", NumMessagesIn: 2, NumBytesIn: 20, NumRejected: 1, NumAntifloodHits: 1},
+			},
+		},
+		{
+			Pid:                 pid2.Pretty(),
+			PeerType:            core.UnknownPeer.String(),
+			ConnectionDirection: "Outbound",
+			Topics:              make([]core.QueryP2PPeerTopicTraffic, 0),
+		},
+	}
+	assert.Equal(t, expected, peers)
+}
+
+func TestNode_GetKnownPeersNilPeerBanHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	peers, err := n.GetKnownPeers()
+	assert.Nil(t, peers)
+	assert.Equal(t, node.ErrNilPeerBanHandler, err)
+}
+
+func TestNode_GetKnownPeersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	n, _ := node.NewNode(
+		node.WithPeerBanHandler(&mock.PeerBanHandlerStub{
+			GetKnownPeersCalled: func() []p2p.PeerStoreEntry {
+				return []p2p.PeerStoreEntry{
+					{Pid: pid1, Addresses: []string{"addr1"}, BanExpiry: 100, BanReason: "reason"},
+					{Pid: pid2, Addresses: []string{"addr2"}, PeerType: core.ValidatorPeer, HonestyScore: 2.5},
+				}
+			},
+		}),
+		node.WithPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{
+			IsDeniedCalled: func(pid core.PeerID) bool {
+				return false
+			},
+		}),
+	)
+
+	peers, err := n.GetKnownPeers()
+	assert.Nil(t, err)
+
+	expected := []core.QueryP2PPeerStoreInfo{
+		{
+			Pid:       pid1.Pretty(),
+			Addresses: []string{"addr1"},
+			PeerType:  core.UnknownPeer.String(),
+			IsBanned:  true,
+			BanExpiry: 100,
+			BanReason: "reason",
+		},
+		{
+			Pid:          pid2.Pretty(),
+			Addresses:    []string{"addr2"},
+			PeerType:     core.ValidatorPeer.String(),
+			HonestyScore: 2.5,
+		},
+	}
+	assert.Equal(t, expected, peers)
+}
+
+func TestNode_BanPeerInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithPeerBanHandler(&mock.PeerBanHandlerStub{
+			BanCalled: func(_ core.PeerID, _ time.Duration, _ string) error {
+				assert.Fail(t, "should have not called Ban")
+				return nil
+			},
+		}),
+	)
+
+	err := n.BanPeer("", 10, "reason")
+	assert.True(t, errors.Is(err, core.ErrEmptyPeerID))
+
+	err = n.BanPeer(core.PeerID("pid").Pretty(), 0, "reason")
+	assert.Equal(t, node.ErrInvalidBanDuration, err)
+}
+
+func TestNode_BanAndUnbanPeerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	bannedDuration := time.Duration(0)
+	bannedReason := ""
+	unbannedPid := core.PeerID("")
+	n, _ := node.NewNode(
+		node.WithPeerBanHandler(&mock.PeerBanHandlerStub{
+			BanCalled: func(p core.PeerID, duration time.Duration, reason string) error {
+				if p == pid {
+					bannedDuration = duration
+					bannedReason = reason
+				}
+				return nil
+			},
+			UnbanCalled: func(p core.PeerID) error {
+				unbannedPid = p
+				return nil
+			},
+		}),
+	)
+
+	err := n.BanPeer(pid.Pretty(), 10, "reason")
+	assert.Nil(t, err)
+	assert.Equal(t, 10*time.Second, bannedDuration)
+	assert.Equal(t, "reason", bannedReason)
+
+	err = n.UnbanPeer(pid.Pretty())
+	assert.Nil(t, err)
+	assert.Equal(t, pid, unbannedPid)
+}
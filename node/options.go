@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -103,6 +104,18 @@ func WithAccountsAdapter(accounts state.AccountsAdapter) Option {
 	}
 }
 
+// WithArchiveAccountsAdapter sets up the accounts adapter used to query the state at a past block. It must not be
+// the accounts adapter used for processing, as the queries recreate its state at the requested block
+func WithArchiveAccountsAdapter(archiveAccounts state.AccountsAdapter) Option {
+	return func(n *Node) error {
+		if check.IfNil(archiveAccounts) {
+			return ErrNilAccountsAdapter
+		}
+		n.archiveAccounts = archiveAccounts
+		return nil
+	}
+}
+
 // WithAddressPubkeyConverter sets up the address public key converter adapter option for the Node
 func WithAddressPubkeyConverter(pubkeyConverter core.PubkeyConverter) Option {
 	return func(n *Node) error {
@@ -380,6 +393,24 @@ func WithConsensusType(consensusType string) Option {
 	}
 }
 
+// WithBackupLeaderTimeFraction sets up the fraction of the subround Block after which the backup leader proposes the
+// block. A value of 0 disables the backup leader
+func WithBackupLeaderTimeFraction(timeFraction float64) Option {
+	return func(n *Node) error {
+		n.backupLeaderTimeFraction = timeFraction
+		return nil
+	}
+}
+
+// WithAdaptiveSubroundsConfig sets up the config used to adapt the end of the subround Block to the measured block
+// proposal times
+func WithAdaptiveSubroundsConfig(adaptiveSubroundsConfig config.AdaptiveSubroundsConfig) Option {
+	return func(n *Node) error {
+		n.adaptiveSubroundsConfig = adaptiveSubroundsConfig
+		return nil
+	}
+}
+
 // WithBootstrapRoundIndex sets up a bootstrapRoundIndex option for the Node
 func WithBootstrapRoundIndex(bootstrapRoundIndex uint64) Option {
 	return func(n *Node) error {
@@ -430,6 +461,17 @@ func WithIndexer(indexer indexer.Indexer) Option {
 	}
 }
 
+// WithTracer sets up the tracer recording the consensus subrounds spans
+func WithTracer(tracer core.Tracer) Option {
+	return func(n *Node) error {
+		if check.IfNil(tracer) {
+			return ErrNilTracer
+		}
+		n.tracer = tracer
+		return nil
+	}
+}
+
 // WithBlockBlackListHandler sets up a block black list handler for the Node
 func WithBlockBlackListHandler(blackListHandler process.TimeCacher) Option {
 	return func(n *Node) error {
@@ -452,6 +494,17 @@ func WithPeerDenialEvaluator(handler p2p.PeerDenialEvaluator) Option {
 	}
 }
 
+// WithPeerBanHandler sets up a peer ban handler for the Node
+func WithPeerBanHandler(handler process.PeerBanHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(handler) {
+			return ErrNilPeerBanHandler
+		}
+		n.peerBanHandler = handler
+		return nil
+	}
+}
+
 // WithBootStorer sets up a boot storer for the Node
 func WithBootStorer(bootStorer process.BootStorer) Option {
 	return func(n *Node) error {
@@ -690,6 +743,39 @@ func WithPeerHonestyHandler(peerHonestyHandler consensus.PeerHonestyHandler) Opt
 	}
 }
 
+// WithEquivocationDetector sets up the component which checks the consensus messages for double signing
+func WithEquivocationDetector(detector consensus.EquivocationDetector) Option {
+	return func(n *Node) error {
+		if check.IfNil(detector) {
+			return ErrNilEquivocationDetector
+		}
+		n.equivocationDetector = detector
+		return nil
+	}
+}
+
+// WithConsensusMessageRecorder sets up the component which records the consensus messages received and sent by the node
+func WithConsensusMessageRecorder(messageRecorder consensus.MessageRecorder) Option {
+	return func(n *Node) error {
+		if check.IfNil(messageRecorder) {
+			return ErrNilConsensusMessageRecorder
+		}
+		n.messageRecorder = messageRecorder
+		return nil
+	}
+}
+
+// WithFinalityTracker sets up the component which announces the final and reverted blocks
+func WithFinalityTracker(finalityTracker FinalityTracker) Option {
+	return func(n *Node) error {
+		if check.IfNil(finalityTracker) {
+			return ErrNilFinalityTracker
+		}
+		n.finalityTracker = finalityTracker
+		return nil
+	}
+}
+
 // WithWatchdogTimer sets up a watchdog for the Node
 func WithWatchdogTimer(watchdog core.WatchdogTimer) Option {
 	return func(n *Node) error {
@@ -5,9 +5,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus/recorder"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash/disabled"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -172,6 +176,32 @@ func TestWithAccountsAdapter_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithArchiveAccountsAdapter_NilAccountsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithArchiveAccountsAdapter(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.archiveAccounts)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+}
+
+func TestWithArchiveAccountsAdapter_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	archiveAccounts := &mock.AccountsStub{}
+
+	opt := WithArchiveAccountsAdapter(archiveAccounts)
+	err := opt(node)
+
+	assert.True(t, node.archiveAccounts == archiveAccounts)
+	assert.Nil(t, err)
+}
+
 func TestWithAddressPubkeyConverter_NilConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -819,6 +849,38 @@ func TestWithConsensusBls_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithBackupLeaderTimeFraction(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	timeFraction := 0.5
+	opt := WithBackupLeaderTimeFraction(timeFraction)
+	err := opt(node)
+
+	assert.Equal(t, timeFraction, node.backupLeaderTimeFraction)
+	assert.Nil(t, err)
+}
+
+func TestWithAdaptiveSubroundsConfig(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	adaptiveSubroundsConfig := config.AdaptiveSubroundsConfig{
+		Enabled:             true,
+		NumRoundsToMeasure:  10,
+		SafetyFactor:        1.5,
+		MinBlockSubroundEnd: 0.15,
+		MaxBlockSubroundEnd: 0.45,
+	}
+	opt := WithAdaptiveSubroundsConfig(adaptiveSubroundsConfig)
+	err := opt(node)
+
+	assert.Equal(t, adaptiveSubroundsConfig, node.adaptiveSubroundsConfig)
+	assert.Nil(t, err)
+}
+
 func TestWithAppStatusHandler_NilAshShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -855,6 +917,30 @@ func TestWithIndexer_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithTracer_NilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithTracer(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilTracer, err)
+}
+
+func TestWithTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	tracer := tracing.NewDisabledTracer()
+	opt := WithTracer(tracer)
+	err := opt(node)
+
+	assert.True(t, node.tracer == tracer)
+	assert.Nil(t, err)
+}
+
 func TestWithKeyGenForAccounts_NilKeygenShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1151,6 +1237,30 @@ func TestWithPeerDenialEvaluator_OkHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithPeerBanHandler_NilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPeerBanHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilPeerBanHandler, err)
+}
+
+func TestWithPeerBanHandler_OkHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	peerBanHandler := &mock.PeerBanHandlerStub{}
+	opt := WithPeerBanHandler(peerBanHandler)
+	err := opt(node)
+
+	assert.True(t, node.peerBanHandler == peerBanHandler)
+	assert.Nil(t, err)
+}
+
 func TestWithNetworkShardingCollector_NilNetworkShardingCollectorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1354,6 +1464,78 @@ func TestWithPeerHonestyHandler_OkPeerHonestyHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithEquivocationDetector_NilDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationDetector(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEquivocationDetector, err)
+}
+
+func TestWithEquivocationDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	detector := disabled.NewDisabledEquivocationDetector()
+	opt := WithEquivocationDetector(detector)
+	err := opt(node)
+
+	assert.True(t, node.equivocationDetector == detector)
+	assert.Nil(t, err)
+}
+
+func TestWithConsensusMessageRecorder_NilRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithConsensusMessageRecorder(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilConsensusMessageRecorder, err)
+}
+
+func TestWithConsensusMessageRecorder_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	messageRecorder := recorder.NewDisabledMessageRecorder()
+	opt := WithConsensusMessageRecorder(messageRecorder)
+	err := opt(node)
+
+	assert.True(t, node.messageRecorder == messageRecorder)
+	assert.Nil(t, err)
+}
+
+func TestWithFinalityTracker_NilTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithFinalityTracker(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilFinalityTracker, err)
+}
+
+func TestWithFinalityTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	finalityTracker := &mock.FinalityTrackerStub{}
+	opt := WithFinalityTracker(finalityTracker)
+	err := opt(node)
+
+	assert.True(t, node.finalityTracker == finalityTracker)
+	assert.Nil(t, err)
+}
+
 func TestWithWatchdogTimer_NilWatchdogShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -128,6 +128,9 @@ var ErrEmptyBuffer = errors.New("empty buffer")
 // ErrNilPeerDenialEvaluator signals that a nil peer denial evaluator was provided
 var ErrNilPeerDenialEvaluator = errors.New("nil peer denial evaluator")
 
+// ErrNilAntifloodHandler signals that a nil antiflood handler was provided
+var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
+
 // ErrNilStatusHandler signals that a nil status handler has been provided
 var ErrNilStatusHandler = errors.New("nil status handler")
 
@@ -143,3 +146,21 @@ var ErrUnsupportedMessageVersion = errors.New("unsupported message version")
 
 // ErrNilSyncTimer signals that a nil sync timer was provided
 var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilPeerStore signals that a nil peer store was provided
+var ErrNilPeerStore = errors.New("nil peer store")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilPeerHonestyScoreProvider signals that a nil peer honesty score provider was provided
+var ErrNilPeerHonestyScoreProvider = errors.New("nil peer honesty score provider")
+
+// ErrPeerNotBanned signals that the provided peer is not banned
+var ErrPeerNotBanned = errors.New("peer is not banned")
+
+// ErrUnsupportedCompression signals that an unsupported payload compression type was detected
+var ErrUnsupportedCompression = errors.New("unsupported payload compression")
+
+// ErrDecompressedPayloadTooLarge signals that a decompressed payload exceeds the maximum allowed size
+var ErrDecompressedPayloadTooLarge = errors.New("decompressed payload too large")
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/display"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 )
 
@@ -34,6 +35,7 @@ func createMockNetworkArgs() libp2p.ArgsNetworkMessenger {
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 }
 
@@ -14,23 +14,26 @@ var _ ConnectionMonitor = (*connectionMonitorWrapper)(nil)
 
 // connectionMonitorWrapper is a wrapper over p2p.ConnectionMonitor that satisfies the Notifiee interface
 // and is able to be notified by the current running host (connection status changes)
-// it handles black list peers
+// it handles black list peers and the inbound connections refused in private mode
 type connectionMonitorWrapper struct {
 	ConnectionMonitor
 	network             network.Network
 	mutPeerBlackList    sync.RWMutex
 	peerDenialEvaluator p2p.PeerDenialEvaluator
+	peersAccess         *peersAccessList
 }
 
 func newConnectionMonitorWrapper(
 	network network.Network,
 	connMonitor ConnectionMonitor,
 	peerDenialEvaluator p2p.PeerDenialEvaluator,
+	peersAccess *peersAccessList,
 ) *connectionMonitorWrapper {
 	return &connectionMonitorWrapper{
 		ConnectionMonitor:   connMonitor,
 		network:             network,
 		peerDenialEvaluator: peerDenialEvaluator,
+		peersAccess:         peersAccess,
 	}
 }
 
@@ -60,6 +63,16 @@ func (cmw *connectionMonitorWrapper) Connected(netw network.Network, conn networ
 		return
 	}
 
+	isInbound := conn.Stat().Direction == network.DirInbound
+	if isInbound && !cmw.peersAccess.isInboundConnectionAllowed(pid) {
+		log.Trace("dropping inbound connection from a peer not allowed in private mode",
+			"pid", pid.Pretty(),
+		)
+		_ = conn.Close()
+
+		return
+	}
+
 	cmw.ConnectionMonitor.Connected(netw, conn)
 }
 
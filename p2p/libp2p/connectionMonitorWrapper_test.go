@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -19,9 +20,18 @@ func createStubConn() *mock.ConnStub {
 		RemotePeerCalled: func() peer.ID {
 			return "remote peer"
 		},
+		StatCalled: func() network.Stat {
+			return network.Stat{Direction: network.DirInbound}
+		},
 	}
 }
 
+func createPeersAccessList(cfg config.PeerAccessConfig) *peersAccessList {
+	pal, _ := newPeersAccessList(cfg)
+
+	return pal
+}
+
 func TestNewConnectionMonitorWrapper_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -29,6 +39,7 @@ func TestNewConnectionMonitorWrapper_ShouldWork(t *testing.T) {
 		&mock.NetworkStub{},
 		&mock.ConnectionMonitorStub{},
 		&mock.PeerDenialEvaluatorStub{},
+		createPeersAccessList(config.PeerAccessConfig{}),
 	)
 
 	assert.False(t, check.IfNil(cmw))
@@ -54,6 +65,7 @@ func TestConnectionMonitorNotifier_ConnectedBlackListedShouldCallClose(t *testin
 				return true
 			},
 		},
+		createPeersAccessList(config.PeerAccessConfig{}),
 	)
 
 	cmw.Connected(cmw.network, conn)
@@ -78,6 +90,97 @@ func TestConnectionMonitorNotifier_ConnectedNotBlackListedShouldCallConnected(t
 				return false
 			},
 		},
+		createPeersAccessList(config.PeerAccessConfig{}),
+	)
+
+	cmw.Connected(cmw.network, conn)
+
+	assert.True(t, peerConnectedCalled)
+}
+
+func TestConnectionMonitorNotifier_ConnectedPrivateModeNotAllowedInboundShouldCallClose(t *testing.T) {
+	t.Parallel()
+
+	peerCloseCalled := false
+	conn := createStubConn()
+	conn.CloseCalled = func() error {
+		peerCloseCalled = true
+
+		return nil
+	}
+	cmw := newConnectionMonitorWrapper(
+		&mock.NetworkStub{},
+		&mock.ConnectionMonitorStub{
+			ConnectedCalled: func(netw network.Network, conn network.Conn) {
+				assert.Fail(t, "should have not called Connected")
+			},
+		},
+		&mock.PeerDenialEvaluatorStub{
+			IsDeniedCalled: func(pid core.PeerID) bool {
+				return false
+			},
+		},
+		createPeersAccessList(config.PeerAccessConfig{PrivateMode: true}),
+	)
+
+	cmw.Connected(cmw.network, conn)
+
+	assert.True(t, peerCloseCalled)
+}
+
+func TestConnectionMonitorNotifier_ConnectedPrivateModeAllowedInboundShouldCallConnected(t *testing.T) {
+	t.Parallel()
+
+	remotePeer, _ := peer.Decode("16Uiu2HAkyqtHSEJDkYhVWTtm9j58Mq5xQJgrApBYXMwS6sdamXuE")
+	peerConnectedCalled := false
+	conn := createStubConn()
+	conn.RemotePeerCalled = func() peer.ID {
+		return remotePeer
+	}
+	cmw := newConnectionMonitorWrapper(
+		&mock.NetworkStub{},
+		&mock.ConnectionMonitorStub{
+			ConnectedCalled: func(netw network.Network, conn network.Conn) {
+				peerConnectedCalled = true
+			},
+		},
+		&mock.PeerDenialEvaluatorStub{
+			IsDeniedCalled: func(pid core.PeerID) bool {
+				return false
+			},
+		},
+		createPeersAccessList(config.PeerAccessConfig{
+			PrivateMode:  true,
+			AllowedPeers: []string{remotePeer.Pretty()},
+		}),
+	)
+
+	cmw.Connected(cmw.network, conn)
+
+	assert.True(t, peerConnectedCalled)
+}
+
+func TestConnectionMonitorNotifier_ConnectedPrivateModeOutboundShouldCallConnected(t *testing.T) {
+	t.Parallel()
+
+	peerConnectedCalled := false
+	conn := createStubConn()
+	conn.StatCalled = func() network.Stat {
+		return network.Stat{Direction: network.DirOutbound}
+	}
+	cmw := newConnectionMonitorWrapper(
+		&mock.NetworkStub{},
+		&mock.ConnectionMonitorStub{
+			ConnectedCalled: func(netw network.Network, conn network.Conn) {
+				peerConnectedCalled = true
+			},
+		},
+		&mock.PeerDenialEvaluatorStub{
+			IsDeniedCalled: func(pid core.PeerID) bool {
+				return false
+			},
+		},
+		createPeersAccessList(config.PeerAccessConfig{PrivateMode: true}),
 	)
 
 	cmw.Connected(cmw.network, conn)
@@ -115,6 +218,7 @@ func TestConnectionMonitorNotifier_FunctionsShouldCallHandler(t *testing.T) {
 			},
 		},
 		&mock.PeerDenialEvaluatorStub{},
+		createPeersAccessList(config.PeerAccessConfig{}),
 	)
 
 	cmw.Listen(nil, nil)
@@ -139,6 +243,7 @@ func TestConnectionMonitorWrapper_SetBlackListHandlerNilHandlerShouldErr(t *test
 		&mock.NetworkStub{},
 		&mock.ConnectionMonitorStub{},
 		&mock.PeerDenialEvaluatorStub{},
+		createPeersAccessList(config.PeerAccessConfig{}),
 	)
 
 	err := cmw.SetPeerDenialEvaluator(nil)
@@ -153,6 +258,7 @@ func TestConnectionMonitorWrapper_SetBlackListHandlerShouldWork(t *testing.T) {
 		&mock.NetworkStub{},
 		&mock.ConnectionMonitorStub{},
 		&mock.PeerDenialEvaluatorStub{},
+		createPeersAccessList(config.PeerAccessConfig{}),
 	)
 	newPeerDenialEvaluator := &mock.PeerDenialEvaluatorStub{}
 
@@ -193,6 +299,7 @@ func TestConnectionMonitorWrapper_CheckConnectionsBlockingShouldWork(t *testing.
 				return bytes.Equal(core.PeerID(blackListPeer).Bytes(), pid.Bytes())
 			},
 		},
+		createPeersAccessList(config.PeerAccessConfig{}),
 	)
 
 	cmw.CheckConnectionsBlocking()
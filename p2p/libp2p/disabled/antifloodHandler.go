@@ -0,0 +1,20 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// AntifloodHandler is a disabled implementation of AntifloodHandler that allows all messages to be processed
+type AntifloodHandler struct {
+}
+
+// CanProcessMessage returns nil (all messages can be processed)
+func (ah *AntifloodHandler) CanProcessMessage(_ p2p.MessageP2P, _ core.PeerID) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ah *AntifloodHandler) IsInterfaceNil() bool {
+	return ah == nil
+}
@@ -0,0 +1,15 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAntifloodHandler_ShouldWork(t *testing.T) {
+	ah := &AntifloodHandler{}
+
+	assert.False(t, check.IfNil(ah))
+	assert.Nil(t, ah.CanProcessMessage(nil, ""))
+}
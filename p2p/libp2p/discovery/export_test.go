@@ -23,3 +23,30 @@ func (ckdd *ContinuousKadDhtDiscoverer) StopDHT() error {
 
 	return err
 }
+
+//------- FileDiscoverer
+
+const FileDiscovererName = fileDiscovererName
+
+func (fd *FileDiscoverer) Refresh() {
+	fd.refresh()
+}
+
+func ReadAddressesFromFile(filePath string) ([]string, error) {
+	return readAddressesFromFile(filePath)
+}
+
+//------- MdnsDiscoverer
+
+const MdnsDiscovererName = mdnsDiscovererName
+
+func (md *MdnsDiscoverer) ServiceTag() string {
+	return md.serviceTag
+}
+
+func (md *MdnsDiscoverer) InitHostConnManagement() error {
+	var err error
+	md.hostConnManagement, err = NewHostWithConnectionManagement(md.host, md.sharder)
+
+	return err
+}
@@ -18,13 +18,67 @@ func NewPeerDiscoverer(
 	sharder p2p.CommonSharder,
 	p2pConfig config.P2PConfig,
 ) (p2p.PeerDiscoverer, error) {
+	numEnabled := 0
+	for _, enabled := range []bool{
+		p2pConfig.KadDhtPeerDiscovery.Enabled,
+		p2pConfig.MdnsPeerDiscovery.Enabled,
+		p2pConfig.FilePeerDiscovery.Enabled,
+	} {
+		if enabled {
+			numEnabled++
+		}
+	}
+	if numEnabled > 1 {
+		return nil, fmt.Errorf("%w, only one peer discovery mechanism can be enabled", p2p.ErrInvalidValue)
+	}
+
 	if p2pConfig.KadDhtPeerDiscovery.Enabled {
 		return createKadDhtPeerDiscoverer(context, host, sharder, p2pConfig)
 	}
+	if p2pConfig.MdnsPeerDiscovery.Enabled {
+		return createMdnsPeerDiscoverer(context, host, sharder, p2pConfig)
+	}
+	if p2pConfig.FilePeerDiscovery.Enabled {
+		return createFilePeerDiscoverer(context, host, sharder, p2pConfig)
+	}
 
 	return discovery.NewNilDiscoverer(), nil
 }
 
+func createMdnsPeerDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	sharder p2p.CommonSharder,
+	p2pConfig config.P2PConfig,
+) (p2p.PeerDiscoverer, error) {
+	arg := discovery.ArgMdnsDiscoverer{
+		Context:         context,
+		Host:            host,
+		Sharder:         sharder,
+		ServiceTag:      p2pConfig.MdnsPeerDiscovery.ServiceTag,
+		RefreshInterval: time.Second * time.Duration(p2pConfig.MdnsPeerDiscovery.RefreshIntervalInSec),
+	}
+
+	return discovery.NewMdnsDiscoverer(arg)
+}
+
+func createFilePeerDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	sharder p2p.CommonSharder,
+	p2pConfig config.P2PConfig,
+) (p2p.PeerDiscoverer, error) {
+	arg := discovery.ArgFileDiscoverer{
+		Context:         context,
+		Host:            host,
+		Sharder:         sharder,
+		FilePath:        p2pConfig.FilePeerDiscovery.FilePath,
+		RefreshInterval: time.Second * time.Duration(p2pConfig.FilePeerDiscovery.RefreshIntervalInSec),
+	}
+
+	return discovery.NewFileDiscoverer(arg)
+}
+
 func createKadDhtPeerDiscoverer(
 	context context.Context,
 	host discovery.ConnectableHost,
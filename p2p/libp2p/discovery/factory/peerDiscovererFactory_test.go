@@ -85,3 +85,72 @@ func TestNewPeerDiscoverer_UnknownShouldErr(t *testing.T) {
 	assert.True(t, check.IfNil(pDiscoverer))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
 }
+
+func TestNewPeerDiscoverer_MoreThanOneEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled: true,
+		},
+		FilePeerDiscovery: config.FilePeerDiscoveryConfig{
+			Enabled: true,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+
+	assert.True(t, check.IfNil(pDiscoverer))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewPeerDiscoverer_MdnsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		MdnsPeerDiscovery: config.MdnsPeerDiscoveryConfig{
+			Enabled:              true,
+			ServiceTag:           "_erd-discovery._udp",
+			RefreshIntervalInSec: 1,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+	_, ok := pDiscoverer.(*discovery.MdnsDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestNewPeerDiscoverer_FileShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		FilePeerDiscovery: config.FilePeerDiscoveryConfig{
+			Enabled:              true,
+			FilePath:             "peers.txt",
+			RefreshIntervalInSec: 1,
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+	_, ok := pDiscoverer.(*discovery.FileDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
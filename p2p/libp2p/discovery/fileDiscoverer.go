@@ -0,0 +1,205 @@
+package discovery
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ p2p.PeerDiscoverer = (*FileDiscoverer)(nil)
+var _ p2p.Reconnecter = (*FileDiscoverer)(nil)
+
+const fileDiscovererName = "file based discovery"
+const commentPrefix = "#"
+
+// ArgFileDiscoverer represents the file based discoverer config argument DTO
+type ArgFileDiscoverer struct {
+	Context         context.Context
+	Host            ConnectableHost
+	Sharder         p2p.CommonSharder
+	FilePath        string
+	RefreshInterval time.Duration
+}
+
+// FileDiscoverer is the peer discovery implementation that reads the peers' addresses from a file. The file is
+// reloaded each time it is modified and the node tries to connect to all listed peers on each refresh
+type FileDiscoverer struct {
+	context            context.Context
+	host               ConnectableHost
+	sharder            Sharder
+	filePath           string
+	refreshInterval    time.Duration
+	mutFile            sync.RWMutex
+	lastModTime        time.Time
+	addresses          []string
+	isBootstrapped     bool
+	hostConnManagement *hostWithConnectionManagement
+}
+
+// NewFileDiscoverer creates a new file based discoverer
+func NewFileDiscoverer(arg ArgFileDiscoverer) (*FileDiscoverer, error) {
+	if check.IfNilReflect(arg.Context) {
+		return nil, p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if check.IfNil(arg.Sharder) {
+		return nil, p2p.ErrNilSharder
+	}
+	sharder, ok := arg.Sharder.(Sharder)
+	if !ok {
+		return nil, fmt.Errorf("%w for sharder: expected discovery.Sharder type of interface", p2p.ErrWrongTypeAssertion)
+	}
+	if len(arg.FilePath) == 0 {
+		return nil, fmt.Errorf("%w, empty FilePath", p2p.ErrInvalidValue)
+	}
+	if arg.RefreshInterval < time.Second {
+		return nil, fmt.Errorf("%w, RefreshInterval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+
+	return &FileDiscoverer{
+		context:         arg.Context,
+		host:            arg.Host,
+		sharder:         sharder,
+		filePath:        arg.FilePath,
+		refreshInterval: arg.RefreshInterval,
+		addresses:       make([]string, 0),
+	}, nil
+}
+
+// Bootstrap loads the peers file and starts the process of connecting to the listed peers
+func (fd *FileDiscoverer) Bootstrap() error {
+	fd.mutFile.Lock()
+	defer fd.mutFile.Unlock()
+
+	if fd.isBootstrapped {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	var err error
+	fd.hostConnManagement, err = NewHostWithConnectionManagement(fd.host, fd.sharder)
+	if err != nil {
+		return err
+	}
+
+	err = fd.reloadFileIfChanged()
+	if err != nil {
+		return err
+	}
+
+	fd.isBootstrapped = true
+	go fd.processLoop()
+
+	return nil
+}
+
+func (fd *FileDiscoverer) processLoop() {
+	for {
+		fd.refresh()
+
+		select {
+		case <-time.After(fd.refreshInterval):
+		case <-fd.context.Done():
+			log.Debug("closing the file based discovery process")
+			return
+		}
+	}
+}
+
+func (fd *FileDiscoverer) refresh() {
+	fd.mutFile.Lock()
+	err := fd.reloadFileIfChanged()
+	addresses := fd.addresses
+	fd.mutFile.Unlock()
+
+	if err != nil {
+		log.Warn("cannot reload the peers file", "path", fd.filePath, "error", err.Error())
+	}
+
+	addrInfos := parseAddresses(addresses, fd.host.ID())
+	connectToPeers(fd.context, fd.hostConnManagement, addrInfos)
+}
+
+// reloadFileIfChanged reads again the peers file if it was modified since the last read. Should be called under mutex
+func (fd *FileDiscoverer) reloadFileIfChanged() error {
+	fileInfo, err := os.Stat(fd.filePath)
+	if err != nil {
+		return err
+	}
+	if fileInfo.ModTime().Equal(fd.lastModTime) {
+		return nil
+	}
+
+	addresses, err := readAddressesFromFile(fd.filePath)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("loaded peers file", "path", fd.filePath, "num addresses", len(addresses))
+	fd.addresses = addresses
+	fd.lastModTime = fileInfo.ModTime()
+
+	return nil
+}
+
+func readAddressesFromFile(filePath string) ([]string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	addresses := make([]string, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		addresses = append(addresses, line)
+	}
+
+	return addresses, scanner.Err()
+}
+
+// Name returns the name of the file based discoverer
+func (fd *FileDiscoverer) Name() string {
+	return fileDiscovererName
+}
+
+// ReconnectToNetwork will try to connect to all the peers listed in the peers file
+func (fd *FileDiscoverer) ReconnectToNetwork() <-chan struct{} {
+	chanDone := make(chan struct{}, 1)
+
+	fd.mutFile.RLock()
+	isBootstrapped := fd.isBootstrapped
+	fd.mutFile.RUnlock()
+
+	if !isBootstrapped {
+		chanDone <- struct{}{}
+		return chanDone
+	}
+
+	go func() {
+		fd.refresh()
+		chanDone <- struct{}{}
+	}()
+
+	return chanDone
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fd *FileDiscoverer) IsInterfaceNil() bool {
+	return fd == nil
+}
@@ -0,0 +1,205 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPid1 = "16Uiu2HAkyqtHSEJDkYhVWTtm9j58Mq5xQJgrApBYXMwS6sdamXuE"
+const testPid2 = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+const testAddress1 = "/ip4/127.0.0.1/tcp/10001/p2p/" + testPid1
+const testAddress2 = "/ip4/127.0.0.1/tcp/10002/p2p/" + testPid2
+
+func createTestHostForDiscoverers(mutConnected *sync.Mutex, connected map[peer.ID]struct{}) *mock.ConnectableHostStub {
+	return &mock.ConnectableHostStub{
+		IDCalled: func() peer.ID {
+			return "self"
+		},
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				PeersCall: func() []peer.ID {
+					return make([]peer.ID, 0)
+				},
+				ConnectednessCalled: func(id peer.ID) network.Connectedness {
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectCalled: func(ctx context.Context, pi peer.AddrInfo) error {
+			mutConnected.Lock()
+			connected[pi.ID] = struct{}{}
+			mutConnected.Unlock()
+
+			return nil
+		},
+	}
+}
+
+func createTestArgFileDiscoverer(filePath string) discovery.ArgFileDiscoverer {
+	return discovery.ArgFileDiscoverer{
+		Context:         context.Background(),
+		Host:            &mock.ConnectableHostStub{},
+		Sharder:         &mock.SharderStub{},
+		FilePath:        filePath,
+		RefreshInterval: time.Second,
+	}
+}
+
+func createPeersFilePath() (string, func()) {
+	workingDir, _ := ioutil.TempDir("", "filediscoverer_temp")
+
+	return filepath.Join(workingDir, "peers.txt"), func() {
+		_ = os.RemoveAll(workingDir)
+	}
+}
+
+func writePeersFile(t *testing.T, filePath string, lines string) {
+	err := ioutil.WriteFile(filePath, []byte(lines), os.ModePerm)
+	assert.Nil(t, err)
+}
+
+func TestNewFileDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestArgFileDiscoverer("file")
+	arg.Context = nil
+	fd, err := discovery.NewFileDiscoverer(arg)
+	assert.True(t, check.IfNil(fd))
+	assert.Equal(t, p2p.ErrNilContext, err)
+
+	arg = createTestArgFileDiscoverer("file")
+	arg.Host = nil
+	fd, err = discovery.NewFileDiscoverer(arg)
+	assert.True(t, check.IfNil(fd))
+	assert.Equal(t, p2p.ErrNilHost, err)
+
+	arg = createTestArgFileDiscoverer("file")
+	arg.Sharder = nil
+	fd, err = discovery.NewFileDiscoverer(arg)
+	assert.True(t, check.IfNil(fd))
+	assert.Equal(t, p2p.ErrNilSharder, err)
+
+	arg = createTestArgFileDiscoverer("")
+	fd, err = discovery.NewFileDiscoverer(arg)
+	assert.True(t, check.IfNil(fd))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createTestArgFileDiscoverer("file")
+	arg.RefreshInterval = time.Millisecond
+	fd, err = discovery.NewFileDiscoverer(arg)
+	assert.True(t, check.IfNil(fd))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewFileDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fd, err := discovery.NewFileDiscoverer(createTestArgFileDiscoverer("file"))
+
+	assert.False(t, check.IfNil(fd))
+	assert.Nil(t, err)
+	assert.Equal(t, discovery.FileDiscovererName, fd.Name())
+}
+
+func TestReadAddressesFromFile_ShouldSkipCommentsAndEmptyLines(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createPeersFilePath()
+	defer cleanup()
+	writePeersFile(t, filePath, "# comment\n\n  "+testAddress1+"  \n"+testAddress2+"\n")
+
+	addresses, err := discovery.ReadAddressesFromFile(filePath)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{testAddress1, testAddress2}, addresses)
+}
+
+func TestFileDiscoverer_BootstrapMissingFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createPeersFilePath()
+	defer cleanup()
+	arg := createTestArgFileDiscoverer(filePath)
+	fd, _ := discovery.NewFileDiscoverer(arg)
+
+	err := fd.Bootstrap()
+
+	assert.NotNil(t, err)
+}
+
+func TestFileDiscoverer_BootstrapTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createPeersFilePath()
+	defer cleanup()
+	writePeersFile(t, filePath, "")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mutConnected := &sync.Mutex{}
+	arg := createTestArgFileDiscoverer(filePath)
+	arg.Context = ctx
+	arg.Host = createTestHostForDiscoverers(mutConnected, make(map[peer.ID]struct{}))
+	fd, _ := discovery.NewFileDiscoverer(arg)
+
+	err := fd.Bootstrap()
+	assert.Nil(t, err)
+
+	err = fd.Bootstrap()
+	assert.Equal(t, p2p.ErrPeerDiscoveryProcessAlreadyStarted, err)
+}
+
+func TestFileDiscoverer_RefreshShouldConnectAndReloadChangedFile(t *testing.T) {
+	t.Parallel()
+
+	filePath, cleanup := createPeersFilePath()
+	defer cleanup()
+	writePeersFile(t, filePath, testAddress1+"\ninvalid address\n")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mutConnected := &sync.Mutex{}
+	connected := make(map[peer.ID]struct{})
+	arg := createTestArgFileDiscoverer(filePath)
+	arg.Context = ctx
+	arg.RefreshInterval = time.Hour
+	arg.Host = createTestHostForDiscoverers(mutConnected, connected)
+	fd, _ := discovery.NewFileDiscoverer(arg)
+
+	_ = fd.Bootstrap()
+	fd.Refresh()
+
+	pid1, _ := peer.Decode(testPid1)
+	pid2, _ := peer.Decode(testPid2)
+	mutConnected.Lock()
+	assert.Equal(t, 1, len(connected))
+	_, found := connected[pid1]
+	assert.True(t, found)
+	mutConnected.Unlock()
+
+	writePeersFile(t, filePath, testAddress1+"\n"+testAddress2+"\n")
+	future := time.Now().Add(time.Minute)
+	_ = os.Chtimes(filePath, future, future)
+
+	<-fd.ReconnectToNetwork()
+
+	mutConnected.Lock()
+	assert.Equal(t, 2, len(connected))
+	_, found = connected[pid2]
+	assert.True(t, found)
+	mutConnected.Unlock()
+}
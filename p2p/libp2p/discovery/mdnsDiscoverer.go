@@ -0,0 +1,170 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+)
+
+var _ p2p.PeerDiscoverer = (*MdnsDiscoverer)(nil)
+var _ p2p.Reconnecter = (*MdnsDiscoverer)(nil)
+
+const mdnsDiscovererName = "mdns discovery"
+
+// ArgMdnsDiscoverer represents the mDNS discoverer config argument DTO
+type ArgMdnsDiscoverer struct {
+	Context         context.Context
+	Host            ConnectableHost
+	Sharder         p2p.CommonSharder
+	ServiceTag      string
+	RefreshInterval time.Duration
+}
+
+// MdnsDiscoverer is the peer discovery implementation that uses the libp2p multicast DNS service to find the peers
+// from the local network
+type MdnsDiscoverer struct {
+	context            context.Context
+	host               ConnectableHost
+	sharder            Sharder
+	serviceTag         string
+	refreshInterval    time.Duration
+	mutService         sync.Mutex
+	service            libp2pDiscovery.Service
+	hostConnManagement *hostWithConnectionManagement
+}
+
+// NewMdnsDiscoverer creates a new mDNS discoverer
+func NewMdnsDiscoverer(arg ArgMdnsDiscoverer) (*MdnsDiscoverer, error) {
+	if check.IfNilReflect(arg.Context) {
+		return nil, p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if check.IfNil(arg.Sharder) {
+		return nil, p2p.ErrNilSharder
+	}
+	sharder, ok := arg.Sharder.(Sharder)
+	if !ok {
+		return nil, fmt.Errorf("%w for sharder: expected discovery.Sharder type of interface", p2p.ErrWrongTypeAssertion)
+	}
+	if len(arg.ServiceTag) == 0 {
+		return nil, fmt.Errorf("%w, empty ServiceTag", p2p.ErrInvalidValue)
+	}
+	if arg.RefreshInterval < time.Second {
+		return nil, fmt.Errorf("%w, RefreshInterval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+
+	return &MdnsDiscoverer{
+		context:         arg.Context,
+		host:            arg.Host,
+		sharder:         sharder,
+		serviceTag:      arg.ServiceTag,
+		refreshInterval: arg.RefreshInterval,
+	}, nil
+}
+
+// Bootstrap starts the mDNS service which announces the host and periodically queries the local network
+func (md *MdnsDiscoverer) Bootstrap() error {
+	md.mutService.Lock()
+	defer md.mutService.Unlock()
+
+	if md.hostConnManagement != nil {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	var err error
+	md.hostConnManagement, err = NewHostWithConnectionManagement(md.host, md.sharder)
+	if err != nil {
+		return err
+	}
+
+	err = md.startService()
+	if err != nil {
+		md.hostConnManagement = nil
+		return err
+	}
+
+	go md.closeOnContextDone()
+
+	return nil
+}
+
+func (md *MdnsDiscoverer) startService() error {
+	service, err := libp2pDiscovery.NewMdnsService(md.context, md.host, md.refreshInterval, md.serviceTag)
+	if err != nil {
+		return err
+	}
+
+	service.RegisterNotifee(md)
+	md.service = service
+
+	return nil
+}
+
+func (md *MdnsDiscoverer) closeOnContextDone() {
+	<-md.context.Done()
+	log.Debug("closing the mdns discovery process")
+
+	md.mutService.Lock()
+	defer md.mutService.Unlock()
+
+	md.closeService()
+}
+
+func (md *MdnsDiscoverer) closeService() {
+	if md.service == nil {
+		return
+	}
+
+	err := md.service.Close()
+	if err != nil {
+		log.Debug("cannot close the mdns service", "error", err.Error())
+	}
+	md.service = nil
+}
+
+// HandlePeerFound is called by the mDNS service each time a peer from the local network answers a query
+func (md *MdnsDiscoverer) HandlePeerFound(addrInfo peer.AddrInfo) {
+	if addrInfo.ID == md.host.ID() {
+		return
+	}
+
+	connectToPeers(md.context, md.hostConnManagement, []peer.AddrInfo{addrInfo})
+}
+
+// Name returns the name of the mDNS discoverer
+func (md *MdnsDiscoverer) Name() string {
+	return mdnsDiscovererName
+}
+
+// ReconnectToNetwork restarts the mDNS service so the local network is queried again without waiting for the
+// refresh interval
+func (md *MdnsDiscoverer) ReconnectToNetwork() <-chan struct{} {
+	chanDone := make(chan struct{}, 1)
+
+	md.mutService.Lock()
+	if md.service != nil && md.context.Err() == nil {
+		md.closeService()
+		err := md.startService()
+		if err != nil {
+			log.Debug("cannot restart the mdns service", "error", err.Error())
+		}
+	}
+	md.mutService.Unlock()
+
+	chanDone <- struct{}{}
+
+	return chanDone
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *MdnsDiscoverer) IsInterfaceNil() bool {
+	return md == nil
+}
@@ -0,0 +1,117 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestArgMdnsDiscoverer() discovery.ArgMdnsDiscoverer {
+	return discovery.ArgMdnsDiscoverer{
+		Context:         context.Background(),
+		Host:            &mock.ConnectableHostStub{},
+		Sharder:         &mock.SharderStub{},
+		ServiceTag:      "_erd-discovery._udp",
+		RefreshInterval: time.Second,
+	}
+}
+
+func TestNewMdnsDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestArgMdnsDiscoverer()
+	arg.Context = nil
+	md, err := discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.Equal(t, p2p.ErrNilContext, err)
+
+	arg = createTestArgMdnsDiscoverer()
+	arg.Host = nil
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.Equal(t, p2p.ErrNilHost, err)
+
+	arg = createTestArgMdnsDiscoverer()
+	arg.Sharder = nil
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.Equal(t, p2p.ErrNilSharder, err)
+
+	arg = createTestArgMdnsDiscoverer()
+	arg.ServiceTag = ""
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createTestArgMdnsDiscoverer()
+	arg.RefreshInterval = time.Millisecond
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewMdnsDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	md, err := discovery.NewMdnsDiscoverer(createTestArgMdnsDiscoverer())
+
+	assert.False(t, check.IfNil(md))
+	assert.Nil(t, err)
+	assert.Equal(t, discovery.MdnsDiscovererName, md.Name())
+	assert.Equal(t, "_erd-discovery._udp", md.ServiceTag())
+}
+
+func TestMdnsDiscoverer_ReconnectToNetworkNotBootstrappedShouldNotBlock(t *testing.T) {
+	t.Parallel()
+
+	md, _ := discovery.NewMdnsDiscoverer(createTestArgMdnsDiscoverer())
+
+	select {
+	case <-md.ReconnectToNetwork():
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout while waiting for ReconnectToNetwork")
+	}
+}
+
+func TestMdnsDiscoverer_HandlePeerFoundShouldConnectToOtherPeers(t *testing.T) {
+	t.Parallel()
+
+	self := peer.ID(testPid1)
+	connected := make([]peer.ID, 0)
+	arg := createTestArgMdnsDiscoverer()
+	arg.Host = &mock.ConnectableHostStub{
+		IDCalled: func() peer.ID {
+			return self
+		},
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				PeersCall: func() []peer.ID {
+					return make([]peer.ID, 0)
+				},
+				ConnectednessCalled: func(id peer.ID) network.Connectedness {
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectCalled: func(ctx context.Context, pi peer.AddrInfo) error {
+			connected = append(connected, pi.ID)
+			return nil
+		},
+	}
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+	_ = md.InitHostConnManagement()
+
+	md.HandlePeerFound(peer.AddrInfo{ID: self})
+	md.HandlePeerFound(peer.AddrInfo{ID: "other peer"})
+
+	assert.Equal(t, []peer.ID{"other peer"}, connected)
+}
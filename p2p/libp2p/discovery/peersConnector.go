@@ -0,0 +1,63 @@
+package discovery
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// parseAddresses converts the provided addresses into address infos, grouped by peer ID. The invalid addresses and
+// the addresses of the self peer are skipped
+func parseAddresses(addresses []string, self peer.ID) []peer.AddrInfo {
+	result := make([]peer.AddrInfo, 0, len(addresses))
+	indexes := make(map[peer.ID]int)
+	for _, address := range addresses {
+		addrInfo, err := parseAddress(address)
+		if err != nil {
+			log.Debug("invalid peer address", "address", address, "error", err.Error())
+			continue
+		}
+		if addrInfo.ID == self {
+			continue
+		}
+
+		idx, found := indexes[addrInfo.ID]
+		if found {
+			result[idx].Addrs = append(result[idx].Addrs, addrInfo.Addrs...)
+			continue
+		}
+
+		indexes[addrInfo.ID] = len(result)
+		result = append(result, *addrInfo)
+	}
+
+	return result
+}
+
+func parseAddress(address string) (*peer.AddrInfo, error) {
+	ma, err := multiaddr.NewMultiaddr(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return peer.AddrInfoFromP2pAddr(ma)
+}
+
+// connectToPeers tries to connect to the provided peers that are not already connected
+func connectToPeers(ctx context.Context, h ConnectableHost, addrInfos []peer.AddrInfo) {
+	for _, addrInfo := range addrInfos {
+		if h.Network().Connectedness(addrInfo.ID) == network.Connected {
+			continue
+		}
+
+		err := h.Connect(ctx, addrInfo)
+		if err != nil {
+			log.Trace("cannot connect to discovered peer",
+				"pid", addrInfo.ID.Pretty(),
+				"error", err.Error(),
+			)
+		}
+	}
+}
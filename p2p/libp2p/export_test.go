@@ -18,6 +18,8 @@ var PubsubTimeCacheDuration = pubsubTimeCacheDuration
 var AcceptMessagesInAdvanceDuration = acceptMessagesInAdvanceDuration
 
 const CurrentTopicMessageVersion = currentTopicMessageVersion
+const CompressedTopicMessageVersion = compressedTopicMessageVersion
+const SnappyCompression = snappyCompression
 
 func (netMes *networkMessenger) SetHost(newHost ConnectableHost) {
 	netMes.p2pHost = newHost
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -32,6 +33,7 @@ func createMessenger() p2p.Messenger {
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(args)
@@ -14,10 +14,30 @@ import (
 
 const currentTopicMessageVersion = uint32(1)
 
+// compressedTopicMessageVersion is used only by the messages carrying a compressed payload so the nodes which do not
+// know how to decompress it will reject them instead of processing the compressed bytes as a raw payload
+const compressedTopicMessageVersion = uint32(2)
+
 // NewMessage returns a new instance of a Message object
 func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Message, error) {
+	newMsg, compression, err := newWireMessage(msg, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = decompressMessage(newMsg, compression)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMsg, nil
+}
+
+// newWireMessage returns a new instance of a Message object holding the payload as it was received from the wire,
+// together with the compression applied on it by the sender
+func newWireMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Message, uint32, error) {
 	if check.IfNil(marshalizer) {
-		return nil, p2p.ErrNilMarshalizer
+		return nil, noCompression, p2p.ErrNilMarshalizer
 	}
 
 	newMsg := &message.Message{
@@ -32,17 +52,24 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 	topicMessage := &data.TopicMessage{}
 	err := marshalizer.Unmarshal(topicMessage, msg.Data)
 	if err != nil {
-		return nil, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
+		return nil, noCompression, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
 	}
 
 	//TODO change this area when new versions of the message will need to be implemented
-	if topicMessage.Version != currentTopicMessageVersion {
-		return nil, fmt.Errorf("%w, supported %d, got %d",
-			p2p.ErrUnsupportedMessageVersion, currentTopicMessageVersion, topicMessage.Version)
+	switch topicMessage.Version {
+	case currentTopicMessageVersion:
+		if topicMessage.Compression != noCompression {
+			return nil, noCompression, fmt.Errorf("%w for topicMessage.Compression on version %d",
+				p2p.ErrUnsupportedFields, topicMessage.Version)
+		}
+	case compressedTopicMessageVersion:
+	default:
+		return nil, noCompression, fmt.Errorf("%w, supported %d and %d, got %d",
+			p2p.ErrUnsupportedMessageVersion, currentTopicMessageVersion, compressedTopicMessageVersion, topicMessage.Version)
 	}
 
 	if len(topicMessage.SignatureOnPid)+len(topicMessage.Pk) > 0 {
-		return nil, fmt.Errorf("%w for topicMessage.SignatureOnPid and topicMessage.Pk",
+		return nil, noCompression, fmt.Errorf("%w for topicMessage.SignatureOnPid and topicMessage.Pk",
 			p2p.ErrUnsupportedFields)
 	}
 
@@ -51,9 +78,20 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 
 	id, err := peer.IDFromBytes(newMsg.From())
 	if err != nil {
-		return nil, err
+		return nil, noCompression, err
 	}
 
 	newMsg.PeerField = core.PeerID(id)
-	return newMsg, nil
+	return newMsg, topicMessage.Compression, nil
+}
+
+// decompressMessage replaces the wire payload of the message with the decompressed one
+func decompressMessage(msg *message.Message, compression uint32) error {
+	payload, err := decompressPayload(compression, msg.DataField)
+	if err != nil {
+		return fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
+	}
+
+	msg.DataField = payload
+	return nil
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/snappy"
 	libp2pCrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -84,6 +85,76 @@ func TestMessage_ShouldWork(t *testing.T) {
 	assert.False(t, check.IfNil(m))
 }
 
+func TestMessage_CompressedPayloadShouldBeDecompressed(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte("data data data data data data data data data data")
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CompressedTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     snappy.Encode(nil, payload),
+		Compression: libp2p.SnappyCompression,
+	}
+	buff, _ := marshalizer.Marshal(topicMessage)
+	mes := &pubsubpb.Message{
+		From: getRandomID(),
+		Data: buff,
+	}
+
+	pMes := &pubsub.Message{Message: mes}
+	m, err := libp2p.NewMessage(pMes, marshalizer)
+
+	assert.Nil(t, err)
+	assert.Equal(t, payload, m.Data())
+}
+
+func TestMessage_UnsupportedCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CompressedTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     []byte("data"),
+		Compression: 100,
+	}
+	buff, _ := marshalizer.Marshal(topicMessage)
+	mes := &pubsubpb.Message{
+		From: getRandomID(),
+		Data: buff,
+	}
+
+	pMes := &pubsub.Message{Message: mes}
+	m, err := libp2p.NewMessage(pMes, marshalizer)
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrMessageUnmarshalError))
+}
+
+func TestMessage_CompressedPayloadOnUncompressedVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     snappy.Encode(nil, []byte("data")),
+		Compression: libp2p.SnappyCompression,
+	}
+	buff, _ := marshalizer.Marshal(topicMessage)
+	mes := &pubsubpb.Message{
+		From: getRandomID(),
+		Data: buff,
+	}
+
+	pMes := &pubsub.Message{Message: mes}
+	m, err := libp2p.NewMessage(pMes, marshalizer)
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
+}
+
 func TestMessage_From(t *testing.T) {
 	t.Parallel()
 
@@ -134,7 +205,7 @@ func TestMessage_WrongVersionShouldErr(t *testing.T) {
 	marshalizer := &testscommon.ProtoMarshalizerMock{}
 
 	topicMessage := &data.TopicMessage{
-		Version:   libp2p.CurrentTopicMessageVersion + 1,
+		Version:   libp2p.CompressedTopicMessageVersion + 1,
 		Timestamp: time.Now().Unix(),
 		Payload:   []byte("data"),
 	}
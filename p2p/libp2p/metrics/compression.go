@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// Compression is a metric that counts the compressed payloads and the bandwidth saved by compressing them
+type Compression struct {
+	numCompressedPayloads uint32
+	numBytesSaved         uint64
+	totalBytesSaved       uint64
+}
+
+// NewCompression returns a new compression metric instance
+func NewCompression() *Compression {
+	return &Compression{}
+}
+
+// AddCompressedPayload records a payload that was sent compressed, incrementing the numCompressedPayloads counter
+// and adding the difference between the original and the compressed sizes to the saved bytes counters
+func (comp *Compression) AddCompressedPayload(originalSize int, compressedSize int) {
+	atomic.AddUint32(&comp.numCompressedPayloads, 1)
+
+	if compressedSize >= originalSize {
+		return
+	}
+
+	saved := uint64(originalSize - compressedSize)
+	atomic.AddUint64(&comp.numBytesSaved, saved)
+	atomic.AddUint64(&comp.totalBytesSaved, saved)
+}
+
+// ResetNumCompressedPayloads resets the numCompressedPayloads counter returning the previous value
+func (comp *Compression) ResetNumCompressedPayloads() uint32 {
+	return atomic.SwapUint32(&comp.numCompressedPayloads, 0)
+}
+
+// ResetNumBytesSaved resets the numBytesSaved counter returning the previous value
+func (comp *Compression) ResetNumBytesSaved() uint64 {
+	return atomic.SwapUint64(&comp.numBytesSaved, 0)
+}
+
+// TotalBytesSaved returns the number of bytes saved since the metric was created
+func (comp *Compression) TotalBytesSaved() uint64 {
+	return atomic.LoadUint64(&comp.totalBytesSaved)
+}
@@ -0,0 +1,23 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompression_AddCompressedPayloadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	comp := metrics.NewCompression()
+
+	comp.AddCompressedPayload(100, 40)
+	comp.AddCompressedPayload(50, 60)
+
+	assert.Equal(t, uint32(2), comp.ResetNumCompressedPayloads())
+	assert.Equal(t, uint32(0), comp.ResetNumCompressedPayloads())
+	assert.Equal(t, uint64(60), comp.ResetNumBytesSaved())
+	assert.Equal(t, uint64(0), comp.ResetNumBytesSaved())
+	assert.Equal(t, uint64(60), comp.TotalBytesSaved())
+}
@@ -0,0 +1,6 @@
+package metrics
+
+import "errors"
+
+// ErrNilPeersTraffic signals that a nil peers traffic metric has been provided
+var ErrNilPeersTraffic = errors.New("nil peers traffic")
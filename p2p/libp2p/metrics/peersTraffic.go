@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeersTraffic is a metric that accounts, for each peer and topic, the exchanged messages and bytes, the rejected
+// messages and the antiflood hits
+type PeersTraffic struct {
+	mut   sync.RWMutex
+	peers map[core.PeerID]map[string]*p2p.PeerTopicTraffic
+}
+
+// NewPeersTraffic returns a new PeersTraffic instance
+func NewPeersTraffic() *PeersTraffic {
+	return &PeersTraffic{
+		peers: make(map[core.PeerID]map[string]*p2p.PeerTopicTraffic),
+	}
+}
+
+// AddIncomingMessage accounts a message received from the provided peer on the provided topic
+func (pt *PeersTraffic) AddIncomingMessage(pid core.PeerID, topic string, size uint64, isRejected bool) {
+	pt.mut.Lock()
+	defer pt.mut.Unlock()
+
+	traffic := pt.getTopicTraffic(pid, topic)
+	traffic.NumMessagesIn++
+	traffic.NumBytesIn += size
+	if isRejected {
+		traffic.NumRejected++
+	}
+}
+
+// AddOutgoingMessage accounts a message sent to the provided peer on the provided topic
+func (pt *PeersTraffic) AddOutgoingMessage(pid core.PeerID, topic string, size uint64) {
+	pt.mut.Lock()
+	defer pt.mut.Unlock()
+
+	traffic := pt.getTopicTraffic(pid, topic)
+	traffic.NumMessagesOut++
+	traffic.NumBytesOut += size
+}
+
+// AddAntifloodHit accounts a message of the provided peer that was stopped by the antiflood component
+func (pt *PeersTraffic) AddAntifloodHit(pid core.PeerID, topic string) {
+	pt.mut.Lock()
+	defer pt.mut.Unlock()
+
+	traffic := pt.getTopicTraffic(pid, topic)
+	traffic.NumAntifloodHits++
+}
+
+func (pt *PeersTraffic) getTopicTraffic(pid core.PeerID, topic string) *p2p.PeerTopicTraffic {
+	topics, found := pt.peers[pid]
+	if !found {
+		topics = make(map[string]*p2p.PeerTopicTraffic)
+		pt.peers[pid] = topics
+	}
+
+	traffic, found := topics[topic]
+	if !found {
+		traffic = &p2p.PeerTopicTraffic{
+			Topic: topic,
+		}
+		topics[topic] = traffic
+	}
+
+	return traffic
+}
+
+// GetPeerTraffic returns a snapshot of the counters accounted for the provided peer, sorted by topic
+func (pt *PeersTraffic) GetPeerTraffic(pid core.PeerID) []p2p.PeerTopicTraffic {
+	pt.mut.RLock()
+	defer pt.mut.RUnlock()
+
+	topics := pt.peers[pid]
+	result := make([]p2p.PeerTopicTraffic, 0, len(topics))
+	for _, traffic := range topics {
+		result = append(result, *traffic)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Topic < result[j].Topic
+	})
+
+	return result
+}
+
+// RetainPeers removes the counters of all the peers that are not contained in the provided slice
+func (pt *PeersTraffic) RetainPeers(pids []core.PeerID) {
+	pidsToRetain := make(map[core.PeerID]struct{}, len(pids))
+	for _, pid := range pids {
+		pidsToRetain[pid] = struct{}{}
+	}
+
+	pt.mut.Lock()
+	defer pt.mut.Unlock()
+
+	for pid := range pt.peers {
+		_, found := pidsToRetain[pid]
+		if !found {
+			delete(pt.peers, pid)
+		}
+	}
+}
+
+// NumPeers returns the number of peers that have counters
+func (pt *PeersTraffic) NumPeers() int {
+	pt.mut.RLock()
+	defer pt.mut.RUnlock()
+
+	return len(pt.peers)
+}
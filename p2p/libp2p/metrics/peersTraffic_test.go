@@ -0,0 +1,86 @@
+package metrics_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeersTraffic_AddShouldAccountPerPeerAndTopic(t *testing.T) {
+	t.Parallel()
+
+	pt := metrics.NewPeersTraffic()
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+
+	pt.AddIncomingMessage(pid1, "topicB", 100, false)
+	pt.AddIncomingMessage(pid1, "topicB", 50, true)
+	pt.AddOutgoingMessage(pid1, "topicB", 10)
+	pt.AddAntifloodHit(pid1, "topicA")
+	pt.AddIncomingMessage(pid2, "topicA", 7, false)
+
+	expected := []p2p.PeerTopicTraffic{
+		{
+			Topic:            "topicA",
+			NumAntifloodHits: 1,
+		},
+		{
+			Topic:          "topicB",
+			NumMessagesIn:  2,
+			NumBytesIn:     150,
+			NumMessagesOut: 1,
+			NumBytesOut:    10,
+			NumRejected:    1,
+		},
+	}
+	assert.Equal(t, expected, pt.GetPeerTraffic(pid1))
+	assert.Equal(t, 1, len(pt.GetPeerTraffic(pid2)))
+	assert.Equal(t, 0, len(pt.GetPeerTraffic("unknown")))
+	assert.Equal(t, 2, pt.NumPeers())
+}
+
+func TestPeersTraffic_RetainPeersShouldRemoveOtherPeers(t *testing.T) {
+	t.Parallel()
+
+	pt := metrics.NewPeersTraffic()
+	pt.AddIncomingMessage("pid1", "topic", 1, false)
+	pt.AddIncomingMessage("pid2", "topic", 1, false)
+	pt.AddIncomingMessage("pid3", "topic", 1, false)
+
+	pt.RetainPeers([]core.PeerID{"pid2", "pid4"})
+
+	assert.Equal(t, 1, pt.NumPeers())
+	assert.Equal(t, 1, len(pt.GetPeerTraffic("pid2")))
+}
+
+func TestPeersTraffic_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pt := metrics.NewPeersTraffic()
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 5 {
+			case 0:
+				pt.AddIncomingMessage("pid", "topic", 1, false)
+			case 1:
+				pt.AddOutgoingMessage("pid", "topic", 1)
+			case 2:
+				pt.AddAntifloodHit("pid", "topic")
+			case 3:
+				_ = pt.GetPeerTraffic("pid")
+			case 4:
+				pt.RetainPeers([]core.PeerID{"pid"})
+			}
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+)
+
+// PubsubTrafficTracer is a pubsub event tracer that accounts, for each peer and topic, the messages published or
+// relayed to that peer through pubsub. The trace events do not carry the messages sizes, so the sizes are remembered
+// when the messages pass through the topic validators. The messages that were not validated by this node, as the
+// ones published on topics without a registered processor, are accounted with a zero size
+type PubsubTrafficTracer struct {
+	peersTraffic *PeersTraffic
+	sizes        storage.Cacher
+}
+
+// NewPubsubTrafficTracer returns a new PubsubTrafficTracer instance remembering at most sizesCapacity message sizes
+func NewPubsubTrafficTracer(peersTraffic *PeersTraffic, sizesCapacity int) (*PubsubTrafficTracer, error) {
+	if peersTraffic == nil {
+		return nil, ErrNilPeersTraffic
+	}
+
+	sizes, err := lrucache.NewCache(sizesCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubsubTrafficTracer{
+		peersTraffic: peersTraffic,
+		sizes:        sizes,
+	}, nil
+}
+
+// AddMessageSize remembers the size of the message with the provided pubsub message ID
+func (ptt *PubsubTrafficTracer) AddMessageSize(messageID string, size uint64) {
+	ptt.sizes.Put([]byte(messageID), size, 0)
+}
+
+// Trace accounts the messages contained in the RPCs sent to the connected peers
+func (ptt *PubsubTrafficTracer) Trace(evt *pb.TraceEvent) {
+	if evt.GetType() != pb.TraceEvent_SEND_RPC {
+		return
+	}
+
+	sendRPC := evt.GetSendRPC()
+	pid := core.PeerID(sendRPC.GetSendTo())
+	for _, msg := range sendRPC.GetMeta().GetMessages() {
+		size := ptt.getMessageSize(msg.GetMessageID())
+		for _, topic := range msg.GetTopics() {
+			ptt.peersTraffic.AddOutgoingMessage(pid, topic, size)
+		}
+	}
+}
+
+func (ptt *PubsubTrafficTracer) getMessageSize(messageID []byte) uint64 {
+	value, ok := ptt.sizes.Get(messageID)
+	if !ok {
+		return 0
+	}
+
+	size, ok := value.(uint64)
+	if !ok {
+		return 0
+	}
+
+	return size
+}
@@ -0,0 +1,86 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSendRPCEvent(sendTo core.PeerID, messages ...*pb.TraceEvent_MessageMeta) *pb.TraceEvent {
+	return &pb.TraceEvent{
+		Type: pb.TraceEvent_SEND_RPC.Enum(),
+		SendRPC: &pb.TraceEvent_SendRPC{
+			SendTo: []byte(sendTo),
+			Meta: &pb.TraceEvent_RPCMeta{
+				Messages: messages,
+			},
+		},
+	}
+}
+
+func TestNewPubsubTrafficTracer_NilPeersTrafficShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ptt, err := metrics.NewPubsubTrafficTracer(nil, 10)
+
+	assert.Nil(t, ptt)
+	assert.Equal(t, metrics.ErrNilPeersTraffic, err)
+}
+
+func TestNewPubsubTrafficTracer_InvalidCapacityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ptt, err := metrics.NewPubsubTrafficTracer(metrics.NewPeersTraffic(), 0)
+
+	assert.Nil(t, ptt)
+	assert.NotNil(t, err)
+}
+
+func TestPubsubTrafficTracer_TraceShouldAccountSentMessagesPerPeerAndTopic(t *testing.T) {
+	t.Parallel()
+
+	pt := metrics.NewPeersTraffic()
+	ptt, err := metrics.NewPubsubTrafficTracer(pt, 10)
+	require.Nil(t, err)
+
+	ptt.AddMessageSize("msg1", 100)
+	ptt.AddMessageSize("msg2", 30)
+
+	ptt.Trace(createSendRPCEvent("pid1",
+		&pb.TraceEvent_MessageMeta{MessageID: []byte("msg1"), Topics: []string{"topicA"}},
+		&pb.TraceEvent_MessageMeta{MessageID: []byte("msg2"), Topics: []string{"topicA", "topicB"}},
+	))
+	ptt.Trace(createSendRPCEvent("pid1",
+		&pb.TraceEvent_MessageMeta{MessageID: []byte("not validated"), Topics: []string{"topicB"}},
+	))
+	ptt.Trace(createSendRPCEvent("pid2",
+		&pb.TraceEvent_MessageMeta{MessageID: []byte("msg1"), Topics: []string{"topicA"}},
+	))
+	ptt.Trace(&pb.TraceEvent{
+		Type: pb.TraceEvent_DROP_RPC.Enum(),
+		DropRPC: &pb.TraceEvent_DropRPC{
+			SendTo: []byte("pid3"),
+		},
+	})
+
+	expected := []p2p.PeerTopicTraffic{
+		{
+			Topic:          "topicA",
+			NumMessagesOut: 2,
+			NumBytesOut:    130,
+		},
+		{
+			Topic:          "topicB",
+			NumMessagesOut: 2,
+			NumBytesOut:    30,
+		},
+	}
+	assert.Equal(t, expected, pt.GetPeerTraffic("pid1"))
+	assert.Equal(t, []p2p.PeerTopicTraffic{{Topic: "topicA", NumMessagesOut: 1, NumBytesOut: 100}}, pt.GetPeerTraffic("pid2"))
+	assert.Equal(t, 2, pt.NumPeers())
+}
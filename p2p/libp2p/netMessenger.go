@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -43,6 +44,10 @@ const ListenLocalhostAddrWithIp4AndTcp = "/ip4/127.0.0.1/tcp/"
 // DirectSendID represents the protocol ID for sending and receiving direct P2P messages
 const DirectSendID = protocol.ID("/erd/directsend/1.0.0")
 
+// PayloadCompressionID represents the protocol ID advertised by the peers able to decompress the payloads. It carries
+// no data, the peers learn about it through the identify protocol
+const PayloadCompressionID = protocol.ID("/erd/compression/1.0.0")
+
 const durationBetweenSends = time.Microsecond * 10
 const durationCheckConnections = time.Second
 const refreshPeersOnTopic = time.Second * 3
@@ -54,6 +59,8 @@ const timeBetweenPeerPrints = time.Second * 20
 const timeBetweenExternalLoggersCheck = time.Second * 20
 const defaultThresholdMinConnectedPeers = 3
 const minRangePortValue = 1025
+const minPeerStoreRefreshInterval = time.Second
+const pubsubTrafficSizesCapacity = 50000
 
 //TODO remove the header size of the message when commit d3c5ecd3a3e884206129d9f2a9a4ddfd5e7c8951 from
 // https://github.com/libp2p/go-libp2p-pubsub/pull/189/commits will be part of a new release
@@ -93,9 +100,17 @@ type networkMessenger struct {
 	goRoutinesThrottler *throttler.NumGoRoutinesThrottler
 	ip                  *identityProvider
 	connectionsMetric   *metrics.Connections
+	peersTraffic        *metrics.PeersTraffic
+	pubsubTrafficTracer *metrics.PubsubTrafficTracer
 	debugger            p2p.Debugger
 	marshalizer         p2p.Marshalizer
 	syncTimer           p2p.SyncTimer
+	peerStore           p2p.PeerStore
+	peerStoreConfig     config.PeerStoreConfig
+	peersAccess         *peersAccessList
+	compressor          *payloadCompressor
+	mutAntiflood        sync.RWMutex
+	antifloodHandler    p2p.AntifloodHandler
 }
 
 // ArgsNetworkMessenger defines the options used to create a p2p wrapper
@@ -104,6 +119,7 @@ type ArgsNetworkMessenger struct {
 	Marshalizer   p2p.Marshalizer
 	P2pConfig     config.P2PConfig
 	SyncTimer     p2p.SyncTimer
+	PeerStore     p2p.PeerStore
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
@@ -114,6 +130,9 @@ func NewNetworkMessenger(args ArgsNetworkMessenger) (*networkMessenger, error) {
 	if check.IfNil(args.SyncTimer) {
 		return nil, fmt.Errorf("%w when creating a new network messenger", p2p.ErrNilSyncTimer)
 	}
+	if check.IfNil(args.PeerStore) {
+		return nil, fmt.Errorf("%w when creating a new network messenger", p2p.ErrNilPeerStore)
+	}
 
 	p2pPrivKey, err := createP2PPrivKey(args.P2pConfig.Node.Seed)
 	if err != nil {
@@ -193,11 +212,33 @@ func createMessenger(
 		subscriptions:     make(map[string]*pubsub.Subscription),
 		outgoingPLB:       loadBalancer.NewOutgoingChannelLoadBalancer(),
 		peerShardResolver: &unknownPeerShardResolver{},
+		antifloodHandler:  &disabled.AntifloodHandler{},
 		marshalizer:       args.Marshalizer,
 		syncTimer:         args.SyncTimer,
+		peerStore:         args.PeerStore,
+		peerStoreConfig:   args.P2pConfig.PeerStore,
 	}
 	netMes.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pHost.ID()))
 
+	netMes.peersAccess, err = newPeersAccessList(args.P2pConfig.PeerAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	netMes.compressor, err = newPayloadCompressor(args.P2pConfig.PayloadCompression)
+	if err != nil {
+		return nil, err
+	}
+	netMes.p2pHost.SetStreamHandler(PayloadCompressionID, func(stream network.Stream) {
+		_ = stream.Reset()
+	})
+
+	netMes.peersTraffic = metrics.NewPeersTraffic()
+	netMes.pubsubTrafficTracer, err = metrics.NewPubsubTrafficTracer(netMes.peersTraffic, pubsubTrafficSizesCapacity)
+	if err != nil {
+		return nil, err
+	}
+
 	err = netMes.createPubSub(withMessageSigning)
 	if err != nil {
 		return nil, err
@@ -220,6 +261,16 @@ func createMessenger(
 
 	netMes.createConnectionsMetric()
 
+	err = netMes.startStaticPeersReconnecter(args.P2pConfig.PeerAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	err = netMes.startPeerStoreRefresh()
+	if err != nil {
+		return nil, err
+	}
+
 	netMes.ds, err = NewDirectSender(ctx, p2pHost, netMes.directMessageHandler)
 	if err != nil {
 		return nil, err
@@ -238,6 +289,7 @@ func createMessenger(
 func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 	optsPS := []pubsub.Option{
 		pubsub.WithMessageSigning(withMessageSigning),
+		pubsub.WithEventTracer(netMes.pubsubTrafficTracer),
 	}
 
 	pubsub.TimeCacheDuration = pubsubTimeCacheDuration
@@ -281,7 +333,7 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 				continue
 			}
 
-			buffToSend := netMes.createMessageBytes(sendableData.Buff)
+			buffToSend := netMes.createMessageBytes(sendableData.Topic, sendableData.Buff, netMes.topicPeersSupportCompression(sendableData.Topic))
 			if len(buffToSend) == 0 {
 				continue
 			}
@@ -296,11 +348,18 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 	return nil
 }
 
-func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
+func (netMes *networkMessenger) createMessageBytes(topic string, buff []byte, receiversSupportCompression func() bool) []byte {
+	payload, compression := netMes.compressor.compress(topic, buff, receiversSupportCompression)
+	version := currentTopicMessageVersion
+	if compression != noCompression {
+		version = compressedTopicMessageVersion
+	}
+
 	message := &data.TopicMessage{
-		Version:   currentTopicMessageVersion,
-		Payload:   buff,
-		Timestamp: netMes.syncTimer.CurrentTime().Unix(),
+		Version:     version,
+		Payload:     payload,
+		Timestamp:   netMes.syncTimer.CurrentTime().Unix(),
+		Compression: compression,
 	}
 
 	buffToSend, errMarshal := netMes.marshalizer.Marshal(message)
@@ -312,6 +371,24 @@ func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
 	return buffToSend
 }
 
+func (netMes *networkMessenger) topicPeersSupportCompression(topic string) func() bool {
+	return func() bool {
+		return netMes.peersSupportCompression(netMes.pb.ListPeers(topic))
+	}
+}
+
+// peersSupportCompression returns true if all the provided peers advertised the payload compression protocol
+func (netMes *networkMessenger) peersSupportCompression(peers []peer.ID) bool {
+	for _, pid := range peers {
+		protocols, err := netMes.p2pHost.Peerstore().SupportsProtocols(pid, string(PayloadCompressionID))
+		if err != nil || len(protocols) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error {
 	args := factory.ArgsSharderFactory{
 		PeerShardResolver:       &unknownPeerShardResolver{},
@@ -322,6 +399,7 @@ func (netMes *networkMessenger) createSharder(p2pConfig config.P2PConfig) error
 		MaxIntraShardObservers:  int(p2pConfig.Sharding.MaxIntraShardObservers),
 		MaxCrossShardObservers:  int(p2pConfig.Sharding.MaxCrossShardObservers),
 		Type:                    p2pConfig.Sharding.Type,
+		ProtectedPeers:          netMes.peersAccess.protectedPeers(),
 	}
 
 	var err error
@@ -364,6 +442,7 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 		netMes.p2pHost.Network(),
 		netMes.connMonitor,
 		&disabled.NilPeerDenialEvaluator{},
+		netMes.peersAccess,
 	)
 	netMes.p2pHost.Network().Notify(cmw)
 	netMes.connMonitorWrapper = cmw
@@ -378,6 +457,84 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 	return nil
 }
 
+func (netMes *networkMessenger) startStaticPeersReconnecter(peerAccessConfig config.PeerAccessConfig) error {
+	if len(netMes.peersAccess.staticPeers) == 0 {
+		return nil
+	}
+
+	reconnecter, err := newStaticPeersReconnecter(
+		netMes,
+		netMes.peersAccess.staticPeers,
+		time.Duration(peerAccessConfig.MinReconnectBackoffInSec)*time.Second,
+		time.Duration(peerAccessConfig.MaxReconnectBackoffInSec)*time.Second,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("keeping static peers connected", "num static peers", len(netMes.peersAccess.staticPeers))
+	reconnecter.startReconnecting(netMes.ctx)
+
+	return nil
+}
+
+func (netMes *networkMessenger) startPeerStoreRefresh() error {
+	if !netMes.peerStoreConfig.Enabled {
+		return nil
+	}
+
+	refreshInterval := time.Duration(netMes.peerStoreConfig.RefreshIntervalInSec) * time.Second
+	if refreshInterval < minPeerStoreRefreshInterval {
+		return fmt.Errorf("%w, peer store RefreshIntervalInSec should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-netMes.ctx.Done():
+				log.Debug("closing the peer store refresh loop")
+				return
+			case <-time.After(refreshInterval):
+				netMes.refreshPeerStore()
+			}
+		}
+	}()
+
+	return nil
+}
+
+// refreshPeerStore records in the peer store the listening addresses and the shard information of the connected peers
+func (netMes *networkMessenger) refreshPeerStore() {
+	h := netMes.p2pHost
+	for _, pid := range h.Network().Peers() {
+		addresses := make([]string, 0)
+		for _, addr := range h.Peerstore().Addrs(pid) {
+			addresses = append(addresses, addr.String()+"/p2p/"+pid.Pretty())
+		}
+
+		p := core.PeerID(pid)
+		netMes.peerStore.UpdatePeer(p, addresses, netMes.peerShardResolver.GetPeerInfo(p))
+	}
+}
+
+// connectToKnownPeers tries to connect to the most recently seen peers from the peer store, that are not banned
+func (netMes *networkMessenger) connectToKnownPeers() {
+	addresses := netMes.peerStore.GetKnownAddresses(int(netMes.peerStoreConfig.NumKnownPeersToConnect))
+	if len(addresses) == 0 {
+		return
+	}
+
+	log.Debug("connecting to peers known from the previous runs", "num addresses", len(addresses))
+	go func() {
+		for _, address := range addresses {
+			err := netMes.ConnectToPeer(address)
+			if err != nil {
+				log.Trace("cannot connect to known peer", "address", address, "error", err.Error())
+			}
+		}
+	}()
+}
+
 func (netMes *networkMessenger) createConnectionsMetric() {
 	netMes.connectionsMetric = metrics.NewConnections()
 	netMes.p2pHost.Network().Notify(netMes.connectionsMetric)
@@ -405,6 +562,7 @@ func (netMes *networkMessenger) printLogsStats() {
 
 		conns := netMes.connectionsMetric.ResetNumConnections()
 		disconns := netMes.connectionsMetric.ResetNumDisconnections()
+		netMes.peersTraffic.RetainPeers(netMes.ConnectedPeers())
 
 		peersInfo := netMes.GetConnectedPeersInfo()
 		log.Debug("network connection status",
@@ -424,6 +582,14 @@ func (netMes *networkMessenger) printLogsStats() {
 			"connections/s", connsPerSec,
 			"disconnections/s", disconnsPerSec,
 		)
+
+		if netMes.compressor.isEnabled() {
+			log.Debug("network compression metrics",
+				"compressed payloads", netMes.compressor.metric.ResetNumCompressedPayloads(),
+				"bytes saved", netMes.compressor.metric.ResetNumBytesSaved(),
+				"total bytes saved", netMes.compressor.metric.TotalBytesSaved(),
+			)
+		}
 	}
 }
 
@@ -476,6 +642,15 @@ func (netMes *networkMessenger) Close() error {
 	log.Debug("closing network messenger's components through the context...")
 	netMes.cancelFunc()
 
+	log.Debug("closing network messenger's peer store...")
+	errPeerStore := netMes.peerStore.Close()
+	if errPeerStore != nil {
+		err = errPeerStore
+		log.Warn("networkMessenger.Close",
+			"component", "peerStore",
+			"error", err)
+	}
+
 	log.Debug("closing network messenger's debugger...")
 	errDebugger := netMes.debugger.Close()
 	if errDebugger != nil {
@@ -525,8 +700,10 @@ func (netMes *networkMessenger) ConnectToPeer(address string) error {
 	return netMes.p2pHost.ConnectToPeer(netMes.ctx, address)
 }
 
-// Bootstrap will start the peer discovery mechanism
+// Bootstrap will start the peer discovery mechanism, also connecting to the peers known from the previous runs
 func (netMes *networkMessenger) Bootstrap() error {
+	netMes.connectToKnownPeers()
+
 	return netMes.peerDiscoverer.Bootstrap()
 }
 
@@ -739,6 +916,8 @@ func (netMes *networkMessenger) RegisterMessageProcessor(topic string, handler p
 
 func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, topic string) func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
 	return func(ctx context.Context, pid peer.ID, message *pubsub.Message) bool {
+		netMes.pubsubTrafficTracer.AddMessageSize(pubsub.DefaultMsgIdFn(message.Message), uint64(len(message.Data)))
+
 		fromConnectedPeer := core.PeerID(pid)
 		msg, err := netMes.transformAndCheckMessage(message, fromConnectedPeer, topic)
 		if err != nil {
@@ -765,17 +944,28 @@ func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, top
 }
 
 func (netMes *networkMessenger) transformAndCheckMessage(pbMsg *pubsub.Message, pid core.PeerID, topic string) (p2p.MessageP2P, error) {
-	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer)
+	msg, compression, errUnmarshal := newWireMessage(pbMsg, netMes.marshalizer)
 	if errUnmarshal != nil {
-		//this error is so severe that will need to blacklist both the originator and the connected peer as there is
-		// no way this node can communicate with them
-		pidFrom := core.PeerID(pbMsg.From)
-		netMes.blacklistPid(pid, core.WrongP2PMessageBlacklistDuration)
-		netMes.blacklistPid(pidFrom, core.WrongP2PMessageBlacklistDuration)
-
+		netMes.blacklistMessageSenders(pbMsg, pid)
 		return nil, errUnmarshal
 	}
 
+	if compression != noCompression {
+		//the compressed payloads are checked against the antiflood component on their wire size before spending
+		// resources on decompressing them
+		err := netMes.getAntifloodHandler().CanProcessMessage(msg, pid)
+		if err != nil {
+			netMes.processDebugMessage(topic, pid, uint64(len(msg.Data())), true)
+			return nil, err
+		}
+
+		errUnmarshal = decompressMessage(msg, compression)
+		if errUnmarshal != nil {
+			netMes.blacklistMessageSenders(pbMsg, pid)
+			return nil, errUnmarshal
+		}
+	}
+
 	err := netMes.validMessageByTimestamp(msg)
 	if err != nil {
 		//not reprocessing nor rebrodcasting the same message over and over again
@@ -794,6 +984,21 @@ func (netMes *networkMessenger) transformAndCheckMessage(pbMsg *pubsub.Message,
 	return msg, nil
 }
 
+// blacklistMessageSenders blacklists both the originator and the connected peer of a message that can not be
+// unmarshalled as there is no way this node can communicate with them
+func (netMes *networkMessenger) blacklistMessageSenders(pbMsg *pubsub.Message, pid core.PeerID) {
+	pidFrom := core.PeerID(pbMsg.From)
+	netMes.blacklistPid(pid, core.WrongP2PMessageBlacklistDuration)
+	netMes.blacklistPid(pidFrom, core.WrongP2PMessageBlacklistDuration)
+}
+
+func (netMes *networkMessenger) getAntifloodHandler() p2p.AntifloodHandler {
+	netMes.mutAntiflood.RLock()
+	defer netMes.mutAntiflood.RUnlock()
+
+	return netMes.antifloodHandler
+}
+
 func (netMes *networkMessenger) blacklistPid(pid core.PeerID, banDuration time.Duration) {
 	if netMes.connMonitorWrapper.PeerDenialEvaluator().IsDenied(pid) {
 		return
@@ -841,6 +1046,7 @@ func (netMes *networkMessenger) processDebugMessage(topic string, fromConnectedP
 		netMes.debugger.AddOutgoingMessage(topic, size, isRejected)
 	} else {
 		netMes.debugger.AddIncomingMessage(topic, size, isRejected)
+		netMes.peersTraffic.AddIncomingMessage(fromConnectedPeer, topic, size, isRejected)
 	}
 }
 
@@ -917,13 +1123,19 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 		return err
 	}
 
-	buffToSend := netMes.createMessageBytes(buff)
+	receiversSupportCompression := func() bool {
+		return netMes.peersSupportCompression([]peer.ID{peer.ID(peerID)})
+	}
+	buffToSend := netMes.createMessageBytes(topic, buff, receiversSupportCompression)
 	if len(buffToSend) == 0 {
 		return nil
 	}
 
 	err = netMes.ds.Send(topic, buffToSend, peerID)
 	netMes.debugger.AddOutgoingMessage(topic, uint64(len(buffToSend)), err != nil)
+	if err == nil {
+		netMes.peersTraffic.AddOutgoingMessage(peerID, topic, uint64(len(buffToSend)))
+	}
 
 	return err
 }
@@ -962,7 +1174,7 @@ func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fr
 				"seq no", p2p.MessageOriginatorSeq(msg),
 			)
 		}
-		netMes.debugger.AddIncomingMessage(topic, uint64(len(msg.Data())), errProcess != nil)
+		netMes.processDebugMessage(topic, fromConnectedPeer, uint64(len(msg.Data())), errProcess != nil)
 	}(msg)
 
 	return nil
@@ -1014,6 +1226,20 @@ func (netMes *networkMessenger) SetPeerDenialEvaluator(handler p2p.PeerDenialEva
 	return netMes.connMonitorWrapper.SetPeerDenialEvaluator(handler)
 }
 
+// SetAntifloodHandler sets the antiflood handler used to check the compressed messages on their wire size, before
+// decompressing them
+func (netMes *networkMessenger) SetAntifloodHandler(handler p2p.AntifloodHandler) error {
+	if check.IfNil(handler) {
+		return p2p.ErrNilAntifloodHandler
+	}
+
+	netMes.mutAntiflood.Lock()
+	netMes.antifloodHandler = handler
+	netMes.mutAntiflood.Unlock()
+
+	return nil
+}
+
 // GetConnectedPeersInfo gets the current connected peers information
 func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	peers := netMes.p2pHost.Network().Peers()
@@ -1055,6 +1281,52 @@ func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo
 	return connPeerInfo
 }
 
+// GetPeersTraffic returns the traffic accounted for each connected peer, together with its shard information and
+// the direction of the connection. The peers are sorted by their pretty printed ID
+func (netMes *networkMessenger) GetPeersTraffic() []p2p.PeerTrafficInfo {
+	peers := netMes.p2pHost.Network().Peers()
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Pretty() < peers[j].Pretty()
+	})
+
+	peersTraffic := make([]p2p.PeerTrafficInfo, 0, len(peers))
+	for _, p := range peers {
+		pid := core.PeerID(p)
+		connectionDirection := network.DirUnknown
+		conns := netMes.p2pHost.Network().ConnsToPeer(p)
+		if len(conns) > 0 {
+			connectionDirection = conns[0].Stat().Direction
+		}
+
+		peerInfo := netMes.peerShardResolver.GetPeerInfo(pid)
+		peersTraffic = append(peersTraffic, p2p.PeerTrafficInfo{
+			Pid:                 pid,
+			ShardID:             peerInfo.ShardID,
+			PeerType:            peerInfo.PeerType,
+			ConnectionDirection: connectionDirection.String(),
+			Topics:              netMes.peersTraffic.GetPeerTraffic(pid),
+		})
+	}
+
+	return peersTraffic
+}
+
+// TotalBytesSavedByCompression returns the number of bytes saved by compressing the sent payloads since the
+// messenger was created
+func (netMes *networkMessenger) TotalBytesSavedByCompression() uint64 {
+	return netMes.compressor.metric.TotalBytesSaved()
+}
+
+// AddAntifloodHit accounts a message of the provided peer that was stopped by the antiflood component. The hits of
+// the peers that are not connected (message originators) are ignored
+func (netMes *networkMessenger) AddAntifloodHit(pid core.PeerID, topic string) {
+	if !netMes.IsConnected(pid) {
+		return
+	}
+
+	netMes.peersTraffic.AddAntifloodHit(pid, topic)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (netMes *networkMessenger) IsInterfaceNil() bool {
 	return netMes == nil
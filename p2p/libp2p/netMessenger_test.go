@@ -19,13 +19,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/golang/snappy"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var timeoutWaitResponses = time.Second * 2
@@ -83,6 +86,7 @@ func createMockNetworkArgs() libp2p.ArgsNetworkMessenger {
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 }
 
@@ -155,6 +159,27 @@ func TestNewNetworkMessenger_NilSyncTimerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, p2p.ErrNilSyncTimer))
 }
 
+func TestNewNetworkMessenger_NilPeerStoreShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.PeerStore = nil
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrNilPeerStore))
+}
+
+func TestNewNetworkMessenger_InvalidPeerStoreRefreshIntervalShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.PeerStore = config.PeerStoreConfig{
+		Enabled:              true,
+		RefreshIntervalInSec: 0,
+	}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
 func TestNewNetworkMessenger_WithDeactivatedKadDiscovererShouldWork(t *testing.T) {
 	arg := createMockNetworkArgs()
 	mes, err := libp2p.NewNetworkMessenger(arg)
@@ -545,6 +570,76 @@ func TestLibp2pMessenger_BroadcastDataBetween2PeersWithLargeMsgShouldWork(t *tes
 	_ = mes2.Close()
 }
 
+func TestLibp2pMessenger_BroadcastCompressedDataBetween2PeersShouldWork(t *testing.T) {
+	msg := bytes.Repeat([]byte("compressible message "), 1000)
+
+	netw := mocknet.New(context.Background())
+	args := createMockNetworkArgs()
+	args.P2pConfig.PayloadCompression = config.PayloadCompressionConfig{
+		Enabled: true,
+		Topics: []config.TopicCompressionConfig{
+			{
+				TopicPrefix:      "test",
+				Type:             p2p.SnappyCompression,
+				ThresholdInBytes: 1024,
+			},
+		},
+	}
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+
+	adr2 := mes2.Addresses()[0]
+
+	fmt.Printf("Connecting to %s...\n", adr2)
+
+	_ = mes1.ConnectToPeer(adr2)
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	fmt.Println("Delaying as to allow peers to announce themselves on the opened topic...")
+	time.Sleep(time.Second)
+
+	fmt.Printf("sending message from %s...\n", mes1.ID().Pretty())
+
+	mes1.Broadcast("test", msg)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+	assert.True(t, mes1.TotalBytesSavedByCompression() > 0)
+	assert.Equal(t, uint64(0), mes2.TotalBytesSavedByCompression())
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestNewNetworkMessenger_UnsupportedCompressionShouldErr(t *testing.T) {
+	args := createMockNetworkArgs()
+	args.P2pConfig.PayloadCompression = config.PayloadCompressionConfig{
+		Enabled: true,
+		Topics: []config.TopicCompressionConfig{
+			{
+				TopicPrefix: "test",
+				Type:        "unknown",
+			},
+		},
+	}
+
+	mes, err := libp2p.NewNetworkMessenger(args)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
 func TestLibp2pMessenger_Peers(t *testing.T) {
 	_, mes1, mes2 := createMockNetworkOf2()
 
@@ -1012,6 +1107,92 @@ func TestLibp2pMessenger_SendDirectWithRealNetToConnectedPeerShouldWork(t *testi
 	_ = mes2.Close()
 }
 
+func TestLibp2pMessenger_GetPeersTrafficShouldAccountDirectMessagesAndAntifloodHits(t *testing.T) {
+	msg := []byte("test message")
+
+	netw := mocknet.New(context.Background())
+	mes1, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+	defer func() {
+		_ = mes1.Close()
+		_ = mes2.Close()
+	}()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(1)
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	err := mes1.SendToConnectedPeer("test", msg, mes2.ID())
+	assert.Nil(t, err)
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	mes2.AddAntifloodHit(mes1.ID(), "test")
+	mes2.AddAntifloodHit("not connected peer", "test")
+
+	peersTraffic1 := mes1.GetPeersTraffic()
+	require.Equal(t, 1, len(peersTraffic1))
+	assert.Equal(t, mes2.ID(), peersTraffic1[0].Pid)
+	require.Equal(t, 1, len(peersTraffic1[0].Topics))
+	assert.Equal(t, uint64(1), peersTraffic1[0].Topics[0].NumMessagesOut)
+	assert.Equal(t, uint64(0), peersTraffic1[0].Topics[0].NumMessagesIn)
+
+	peersTraffic2 := mes2.GetPeersTraffic()
+	require.Equal(t, 1, len(peersTraffic2))
+	assert.Equal(t, mes1.ID(), peersTraffic2[0].Pid)
+	// the mock network sets the connection directions based on the link order, not on the dialing peer
+	directions := []string{peersTraffic1[0].ConnectionDirection, peersTraffic2[0].ConnectionDirection}
+	assert.ElementsMatch(t, []string{network.DirOutbound.String(), network.DirInbound.String()}, directions)
+	assert.Equal(t, core.UnknownPeer, peersTraffic2[0].PeerType)
+	require.Equal(t, 1, len(peersTraffic2[0].Topics))
+	assert.Equal(t, uint64(1), peersTraffic2[0].Topics[0].NumMessagesIn)
+	assert.Equal(t, uint64(len(msg)), peersTraffic2[0].Topics[0].NumBytesIn)
+	assert.Equal(t, uint64(0), peersTraffic2[0].Topics[0].NumRejected)
+	assert.Equal(t, uint64(1), peersTraffic2[0].Topics[0].NumAntifloodHits)
+}
+
+func TestLibp2pMessenger_GetPeersTrafficShouldAccountBroadcastMessages(t *testing.T) {
+	msg := []byte("test message")
+
+	_, mes1, mes2 := createMockNetworkOf2()
+	defer func() {
+		_ = mes1.Close()
+		_ = mes2.Close()
+	}()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	time.Sleep(time.Second)
+
+	mes1.Broadcast("test", msg)
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	peersTraffic1 := mes1.GetPeersTraffic()
+	require.Equal(t, 1, len(peersTraffic1))
+	assert.Equal(t, mes2.ID(), peersTraffic1[0].Pid)
+	require.Equal(t, 1, len(peersTraffic1[0].Topics))
+	assert.Equal(t, "test", peersTraffic1[0].Topics[0].Topic)
+	assert.Equal(t, uint64(1), peersTraffic1[0].Topics[0].NumMessagesOut)
+	assert.True(t, peersTraffic1[0].Topics[0].NumBytesOut > 0)
+}
+
 //------- Bootstrap
 
 func TestNetworkMessenger_BootstrapPeerDiscoveryShouldCallPeerBootstrapper(t *testing.T) {
@@ -1141,6 +1322,7 @@ func TestNetworkMessenger_PreventReprocessingShouldWork(t *testing.T) {
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1204,6 +1386,7 @@ func TestNetworkMessenger_PubsubCallbackNotMessageNotValidShouldNotCallHandler(t
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1274,6 +1457,7 @@ func TestNetworkMessenger_PubsubCallbackReturnsFalseIfHandlerErrors(t *testing.T
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1318,6 +1502,138 @@ func TestNetworkMessenger_PubsubCallbackReturnsFalseIfHandlerErrors(t *testing.T
 	_ = mes.Close()
 }
 
+func createCompressedPubsubMessage(marshalizer p2p.Marshalizer, from core.PeerID, payload []byte) *pubsub.Message {
+	innerMessage := &data.TopicMessage{
+		Payload:     snappy.Encode(nil, payload),
+		Timestamp:   time.Now().Unix(),
+		Version:     libp2p.CompressedTopicMessageVersion,
+		Compression: libp2p.SnappyCompression,
+	}
+	buff, _ := marshalizer.Marshal(innerMessage)
+
+	return &pubsub.Message{
+		Message: &pubsub_pb.Message{
+			From:  []byte(from),
+			Data:  buff,
+			Seqno: []byte{0, 0, 0, 1},
+		},
+	}
+}
+
+func TestNetworkMessenger_SetAntifloodHandlerNilShouldErr(t *testing.T) {
+	mes, _ := libp2p.NewNetworkMessenger(createMockNetworkArgs())
+
+	err := mes.SetAntifloodHandler(nil)
+	assert.Equal(t, p2p.ErrNilAntifloodHandler, err)
+
+	_ = mes.Close()
+}
+
+func TestNetworkMessenger_PubsubCallbackCompressedMessageShouldCheckTheAntifloodBeforeDecompressing(t *testing.T) {
+	args := createMockNetworkArgs()
+	mes, _ := libp2p.NewNetworkMessenger(args)
+
+	numCalled := uint32(0)
+	handler := &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			atomic.AddUint32(&numCalled, 1)
+			return nil
+		},
+	}
+	payload := bytes.Repeat([]byte("compressible payload "), 100)
+	compressedPayload := snappy.Encode(nil, payload)
+	expectedErr := errors.New("expected error")
+	numAntifloodChecks := uint32(0)
+	_ = mes.SetAntifloodHandler(&mock.AntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			atomic.AddUint32(&numAntifloodChecks, 1)
+			assert.Equal(t, compressedPayload, message.Data())
+			return expectedErr
+		},
+	})
+
+	callBackFunc := mes.PubsubCallback(handler, "")
+	pid := peer.ID(mes.ID())
+	msg := createCompressedPubsubMessage(args.Marshalizer, mes.ID(), payload)
+
+	assert.False(t, callBackFunc(context.Background(), pid, msg))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numAntifloodChecks))
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numCalled))
+
+	_ = mes.Close()
+}
+
+func TestNetworkMessenger_PubsubCallbackCompressedMessageAllowedByTheAntifloodShouldBeDecompressed(t *testing.T) {
+	args := createMockNetworkArgs()
+	mes, _ := libp2p.NewNetworkMessenger(args)
+
+	payload := bytes.Repeat([]byte("compressible payload "), 100)
+	var receivedData []byte
+	handler := &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			receivedData = message.Data()
+			return nil
+		},
+	}
+	numAntifloodChecks := uint32(0)
+	_ = mes.SetAntifloodHandler(&mock.AntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			atomic.AddUint32(&numAntifloodChecks, 1)
+			return nil
+		},
+	})
+
+	callBackFunc := mes.PubsubCallback(handler, "")
+	pid := peer.ID(mes.ID())
+	msg := createCompressedPubsubMessage(args.Marshalizer, mes.ID(), payload)
+
+	assert.True(t, callBackFunc(context.Background(), pid, msg))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numAntifloodChecks))
+	assert.Equal(t, payload, receivedData)
+
+	_ = mes.Close()
+}
+
+func TestNetworkMessenger_PubsubCallbackUncompressedMessageShouldNotCheckTheAntiflood(t *testing.T) {
+	args := createMockNetworkArgs()
+	mes, _ := libp2p.NewNetworkMessenger(args)
+
+	numCalled := uint32(0)
+	handler := &mock.MessageProcessorStub{
+		ProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			atomic.AddUint32(&numCalled, 1)
+			return nil
+		},
+	}
+	_ = mes.SetAntifloodHandler(&mock.AntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			assert.Fail(t, "should have not checked the antiflood")
+			return nil
+		},
+	})
+
+	callBackFunc := mes.PubsubCallback(handler, "")
+	pid := peer.ID(mes.ID())
+	innerMessage := &data.TopicMessage{
+		Payload:   []byte("data"),
+		Timestamp: time.Now().Unix(),
+		Version:   libp2p.CurrentTopicMessageVersion,
+	}
+	buff, _ := args.Marshalizer.Marshal(innerMessage)
+	msg := &pubsub.Message{
+		Message: &pubsub_pb.Message{
+			From:  []byte(mes.ID()),
+			Data:  buff,
+			Seqno: []byte{0, 0, 0, 1},
+		},
+	}
+
+	assert.True(t, callBackFunc(context.Background(), pid, msg))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalled))
+
+	_ = mes.Close()
+}
+
 func TestNetworkMessenger_UnjoinAllTopicsShouldWork(t *testing.T) {
 	args := libp2p.ArgsNetworkMessenger{
 		Marshalizer:   &testscommon.ProtoMarshalizerMock{},
@@ -1334,6 +1650,7 @@ func TestNetworkMessenger_UnjoinAllTopicsShouldWork(t *testing.T) {
 			},
 		},
 		SyncTimer: &libp2p.LocalSyncTimer{},
+		PeerStore: peerStore.NewDisabledPeerStore(),
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
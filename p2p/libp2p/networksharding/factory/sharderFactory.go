@@ -21,6 +21,7 @@ type ArgsSharderFactory struct {
 	MaxIntraShardObservers  int
 	MaxCrossShardObservers  int
 	Type                    string
+	ProtectedPeers          []peer.ID
 }
 
 // NewSharder creates new Sharder instances
@@ -33,8 +34,9 @@ func NewSharder(arg ArgsSharderFactory) (p2p.CommonSharder, error) {
 			"MaxCrossShardValidators", arg.MaxCrossShardValidators,
 			"MaxIntraShardObservers", arg.MaxIntraShardObservers,
 			"MaxCrossShardObservers", arg.MaxCrossShardObservers,
+			"num protected peers", len(arg.ProtectedPeers),
 		)
-		return networksharding.NewListsSharder(
+		ls, err := networksharding.NewListsSharder(
 			arg.PeerShardResolver,
 			arg.Pid,
 			arg.MaxConnectionCount,
@@ -43,6 +45,13 @@ func NewSharder(arg ArgsSharderFactory) (p2p.CommonSharder, error) {
 			arg.MaxIntraShardObservers,
 			arg.MaxCrossShardObservers,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		ls.SetProtectedPeers(arg.ProtectedPeers)
+
+		return ls, nil
 	case p2p.OneListSharder:
 		log.Debug("using one list sharder",
 			"MaxConnectionCount", arg.MaxConnectionCount,
@@ -84,6 +84,8 @@ type listsSharder struct {
 	maxCrossShardObservers  int
 	maxUnknown              int
 	computeDistance         func(src peer.ID, dest peer.ID) *big.Int
+	mutProtectedPeers       sync.RWMutex
+	protectedPeers          map[peer.ID]struct{}
 }
 
 // NewListsSharder creates a new kad list based kad sharder instance
@@ -133,6 +135,7 @@ func NewListsSharder(
 		maxCrossShardValidators: maxCrossShardValidators,
 		maxIntraShardObservers:  maxIntraShardObservers,
 		maxCrossShardObservers:  maxCrossShardObservers,
+		protectedPeers:          make(map[peer.ID]struct{}),
 	}
 
 	ls.maxUnknown = maxPeerCount - providedPeers
@@ -142,7 +145,7 @@ func NewListsSharder(
 
 // ComputeEvictionList returns the eviction list
 func (ls *listsSharder) ComputeEvictionList(pidList []peer.ID) []peer.ID {
-	peerDistances := ls.splitPeerIds(pidList)
+	peerDistances := ls.splitPeerIds(ls.removeProtectedPeers(pidList))
 
 	existingNumIntraShardValidators := len(peerDistances[intraShardValidators])
 	existingNumIntraShardObservers := len(peerDistances[intraShardObservers])
@@ -173,6 +176,41 @@ func (ls *listsSharder) ComputeEvictionList(pidList []peer.ID) []peer.ID {
 	return evictionProposed
 }
 
+// removeProtectedPeers returns the provided list without the protected peers. Protected peers are never evicted and
+// do not consume the slots of the other lists
+func (ls *listsSharder) removeProtectedPeers(pidList []peer.ID) []peer.ID {
+	ls.mutProtectedPeers.RLock()
+	defer ls.mutProtectedPeers.RUnlock()
+
+	if len(ls.protectedPeers) == 0 {
+		return pidList
+	}
+
+	filtered := make([]peer.ID, 0, len(pidList))
+	for _, pid := range pidList {
+		_, isProtected := ls.protectedPeers[pid]
+		if isProtected {
+			continue
+		}
+
+		filtered = append(filtered, pid)
+	}
+
+	return filtered
+}
+
+// SetProtectedPeers sets the peers that will never be evicted (static and trusted peers)
+func (ls *listsSharder) SetProtectedPeers(pids []peer.ID) {
+	protectedPeers := make(map[peer.ID]struct{}, len(pids))
+	for _, pid := range pids {
+		protectedPeers[pid] = struct{}{}
+	}
+
+	ls.mutProtectedPeers.Lock()
+	ls.protectedPeers = protectedPeers
+	ls.mutProtectedPeers.Unlock()
+}
+
 // computeUsedAndSpare returns the used and the remaining of the two provided (capacity) values
 // if used > maximum, used will equal to maximum and remaining will be 0
 func computeUsedAndSpare(existing int, maximum int) (int, int) {
@@ -267,6 +267,30 @@ func TestListsSharder_ComputeEvictionListReachedIntraShardShouldSortAndEvict(t *
 	assert.Equal(t, pidCrtShard1, evictList[0])
 }
 
+func TestListsSharder_ComputeEvictionListProtectedPeersShouldNotBeEvicted(t *testing.T) {
+	t.Parallel()
+
+	ls, _ := NewListsSharder(
+		createStringPeersShardResolver(),
+		crtPid,
+		minAllowedConnectedPeersListSharder,
+		minAllowedValidators,
+		minAllowedValidators,
+		minAllowedObservers,
+		minAllowedObservers,
+	)
+	pidCrtShard1 := peer.ID(fmt.Sprintf("%d - 1 - %s", crtShardId, validatorMarker))
+	pidCrtShard2 := peer.ID(fmt.Sprintf("%d - 2 - %s", crtShardId, validatorMarker))
+	pidCrtShard3 := peer.ID(fmt.Sprintf("%d - 3 - %s", crtShardId, validatorMarker))
+	pids := []peer.ID{pidCrtShard3, pidCrtShard2, pidCrtShard1}
+	ls.SetProtectedPeers([]peer.ID{pidCrtShard1})
+
+	evictList := ls.ComputeEvictionList(pids)
+
+	assert.Equal(t, 1, len(evictList))
+	assert.Equal(t, pidCrtShard2, evictList[0])
+}
+
 func TestListsSharder_ComputeEvictionListUnknownPeersShouldFillTheGap(t *testing.T) {
 	t.Parallel()
 
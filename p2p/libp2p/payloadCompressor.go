@@ -0,0 +1,163 @@
+package libp2p
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
+	"github.com/golang/snappy"
+)
+
+// the compression identifiers are written in the topic message and are part of the protocol: do not change them
+const (
+	noCompression     = uint32(0)
+	snappyCompression = uint32(1)
+	gzipCompression   = uint32(2)
+)
+
+var compressionTypes = map[string]uint32{
+	p2p.SnappyCompression: snappyCompression,
+	p2p.GzipCompression:   gzipCompression,
+}
+
+// topicCompressionRule defines the compression applied on the payloads sent on the topics starting with topicPrefix
+type topicCompressionRule struct {
+	topicPrefix string
+	compression uint32
+	threshold   int
+}
+
+// payloadCompressor decides, based on the configured rules, which outgoing payloads are compressed
+type payloadCompressor struct {
+	rules  []topicCompressionRule
+	metric *metrics.Compression
+}
+
+func newPayloadCompressor(cfg config.PayloadCompressionConfig) (*payloadCompressor, error) {
+	pc := &payloadCompressor{
+		rules:  make([]topicCompressionRule, 0, len(cfg.Topics)),
+		metric: metrics.NewCompression(),
+	}
+	if !cfg.Enabled {
+		return pc, nil
+	}
+
+	for _, topicCfg := range cfg.Topics {
+		compression, found := compressionTypes[topicCfg.Type]
+		if !found {
+			return nil, fmt.Errorf("%w %s for topic prefix %s", p2p.ErrUnsupportedCompression, topicCfg.Type, topicCfg.TopicPrefix)
+		}
+
+		pc.rules = append(pc.rules, topicCompressionRule{
+			topicPrefix: topicCfg.TopicPrefix,
+			compression: compression,
+			threshold:   int(topicCfg.ThresholdInBytes),
+		})
+	}
+
+	return pc, nil
+}
+
+// compress returns the payload that should be sent on the provided topic together with the compression used.
+// The payload is left untouched if no rule matches, if it is smaller than the rule's threshold, if not all the
+// receivers are able to decompress it or if the compression does not reduce its size
+func (pc *payloadCompressor) compress(topic string, buff []byte, receiversSupportCompression func() bool) ([]byte, uint32) {
+	rule, found := pc.ruleForTopic(topic)
+	if !found || len(buff) < rule.threshold {
+		return buff, noCompression
+	}
+	if !receiversSupportCompression() {
+		return buff, noCompression
+	}
+
+	compressed, err := compressPayload(rule.compression, buff)
+	if err != nil {
+		log.Trace("cannot compress payload", "topic", topic, "error", err.Error())
+		return buff, noCompression
+	}
+	if len(compressed) >= len(buff) {
+		return buff, noCompression
+	}
+
+	pc.metric.AddCompressedPayload(len(buff), len(compressed))
+
+	return compressed, rule.compression
+}
+
+func (pc *payloadCompressor) ruleForTopic(topic string) (topicCompressionRule, bool) {
+	for _, rule := range pc.rules {
+		if strings.HasPrefix(topic, rule.topicPrefix) {
+			return rule, true
+		}
+	}
+
+	return topicCompressionRule{}, false
+}
+
+func (pc *payloadCompressor) isEnabled() bool {
+	return len(pc.rules) > 0
+}
+
+func compressPayload(compression uint32, buff []byte) ([]byte, error) {
+	switch compression {
+	case snappyCompression:
+		return snappy.Encode(nil, buff), nil
+	case gzipCompression:
+		b := &bytes.Buffer{}
+		w := gzip.NewWriter(b)
+		_, err := w.Write(buff)
+		if err != nil {
+			return nil, err
+		}
+		err = w.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		return b.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("%w %d", p2p.ErrUnsupportedCompression, compression)
+	}
+}
+
+// decompressPayload reverts the compression applied by the sender. The decompressed size is bounded by
+// maxSendBuffSize as no honest peer is able to send a larger payload
+func decompressPayload(compression uint32, buff []byte) ([]byte, error) {
+	switch compression {
+	case noCompression:
+		return buff, nil
+	case snappyCompression:
+		decodedLen, err := snappy.DecodedLen(buff)
+		if err != nil {
+			return nil, err
+		}
+		if decodedLen > maxSendBuffSize {
+			return nil, p2p.ErrDecompressedPayloadTooLarge
+		}
+
+		return snappy.Decode(nil, buff)
+	case gzipCompression:
+		r, err := gzip.NewReader(bytes.NewReader(buff))
+		if err != nil {
+			return nil, err
+		}
+
+		decompressed, err := ioutil.ReadAll(io.LimitReader(r, int64(maxSendBuffSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(decompressed) > maxSendBuffSize {
+			return nil, p2p.ErrDecompressedPayloadTooLarge
+		}
+
+		return decompressed, nil
+	default:
+		return nil, fmt.Errorf("%w %d", p2p.ErrUnsupportedCompression, compression)
+	}
+}
@@ -0,0 +1,154 @@
+package libp2p
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCompressiblePayload(size int) []byte {
+	return bytes.Repeat([]byte("compressible payload "), size/21+1)[:size]
+}
+
+func receiversSupportCompression() bool {
+	return true
+}
+
+func createPayloadCompressionConfig(compressionType string) config.PayloadCompressionConfig {
+	return config.PayloadCompressionConfig{
+		Enabled: true,
+		Topics: []config.TopicCompressionConfig{
+			{
+				TopicPrefix:      "txBlockBodies",
+				Type:             compressionType,
+				ThresholdInBytes: 100,
+			},
+		},
+	}
+}
+
+func TestNewPayloadCompressor_UnsupportedTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pc, err := newPayloadCompressor(createPayloadCompressionConfig("zip"))
+
+	assert.Nil(t, pc)
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
+func TestNewPayloadCompressor_DisabledShouldIgnoreRules(t *testing.T) {
+	t.Parallel()
+
+	cfg := createPayloadCompressionConfig("zip")
+	cfg.Enabled = false
+	pc, err := newPayloadCompressor(cfg)
+
+	assert.Nil(t, err)
+	assert.False(t, pc.isEnabled())
+
+	payload := createCompressiblePayload(1000)
+	buff, compression := pc.compress("txBlockBodies_0", payload, receiversSupportCompression)
+	assert.Equal(t, payload, buff)
+	assert.Equal(t, noCompression, compression)
+}
+
+func TestPayloadCompressor_CompressShouldSkipUnmatchedTopicsAndSmallPayloads(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := newPayloadCompressor(createPayloadCompressionConfig(p2p.SnappyCompression))
+
+	payload := createCompressiblePayload(1000)
+	buff, compression := pc.compress("transactions_0", payload, receiversSupportCompression)
+	assert.Equal(t, payload, buff)
+	assert.Equal(t, noCompression, compression)
+
+	payload = createCompressiblePayload(99)
+	buff, compression = pc.compress("txBlockBodies_0", payload, receiversSupportCompression)
+	assert.Equal(t, payload, buff)
+	assert.Equal(t, noCompression, compression)
+	assert.Equal(t, uint32(0), pc.metric.ResetNumCompressedPayloads())
+}
+
+func TestPayloadCompressor_CompressShouldSkipIfReceiversDoNotSupportCompression(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := newPayloadCompressor(createPayloadCompressionConfig(p2p.SnappyCompression))
+
+	payload := createCompressiblePayload(1000)
+	buff, compression := pc.compress("txBlockBodies_0", payload, func() bool {
+		return false
+	})
+
+	assert.Equal(t, payload, buff)
+	assert.Equal(t, noCompression, compression)
+	assert.Equal(t, uint32(0), pc.metric.ResetNumCompressedPayloads())
+}
+
+func TestPayloadCompressor_CompressShouldSkipIncompressiblePayloads(t *testing.T) {
+	t.Parallel()
+
+	pc, _ := newPayloadCompressor(createPayloadCompressionConfig(p2p.GzipCompression))
+
+	payload := []byte("short payload that gzip will only enlarge because of its header and footer ......")
+	pc.rules[0].threshold = 0
+	buff, compression := pc.compress("txBlockBodies_0", payload, receiversSupportCompression)
+
+	assert.Equal(t, payload, buff)
+	assert.Equal(t, noCompression, compression)
+}
+
+func TestPayloadCompressor_CompressDecompressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, compressionType := range []string{p2p.SnappyCompression, p2p.GzipCompression} {
+		pc, _ := newPayloadCompressor(createPayloadCompressionConfig(compressionType))
+
+		payload := createCompressiblePayload(1000)
+		buff, compression := pc.compress("txBlockBodies_0_1", payload, receiversSupportCompression)
+		assert.Equal(t, compressionTypes[compressionType], compression)
+		assert.True(t, len(buff) < len(payload))
+		assert.Equal(t, uint32(1), pc.metric.ResetNumCompressedPayloads())
+		assert.Equal(t, uint64(len(payload)-len(buff)), pc.metric.ResetNumBytesSaved())
+
+		decompressed, err := decompressPayload(compression, buff)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, decompressed)
+	}
+}
+
+func TestDecompressPayload_UnsupportedCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	buff, err := decompressPayload(100, []byte("payload"))
+
+	assert.Nil(t, buff)
+	assert.True(t, errors.Is(err, p2p.ErrUnsupportedCompression))
+}
+
+func TestDecompressPayload_TooLargePayloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	payload := make([]byte, maxSendBuffSize+1)
+	for _, compression := range []uint32{snappyCompression, gzipCompression} {
+		buff, _ := compressPayload(compression, payload)
+
+		decompressed, err := decompressPayload(compression, buff)
+		assert.Nil(t, decompressed)
+		assert.Equal(t, p2p.ErrDecompressedPayloadTooLarge, err)
+	}
+}
+
+func TestDecompressPayload_CorruptedPayloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	for _, compression := range []uint32{snappyCompression, gzipCompression} {
+		decompressed, err := decompressPayload(compression, []byte("corrupted"))
+
+		assert.Nil(t, decompressed)
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,132 @@
+package libp2p
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// staticPeer holds the address used to dial a static peer together with its decoded peer ID
+type staticPeer struct {
+	address string
+	pid     peer.ID
+}
+
+// peersAccessList holds the static, trusted and allowed peers defined in the config and decides which peers
+// are protected against eviction and which peers can open inbound connections when running in private mode
+type peersAccessList struct {
+	privateMode  bool
+	staticPeers  []staticPeer
+	trustedPeers map[peer.ID]struct{}
+	allowedPeers map[peer.ID]struct{}
+}
+
+func newPeersAccessList(cfg config.PeerAccessConfig) (*peersAccessList, error) {
+	pal := &peersAccessList{
+		privateMode:  cfg.PrivateMode,
+		staticPeers:  make([]staticPeer, 0, len(cfg.StaticPeers)),
+		trustedPeers: make(map[peer.ID]struct{}),
+		allowedPeers: make(map[peer.ID]struct{}),
+	}
+
+	for _, address := range cfg.StaticPeers {
+		pid, err := decodePeerIDFromAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for static peer %s", err, address)
+		}
+
+		pal.staticPeers = append(pal.staticPeers, staticPeer{
+			address: address,
+			pid:     pid,
+		})
+	}
+
+	err := decodePeerIDsInMap(cfg.TrustedPeers, pal.trustedPeers)
+	if err != nil {
+		return nil, fmt.Errorf("%w for trusted peers", err)
+	}
+
+	err = decodePeerIDsInMap(cfg.AllowedPeers, pal.allowedPeers)
+	if err != nil {
+		return nil, fmt.Errorf("%w for allowed peers", err)
+	}
+
+	return pal, nil
+}
+
+func decodePeerIDFromAddress(address string) (peer.ID, error) {
+	ma, err := multiaddr.NewMultiaddr(address)
+	if err != nil {
+		return "", err
+	}
+
+	addrInfo, err := peer.AddrInfoFromP2pAddr(ma)
+	if err != nil {
+		return "", err
+	}
+
+	return addrInfo.ID, nil
+}
+
+func decodePeerIDsInMap(prettyPids []string, m map[peer.ID]struct{}) error {
+	for _, prettyPid := range prettyPids {
+		pid, err := peer.Decode(prettyPid)
+		if err != nil {
+			return fmt.Errorf("%w, %s: %v", p2p.ErrInvalidValue, prettyPid, err)
+		}
+
+		m[pid] = struct{}{}
+	}
+
+	return nil
+}
+
+// isTrusted returns true if the provided peer was defined as a trusted peer
+func (pal *peersAccessList) isTrusted(pid peer.ID) bool {
+	_, found := pal.trustedPeers[pid]
+
+	return found
+}
+
+// isStatic returns true if the provided peer was defined as a static peer
+func (pal *peersAccessList) isStatic(pid peer.ID) bool {
+	for _, sp := range pal.staticPeers {
+		if sp.pid == pid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// protectedPeers returns the static and trusted peers, that should never be evicted
+func (pal *peersAccessList) protectedPeers() []peer.ID {
+	pids := make([]peer.ID, 0, len(pal.staticPeers)+len(pal.trustedPeers))
+	for _, sp := range pal.staticPeers {
+		pids = append(pids, sp.pid)
+	}
+	for pid := range pal.trustedPeers {
+		if pal.isStatic(pid) {
+			continue
+		}
+
+		pids = append(pids, pid)
+	}
+
+	return pids
+}
+
+// isInboundConnectionAllowed returns true if the provided peer can open an inbound connection. When not running in
+// private mode, all peers are allowed
+func (pal *peersAccessList) isInboundConnectionAllowed(pid peer.ID) bool {
+	if !pal.privateMode {
+		return true
+	}
+
+	_, isAllowed := pal.allowedPeers[pid]
+
+	return isAllowed || pal.isTrusted(pid) || pal.isStatic(pid)
+}
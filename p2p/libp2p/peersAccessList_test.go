@@ -0,0 +1,99 @@
+package libp2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const staticPid = "16Uiu2HAkyqtHSEJDkYhVWTtm9j58Mq5xQJgrApBYXMwS6sdamXuE"
+const trustedPid = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+const staticPeerAddress = "/ip4/127.0.0.1/tcp/10000/p2p/" + staticPid
+
+func decodePid(pretty string) peer.ID {
+	pid, _ := peer.Decode(pretty)
+
+	return pid
+}
+
+func TestNewPeersAccessList_InvalidStaticPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pal, err := newPeersAccessList(config.PeerAccessConfig{
+		StaticPeers: []string{"/ip4/127.0.0.1/tcp/10000"},
+	})
+
+	assert.Nil(t, pal)
+	assert.NotNil(t, err)
+}
+
+func TestNewPeersAccessList_InvalidTrustedPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pal, err := newPeersAccessList(config.PeerAccessConfig{
+		TrustedPeers: []string{"invalid pid"},
+	})
+
+	assert.Nil(t, pal)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewPeersAccessList_InvalidAllowedPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pal, err := newPeersAccessList(config.PeerAccessConfig{
+		AllowedPeers: []string{"invalid pid"},
+	})
+
+	assert.Nil(t, pal)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestPeersAccessList_ProtectedPeers(t *testing.T) {
+	t.Parallel()
+
+	pal, err := newPeersAccessList(config.PeerAccessConfig{
+		StaticPeers:  []string{staticPeerAddress},
+		TrustedPeers: []string{trustedPid, staticPid},
+	})
+	assert.Nil(t, err)
+
+	protected := pal.protectedPeers()
+
+	assert.Equal(t, 2, len(protected))
+	assert.Equal(t, decodePid(staticPid), protected[0])
+	assert.Equal(t, decodePid(trustedPid), protected[1])
+	assert.True(t, pal.isStatic(decodePid(staticPid)))
+	assert.False(t, pal.isStatic(decodePid(trustedPid)))
+	assert.True(t, pal.isTrusted(decodePid(trustedPid)))
+}
+
+func TestPeersAccessList_IsInboundConnectionAllowedNotPrivateModeShouldAllowAll(t *testing.T) {
+	t.Parallel()
+
+	pal, _ := newPeersAccessList(config.PeerAccessConfig{})
+
+	assert.True(t, pal.isInboundConnectionAllowed("random peer"))
+}
+
+func TestPeersAccessList_IsInboundConnectionAllowedPrivateMode(t *testing.T) {
+	t.Parallel()
+
+	allowedPid := "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bc"
+	pal, err := newPeersAccessList(config.PeerAccessConfig{
+		StaticPeers:  []string{staticPeerAddress},
+		TrustedPeers: []string{trustedPid},
+		AllowedPeers: []string{allowedPid},
+		PrivateMode:  true,
+	})
+	assert.Nil(t, err)
+
+	assert.True(t, pal.isInboundConnectionAllowed(decodePid(staticPid)))
+	assert.True(t, pal.isInboundConnectionAllowed(decodePid(trustedPid)))
+	assert.True(t, pal.isInboundConnectionAllowed(decodePid(allowedPid)))
+	assert.False(t, pal.isInboundConnectionAllowed("random peer"))
+}
@@ -0,0 +1,119 @@
+package libp2p
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const durationCheckStaticPeers = time.Second
+
+// peerConnector defines the operations needed to keep a peer connected
+type peerConnector interface {
+	ConnectToPeer(address string) error
+	IsConnected(peerID core.PeerID) bool
+}
+
+type staticPeerState struct {
+	staticPeer
+	backoff         time.Duration
+	nextAttemptTime time.Time
+}
+
+// staticPeersReconnecter periodically checks the static peers and redials the disconnected ones using an
+// exponential backoff bounded by the configured minimum and maximum values
+type staticPeersReconnecter struct {
+	connector  peerConnector
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	mutPeers   sync.Mutex
+	peers      []*staticPeerState
+}
+
+func newStaticPeersReconnecter(
+	connector peerConnector,
+	staticPeers []staticPeer,
+	minBackoff time.Duration,
+	maxBackoff time.Duration,
+) (*staticPeersReconnecter, error) {
+	if connector == nil {
+		return nil, p2p.ErrNilHost
+	}
+	if minBackoff < time.Second {
+		return nil, fmt.Errorf("%w, MinReconnectBackoffInSec should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+	if maxBackoff < minBackoff {
+		return nil, fmt.Errorf("%w, MaxReconnectBackoffInSec should have been at least MinReconnectBackoffInSec", p2p.ErrInvalidValue)
+	}
+
+	spr := &staticPeersReconnecter{
+		connector:  connector,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		peers:      make([]*staticPeerState, 0, len(staticPeers)),
+	}
+	for _, sp := range staticPeers {
+		spr.peers = append(spr.peers, &staticPeerState{
+			staticPeer: sp,
+			backoff:    minBackoff,
+		})
+	}
+
+	return spr, nil
+}
+
+// startReconnecting will periodically check the static peers until the provided context is done
+func (spr *staticPeersReconnecter) startReconnecting(ctx context.Context) {
+	go func() {
+		for {
+			spr.checkStaticPeers(time.Now())
+
+			select {
+			case <-ctx.Done():
+				log.Debug("closing the static peers reconnect loop")
+				return
+			case <-time.After(durationCheckStaticPeers):
+			}
+		}
+	}()
+}
+
+// checkStaticPeers tries to connect to each disconnected static peer whose backoff expired
+func (spr *staticPeersReconnecter) checkStaticPeers(now time.Time) {
+	spr.mutPeers.Lock()
+	defer spr.mutPeers.Unlock()
+
+	for _, sp := range spr.peers {
+		if spr.connector.IsConnected(core.PeerID(sp.pid)) {
+			sp.backoff = spr.minBackoff
+			sp.nextAttemptTime = time.Time{}
+			continue
+		}
+		if now.Before(sp.nextAttemptTime) {
+			continue
+		}
+
+		err := spr.connector.ConnectToPeer(sp.address)
+		if err == nil {
+			log.Debug("connected to static peer", "pid", sp.pid.Pretty())
+			sp.backoff = spr.minBackoff
+			sp.nextAttemptTime = time.Time{}
+			continue
+		}
+
+		log.Debug("cannot connect to static peer",
+			"pid", sp.pid.Pretty(),
+			"next attempt in", sp.backoff,
+			"error", err.Error(),
+		)
+		sp.nextAttemptTime = now.Add(sp.backoff)
+		sp.backoff *= 2
+		if sp.backoff > spr.maxBackoff {
+			sp.backoff = spr.maxBackoff
+		}
+	}
+}
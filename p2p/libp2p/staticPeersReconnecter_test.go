@@ -0,0 +1,129 @@
+package libp2p
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/stretchr/testify/assert"
+)
+
+type peerConnectorStub struct {
+	connectToPeerCalled func(address string) error
+	isConnectedCalled   func(peerID core.PeerID) bool
+}
+
+func (pcs *peerConnectorStub) ConnectToPeer(address string) error {
+	return pcs.connectToPeerCalled(address)
+}
+
+func (pcs *peerConnectorStub) IsConnected(peerID core.PeerID) bool {
+	return pcs.isConnectedCalled(peerID)
+}
+
+func createTestStaticPeers() []staticPeer {
+	return []staticPeer{
+		{
+			address: staticPeerAddress,
+			pid:     decodePid(staticPid),
+		},
+	}
+}
+
+func TestNewStaticPeersReconnecter_NilConnectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	spr, err := newStaticPeersReconnecter(nil, createTestStaticPeers(), time.Second, time.Minute)
+
+	assert.Nil(t, spr)
+	assert.Equal(t, p2p.ErrNilHost, err)
+}
+
+func TestNewStaticPeersReconnecter_InvalidBackoffShouldErr(t *testing.T) {
+	t.Parallel()
+
+	spr, err := newStaticPeersReconnecter(&peerConnectorStub{}, createTestStaticPeers(), 0, time.Minute)
+	assert.Nil(t, spr)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	spr, err = newStaticPeersReconnecter(&peerConnectorStub{}, createTestStaticPeers(), time.Minute, time.Second)
+	assert.Nil(t, spr)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestStaticPeersReconnecter_CheckStaticPeersConnectedShouldNotDial(t *testing.T) {
+	t.Parallel()
+
+	connector := &peerConnectorStub{
+		connectToPeerCalled: func(address string) error {
+			assert.Fail(t, "should have not dialed a connected peer")
+			return nil
+		},
+		isConnectedCalled: func(peerID core.PeerID) bool {
+			return true
+		},
+	}
+	spr, _ := newStaticPeersReconnecter(connector, createTestStaticPeers(), time.Second, time.Minute)
+
+	spr.checkStaticPeers(time.Now())
+}
+
+func TestStaticPeersReconnecter_CheckStaticPeersShouldBackoffExponentially(t *testing.T) {
+	t.Parallel()
+
+	numDials := 0
+	connector := &peerConnectorStub{
+		connectToPeerCalled: func(address string) error {
+			assert.Equal(t, staticPeerAddress, address)
+			numDials++
+			return errors.New("dial failed")
+		},
+		isConnectedCalled: func(peerID core.PeerID) bool {
+			return false
+		},
+	}
+	spr, _ := newStaticPeersReconnecter(connector, createTestStaticPeers(), time.Second, 3*time.Second)
+
+	start := time.Now()
+	spr.checkStaticPeers(start)
+	assert.Equal(t, 1, numDials)
+
+	spr.checkStaticPeers(start.Add(500 * time.Millisecond))
+	assert.Equal(t, 1, numDials)
+
+	spr.checkStaticPeers(start.Add(time.Second))
+	assert.Equal(t, 2, numDials)
+	assert.Equal(t, 3*time.Second, spr.peers[0].backoff)
+
+	spr.checkStaticPeers(start.Add(2 * time.Second))
+	assert.Equal(t, 2, numDials)
+
+	spr.checkStaticPeers(start.Add(3 * time.Second))
+	assert.Equal(t, 3, numDials)
+	assert.Equal(t, 3*time.Second, spr.peers[0].backoff)
+}
+
+func TestStaticPeersReconnecter_CheckStaticPeersReconnectedShouldResetBackoff(t *testing.T) {
+	t.Parallel()
+
+	isConnected := false
+	connector := &peerConnectorStub{
+		connectToPeerCalled: func(address string) error {
+			return errors.New("dial failed")
+		},
+		isConnectedCalled: func(peerID core.PeerID) bool {
+			return isConnected
+		},
+	}
+	spr, _ := newStaticPeersReconnecter(connector, createTestStaticPeers(), time.Second, time.Minute)
+
+	spr.checkStaticPeers(time.Now())
+	assert.Equal(t, 2*time.Second, spr.peers[0].backoff)
+
+	isConnected = true
+	spr.checkStaticPeers(time.Now())
+	assert.Equal(t, time.Second, spr.peers[0].backoff)
+	assert.True(t, spr.peers[0].nextAttemptTime.IsZero())
+}
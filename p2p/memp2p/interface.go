@@ -0,0 +1,10 @@
+package memp2p
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// messageRouter defines the component able to delay or drop the messages sent through the in-memory network
+type messageRouter interface {
+	route(from core.PeerID, to core.PeerID, size int, deliver func())
+}
@@ -17,6 +17,8 @@ const maxQueueSize = 1000
 
 var log = logger.GetOrCreate("p2p/memp2p")
 
+var _ p2p.Messenger = (*Messenger)(nil)
+
 // Messenger is an implementation of the p2p.Messenger interface that
 // uses no real networking code, but instead connects to a network simulated in
 // memory (the Network struct). The Messenger is intended for use
@@ -258,6 +260,25 @@ func (messenger *Messenger) UnregisterMessageProcessor(topic string) error {
 	return nil
 }
 
+// UnregisterAllMessageProcessors unsets the message processors for all topics
+func (messenger *Messenger) UnregisterAllMessageProcessors() error {
+	messenger.topicsMutex.Lock()
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
+// UnjoinAllTopics removes all the topics of interest for this Messenger, together with their message processors
+func (messenger *Messenger) UnjoinAllTopics() error {
+	messenger.topicsMutex.Lock()
+	messenger.topics = make(map[string]struct{})
+	messenger.topicValidators = make(map[string]p2p.MessageProcessor)
+	messenger.topicsMutex.Unlock()
+
+	return nil
+}
+
 // OutgoingChannelLoadBalancer does nothing, as it is not applicable to the in-memory network.
 func (messenger *Messenger) OutgoingChannelLoadBalancer() p2p.ChannelLoadBalancer {
 	return nil
@@ -302,7 +323,7 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 
 	peers := messenger.network.Peers()
 	for _, peer := range peers {
-		peer.receiveMessage(messageObject)
+		messenger.network.deliver(messenger.ID(), peer, messageObject)
 	}
 
 	return nil
@@ -311,33 +332,38 @@ func (messenger *Messenger) synchronousBroadcast(topic string, data []byte) erro
 func (messenger *Messenger) processFromQueue() {
 	for {
 		messageObject := <-messenger.processQueue
-		if check.IfNil(messageObject) {
-			continue
-		}
+		messenger.processMessage(messageObject)
+	}
+}
 
-		topic := messageObject.Topics()[0]
-		if topic == "" {
-			continue
-		}
+// processMessage passes the message to the message processor of its topic, if this Messenger has the topic
+func (messenger *Messenger) processMessage(messageObject p2p.MessageP2P) {
+	if check.IfNil(messageObject) {
+		return
+	}
 
-		messenger.topicsMutex.Lock()
-		_, found := messenger.topics[topic]
-		if !found {
-			messenger.topicsMutex.Unlock()
-			continue
-		}
+	topic := messageObject.Topics()[0]
+	if topic == "" {
+		return
+	}
 
-		// numReceived gets incremented because the message arrived on a registered topic
-		atomic.AddUint64(&messenger.numReceived, 1)
-		validator := messenger.topicValidators[topic]
-		if check.IfNil(validator) {
-			messenger.topicsMutex.Unlock()
-			continue
-		}
+	messenger.topicsMutex.Lock()
+	_, found := messenger.topics[topic]
+	if !found {
 		messenger.topicsMutex.Unlock()
+		return
+	}
 
-		_ = validator.ProcessReceivedMessage(messageObject, messenger.p2pID)
+	// numReceived gets incremented because the message arrived on a registered topic
+	atomic.AddUint64(&messenger.numReceived, 1)
+	validator := messenger.topicValidators[topic]
+	if check.IfNil(validator) {
+		messenger.topicsMutex.Unlock()
+		return
 	}
+	messenger.topicsMutex.Unlock()
+
+	_ = validator.ProcessReceivedMessage(messageObject, messenger.p2pID)
 }
 
 // SendToConnectedPeer sends a message directly to the peer specified by the ID.
@@ -351,7 +377,7 @@ func (messenger *Messenger) SendToConnectedPeer(topic string, buff []byte, peerI
 			return ErrReceivingPeerNotConnected
 		}
 
-		receivingPeer.receiveMessage(messageObject)
+		messenger.network.deliver(messenger.ID(), receivingPeer, messageObject)
 
 		return nil
 	}
@@ -403,6 +429,16 @@ func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
 }
 
+// GetPeersTraffic returns an empty slice. Not implemented.
+func (messenger *Messenger) GetPeersTraffic() []p2p.PeerTrafficInfo {
+	return make([]p2p.PeerTrafficInfo, 0)
+}
+
+// TotalBytesSavedByCompression returns 0 as the payloads are never compressed
+func (messenger *Messenger) TotalBytesSavedByCompression() uint64 {
+	return 0
+}
+
 // Close disconnects this Messenger from the network it was connected to.
 func (messenger *Messenger) Close() error {
 	messenger.network.UnregisterPeer(messenger.ID())
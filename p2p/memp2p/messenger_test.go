@@ -184,3 +184,20 @@ func TestSendingDirectMessages(t *testing.T) {
 	// Peer1 got the message
 	assert.Equal(t, uint64(1), peer1.NumMessagesReceived())
 }
+
+func TestUnjoinAllTopics(t *testing.T) {
+	network := memp2p.NewNetwork()
+
+	messenger, _ := memp2p.NewMessenger(network)
+	_ = messenger.CreateTopic("rocket", false)
+	_ = messenger.RegisterMessageProcessor("rocket", &mock.MessageProcessorStub{})
+
+	err := messenger.UnregisterAllMessageProcessors()
+	assert.Nil(t, err)
+	assert.Nil(t, messenger.TopicValidator("rocket"))
+	assert.True(t, messenger.HasTopic("rocket"))
+
+	err = messenger.UnjoinAllTopics()
+	assert.Nil(t, err)
+	assert.False(t, messenger.HasTopic("rocket"))
+}
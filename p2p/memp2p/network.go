@@ -12,8 +12,9 @@ import (
 // peers. The peers are connected to the network if they are in the internal
 // `peers` map; otherwise, they are disconnected.
 type Network struct {
-	mutex sync.RWMutex
-	peers map[core.PeerID]*Messenger
+	mutex  sync.RWMutex
+	peers  map[core.PeerID]*Messenger
+	router messageRouter
 }
 
 // NewNetwork constructs a new Network instance with an empty
@@ -129,3 +130,29 @@ func (network *Network) IsPeerConnected(peerID core.PeerID) bool {
 	network.mutex.RUnlock()
 	return found
 }
+
+// setRouter sets the component that decides when and if the messages reach their destination. A nil router means
+// that all messages are delivered instantly.
+func (network *Network) setRouter(router messageRouter) {
+	network.mutex.Lock()
+	network.router = router
+	network.mutex.Unlock()
+}
+
+// deliver hands the message to the receiving messenger, either directly or through the configured router. The
+// messages delivered by the router are processed synchronously, so the router knows they were processed once
+// it delivered them
+func (network *Network) deliver(from core.PeerID, to *Messenger, message *message) {
+	network.mutex.RLock()
+	router := network.router
+	network.mutex.RUnlock()
+
+	if router == nil {
+		to.receiveMessage(message)
+		return
+	}
+
+	router.route(from, to.ID(), len(message.Data()), func() {
+		to.processMessage(message)
+	})
+}
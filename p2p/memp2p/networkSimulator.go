@@ -0,0 +1,378 @@
+package memp2p
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+var _ messageRouter = (*NetworkSimulator)(nil)
+
+// LinkConditions defines how the messages sent from one peer to another are affected by the simulated network
+type LinkConditions struct {
+	// Latency is the fixed delay added to each message
+	Latency time.Duration
+	// Jitter is the upper bound of the random delay added on top of the latency
+	Jitter time.Duration
+	// LossRate is the probability, in the [0, 1] interval, that a message is dropped
+	LossRate float64
+	// BandwidthBytesPerSec limits the number of payload bytes the link can carry each second. 0 means unlimited
+	BandwidthBytesPerSec uint64
+}
+
+func (lc LinkConditions) check() error {
+	if lc.Latency < 0 || lc.Jitter < 0 {
+		return fmt.Errorf("%w, negative latency or jitter", ErrInvalidLinkConditions)
+	}
+	if lc.LossRate < 0 || lc.LossRate > 1 {
+		return fmt.Errorf("%w, loss rate should be in the [0, 1] interval", ErrInvalidLinkConditions)
+	}
+
+	return nil
+}
+
+// ArgsNetworkSimulator represents the network simulator config argument DTO
+type ArgsNetworkSimulator struct {
+	Seed        int64
+	StartTime   time.Time
+	DefaultLink LinkConditions
+}
+
+// SimulatorStatistics holds the counters of the messages routed by the network simulator
+type SimulatorStatistics struct {
+	NumDelivered   uint64
+	NumLost        uint64
+	NumPartitioned uint64
+}
+
+type link struct {
+	from core.PeerID
+	to   core.PeerID
+}
+
+type simulatorEvent struct {
+	at     time.Time
+	seqNo  uint64
+	link   *link
+	action func()
+}
+
+type eventsQueue []*simulatorEvent
+
+func (eq eventsQueue) Len() int { return len(eq) }
+
+func (eq eventsQueue) Less(i, j int) bool {
+	if eq[i].at.Equal(eq[j].at) {
+		return eq[i].seqNo < eq[j].seqNo
+	}
+
+	return eq[i].at.Before(eq[j].at)
+}
+
+func (eq eventsQueue) Swap(i, j int) { eq[i], eq[j] = eq[j], eq[i] }
+
+func (eq *eventsQueue) Push(x interface{}) { *eq = append(*eq, x.(*simulatorEvent)) }
+
+func (eq *eventsQueue) Pop() interface{} {
+	old := *eq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*eq = old[:n-1]
+
+	return item
+}
+
+// NetworkSimulator wraps an in-memory Network and routes its messages through simulated links that can add
+// latency, jitter, packet loss and bandwidth limits. The peers can be split in partitions that can be scripted
+// to change over time. The simulator has its own clock that only moves when Advance is called and all random
+// decisions are taken using the provided seed, so the same sequence of sent messages always produces the same
+// sequence of deliveries. The receivers process the delivered messages synchronously, so Advance and RunUntilIdle
+// return only after the message processors handled all the messages delivered meanwhile.
+type NetworkSimulator struct {
+	network     *Network
+	clock       *SimulatedClock
+	mut         sync.Mutex
+	mutAdvance  sync.Mutex
+	randomizer  *rand.Rand
+	defaultLink LinkConditions
+	links       map[link]LinkConditions
+	busyUntil   map[link]time.Time
+	partitions  map[core.PeerID]int
+	events      eventsQueue
+	seqNo       uint64
+	stats       SimulatorStatistics
+	chanStop    chan struct{}
+}
+
+// NewNetworkSimulator creates a new network simulator together with the in-memory network it controls
+func NewNetworkSimulator(args ArgsNetworkSimulator) (*NetworkSimulator, error) {
+	err := args.DefaultLink.check()
+	if err != nil {
+		return nil, err
+	}
+
+	ns := &NetworkSimulator{
+		network:     NewNetwork(),
+		clock:       NewSimulatedClock(args.StartTime),
+		randomizer:  rand.New(rand.NewSource(args.Seed)),
+		defaultLink: args.DefaultLink,
+		links:       make(map[link]LinkConditions),
+		busyUntil:   make(map[link]time.Time),
+		partitions:  make(map[core.PeerID]int),
+		events:      make(eventsQueue, 0),
+	}
+	ns.network.setRouter(ns)
+
+	return ns, nil
+}
+
+// Network returns the in-memory network controlled by the simulator. The messengers should be created on it
+func (ns *NetworkSimulator) Network() *Network {
+	return ns.network
+}
+
+// Clock returns the simulated clock
+func (ns *NetworkSimulator) Clock() *SimulatedClock {
+	return ns.clock
+}
+
+// SetLinkConditions overrides the default link conditions for the messages sent from one peer to another. The
+// setting is directional, so asymmetric links can be simulated
+func (ns *NetworkSimulator) SetLinkConditions(from core.PeerID, to core.PeerID, conditions LinkConditions) error {
+	err := conditions.check()
+	if err != nil {
+		return err
+	}
+
+	ns.mut.Lock()
+	ns.links[link{from: from, to: to}] = conditions
+	ns.mut.Unlock()
+
+	return nil
+}
+
+// Partition splits the network in the provided groups. Messages are only delivered between peers of the same
+// group. All the peers not contained in any group form an additional group. Messages already in flight between
+// peers that become partitioned are dropped
+func (ns *NetworkSimulator) Partition(groups ...[]core.PeerID) {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	ns.partitions = make(map[core.PeerID]int)
+	for idx, group := range groups {
+		for _, pid := range group {
+			ns.partitions[pid] = idx + 1
+		}
+	}
+}
+
+// Heal removes all partitions
+func (ns *NetworkSimulator) Heal() {
+	ns.Partition()
+}
+
+// Schedule registers an action that will be executed when the simulated clock reaches the current time plus the
+// provided delay
+func (ns *NetworkSimulator) Schedule(delay time.Duration, action func()) {
+	if action == nil {
+		return
+	}
+
+	ns.mut.Lock()
+	ns.pushEvent(ns.clock.CurrentTime().Add(delay), nil, action)
+	ns.mut.Unlock()
+}
+
+// SchedulePartition splits the network in the provided groups after the provided delay
+func (ns *NetworkSimulator) SchedulePartition(delay time.Duration, groups ...[]core.PeerID) {
+	ns.Schedule(delay, func() {
+		ns.Partition(groups...)
+	})
+}
+
+// ScheduleHeal removes all partitions after the provided delay
+func (ns *NetworkSimulator) ScheduleHeal(delay time.Duration) {
+	ns.Schedule(delay, ns.Heal)
+}
+
+// route is called by the network for each sent message and schedules its delivery. The messages a peer sends to
+// itself are delivered instantly
+func (ns *NetworkSimulator) route(from core.PeerID, to core.PeerID, size int, deliver func()) {
+	if from == to {
+		deliver()
+		return
+	}
+
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	now := ns.clock.CurrentTime()
+	l := link{from: from, to: to}
+	if !ns.areConnected(l) {
+		ns.stats.NumPartitioned++
+		return
+	}
+
+	conditions, found := ns.links[l]
+	if !found {
+		conditions = ns.defaultLink
+	}
+	if conditions.LossRate > 0 && ns.randomizer.Float64() < conditions.LossRate {
+		ns.stats.NumLost++
+		return
+	}
+
+	sendTime := now
+	if conditions.BandwidthBytesPerSec > 0 {
+		busyUntil := ns.busyUntil[l]
+		if busyUntil.After(sendTime) {
+			sendTime = busyUntil
+		}
+		sendTime = sendTime.Add(time.Duration(uint64(size) * uint64(time.Second) / conditions.BandwidthBytesPerSec))
+		ns.busyUntil[l] = sendTime
+	}
+
+	deliveryTime := sendTime.Add(conditions.Latency)
+	if conditions.Jitter > 0 {
+		deliveryTime = deliveryTime.Add(time.Duration(ns.randomizer.Int63n(int64(conditions.Jitter) + 1)))
+	}
+
+	ns.pushEvent(deliveryTime, &l, deliver)
+}
+
+func (ns *NetworkSimulator) areConnected(l link) bool {
+	return ns.partitions[l.from] == ns.partitions[l.to]
+}
+
+func (ns *NetworkSimulator) pushEvent(at time.Time, l *link, action func()) {
+	ns.seqNo++
+	heap.Push(&ns.events, &simulatorEvent{
+		at:     at,
+		seqNo:  ns.seqNo,
+		link:   l,
+		action: action,
+	})
+}
+
+// Advance moves the simulated clock forward with the provided duration, delivering the messages and executing
+// the scheduled actions that become due, in their chronological order
+func (ns *NetworkSimulator) Advance(duration time.Duration) {
+	ns.mutAdvance.Lock()
+	defer ns.mutAdvance.Unlock()
+
+	target := ns.clock.CurrentTime().Add(duration)
+	for {
+		event := ns.popEventDueUntil(target)
+		if event == nil {
+			break
+		}
+
+		event.action()
+	}
+
+	ns.clock.setTime(target)
+}
+
+// RunUntilIdle advances the simulated clock until there are no more pending messages or scheduled actions
+func (ns *NetworkSimulator) RunUntilIdle() {
+	for {
+		ns.mut.Lock()
+		if len(ns.events) == 0 {
+			ns.mut.Unlock()
+			return
+		}
+		next := ns.events[0].at
+		ns.mut.Unlock()
+
+		ns.Advance(next.Sub(ns.clock.CurrentTime()))
+	}
+}
+
+func (ns *NetworkSimulator) popEventDueUntil(target time.Time) *simulatorEvent {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	for len(ns.events) > 0 {
+		if ns.events[0].at.After(target) {
+			return nil
+		}
+
+		event := heap.Pop(&ns.events).(*simulatorEvent)
+		ns.clock.setTime(event.at)
+		if event.link != nil && !ns.areConnected(*event.link) {
+			ns.stats.NumPartitioned++
+			continue
+		}
+		if event.link != nil {
+			ns.stats.NumDelivered++
+		}
+
+		return event
+	}
+
+	return nil
+}
+
+// NumPendingEvents returns the number of messages in flight and actions not yet executed
+func (ns *NetworkSimulator) NumPendingEvents() int {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	return len(ns.events)
+}
+
+// Statistics returns a snapshot of the routed messages counters
+func (ns *NetworkSimulator) Statistics() SimulatorStatistics {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	return ns.stats
+}
+
+// StartRealTime starts advancing the simulated clock with the provided step, once each step of wall clock time.
+// Useful for the tests in which the components under test run their own goroutines
+func (ns *NetworkSimulator) StartRealTime(step time.Duration) {
+	ns.mut.Lock()
+	if ns.chanStop != nil {
+		ns.mut.Unlock()
+		return
+	}
+	chanStop := make(chan struct{})
+	ns.chanStop = chanStop
+	ns.mut.Unlock()
+
+	go func() {
+		for {
+			select {
+			case <-chanStop:
+				return
+			case <-time.After(step):
+			}
+
+			ns.Advance(step)
+		}
+	}()
+}
+
+// Close stops the real time advancing of the simulated clock, if started
+func (ns *NetworkSimulator) Close() error {
+	ns.mut.Lock()
+	defer ns.mut.Unlock()
+
+	if ns.chanStop != nil {
+		close(ns.chanStop)
+		ns.chanStop = nil
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ns *NetworkSimulator) IsInterfaceNil() bool {
+	return ns == nil
+}
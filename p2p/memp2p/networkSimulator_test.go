@@ -0,0 +1,171 @@
+package memp2p_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func createSimulatorWithPeers(t *testing.T, args memp2p.ArgsNetworkSimulator, numPeers int) (*memp2p.NetworkSimulator, []*memp2p.Messenger) {
+	simulator, err := memp2p.NewNetworkSimulator(args)
+	assert.Nil(t, err)
+
+	peers := make([]*memp2p.Messenger, numPeers)
+	for i := 0; i < numPeers; i++ {
+		peers[i], _ = memp2p.NewMessenger(simulator.Network())
+		_ = peers[i].CreateTopic("rocket", false)
+	}
+
+	return simulator, peers
+}
+
+func TestNewNetworkSimulator_InvalidDefaultLinkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simulator, err := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{
+		DefaultLink: memp2p.LinkConditions{LossRate: 1.5},
+	})
+	assert.Nil(t, simulator)
+	assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+
+	simulator, err = memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{
+		DefaultLink: memp2p.LinkConditions{Latency: -time.Second},
+	})
+	assert.Nil(t, simulator)
+	assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+}
+
+func TestNetworkSimulator_SetLinkConditionsInvalidShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simulator, _ := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{})
+
+	err := simulator.SetLinkConditions("a", "b", memp2p.LinkConditions{Jitter: -time.Second})
+	assert.True(t, errors.Is(err, memp2p.ErrInvalidLinkConditions))
+}
+
+func TestNetworkSimulator_LatencyShouldDelayDelivery(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers := createSimulatorWithPeers(t, memp2p.ArgsNetworkSimulator{
+		DefaultLink: memp2p.LinkConditions{Latency: time.Second},
+	}, 2)
+
+	peers[0].Broadcast("rocket", []byte("launch the rocket"))
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0})
+
+	simulator.Advance(time.Millisecond * 999)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0})
+
+	simulator.Advance(time.Millisecond)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1})
+	assert.Equal(t, time.Second, simulator.Clock().Elapsed())
+	assert.Equal(t, uint64(1), simulator.Statistics().NumDelivered)
+}
+
+func TestNetworkSimulator_AsymmetricLinkShouldWork(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers := createSimulatorWithPeers(t, memp2p.ArgsNetworkSimulator{}, 2)
+	_ = simulator.SetLinkConditions(peers[0].ID(), peers[1].ID(), memp2p.LinkConditions{Latency: time.Minute})
+
+	_ = peers[0].SendToConnectedPeer("rocket", []byte("slow"), peers[1].ID())
+	_ = peers[1].SendToConnectedPeer("rocket", []byte("fast"), peers[0].ID())
+	simulator.Advance(time.Second)
+
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 0})
+	assert.Equal(t, 1, simulator.NumPendingEvents())
+
+	simulator.RunUntilIdle()
+
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1})
+	assert.Equal(t, time.Minute, simulator.Clock().Elapsed())
+}
+
+func TestNetworkSimulator_BandwidthShouldSerializeMessages(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers := createSimulatorWithPeers(t, memp2p.ArgsNetworkSimulator{
+		DefaultLink: memp2p.LinkConditions{BandwidthBytesPerSec: 1000},
+	}, 2)
+
+	payload := make([]byte, 500)
+	for i := 0; i < 4; i++ {
+		_ = peers[0].SendToConnectedPeer("rocket", payload, peers[1].ID())
+	}
+
+	simulator.Advance(time.Second)
+	testReceivedMessages(t, peers, map[int]uint64{0: 0, 1: 2})
+
+	simulator.Advance(time.Second)
+	testReceivedMessages(t, peers, map[int]uint64{0: 0, 1: 4})
+}
+
+func TestNetworkSimulator_LossShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	numSent := 200
+	args := memp2p.ArgsNetworkSimulator{
+		Seed:        42,
+		DefaultLink: memp2p.LinkConditions{LossRate: 0.3, Jitter: time.Second},
+	}
+
+	stats := make([]memp2p.SimulatorStatistics, 0, 2)
+	for run := 0; run < 2; run++ {
+		simulator, peers := createSimulatorWithPeers(t, args, 2)
+		for i := 0; i < numSent; i++ {
+			_ = peers[0].SendToConnectedPeer("rocket", []byte("payload"), peers[1].ID())
+		}
+		simulator.RunUntilIdle()
+
+		stats = append(stats, simulator.Statistics())
+	}
+
+	assert.Equal(t, stats[0], stats[1])
+	assert.Equal(t, uint64(numSent), stats[0].NumDelivered+stats[0].NumLost)
+	assert.True(t, stats[0].NumLost > 0)
+	assert.True(t, stats[0].NumDelivered > 0)
+}
+
+func TestNetworkSimulator_ScriptedPartitionAndHealShouldWork(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers := createSimulatorWithPeers(t, memp2p.ArgsNetworkSimulator{
+		DefaultLink: memp2p.LinkConditions{Latency: time.Millisecond * 10},
+	}, 4)
+	groupA := []core.PeerID{peers[0].ID(), peers[1].ID()}
+	groupB := []core.PeerID{peers[2].ID(), peers[3].ID()}
+
+	simulator.SchedulePartition(time.Second, groupA, groupB)
+	simulator.ScheduleHeal(time.Second * 3)
+
+	simulator.Advance(time.Second * 2)
+	peers[0].Broadcast("rocket", []byte("partitioned"))
+	simulator.Advance(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 1, 1: 1, 2: 0, 3: 0})
+	assert.Equal(t, uint64(2), simulator.Statistics().NumPartitioned)
+
+	simulator.Advance(time.Second * 2)
+	peers[0].Broadcast("rocket", []byte("healed"))
+	simulator.Advance(time.Millisecond * 100)
+	testReceivedMessages(t, peers, map[int]uint64{0: 2, 1: 2, 2: 1, 3: 1})
+}
+
+func TestNetworkSimulator_PartitionShouldDropInFlightMessages(t *testing.T) {
+	t.Parallel()
+
+	simulator, peers := createSimulatorWithPeers(t, memp2p.ArgsNetworkSimulator{
+		DefaultLink: memp2p.LinkConditions{Latency: time.Second},
+	}, 2)
+
+	_ = peers[0].SendToConnectedPeer("rocket", []byte("in flight"), peers[1].ID())
+	simulator.Partition([]core.PeerID{peers[0].ID()})
+	simulator.RunUntilIdle()
+
+	testReceivedMessages(t, peers, map[int]uint64{0: 0, 1: 0})
+	assert.Equal(t, uint64(1), simulator.Statistics().NumPartitioned)
+}
@@ -0,0 +1,75 @@
+package memp2p
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SimulatedClock is a clock that only moves forward when the network simulator advances it. It can be used as
+// the sync timer of the components under test so they share the simulator's notion of time.
+type SimulatedClock struct {
+	mut  sync.RWMutex
+	now  time.Time
+	zero time.Time
+}
+
+// NewSimulatedClock creates a new simulated clock that starts at the provided time
+func NewSimulatedClock(startTime time.Time) *SimulatedClock {
+	return &SimulatedClock{
+		now:  startTime,
+		zero: startTime,
+	}
+}
+
+// setTime moves the clock to the provided time. The clock never goes backwards
+func (sc *SimulatedClock) setTime(t time.Time) {
+	sc.mut.Lock()
+	if t.After(sc.now) {
+		sc.now = t
+	}
+	sc.mut.Unlock()
+}
+
+// CurrentTime returns the current simulated time
+func (sc *SimulatedClock) CurrentTime() time.Time {
+	sc.mut.RLock()
+	defer sc.mut.RUnlock()
+
+	return sc.now
+}
+
+// Elapsed returns the simulated time passed since the clock was created
+func (sc *SimulatedClock) Elapsed() time.Duration {
+	sc.mut.RLock()
+	defer sc.mut.RUnlock()
+
+	return sc.now.Sub(sc.zero)
+}
+
+// StartSyncingTime does nothing as the simulated clock does not need synchronization
+func (sc *SimulatedClock) StartSyncingTime() {
+}
+
+// ClockOffset returns 0 as the simulated clock does not need synchronization
+func (sc *SimulatedClock) ClockOffset() time.Duration {
+	return 0
+}
+
+// FormattedCurrentTime returns the current simulated time in a human readable format
+func (sc *SimulatedClock) FormattedCurrentTime() string {
+	t := sc.CurrentTime()
+
+	return fmt.Sprintf("%.4d-%.2d-%.2d %.2d:%.2d:%.2d.%.9d ",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
+
+// Close does nothing
+func (sc *SimulatedClock) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *SimulatedClock) IsInterfaceNil() bool {
+	return sc == nil
+}
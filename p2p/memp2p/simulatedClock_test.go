@@ -0,0 +1,31 @@
+package memp2p_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatedClock_ShouldOnlyMoveWithTheSimulator(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Unix(1000, 0)
+	simulator, _ := memp2p.NewNetworkSimulator(memp2p.ArgsNetworkSimulator{
+		StartTime: startTime,
+	})
+	clock := simulator.Clock()
+
+	assert.False(t, clock.IsInterfaceNil())
+	assert.Equal(t, startTime, clock.CurrentTime())
+	assert.Equal(t, time.Duration(0), clock.ClockOffset())
+
+	time.Sleep(time.Millisecond * 10)
+	assert.Equal(t, startTime, clock.CurrentTime())
+
+	simulator.Advance(time.Minute)
+	assert.Equal(t, startTime.Add(time.Minute), clock.CurrentTime())
+	assert.Equal(t, time.Minute, clock.Elapsed())
+	assert.Equal(t, clock.CurrentTime().Format("2006-01-02 15:04:05.000000000 "), clock.FormattedCurrentTime())
+}
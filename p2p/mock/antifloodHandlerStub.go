@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// AntifloodHandlerStub -
+type AntifloodHandlerStub struct {
+	CanProcessMessageCalled func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+}
+
+// CanProcessMessage -
+func (ahs *AntifloodHandlerStub) CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if ahs.CanProcessMessageCalled != nil {
+		return ahs.CanProcessMessageCalled(message, fromConnectedPeer)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ahs *AntifloodHandlerStub) IsInterfaceNil() bool {
+	return ahs == nil
+}
@@ -19,6 +19,13 @@ const (
 	NilListSharder = "NilListSharder"
 )
 
+const (
+	// SnappyCompression is the payload compression variant that uses the snappy algorithm
+	SnappyCompression = "snappy"
+	// GzipCompression is the payload compression variant that uses the gzip algorithm
+	GzipCompression = "gzip"
+)
+
 // MessageProcessor is the interface used to describe what a receive message processor should do
 // All implementations that will be called from Messenger implementation will need to satisfy this interface
 // If the function returns a non nil value, the received message will not be propagated to its connected peers
@@ -141,6 +148,8 @@ type Messenger interface {
 	SetPeerShardResolver(peerShardResolver PeerShardResolver) error
 	SetPeerDenialEvaluator(handler PeerDenialEvaluator) error
 	GetConnectedPeersInfo() *ConnectedPeersInfo
+	GetPeersTraffic() []PeerTrafficInfo
+	TotalBytesSavedByCompression() uint64
 	UnjoinAllTopics() error
 
 	// IsInterfaceNil returns true if there is no value under the interface
@@ -267,6 +276,12 @@ type PeerDenialEvaluator interface {
 	IsInterfaceNil() bool
 }
 
+// AntifloodHandler defines the behavior of a component able to tell if a received message can be processed
+type AntifloodHandler interface {
+	CanProcessMessage(message MessageP2P, fromConnectedPeer core.PeerID) error
+	IsInterfaceNil() bool
+}
+
 // ConnectionMonitorWrapper uses a connection monitor but checks if the peer is blacklisted or not
 //TODO this should be removed after merging of the PeerShardResolver and BlacklistHandler
 type ConnectionMonitorWrapper interface {
@@ -295,3 +310,57 @@ type SyncTimer interface {
 	CurrentTime() time.Time
 	IsInterfaceNil() bool
 }
+
+// PeerStoreEntry holds the information kept by the peer store about a known peer. The timestamps are unix timestamps
+// in seconds and a zero BanExpiry means that the peer is not banned
+type PeerStoreEntry struct {
+	Pid          core.PeerID
+	Addresses    []string
+	LastSeen     int64
+	ShardID      uint32
+	PeerType     core.P2PPeerType
+	HonestyScore float64
+	BanExpiry    int64
+	BanReason    string
+}
+
+// PeerStore defines the behavior of a component able to persist the known peers and their bans between restarts
+type PeerStore interface {
+	UpdatePeer(pid core.PeerID, addresses []string, peerInfo core.P2PPeerInfo)
+	Ban(pid core.PeerID, duration time.Duration, reason string) error
+	Unban(pid core.PeerID) error
+	GetPeers() []PeerStoreEntry
+	GetBannedPeers() []PeerStoreEntry
+	GetKnownAddresses(maxNumPeers int) []string
+	SetPeerHonestyScoreProvider(provider PeerHonestyScoreProvider) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// PeerHonestyScoreProvider defines the behavior of a component able to provide the honesty score of a public key
+type PeerHonestyScoreProvider interface {
+	GetScore(pk string) float64
+	IsInterfaceNil() bool
+}
+
+// PeerTopicTraffic holds the messages and bytes exchanged with a peer on a topic. The outgoing counters account both
+// the direct messages and the messages published or relayed to the peer through pubsub
+type PeerTopicTraffic struct {
+	Topic            string
+	NumMessagesIn    uint64
+	NumBytesIn       uint64
+	NumMessagesOut   uint64
+	NumBytesOut      uint64
+	NumRejected      uint64
+	NumAntifloodHits uint64
+}
+
+// PeerTrafficInfo holds the traffic accounted for a connected peer, together with its shard information and the
+// direction of the connection
+type PeerTrafficInfo struct {
+	Pid                 core.PeerID
+	ShardID             uint32
+	PeerType            core.P2PPeerType
+	ConnectionDirection string
+	Topics              []PeerTopicTraffic
+}
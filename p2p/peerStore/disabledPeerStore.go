@@ -0,0 +1,63 @@
+package peerStore
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ p2p.PeerStore = (*disabledPeerStore)(nil)
+
+type disabledPeerStore struct {
+}
+
+// NewDisabledPeerStore returns a peer store implementation that does not keep any peer, used when the peer store
+// is disabled
+func NewDisabledPeerStore() *disabledPeerStore {
+	return &disabledPeerStore{}
+}
+
+// UpdatePeer does nothing
+func (dps *disabledPeerStore) UpdatePeer(_ core.PeerID, _ []string, _ core.P2PPeerInfo) {
+}
+
+// Ban does nothing
+func (dps *disabledPeerStore) Ban(_ core.PeerID, _ time.Duration, _ string) error {
+	return nil
+}
+
+// Unban does nothing
+func (dps *disabledPeerStore) Unban(_ core.PeerID) error {
+	return nil
+}
+
+// GetPeers returns an empty slice
+func (dps *disabledPeerStore) GetPeers() []p2p.PeerStoreEntry {
+	return make([]p2p.PeerStoreEntry, 0)
+}
+
+// GetBannedPeers returns an empty slice
+func (dps *disabledPeerStore) GetBannedPeers() []p2p.PeerStoreEntry {
+	return make([]p2p.PeerStoreEntry, 0)
+}
+
+// GetKnownAddresses returns an empty slice
+func (dps *disabledPeerStore) GetKnownAddresses(_ int) []string {
+	return make([]string, 0)
+}
+
+// SetPeerHonestyScoreProvider does nothing
+func (dps *disabledPeerStore) SetPeerHonestyScoreProvider(_ p2p.PeerHonestyScoreProvider) error {
+	return nil
+}
+
+// Close does nothing
+func (dps *disabledPeerStore) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dps *disabledPeerStore) IsInterfaceNil() bool {
+	return dps == nil
+}
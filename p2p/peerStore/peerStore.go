@@ -0,0 +1,336 @@
+package peerStore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ p2p.PeerStore = (*peerStore)(nil)
+
+var log = logger.GetOrCreate("p2p/peerstore")
+
+const minNumPeers = 1
+
+// ArgsPeerStore holds the arguments needed to create a peer store
+type ArgsPeerStore struct {
+	Storer      storage.Storer
+	MaxNumPeers uint32
+}
+
+// peerRecord is the persisted form of a peer store entry
+type peerRecord struct {
+	Pid          []byte
+	Addresses    []string
+	LastSeen     int64
+	ShardID      uint32
+	PeerType     core.P2PPeerType
+	HonestyScore float64
+	BanExpiry    int64
+	BanReason    string
+}
+
+type peerStore struct {
+	storer      storage.Storer
+	maxNumPeers int
+
+	mut                  sync.RWMutex
+	peers                map[core.PeerID]*peerRecord
+	honestyScoreProvider p2p.PeerHonestyScoreProvider
+}
+
+// NewPeerStore creates a new peer store, loading the peers already persisted in the provided storer
+func NewPeerStore(args ArgsPeerStore) (*peerStore, error) {
+	if check.IfNil(args.Storer) {
+		return nil, p2p.ErrNilStorer
+	}
+	if args.MaxNumPeers < minNumPeers {
+		return nil, fmt.Errorf("%w, MaxNumPeers should be at least %d", p2p.ErrInvalidValue, minNumPeers)
+	}
+
+	ps := &peerStore{
+		storer:      args.Storer,
+		maxNumPeers: int(args.MaxNumPeers),
+		peers:       make(map[core.PeerID]*peerRecord),
+	}
+	ps.loadPeers()
+
+	return ps, nil
+}
+
+// loadPeers loads the persisted records, dropping the ones holding no addresses and no active ban as they are of no
+// use anymore (e.g. the records created by an expired ban)
+func (ps *peerStore) loadPeers() {
+	now := time.Now().Unix()
+	obsoleteKeys := make([][]byte, 0)
+	ps.storer.RangeKeys(func(key []byte, value []byte) bool {
+		record := &peerRecord{}
+		err := json.Unmarshal(value, record)
+		if err != nil {
+			log.Debug("peer store: discarding corrupted record", "error", err.Error())
+			return true
+		}
+		if !isBanned(record, now) && len(record.Addresses) == 0 {
+			obsoleteKeys = append(obsoleteKeys, key)
+			return true
+		}
+
+		ps.peers[core.PeerID(key)] = record
+		return true
+	})
+
+	for _, key := range obsoleteKeys {
+		err := ps.storer.Remove(key)
+		if err != nil {
+			log.Debug("peer store: remove obsolete record", "pid", core.PeerID(key).Pretty(), "error", err.Error())
+		}
+	}
+
+	log.Debug("peer store: loaded known peers",
+		"num peers", len(ps.peers),
+		"num banned peers", len(ps.GetBannedPeers()),
+		"num dropped records", len(obsoleteKeys))
+}
+
+// UpdatePeer records the addresses of the provided peer together with its shard, type and honesty score, marking it
+// as seen now
+func (ps *peerStore) UpdatePeer(pid core.PeerID, addresses []string, peerInfo core.P2PPeerInfo) {
+	ps.mut.Lock()
+	defer ps.mut.Unlock()
+
+	record, found := ps.peers[pid]
+	if !found {
+		ps.evictPeerIfFullNoLock()
+
+		record = &peerRecord{
+			Pid: pid.Bytes(),
+		}
+		ps.peers[pid] = record
+	}
+
+	record.LastSeen = time.Now().Unix()
+	if len(addresses) > 0 {
+		record.Addresses = addresses
+	}
+	if peerInfo.PeerType != core.UnknownPeer {
+		record.ShardID = peerInfo.ShardID
+		record.PeerType = peerInfo.PeerType
+	}
+	if !check.IfNil(ps.honestyScoreProvider) && len(peerInfo.PkBytes) > 0 {
+		record.HonestyScore = ps.honestyScoreProvider.GetScore(string(peerInfo.PkBytes))
+	}
+
+	ps.persistNoLock(record)
+}
+
+// evictPeerIfFullNoLock removes the least recently seen peer that is not banned, if the store is full. If all the
+// stored peers are banned, the ban that expires the soonest is evicted so the store never grows over its capacity
+func (ps *peerStore) evictPeerIfFullNoLock() {
+	if len(ps.peers) < ps.maxNumPeers {
+		return
+	}
+
+	now := time.Now().Unix()
+	var oldest *peerRecord
+	var soonestBanExpiry *peerRecord
+	for _, record := range ps.peers {
+		if isBanned(record, now) {
+			if soonestBanExpiry == nil || record.BanExpiry < soonestBanExpiry.BanExpiry {
+				soonestBanExpiry = record
+			}
+			continue
+		}
+		if oldest == nil || record.LastSeen < oldest.LastSeen {
+			oldest = record
+		}
+	}
+	if oldest == nil {
+		oldest = soonestBanExpiry
+	}
+	if oldest == nil {
+		return
+	}
+
+	delete(ps.peers, core.PeerID(oldest.Pid))
+	err := ps.storer.Remove(oldest.Pid)
+	if err != nil {
+		log.Debug("peer store: remove peer", "pid", core.PeerID(oldest.Pid).Pretty(), "error", err.Error())
+	}
+}
+
+// Ban records the ban of the provided peer for the given duration and reason
+func (ps *peerStore) Ban(pid core.PeerID, duration time.Duration, reason string) error {
+	if len(pid) == 0 {
+		return fmt.Errorf("%w, empty peer ID", p2p.ErrInvalidValue)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("%w for the ban duration", p2p.ErrInvalidDurationProvided)
+	}
+
+	ps.mut.Lock()
+	defer ps.mut.Unlock()
+
+	record, found := ps.peers[pid]
+	if !found {
+		ps.evictPeerIfFullNoLock()
+
+		record = &peerRecord{
+			Pid: pid.Bytes(),
+		}
+		ps.peers[pid] = record
+	}
+
+	banExpiry := time.Now().Add(duration).Unix()
+	if banExpiry > record.BanExpiry {
+		record.BanExpiry = banExpiry
+	}
+	record.BanReason = reason
+
+	ps.persistNoLock(record)
+
+	return nil
+}
+
+// Unban removes the ban of the provided peer
+func (ps *peerStore) Unban(pid core.PeerID) error {
+	ps.mut.Lock()
+	defer ps.mut.Unlock()
+
+	record, found := ps.peers[pid]
+	if !found || !isBanned(record, time.Now().Unix()) {
+		return fmt.Errorf("%w, pid %s", p2p.ErrPeerNotBanned, pid.Pretty())
+	}
+
+	record.BanExpiry = 0
+	record.BanReason = ""
+
+	ps.persistNoLock(record)
+
+	return nil
+}
+
+// GetPeers returns all the known peers, sorted by their peer ID
+func (ps *peerStore) GetPeers() []p2p.PeerStoreEntry {
+	return ps.getPeers(false)
+}
+
+// GetBannedPeers returns the peers that are currently banned, sorted by their peer ID
+func (ps *peerStore) GetBannedPeers() []p2p.PeerStoreEntry {
+	return ps.getPeers(true)
+}
+
+func (ps *peerStore) getPeers(onlyBanned bool) []p2p.PeerStoreEntry {
+	ps.mut.RLock()
+	defer ps.mut.RUnlock()
+
+	now := time.Now().Unix()
+	entries := make([]p2p.PeerStoreEntry, 0, len(ps.peers))
+	for _, record := range ps.peers {
+		banned := isBanned(record, now)
+		if onlyBanned && !banned {
+			continue
+		}
+
+		entry := p2p.PeerStoreEntry{
+			Pid:          core.PeerID(record.Pid),
+			Addresses:    make([]string, len(record.Addresses)),
+			LastSeen:     record.LastSeen,
+			ShardID:      record.ShardID,
+			PeerType:     record.PeerType,
+			HonestyScore: record.HonestyScore,
+		}
+		copy(entry.Addresses, record.Addresses)
+		if banned {
+			entry.BanExpiry = record.BanExpiry
+			entry.BanReason = record.BanReason
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Pid < entries[j].Pid
+	})
+
+	return entries
+}
+
+// GetKnownAddresses returns the addresses of at most maxNumPeers peers, that are not banned, starting with the
+// most recently seen ones
+func (ps *peerStore) GetKnownAddresses(maxNumPeers int) []string {
+	ps.mut.RLock()
+	defer ps.mut.RUnlock()
+
+	now := time.Now().Unix()
+	records := make([]*peerRecord, 0, len(ps.peers))
+	for _, record := range ps.peers {
+		if isBanned(record, now) || len(record.Addresses) == 0 {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastSeen > records[j].LastSeen
+	})
+	if len(records) > maxNumPeers {
+		records = records[:maxNumPeers]
+	}
+
+	addresses := make([]string, 0, len(records))
+	for _, record := range records {
+		addresses = append(addresses, record.Addresses...)
+	}
+
+	return addresses
+}
+
+// SetPeerHonestyScoreProvider sets the component used to fetch the honesty scores of the updated peers
+func (ps *peerStore) SetPeerHonestyScoreProvider(provider p2p.PeerHonestyScoreProvider) error {
+	if check.IfNil(provider) {
+		return p2p.ErrNilPeerHonestyScoreProvider
+	}
+
+	ps.mut.Lock()
+	ps.honestyScoreProvider = provider
+	ps.mut.Unlock()
+
+	return nil
+}
+
+func (ps *peerStore) persistNoLock(record *peerRecord) {
+	buff, err := json.Marshal(record)
+	if err != nil {
+		log.Debug("peer store: marshal record", "error", err.Error())
+		return
+	}
+
+	err = ps.storer.Put(record.Pid, buff)
+	if err != nil {
+		log.Debug("peer store: persist record",
+			"pid", core.PeerID(record.Pid).Pretty(),
+			"error", err.Error())
+	}
+}
+
+func isBanned(record *peerRecord, now int64) bool {
+	return record.BanExpiry > now
+}
+
+// Close closes the underlying storer
+func (ps *peerStore) Close() error {
+	return ps.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ps *peerStore) IsInterfaceNil() bool {
+	return ps == nil
+}
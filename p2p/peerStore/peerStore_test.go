@@ -0,0 +1,294 @@
+package peerStore_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type honestyScoreProviderStub struct {
+	getScoreCalled func(pk string) float64
+}
+
+func (hsps *honestyScoreProviderStub) GetScore(pk string) float64 {
+	return hsps.getScoreCalled(pk)
+}
+
+func (hsps *honestyScoreProviderStub) IsInterfaceNil() bool {
+	return hsps == nil
+}
+
+func createStorer(t *testing.T) storage.Storer {
+	cache, _ := lrucache.NewCache(10)
+	storer, err := storageUnit.NewStorageUnit(cache, memorydb.New())
+	require.Nil(t, err)
+
+	return storer
+}
+
+func createMockArgs(t *testing.T) peerStore.ArgsPeerStore {
+	return peerStore.ArgsPeerStore{
+		Storer:      createStorer(t),
+		MaxNumPeers: 10,
+	}
+}
+
+func TestNewPeerStore_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.Storer = nil
+	ps, err := peerStore.NewPeerStore(args)
+
+	assert.True(t, check.IfNil(ps))
+	assert.Equal(t, p2p.ErrNilStorer, err)
+}
+
+func TestNewPeerStore_InvalidMaxNumPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.MaxNumPeers = 0
+	ps, err := peerStore.NewPeerStore(args)
+
+	assert.True(t, check.IfNil(ps))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewPeerStore_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ps, err := peerStore.NewPeerStore(createMockArgs(t))
+
+	assert.False(t, check.IfNil(ps))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ps.GetPeers()))
+}
+
+func TestPeerStore_UpdatePeerShouldRecordPeerInfo(t *testing.T) {
+	t.Parallel()
+
+	ps, _ := peerStore.NewPeerStore(createMockArgs(t))
+	_ = ps.SetPeerHonestyScoreProvider(&honestyScoreProviderStub{
+		getScoreCalled: func(pk string) float64 {
+			return 7.5
+		},
+	})
+
+	pid := core.PeerID("pid")
+	ps.UpdatePeer(pid, []string{"addr"}, core.P2PPeerInfo{
+		PeerType: core.ValidatorPeer,
+		ShardID:  2,
+		PkBytes:  []byte("pk"),
+	})
+	ps.UpdatePeer(pid, nil, core.P2PPeerInfo{})
+
+	peers := ps.GetPeers()
+	require.Equal(t, 1, len(peers))
+	assert.Equal(t, pid, peers[0].Pid)
+	assert.Equal(t, []string{"addr"}, peers[0].Addresses)
+	assert.Equal(t, core.ValidatorPeer, peers[0].PeerType)
+	assert.Equal(t, uint32(2), peers[0].ShardID)
+	assert.Equal(t, 7.5, peers[0].HonestyScore)
+	assert.True(t, peers[0].LastSeen > 0)
+}
+
+func TestPeerStore_UpdatePeerWhenFullShouldEvictTheOldestNotBannedPeer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.MaxNumPeers = 2
+	ps, _ := peerStore.NewPeerStore(args)
+
+	_ = ps.Ban("banned", time.Hour, "reason")
+	ps.UpdatePeer("pid1", []string{"addr1"}, core.P2PPeerInfo{})
+	ps.UpdatePeer("pid2", []string{"addr2"}, core.P2PPeerInfo{})
+
+	peers := ps.GetPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, core.PeerID("banned"), peers[0].Pid)
+	assert.Equal(t, core.PeerID("pid2"), peers[1].Pid)
+}
+
+func TestPeerStore_BanWhenFullShouldEvictTheOldestNotBannedPeer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.MaxNumPeers = 2
+	ps, _ := peerStore.NewPeerStore(args)
+
+	ps.UpdatePeer("pid1", []string{"addr1"}, core.P2PPeerInfo{})
+	ps.UpdatePeer("pid2", []string{"addr2"}, core.P2PPeerInfo{})
+	_ = ps.Ban("banned", time.Hour, "reason")
+
+	peers := ps.GetPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, core.PeerID("banned"), peers[0].Pid)
+	assert.Equal(t, core.PeerID("pid2"), peers[1].Pid)
+}
+
+func TestPeerStore_BanWhenFullOfBannedPeersShouldEvictTheBanExpiringTheSoonest(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.MaxNumPeers = 2
+	ps, _ := peerStore.NewPeerStore(args)
+
+	_ = ps.Ban("banned1", 2*time.Hour, "reason")
+	_ = ps.Ban("banned2", time.Hour, "reason")
+	_ = ps.Ban("banned3", 3*time.Hour, "reason")
+
+	banned := ps.GetBannedPeers()
+	require.Equal(t, 2, len(banned))
+	assert.Equal(t, core.PeerID("banned1"), banned[0].Pid)
+	assert.Equal(t, core.PeerID("banned3"), banned[1].Pid)
+
+	assert.NotNil(t, args.Storer.Has([]byte("banned2")))
+}
+
+func TestPeerStore_BanInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ps, _ := peerStore.NewPeerStore(createMockArgs(t))
+
+	err := ps.Ban("", time.Hour, "reason")
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	err = ps.Ban("pid", 0, "reason")
+	assert.True(t, errors.Is(err, p2p.ErrInvalidDurationProvided))
+}
+
+func TestPeerStore_BanAndUnbanShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ps, _ := peerStore.NewPeerStore(createMockArgs(t))
+	ps.UpdatePeer("pid1", []string{"addr1"}, core.P2PPeerInfo{})
+	ps.UpdatePeer("pid2", []string{"addr2"}, core.P2PPeerInfo{})
+
+	err := ps.Ban("pid1", time.Hour, "reason")
+	assert.Nil(t, err)
+
+	banned := ps.GetBannedPeers()
+	require.Equal(t, 1, len(banned))
+	assert.Equal(t, core.PeerID("pid1"), banned[0].Pid)
+	assert.Equal(t, "reason", banned[0].BanReason)
+	assert.True(t, banned[0].BanExpiry > time.Now().Unix())
+	assert.Equal(t, []string{"addr2"}, ps.GetKnownAddresses(10))
+
+	err = ps.Unban("pid1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ps.GetBannedPeers()))
+
+	err = ps.Unban("pid1")
+	assert.True(t, errors.Is(err, p2p.ErrPeerNotBanned))
+}
+
+func TestPeerStore_BanShouldNotShortenAnExistingBan(t *testing.T) {
+	t.Parallel()
+
+	ps, _ := peerStore.NewPeerStore(createMockArgs(t))
+
+	_ = ps.Ban("pid", time.Hour, "first reason")
+	_ = ps.Ban("pid", time.Second, "second reason")
+
+	banned := ps.GetBannedPeers()
+	require.Equal(t, 1, len(banned))
+	assert.True(t, banned[0].BanExpiry > time.Now().Add(time.Minute).Unix())
+	assert.Equal(t, "second reason", banned[0].BanReason)
+}
+
+func TestPeerStore_GetKnownAddressesShouldLimitTheNumberOfPeers(t *testing.T) {
+	t.Parallel()
+
+	ps, _ := peerStore.NewPeerStore(createMockArgs(t))
+	ps.UpdatePeer("pid1", []string{"addr1"}, core.P2PPeerInfo{})
+	ps.UpdatePeer("pid2", []string{"addr2"}, core.P2PPeerInfo{})
+	ps.UpdatePeer("pid3", nil, core.P2PPeerInfo{})
+
+	assert.Equal(t, 1, len(ps.GetKnownAddresses(1)))
+	assert.Equal(t, 2, len(ps.GetKnownAddresses(10)))
+}
+
+func TestPeerStore_ShouldReloadPeersAndBansFromStorer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	ps, _ := peerStore.NewPeerStore(args)
+	ps.UpdatePeer("pid1", []string{"addr1"}, core.P2PPeerInfo{})
+	_ = ps.Ban("pid2", time.Hour, "reason")
+
+	reloaded, err := peerStore.NewPeerStore(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, ps.GetPeers(), reloaded.GetPeers())
+	banned := reloaded.GetBannedPeers()
+	require.Equal(t, 1, len(banned))
+	assert.Equal(t, core.PeerID("pid2"), banned[0].Pid)
+}
+
+func TestPeerStore_NewShouldDropTheExpiredBansWithoutAddresses(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	expiredBan, _ := json.Marshal(map[string]interface{}{
+		"Pid":       []byte("expired ban"),
+		"BanExpiry": time.Now().Add(-time.Hour).Unix(),
+		"BanReason": "reason",
+	})
+	_ = args.Storer.Put([]byte("expired ban"), expiredBan)
+	expiredBanWithAddresses, _ := json.Marshal(map[string]interface{}{
+		"Pid":       []byte("expired ban with addresses"),
+		"Addresses": []string{"addr"},
+		"BanExpiry": time.Now().Add(-time.Hour).Unix(),
+		"BanReason": "reason",
+	})
+	_ = args.Storer.Put([]byte("expired ban with addresses"), expiredBanWithAddresses)
+	activeBan, _ := json.Marshal(map[string]interface{}{
+		"Pid":       []byte("active ban"),
+		"BanExpiry": time.Now().Add(time.Hour).Unix(),
+		"BanReason": "reason",
+	})
+	_ = args.Storer.Put([]byte("active ban"), activeBan)
+
+	ps, err := peerStore.NewPeerStore(args)
+	require.Nil(t, err)
+
+	peers := ps.GetPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, core.PeerID("active ban"), peers[0].Pid)
+	assert.Equal(t, core.PeerID("expired ban with addresses"), peers[1].Pid)
+
+	assert.NotNil(t, args.Storer.Has([]byte("expired ban")))
+}
+
+func TestPeerStore_NewShouldDiscardCorruptedRecords(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	_ = args.Storer.Put([]byte("pid"), []byte("corrupted"))
+
+	ps, err := peerStore.NewPeerStore(args)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(ps.GetPeers()))
+}
+
+func TestPeerStore_SetPeerHonestyScoreProviderNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ps, _ := peerStore.NewPeerStore(createMockArgs(t))
+
+	err := ps.SetPeerHonestyScoreProvider(nil)
+	assert.Equal(t, p2p.ErrNilPeerHonestyScoreProvider, err)
+}
@@ -44,6 +44,7 @@ type ArgBaseProcessor struct {
 	TpsBenchmark           statistics.TPSBenchmark
 	Version                string
 	HistoryRepository      fullHistory.HistoryRepository
+	CommitJournal          process.CommitJournalHandler
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
@@ -60,6 +61,7 @@ type ArgMetaProcessor struct {
 	SCDataGetter                 external.SCQueryService
 	SCToProtocol                 process.SmartContractToProtocolHandler
 	EpochStartDataCreator        process.EpochStartDataCreator
+	EpochRoundsScheduleCreator   process.EpochStartRoundsScheduleCreator
 	EpochEconomics               process.EndOfEpochEconomics
 	EpochRewardsCreator          process.EpochStartRewardsCreator
 	EpochValidatorInfoCreator    process.EpochStartValidatorInfoCreator
@@ -29,6 +29,9 @@ import (
 
 var log = logger.GetOrCreate("process/block")
 
+// maxHeadersToArchivePerCommit bounds the number of final headers whose root hashes are archived on a block commit
+const maxHeadersToArchivePerCommit = 100
+
 type hashAndHdr struct {
 	hdr  data.HeaderHandler
 	hash []byte
@@ -71,6 +74,7 @@ type baseProcessor struct {
 	version                 string
 
 	appStatusHandler       core.AppStatusHandler
+	tracer                 core.Tracer
 	stateCheckpointModulus uint
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
@@ -78,6 +82,8 @@ type baseProcessor struct {
 	indexer      indexer.Indexer
 	tpsBenchmark statistics.TPSBenchmark
 	historyRepo  fullHistory.HistoryRepository
+
+	commitJournal process.CommitJournalHandler
 }
 
 type bootStorerDataArgs struct {
@@ -114,6 +120,31 @@ func (bp *baseProcessor) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetTracer method is used to set the tracer recording the block lifecycle spans
+func (bp *baseProcessor) SetTracer(tracer core.Tracer) error {
+	if check.IfNil(tracer) {
+		return process.ErrNilTracer
+	}
+
+	bp.tracer = tracer
+	return nil
+}
+
+// startBlockSpan starts a new span for the provided header, setting the header's round as trace attribute
+func (bp *baseProcessor) startBlockSpan(name string, header data.HeaderHandler) core.Span {
+	span := bp.tracer.StartSpan(name)
+	span.SetTraceAttribute(core.TraceAttributeRound, header.GetRound())
+	span.SetAttribute(core.TraceAttributeNonce, header.GetNonce())
+
+	return span
+}
+
+// saveProcessingTime reports the time elapsed since the provided start time as the block processing time
+func (bp *baseProcessor) saveProcessingTime(startTime time.Time) {
+	processingTime := time.Since(startTime)
+	bp.appStatusHandler.SetUInt64Value(core.MetricBlockProcessingTime, uint64(processingTime.Milliseconds()))
+}
+
 // checkBlockValidity method checks if the given block is valid
 func (bp *baseProcessor) checkBlockValidity(
 	headerHandler data.HeaderHandler,
@@ -418,6 +449,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.HistoryRepository) {
 		return process.ErrNilHistoryRepository
 	}
+	if check.IfNil(arguments.CommitJournal) {
+		return process.ErrNilCommitJournal
+	}
 	if len(arguments.Version) == 0 {
 		return process.ErrEmptySoftwareVersion
 	}
@@ -960,6 +994,9 @@ func (bp *baseProcessor) DecodeBlockHeader(dta []byte) data.HeaderHandler {
 }
 
 func (bp *baseProcessor) saveBody(body *block.Body) {
+	span := bp.tracer.StartSpan("baseProcessor.saveBody")
+	defer span.End()
+
 	startTime := time.Now()
 
 	errNotCritical := bp.txCoordinator.SaveBlockDataToStorage(body)
@@ -991,6 +1028,9 @@ func (bp *baseProcessor) saveBody(body *block.Body) {
 }
 
 func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
+	span := bp.tracer.StartSpan("baseProcessor.saveShardHeader")
+	defer span.End()
+
 	startTime := time.Now()
 
 	nonceToByteSlice := bp.uint64Converter.ToByteSlice(header.GetNonce())
@@ -1015,6 +1055,9 @@ func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash [
 }
 
 func (bp *baseProcessor) saveMetaHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
+	span := bp.tracer.StartSpan("baseProcessor.saveMetaHeader")
+	defer span.End()
+
 	startTime := time.Now()
 
 	nonceToByteSlice := bp.uint64Converter.ToByteSlice(header.GetNonce())
@@ -1072,6 +1115,99 @@ func (bp *baseProcessor) updateStateStorage(
 	accounts.PruneTrie(prevRootHash, data.OldRoot)
 }
 
+// archiveFinalRootHashes archives, in ascending nonce order, the root hashes of the final headers which were not
+// archived yet, genesis included, so no final block nonce is left out of the archive. At most
+// maxHeadersToArchivePerCommit headers are archived on a call, so an archive falling behind the final blocks catches
+// up in batches over the next commits instead of loading all the missing headers at once
+func (bp *baseProcessor) archiveFinalRootHashes(finalHeader data.HeaderHandler) {
+	userAccounts := bp.accountsDB[state.UserAccountsState]
+	if check.IfNil(finalHeader) || !userAccounts.IsArchiveModeEnabled() {
+		return
+	}
+
+	firstNonce := bp.getFirstNonceToArchive(userAccounts, finalHeader.GetEpoch())
+	lastNonce := finalHeader.GetNonce()
+	if lastNonce >= firstNonce+maxHeadersToArchivePerCommit {
+		lastNonce = firstNonce + maxHeadersToArchivePerCommit - 1
+	}
+
+	for nonce := firstNonce; nonce <= lastNonce; nonce++ {
+		header, err := bp.getFinalHeaderToArchive(finalHeader, nonce)
+		if err != nil {
+			log.Debug("archiveFinalRootHashes: could not get final header",
+				"nonce", nonce,
+				"error", err.Error())
+			return
+		}
+
+		bp.archiveRootHashes(header)
+	}
+}
+
+// getFirstNonceToArchive returns the nonce following the last archived one, searching the archive from the provided
+// epoch down to the genesis epoch. The genesis nonce is returned if nothing was archived yet
+func (bp *baseProcessor) getFirstNonceToArchive(accounts state.AccountsAdapter, epoch uint32) uint64 {
+	for {
+		lastArchivedNonce, err := accounts.GetLastArchivedNonceInEpoch(epoch)
+		if err == nil {
+			return lastArchivedNonce + 1
+		}
+		if epoch == 0 {
+			return bp.genesisNonce
+		}
+
+		epoch--
+	}
+}
+
+// getFinalHeaderToArchive returns the final header with the provided nonce, read from the storage as only the
+// committed headers are saved by nonce
+func (bp *baseProcessor) getFinalHeaderToArchive(finalHeader data.HeaderHandler, nonce uint64) (data.HeaderHandler, error) {
+	if nonce == finalHeader.GetNonce() {
+		return finalHeader, nil
+	}
+	if nonce == bp.genesisNonce {
+		genesisHeader := bp.blockChain.GetGenesisHeader()
+		if check.IfNil(genesisHeader) {
+			return nil, process.ErrNilHeaderHandler
+		}
+
+		return genesisHeader, nil
+	}
+
+	header, _, err := process.GetHeaderFromStorageWithNonce(
+		nonce,
+		bp.shardCoordinator.SelfId(),
+		bp.store,
+		bp.uint64Converter,
+		bp.marshalizer,
+	)
+
+	return header, err
+}
+
+func (bp *baseProcessor) archiveRootHashes(header data.HeaderHandler) {
+	rootHashes := map[state.AccountsDbIdentifier][]byte{
+		state.UserAccountsState: header.GetRootHash(),
+		state.PeerAccountsState: header.GetValidatorStatsRootHash(),
+	}
+
+	for key, rootHash := range rootHashes {
+		accounts, ok := bp.accountsDB[key]
+		if !ok || len(rootHash) == 0 {
+			continue
+		}
+
+		err := accounts.ArchiveRootHash(header.GetNonce(), header.GetEpoch(), rootHash)
+		if err != nil {
+			log.Debug("archiveRootHashes.ArchiveRootHash",
+				"nonce", header.GetNonce(),
+				"root hash", rootHash,
+				"error", err.Error())
+		}
+	}
+}
+
 // RevertAccountState reverts the account state for cleanup failed process
 func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 	for key := range bp.accountsDB {
@@ -1083,6 +1219,9 @@ func (bp *baseProcessor) RevertAccountState(_ data.HeaderHandler) {
 }
 
 func (bp *baseProcessor) commitAll() error {
+	span := bp.tracer.StartSpan("baseProcessor.commitAll")
+	defer span.End()
+
 	for key := range bp.accountsDB {
 		_, err := bp.accountsDB[key].Commit()
 		if err != nil {
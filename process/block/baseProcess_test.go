@@ -315,6 +315,7 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			TpsBenchmark:       &testscommon.TpsBenchmarkMock{},
 			Version:            "softwareVersion",
 			HistoryRepository:  &mock.HistoryRepositoryStub{},
+			CommitJournal:      &mock.CommitJournalStub{},
 		},
 	}
 
@@ -417,6 +418,27 @@ func TestBaseProcessor_SetAppStatusHandlerOkHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+//------- SetTracer
+func TestBaseProcessor_SetTracerNilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	err := bp.SetTracer(nil)
+	assert.Equal(t, process.ErrNilTracer, err)
+}
+
+func TestBaseProcessor_SetTracerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	bp, _ := blproc.NewShardProcessor(arguments)
+
+	err := bp.SetTracer(&mock.TracerStub{})
+	assert.Nil(t, err)
+}
+
 //------- RevertState
 func TestBaseProcessor_RevertStateRecreateTrieFailsShouldErr(t *testing.T) {
 	t.Parallel()
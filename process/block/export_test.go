@@ -107,6 +107,7 @@ func NewShardProcessorEmptyWith3shards(
 			TpsBenchmark:       &testscommon.TpsBenchmarkMock{},
 			Version:            "softwareVersion",
 			HistoryRepository:  &mock.HistoryRepositoryStub{},
+			CommitJournal:      &mock.CommitJournalStub{},
 		},
 	}
 	shardProc, err := NewShardProcessor(arguments)
@@ -11,6 +11,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -19,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
+	"github.com/ElrondNetwork/elrond-go/process/slash/disabled"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -30,11 +32,13 @@ type metaProcessor struct {
 	scDataGetter                 external.SCQueryService
 	scToProtocol                 process.SmartContractToProtocolHandler
 	epochStartDataCreator        process.EpochStartDataCreator
+	epochRoundsScheduleCreator   process.EpochStartRoundsScheduleCreator
 	epochEconomics               process.EndOfEpochEconomics
 	epochRewardsCreator          process.EpochStartRewardsCreator
 	validatorInfoCreator         process.EpochStartValidatorInfoCreator
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	evidenceProcessor            process.EquivocationEvidenceProcessor
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -65,6 +69,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochStartDataCreator) {
 		return nil, process.ErrNilEpochStartDataCreator
 	}
+	if check.IfNil(arguments.EpochRoundsScheduleCreator) {
+		return nil, process.ErrNilEpochStartRoundsScheduleCreator
+	}
 	if check.IfNil(arguments.EpochEconomics) {
 		return nil, process.ErrNilEpochEconomics
 	}
@@ -92,6 +99,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		uint64Converter:        arguments.Uint64Converter,
 		requestHandler:         arguments.RequestHandler,
 		appStatusHandler:       statusHandler.NewNilStatusHandler(),
+		tracer:                 tracing.NewDisabledTracer(),
 		blockChainHook:         arguments.BlockChainHook,
 		txCoordinator:          arguments.TxCoordinator,
 		epochStartTrigger:      arguments.EpochStartTrigger,
@@ -107,6 +115,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		genesisNonce:           genesisHdr.GetNonce(),
 		version:                core.TrimSoftwareVersion(arguments.Version),
 		historyRepo:            arguments.HistoryRepository,
+		commitJournal:          arguments.CommitJournal,
 	}
 
 	mp := metaProcessor{
@@ -116,10 +125,12 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		scToProtocol:                 arguments.SCToProtocol,
 		pendingMiniBlocksHandler:     arguments.PendingMiniBlocksHandler,
 		epochStartDataCreator:        arguments.EpochStartDataCreator,
+		epochRoundsScheduleCreator:   arguments.EpochRoundsScheduleCreator,
 		epochEconomics:               arguments.EpochEconomics,
 		epochRewardsCreator:          arguments.EpochRewardsCreator,
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
+		evidenceProcessor:            disabled.NewDisabledEvidenceProcessor(),
 	}
 
 	mp.txCounter = NewTransactionCounter()
@@ -171,6 +182,10 @@ func (mp *metaProcessor) ProcessBlock(
 		"epoch", headerHandler.GetEpoch(),
 		"round", headerHandler.GetRound(),
 		"nonce", headerHandler.GetNonce())
+	defer mp.saveProcessingTime(time.Now())
+
+	span := mp.startBlockSpan("metaProcessor.ProcessBlock", headerHandler)
+	defer span.End()
 
 	header, ok := headerHandler.(*block.MetaBlock)
 	if !ok {
@@ -298,6 +313,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.evidenceProcessor.ProcessEvidences(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.txCoordinator.ProcessBlockTransaction(body, haveTime)
 	if err != nil {
 		return err
@@ -335,6 +355,10 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 	header *block.MetaBlock,
 	body *block.Body,
 ) error {
+	if len(header.PeerInfo) > 0 {
+		return process.ErrPeerInfoInEpochStartBlock
+	}
+
 	err := mp.epochStartDataCreator.VerifyEpochStartDataForMetablock(header)
 	if err != nil {
 		return err
@@ -375,6 +399,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.epochRoundsScheduleCreator.VerifyRoundsSchedule(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.scToProtocol.UpdateProtocol(body, header.Nonce)
 	if err != nil {
 		return err
@@ -398,6 +427,17 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 	return nil
 }
 
+// SetEquivocationEvidenceProcessor sets the component which slashes the validators proven to have signed
+// conflicting blocks
+func (mp *metaProcessor) SetEquivocationEvidenceProcessor(evidenceProcessor process.EquivocationEvidenceProcessor) error {
+	if check.IfNil(evidenceProcessor) {
+		return process.ErrNilEquivocationEvidenceProcessor
+	}
+
+	mp.evidenceProcessor = evidenceProcessor
+	return nil
+}
+
 // SetNumProcessedObj will set the num of processed headers
 func (mp *metaProcessor) SetNumProcessedObj(numObj uint64) {
 	mp.headersCounter.shardMBHeadersTotalProcessed = numObj
@@ -612,6 +652,7 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 	}
 
 	mp.restoreBlockBody(bodyHandler)
+	mp.evidenceProcessor.RestoreEvidences(metaBlock)
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
@@ -627,6 +668,9 @@ func (mp *metaProcessor) CreateBlock(
 		return nil, nil, process.ErrNilBlockHeader
 	}
 
+	span := mp.startBlockSpan("metaProcessor.CreateBlock", initialHdr)
+	defer span.End()
+
 	metaHdr, ok := initialHdr.(*block.MetaBlock)
 	if !ok {
 		return nil, nil, process.ErrWrongTypeAssertion
@@ -701,6 +745,13 @@ func (mp *metaProcessor) updateEpochStartHeader(metaHdr *block.MetaBlock) error
 	}
 
 	metaHdr.EpochStart.Economics = *economicsData
+
+	roundsSchedule, err := mp.epochRoundsScheduleCreator.CreateRoundsSchedule(metaHdr)
+	if err != nil {
+		return err
+	}
+
+	metaHdr.EpochStart.RoundsSchedule = roundsSchedule
 	return nil
 }
 
@@ -762,7 +813,7 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		"nonce", metaBlock.GetNonce(),
 	)
 
-	miniBlocks, err := mp.createMiniBlocks(haveTime)
+	miniBlocks, err := mp.createMiniBlocks(metaBlock, haveTime)
 	if err != nil {
 		return nil, err
 	}
@@ -776,6 +827,7 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 }
 
 func (mp *metaProcessor) createMiniBlocks(
+	metaBlock *block.MetaBlock,
 	haveTime func() bool,
 ) (*block.Body, error) {
 	var miniBlocks block.MiniBlockSlice
@@ -790,6 +842,11 @@ func (mp *metaProcessor) createMiniBlocks(
 		return &block.Body{MiniBlocks: miniBlocks}, nil
 	}
 
+	err := mp.evidenceProcessor.IncludeEvidences(metaBlock, haveTime)
+	if err != nil {
+		log.Debug("IncludeEvidences", "error", err.Error())
+	}
+
 	mbsToMe, numTxs, numShardHeaders, err := mp.createAndProcessCrossMiniBlocksDstMe(haveTime)
 	if err != nil {
 		log.Debug("createAndProcessCrossMiniBlocksDstMe", "error", err.Error())
@@ -978,6 +1035,7 @@ func (mp *metaProcessor) CommitBlock(
 	var err error
 	defer func() {
 		if err != nil {
+			mp.commitJournal.AbortCommit()
 			mp.RevertAccountState(headerHandler)
 		}
 	}()
@@ -987,12 +1045,17 @@ func (mp *metaProcessor) CommitBlock(
 		return err
 	}
 
+	mp.commitJournal.BeginCommit()
+
 	log.Debug("started committing block",
 		"epoch", headerHandler.GetEpoch(),
 		"round", headerHandler.GetRound(),
 		"nonce", headerHandler.GetNonce(),
 	)
 
+	span := mp.startBlockSpan("metaProcessor.CommitBlock", headerHandler)
+	defer span.End()
+
 	err = mp.checkBlockValidity(headerHandler, bodyHandler)
 	if err != nil {
 		return err
@@ -1021,7 +1084,9 @@ func (mp *metaProcessor) CommitBlock(
 	rewardsTxs := mp.getRewardsTxs(header, body)
 
 	mp.commitEpochStart(header, body)
+	mp.evidenceProcessor.RemoveIncludedEvidences(header)
 	headerHash := mp.hasher.Compute(string(marshalizedHeader))
+	span.SetTraceAttribute(core.TraceAttributeBlockHash, hex.EncodeToString(headerHash))
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body)
 
@@ -1127,6 +1192,11 @@ func (mp *metaProcessor) CommitBlock(
 
 	mp.prepareDataForBootStorer(args)
 
+	err = mp.commitJournal.FinishCommit(header.GetNonce(), headerHash)
+	if err != nil {
+		return err
+	}
+
 	mp.blockSizeThrottler.Succeed(header.Round)
 
 	mp.displayPoolsInfo()
@@ -1211,6 +1281,7 @@ func (mp *metaProcessor) updateState(lastMetaBlock data.HeaderHandler) {
 	}
 
 	mp.validatorStatisticsProcessor.SetLastFinalizedRootHash(lastMetaBlock.GetValidatorStatsRootHash())
+	mp.archiveFinalRootHashes(lastMetaBlock)
 
 	prevHeader, errNotCritical := process.GetMetaHeader(
 		lastMetaBlock.GetPrevHash(),
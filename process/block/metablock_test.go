@@ -79,11 +79,13 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			TpsBenchmark:       &testscommon.TpsBenchmarkMock{},
 			Version:            "softwareVersion",
 			HistoryRepository:  &mock.HistoryRepositoryStub{},
+			CommitJournal:      &mock.CommitJournalStub{},
 		},
 		SCDataGetter:                 &mock.ScQueryStub{},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
 		PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
 		EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
+		EpochRoundsScheduleCreator:   &mock.EpochStartRoundsScheduleCreatorStub{},
 		EpochEconomics:               &mock.EpochEconomicsStub{},
 		EpochRewardsCreator:          &mock.EpochRewardsCreatorStub{},
 		EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
@@ -228,6 +230,17 @@ func TestNewMetaProcessor_NilForkDetectorShouldErr(t *testing.T) {
 	assert.Nil(t, be)
 }
 
+func TestNewMetaProcessor_NilEpochRoundsScheduleCreatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockMetaArguments()
+	arguments.EpochRoundsScheduleCreator = nil
+
+	be, err := blproc.NewMetaProcessor(arguments)
+	assert.Equal(t, process.ErrNilEpochStartRoundsScheduleCreator, err)
+	assert.Nil(t, be)
+}
+
 func TestNewMetaProcessor_NilShardCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -2530,3 +2543,116 @@ func TestMetaProcessor_CreateBlockCreateHeaderProcessBlock(t *testing.T) {
 	err = mp.ProcessBlock(headerHandler, bodyHandler, func() time.Duration { return time.Second })
 	assert.Nil(t, err)
 }
+
+func TestMetaProcessor_SetEquivocationEvidenceProcessorNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mp, _ := blproc.NewMetaProcessor(createMockMetaArguments())
+	err := mp.SetEquivocationEvidenceProcessor(nil)
+
+	assert.Equal(t, process.ErrNilEquivocationEvidenceProcessor, err)
+}
+
+func TestMetaProcessor_CreateBlockAndProcessBlockShouldHandleEvidences(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("hash1")
+	hdrHash1Bytes := []byte("hdr_hash1")
+	hrdHash2Bytes := []byte("hdr_hash2")
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hash
+	}
+	miniBlock1 := &block.MiniBlock{TxHashes: [][]byte{hash}}
+	dPool := initDataPool([]byte("tx_hash"))
+	dPool.TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return testscommon.NewShardedDataStub()
+	}
+	dPool.HeadersCalled = func() dataRetriever.HeadersPool {
+		cs := &mock.HeadersCacherStub{}
+		cs.RegisterHandlerCalled = func(i func(header data.HeaderHandler, key []byte)) {
+		}
+		cs.GetHeaderByHashCalled = func(key []byte) (handler data.HeaderHandler, e error) {
+			if bytes.Equal(hdrHash1Bytes, key) {
+				return &block.Header{
+					PrevHash:         []byte("hash1"),
+					Nonce:            1,
+					Round:            1,
+					PrevRandSeed:     []byte("roothash"),
+					MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("hash1"), SenderShardID: 1}},
+				}, nil
+			}
+			if bytes.Equal(hrdHash2Bytes, key) {
+				return &block.Header{Nonce: 2, Round: 2}, nil
+			}
+			return nil, errors.New("err")
+		}
+		cs.LenCalled = func() int {
+			return 0
+		}
+		cs.NoncesCalled = func(shardId uint32) []uint64 {
+			return []uint64{1, 2}
+		}
+		cs.MaxSizeCalled = func() int {
+			return 1000
+		}
+		return cs
+	}
+
+	txCoordinator := &mock.TransactionCoordinatorMock{
+		CreateMbsAndProcessCrossShardTransactionsDstMeCalled: func(header data.HeaderHandler, processedMiniBlocksHashes map[string]struct{}, haveTime func() bool) (slices block.MiniBlockSlice, u uint32, b bool, err error) {
+			return block.MiniBlockSlice{miniBlock1}, 0, true, nil
+		},
+	}
+
+	arguments := createMockMetaArguments()
+	arguments.DataPool = dPool
+	arguments.TxCoordinator = txCoordinator
+	arguments.Hasher = hasher
+	blkc := &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: 0, AccumulatedFeesInEpoch: big.NewInt(0), DevFeesInEpoch: big.NewInt(0)}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return hash
+		},
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 0}
+		},
+	}
+	arguments.BlockChain = blkc
+
+	mp, _ := blproc.NewMetaProcessor(arguments)
+
+	includeCalled := false
+	processCalled := false
+	err := mp.SetEquivocationEvidenceProcessor(&mock.EquivocationEvidenceProcessorStub{
+		IncludeEvidencesCalled: func(metaBlock *block.MetaBlock, haveTime func() bool) error {
+			includeCalled = true
+			return nil
+		},
+		ProcessEvidencesCalled: func(metaBlock *block.MetaBlock) error {
+			processCalled = true
+			return nil
+		},
+	})
+	assert.Nil(t, err)
+
+	round := uint64(10)
+	nonce := uint64(5)
+
+	metaHdr := &block.MetaBlock{Round: round}
+	bodyHandler, err := mp.CreateBlockBody(metaHdr, func() bool { return true })
+	assert.Nil(t, err)
+	assert.True(t, includeCalled)
+
+	headerHandler := mp.CreateNewHeader(round, nonce)
+	headerHandler.SetRound(uint64(1))
+	headerHandler.SetNonce(1)
+	headerHandler.SetPrevHash(hash)
+	headerHandler.SetAccumulatedFees(big.NewInt(0))
+
+	err = mp.ProcessBlock(headerHandler, bodyHandler, func() time.Duration { return time.Second })
+	assert.Nil(t, err)
+	assert.True(t, processCalled)
+}
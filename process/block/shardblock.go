@@ -2,6 +2,7 @@ package block
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"time"
@@ -9,6 +10,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -62,6 +64,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		uint64Converter:        arguments.Uint64Converter,
 		requestHandler:         arguments.RequestHandler,
 		appStatusHandler:       statusHandler.NewNilStatusHandler(),
+		tracer:                 tracing.NewDisabledTracer(),
 		blockChainHook:         arguments.BlockChainHook,
 		txCoordinator:          arguments.TxCoordinator,
 		rounder:                arguments.Rounder,
@@ -78,6 +81,7 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		genesisNonce:           genesisHdr.GetNonce(),
 		version:                core.TrimSoftwareVersion(arguments.Version),
 		historyRepo:            arguments.HistoryRepository,
+		commitJournal:          arguments.CommitJournal,
 	}
 
 	sp := shardProcessor{
@@ -133,6 +137,10 @@ func (sp *shardProcessor) ProcessBlock(
 		"round", headerHandler.GetRound(),
 		"nonce", headerHandler.GetNonce(),
 	)
+	defer sp.saveProcessingTime(time.Now())
+
+	span := sp.startBlockSpan("shardProcessor.ProcessBlock", headerHandler)
+	defer span.End()
 
 	header, ok := headerHandler.(*block.Header)
 	if !ok {
@@ -667,6 +675,10 @@ func (sp *shardProcessor) CreateBlock(
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
 	}
+
+	span := sp.startBlockSpan("shardProcessor.CreateBlock", initialHdr)
+	defer span.End()
+
 	shardHdr, ok := initialHdr.(*block.Header)
 	if !ok {
 		return nil, nil, process.ErrWrongTypeAssertion
@@ -732,6 +744,7 @@ func (sp *shardProcessor) CommitBlock(
 	var err error
 	defer func() {
 		if err != nil {
+			sp.commitJournal.AbortCommit()
 			sp.RevertAccountState(headerHandler)
 		}
 	}()
@@ -742,6 +755,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.store.SetEpochForPutOperation(headerHandler.GetEpoch())
+	sp.commitJournal.BeginCommit()
 
 	log.Debug("started committing block",
 		"epoch", headerHandler.GetEpoch(),
@@ -749,6 +763,9 @@ func (sp *shardProcessor) CommitBlock(
 		"nonce", headerHandler.GetNonce(),
 	)
 
+	span := sp.startBlockSpan("shardProcessor.CommitBlock", headerHandler)
+	defer span.End()
+
 	err = sp.checkBlockValidity(headerHandler, bodyHandler)
 	if err != nil {
 		return err
@@ -775,6 +792,7 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	headerHash := sp.hasher.Compute(string(marshalizedHeader))
+	span.SetTraceAttribute(core.TraceAttributeBlockHash, hex.EncodeToString(headerHash))
 
 	sp.saveShardHeader(header, headerHash, marshalizedHeader)
 
@@ -890,6 +908,11 @@ func (sp *shardProcessor) CommitBlock(
 
 	sp.prepareDataForBootStorer(args)
 
+	err = sp.commitJournal.FinishCommit(header.GetNonce(), headerHash)
+	if err != nil {
+		return err
+	}
+
 	// write data to log
 	go sp.txCounter.displayLogInfo(
 		header,
@@ -950,6 +973,8 @@ func (sp *shardProcessor) updateState(headers []data.HeaderHandler, currentHeade
 			break
 		}
 
+		sp.archiveFinalRootHashes(hdr)
+
 		prevHeader, errNotCritical := process.GetShardHeader(
 			hdr.GetPrevHash(),
 			sp.dataPool.Headers(),
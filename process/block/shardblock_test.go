@@ -1775,6 +1775,113 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+func TestShardProcessor_CommitBlockFinishCommitErrorShouldRevertAccountState(t *testing.T) {
+	t.Parallel()
+	tdp := initDataPool([]byte("tx_hash1"))
+	txHash := []byte("tx_hash1")
+
+	rootHash := []byte("root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+
+	prevHdr := &block.Header{
+		Nonce:         0,
+		Round:         0,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		RandSeed:      randSeed,
+	}
+
+	hdr := &block.Header{
+		Nonce:           1,
+		Round:           1,
+		PubKeysBitmap:   rootHash,
+		PrevHash:        hdrHash,
+		Signature:       rootHash,
+		RootHash:        rootHash,
+		PrevRandSeed:    randSeed,
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+	mb := block.MiniBlock{
+		TxHashes: [][]byte{txHash},
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{&mb}}
+
+	mbHdr := block.MiniBlockHeader{
+		TxCount: uint32(len(mb.TxHashes)),
+		Hash:    hdrHash,
+	}
+	mbHdrs := make([]block.MiniBlockHeader, 0)
+	mbHdrs = append(mbHdrs, mbHdr)
+	hdr.MiniBlockHeaders = mbHdrs
+
+	revertCalled := false
+	accounts := &mock.AccountsStub{
+		CommitCalled: func() (i []byte, e error) {
+			return rootHash, nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertCalled = true
+			return nil
+		},
+	}
+	fd := &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+		GetHighestFinalBlockHashCalled: func() []byte {
+			return nil
+		},
+	}
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+	store := initStore()
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = tdp
+	arguments.Store = store
+	arguments.Hasher = hasher
+	arguments.AccountsDB[state.UserAccountsState] = accounts
+	arguments.ForkDetector = fd
+	blockTrackerMock := mock.NewBlockTrackerMock(mock.NewOneShardCoordinatorMock(), createGenesisBlocks(mock.NewOneShardCoordinatorMock()))
+	blockTrackerMock.GetCrossNotarizedHeaderCalled = func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error) {
+		return &block.MetaBlock{}, []byte("hash"), nil
+	}
+	arguments.BlockTracker = blockTrackerMock
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+	arguments.BlockChain = blkc
+	expectedErr := errors.New("expected error")
+	arguments.CommitJournal = &mock.CommitJournalStub{
+		FinishCommitCalled: func(nonce uint64, headerHash []byte) error {
+			return expectedErr
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.ProcessBlock(hdr, body, haveTime)
+	assert.Nil(t, err)
+	err = sp.CommitBlock(hdr, body)
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, revertCalled)
+}
+
 func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -4149,6 +4256,108 @@ func TestShardProcessor_updateStateStorage(t *testing.T) {
 	assert.True(t, cancelPruneWasCalled)
 }
 
+func createMockArgumentsArchivingInMap(numStoredHeaders uint64, archived map[uint64][]byte) blproc.ArgShardProcessor {
+	storedData := make(map[string][]byte)
+	for nonce := uint64(1); nonce <= numStoredHeaders; nonce++ {
+		hash := fmt.Sprintf("hash%d", nonce)
+		hdr := &block.Header{Nonce: nonce, RootHash: []byte(fmt.Sprintf("root hash %d", nonce))}
+		storedData[fmt.Sprintf("nonce%d", nonce)] = []byte(hash)
+		storedData[hash], _ = json.Marshal(hdr)
+	}
+	hdrStore := &mock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			buff, ok := storedData[string(key)]
+			if !ok {
+				return nil, errors.New("key not found")
+			}
+			return buff, nil
+		},
+	}
+
+	lastArchivedNonce := uint64(0)
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.DataPool = testscommon.NewPoolsHolderMock()
+	arguments.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return hdrStore
+		},
+	}
+	arguments.Uint64Converter = &mock.Uint64ByteSliceConverterMock{
+		ToByteSliceCalled: func(nonce uint64) []byte {
+			return []byte(fmt.Sprintf("nonce%d", nonce))
+		},
+	}
+	arguments.BlockTracker = &mock.BlockTrackerMock{}
+	arguments.ForkDetector = &mock.ForkDetectorMock{
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return numStoredHeaders + 1
+		},
+	}
+	arguments.BlockChain = &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{Nonce: 0, RootHash: []byte("genesis root hash")}
+		},
+	}
+	arguments.AccountsDB[state.UserAccountsState] = &mock.AccountsStub{
+		IsArchiveModeEnabledCalled: func() bool {
+			return true
+		},
+		ArchiveRootHashCalled: func(nonce uint64, epoch uint32, rootHash []byte) error {
+			archived[nonce] = rootHash
+			lastArchivedNonce = nonce
+			return nil
+		},
+		GetLastArchivedNonceInEpochCalled: func(epoch uint32) (uint64, error) {
+			if len(archived) == 0 {
+				return 0, state.ErrRootHashNotArchived
+			}
+			return lastArchivedNonce, nil
+		},
+	}
+
+	return arguments
+}
+
+func TestShardProcessor_updateStateStorageShouldArchiveAllFinalHeadersIncludingGenesis(t *testing.T) {
+	t.Parallel()
+
+	archived := make(map[uint64][]byte)
+	sp, _ := blproc.NewShardProcessor(createMockArgumentsArchivingInMap(2, archived))
+
+	hdr3 := &block.Header{Nonce: 3, PrevHash: []byte("hash2"), RootHash: []byte("root hash 3")}
+	sp.UpdateStateStorage([]data.HeaderHandler{hdr3}, &block.Header{})
+
+	expected := map[uint64][]byte{
+		0: []byte("genesis root hash"),
+		1: []byte("root hash 1"),
+		2: []byte("root hash 2"),
+		3: []byte("root hash 3"),
+	}
+	assert.Equal(t, expected, archived)
+}
+
+func TestShardProcessor_updateStateStorageShouldArchiveTheMissingFinalHeadersInBatches(t *testing.T) {
+	t.Parallel()
+
+	archived := make(map[uint64][]byte)
+	sp, _ := blproc.NewShardProcessor(createMockArgumentsArchivingInMap(250, archived))
+	finalHeader := &block.Header{Nonce: 251, RootHash: []byte("root hash 251")}
+
+	sp.UpdateStateStorage([]data.HeaderHandler{finalHeader}, &block.Header{})
+	assert.Equal(t, 100, len(archived))
+	assert.Equal(t, []byte("genesis root hash"), archived[0])
+	assert.Equal(t, []byte("root hash 99"), archived[99])
+
+	sp.UpdateStateStorage([]data.HeaderHandler{finalHeader}, &block.Header{})
+	assert.Equal(t, 200, len(archived))
+	assert.Equal(t, []byte("root hash 199"), archived[199])
+
+	sp.UpdateStateStorage([]data.HeaderHandler{finalHeader}, &block.Header{})
+	assert.Equal(t, 252, len(archived))
+	assert.Equal(t, []byte("root hash 250"), archived[250])
+	assert.Equal(t, []byte("root hash 251"), archived[251])
+}
+
 func TestShardProcessor_checkEpochCorrectnessCrossChainNilCurrentBlock(t *testing.T) {
 	t.Parallel()
 
@@ -117,3 +117,7 @@ const MaxRoundsToKeepUnprocessedTransactions = 100
 
 // MaxHeadersToWhitelistInAdvance defines the maximum number of headers whose miniblocks will be whitelisted in advance
 const MaxHeadersToWhitelistInAdvance = 20
+
+// BackupLeaderIndex defines the index in the consensus group of the validator which proposes the block when the
+// leader did not propose it in time
+const BackupLeaderIndex = 1
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/tracing"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -52,6 +53,7 @@ type transactionCoordinator struct {
 	blockSizeComputation  preprocess.BlockSizeComputationHandler
 	balanceComputation    preprocess.BalanceComputationHandler
 	requestedItemsHandler process.TimeCacher
+	tracer                core.Tracer
 }
 
 // NewTransactionCoordinator creates a transaction coordinator to run and coordinate preprocessors and processors
@@ -116,6 +118,7 @@ func NewTransactionCoordinator(
 		feeHandler:           feeHandler,
 		blockSizeComputation: blockSizeComputation,
 		balanceComputation:   balanceComputation,
+		tracer:               tracing.NewDisabledTracer(),
 	}
 
 	tc.miniBlockPool = miniBlockPool
@@ -251,12 +254,25 @@ func (tc *transactionCoordinator) IsDataPreparedForProcessing(haveTime func() ti
 	return errFound
 }
 
+// SetTracer sets the tracer recording the spans of the preprocessors
+func (tc *transactionCoordinator) SetTracer(tracer core.Tracer) error {
+	if check.IfNil(tracer) {
+		return process.ErrNilTracer
+	}
+
+	tc.tracer = tracer
+	return nil
+}
+
 // SaveBlockDataToStorage saves the data from block body into storage units
 func (tc *transactionCoordinator) SaveBlockDataToStorage(body *block.Body) error {
 	if check.IfNil(body) {
 		return nil
 	}
 
+	span := tc.tracer.StartSpan("transactionCoordinator.SaveBlockDataToStorage")
+	defer span.End()
+
 	separatedBodies := tc.separateBodyByType(body)
 	for key, value := range separatedBodies {
 		err := tc.saveTxBlockToStorage(key, value)
@@ -400,6 +416,9 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 		return process.ErrNilBlockBody
 	}
 
+	span := tc.tracer.StartSpan("transactionCoordinator.ProcessBlockTransaction")
+	defer span.End()
+
 	haveTime := func() bool {
 		return timeRemaining() >= 0
 	}
@@ -462,7 +481,7 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 			return process.ErrMissingPreProcessor
 		}
 
-		err := preProc.ProcessBlockTransactions(separatedBodies[blockType], haveTime)
+		err := tc.processBlockTransactions(preProc, blockType, separatedBodies[blockType], haveTime)
 		if err != nil {
 			return err
 		}
@@ -471,6 +490,19 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 	return nil
 }
 
+func (tc *transactionCoordinator) processBlockTransactions(
+	preProc process.PreProcessor,
+	blockType block.Type,
+	body *block.Body,
+	haveTime func() bool,
+) error {
+	span := tc.tracer.StartSpan("preprocessor.ProcessBlockTransactions")
+	span.SetAttribute(core.TraceAttributeBlockType, blockType.String())
+	defer span.End()
+
+	return preProc.ProcessBlockTransactions(body, haveTime)
+}
+
 func (tc *transactionCoordinator) processMiniBlocksToMe(
 	body *block.Body,
 	haveTime func() bool,
@@ -489,7 +521,7 @@ func (tc *transactionCoordinator) processMiniBlocksToMe(
 			return mbIndex, process.ErrMissingPreProcessor
 		}
 
-		err := preProc.ProcessBlockTransactions(&block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, haveTime)
+		err := tc.processBlockTransactions(preProc, miniBlock.Type, &block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, haveTime)
 		if err != nil {
 			return mbIndex, err
 		}
@@ -514,6 +546,9 @@ func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe
 		return miniBlocks, nrTxAdded, false, nil
 	}
 
+	span := tc.tracer.StartSpan("transactionCoordinator.CreateMbsAndProcessCrossShardTransactionsDstMe")
+	defer span.End()
+
 	crossMiniBlockHashes := hdr.GetMiniBlockHeadersWithDst(tc.shardCoordinator.SelfId())
 	for key, senderShardId := range crossMiniBlockHashes {
 		if !haveTime() {
@@ -583,7 +618,7 @@ func (tc *transactionCoordinator) CreateMbsAndProcessTransactionsFromMe(
 			return nil
 		}
 
-		mbs, err := txPreProc.CreateAndProcessMiniBlocks(haveTime)
+		mbs, err := tc.createAndProcessMiniBlocks(txPreProc, blockType, haveTime)
 		if err != nil {
 			log.Debug("CreateAndProcessMiniBlocks", "error", err.Error())
 		}
@@ -601,6 +636,18 @@ func (tc *transactionCoordinator) CreateMbsAndProcessTransactionsFromMe(
 	return miniBlocks
 }
 
+func (tc *transactionCoordinator) createAndProcessMiniBlocks(
+	preProc process.PreProcessor,
+	blockType block.Type,
+	haveTime func() bool,
+) (block.MiniBlockSlice, error) {
+	span := tc.tracer.StartSpan("preprocessor.CreateAndProcessMiniBlocks")
+	span.SetAttribute(core.TraceAttributeBlockType, blockType.String())
+	defer span.End()
+
+	return preProc.CreateAndProcessMiniBlocks(haveTime)
+}
+
 // CreatePostProcessMiniBlocks returns all the post processed miniblocks
 func (tc *transactionCoordinator) CreatePostProcessMiniBlocks() block.MiniBlockSlice {
 	miniBlocks := make(block.MiniBlockSlice, 0)
@@ -870,6 +917,9 @@ func (tc *transactionCoordinator) revertProcessedTxsResults(txHashes [][]byte) {
 
 // VerifyCreatedBlockTransactions checks whether the created transactions are the same as the one proposed
 func (tc *transactionCoordinator) VerifyCreatedBlockTransactions(hdr data.HeaderHandler, body *block.Body) error {
+	span := tc.tracer.StartSpan("transactionCoordinator.VerifyCreatedBlockTransactions")
+	defer span.End()
+
 	tc.mutInterimProcessors.RLock()
 	defer tc.mutInterimProcessors.RUnlock()
 	errMutex := sync.Mutex{}
@@ -1903,6 +1903,83 @@ func TestTransactionCoordinator_ProcessBlockTransaction(t *testing.T) {
 	assert.Equal(t, process.ErrMissingTransaction, err)
 }
 
+func TestTransactionCoordinator_SetTracerNilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tc, _ := NewTransactionCoordinator(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		initAccountsMock(),
+		initDataPool([]byte("tx_hash1")).MiniBlocks(),
+		&mock.RequestHandlerStub{},
+		&mock.PreProcessorContainerMock{},
+		&mock.InterimProcessorContainerMock{},
+		&mock.GasHandlerMock{},
+		&mock.FeeAccumulatorStub{},
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+	)
+
+	err := tc.SetTracer(nil)
+	assert.Equal(t, process.ErrNilTracer, err)
+}
+
+func TestTransactionCoordinator_ProcessBlockTransactionShouldTraceThePreprocessors(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx_hash1")
+	dataPool := initDataPool(txHash)
+	tc, _ := NewTransactionCoordinator(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		initAccountsMock(),
+		dataPool.MiniBlocks(),
+		&mock.RequestHandlerStub{},
+		createPreProcessorContainerWithDataPool(dataPool, FeeHandlerMock()),
+		&mock.InterimProcessorContainerMock{},
+		&mock.GasHandlerMock{},
+		&mock.FeeAccumulatorStub{},
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+	)
+
+	startedSpans := make([]string, 0)
+	numEndedSpans := 0
+	spansAttributes := make(map[string]interface{})
+	err := tc.SetTracer(&mock.TracerStub{
+		StartSpanCalled: func(name string) core.Span {
+			startedSpans = append(startedSpans, name)
+			return &mock.SpanStub{
+				SetAttributeCalled: func(key string, value interface{}) {
+					spansAttributes[key] = value
+				},
+				EndCalled: func() {
+					numEndedSpans++
+				},
+			}
+		},
+	})
+	assert.Nil(t, err)
+
+	body := &block.Body{}
+	miniBlock := &block.MiniBlock{SenderShardID: 1, ReceiverShardID: 0, Type: block.TxBlock, TxHashes: [][]byte{txHash}}
+	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	tc.RequestBlockTransactions(body)
+
+	haveTime := func() time.Duration {
+		return time.Second
+	}
+	err = tc.ProcessBlockTransaction(body, haveTime)
+	assert.Nil(t, err)
+
+	expectedSpans := []string{"transactionCoordinator.ProcessBlockTransaction", "preprocessor.ProcessBlockTransactions"}
+	assert.Equal(t, expectedSpans, startedSpans)
+	assert.Equal(t, len(expectedSpans), numEndedSpans)
+	assert.Equal(t, block.TxBlock.String(), spansAttributes[core.TraceAttributeBlockType])
+}
+
 func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 	t.Parallel()
 
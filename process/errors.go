@@ -395,6 +395,9 @@ var ErrMissingPreProcessor = errors.New("pre processor is missing")
 // ErrNilAppStatusHandler defines the error for setting a nil AppStatusHandler
 var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilTracer signals that a nil tracer has been provided
+var ErrNilTracer = errors.New("nil tracer")
+
 // ErrNilInterceptedDataFactory signals that a nil intercepted data factory was provided
 var ErrNilInterceptedDataFactory = errors.New("nil intercepted data factory")
 
@@ -656,6 +659,9 @@ var ErrNilEpochEconomics = errors.New("nil epoch economics")
 // ErrNilEpochStartDataCreator signals that nil epoch start data creator was provided
 var ErrNilEpochStartDataCreator = errors.New("nil epoch start data creator")
 
+// ErrNilEpochStartRoundsScheduleCreator signals that nil epoch start rounds schedule creator was provided
+var ErrNilEpochStartRoundsScheduleCreator = errors.New("nil epoch start rounds schedule creator")
+
 // ErrNilEpochStartRewardsCreator signals that nil epoch start rewards creator was provided
 var ErrNilEpochStartRewardsCreator = errors.New("nil epoch start rewards creator")
 
@@ -719,6 +725,9 @@ var ErrNilBlockSizeThrottler = errors.New("block size throttler is nil")
 // ErrNilHistoryRepository signals that history processor is nil
 var ErrNilHistoryRepository = errors.New("history repository is nil")
 
+// ErrNilCommitJournal signals that a nil commit journal has been provided
+var ErrNilCommitJournal = errors.New("nil commit journal")
+
 // ErrInvalidMetaTransaction signals that meta transaction is invalid
 var ErrInvalidMetaTransaction = errors.New("meta transaction is invalid")
 
@@ -764,6 +773,12 @@ var ErrNilRatingsInfoHandler = errors.New("nil ratings info handler")
 // ErrNilDebugger signals that a nil debug handler has been provided
 var ErrNilDebugger = errors.New("nil debug handler")
 
+// ErrNilPeersRatingHandler signals that a nil peers rating handler has been provided
+var ErrNilPeersRatingHandler = errors.New("nil peers rating handler")
+
+// ErrNilAntifloodHitsRecorder signals that a nil antiflood hits recorder has been provided
+var ErrNilAntifloodHitsRecorder = errors.New("nil antiflood hits recorder")
+
 // ErrBuiltInFunctionCalledWithValue signals that builtin function was called with value that is not allowed
 var ErrBuiltInFunctionCalledWithValue = errors.New("built in function called with tx value is not allowed")
 
@@ -865,3 +880,33 @@ var ErrBuiltInFunctionsAreDisabled = errors.New("built in functions are disabled
 
 // ErrRelayedTxDisabled signals that relayed tx are disabled
 var ErrRelayedTxDisabled = errors.New("relayed tx is disabled")
+
+// ErrNilPeerStore signals that a nil peer store was provided
+var ErrNilPeerStore = errors.New("nil peer store")
+
+// ErrNilPeerBanHandler signals that a nil peer ban handler was provided
+var ErrNilPeerBanHandler = errors.New("nil peer ban handler")
+
+// ErrNilEquivocationEvidence signals that a nil equivocation evidence has been provided
+var ErrNilEquivocationEvidence = errors.New("nil equivocation evidence")
+
+// ErrInvalidEquivocationEvidence signals that the provided equivocation evidence does not prove a double signing
+var ErrInvalidEquivocationEvidence = errors.New("invalid equivocation evidence")
+
+// ErrNilEvidenceVerifier signals that a nil equivocation evidence verifier has been provided
+var ErrNilEvidenceVerifier = errors.New("nil equivocation evidence verifier")
+
+// ErrNilSystemSCCallExecutor signals that a nil system smart contract call executor has been provided
+var ErrNilSystemSCCallExecutor = errors.New("nil system smart contract call executor")
+
+// ErrNilEquivocationEvidenceProcessor signals that a nil equivocation evidence processor has been provided
+var ErrNilEquivocationEvidenceProcessor = errors.New("nil equivocation evidence processor")
+
+// ErrEquivocationSlashMismatch signals that an included equivocation evidence did not produce the announced slash
+var ErrEquivocationSlashMismatch = errors.New("equivocation slash mismatch")
+
+// ErrPeerInfoInEpochStartBlock signals that peer actions were found in a start of epoch block
+var ErrPeerInfoInEpochStartBlock = errors.New("peer info is not allowed in a start of epoch block")
+
+// ErrInvalidLeaderIndex signals that the leader index of a header is outside of its consensus group
+var ErrInvalidLeaderIndex = errors.New("invalid leader index")
@@ -51,11 +51,13 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 				OwnerAddress:    "aaaaaa",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
@@ -126,11 +128,13 @@ func TestVmContainerFactory_Create(t *testing.T) {
 				OwnerAddress:    "aaaaaa",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
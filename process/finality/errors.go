@@ -0,0 +1,9 @@
+package finality
+
+import "errors"
+
+// ErrInvalidHistorySize signals that an invalid history size has been provided
+var ErrInvalidHistorySize = errors.New("invalid history size")
+
+// ErrNoFinalBlock signals that no block has been announced as final for the requested shard
+var ErrNoFinalBlock = errors.New("no final block")
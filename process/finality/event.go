@@ -0,0 +1,23 @@
+package finality
+
+// EventType defines the type of a finality event
+type EventType string
+
+const (
+	// BlockFinal signals that a block became final as it has been notarized by a final metablock
+	BlockFinal EventType = "final"
+	// BlockReverted signals that a block previously announced as final has been reverted because of a fork
+	BlockReverted EventType = "reverted"
+)
+
+// Event holds the information about a block which became final or has been reverted
+type Event struct {
+	Index     uint64
+	Type      EventType
+	ShardID   uint32
+	Nonce     uint64
+	Round     uint64
+	Hash      []byte
+	MetaNonce uint64
+	MetaHash  []byte
+}
@@ -0,0 +1,305 @@
+package finality
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("process/finality")
+
+// ArgFinalityTracker holds the arguments needed to create a finality tracker
+type ArgFinalityTracker struct {
+	BlockTracker     NotarizedHeadersNotifier
+	ShardCoordinator sharding.Coordinator
+	HistorySize      int
+}
+
+type finalMetaBlock struct {
+	nonce       uint64
+	hash        []byte
+	finalEvents []Event
+}
+
+// finalityTracker announces the blocks notarized by final metablocks as final blocks. When a final metablock
+// is replaced by another one on the same nonce, all the blocks announced from that nonce onwards are reverted
+type finalityTracker struct {
+	historySize int
+
+	mutProcess sync.Mutex
+
+	mutState        sync.RWMutex
+	finalMetaBlocks []*finalMetaBlock
+	lastFinal       map[uint32]Event
+	events          []Event
+	nextIndex       uint64
+
+	mutHandlers sync.RWMutex
+	handlers    []func(event Event)
+}
+
+// NewFinalityTracker creates a finality tracker which listens to the final metablocks computed by the block tracker
+func NewFinalityTracker(args ArgFinalityTracker) (*finalityTracker, error) {
+	if check.IfNil(args.BlockTracker) {
+		return nil, process.ErrNilBlockTracker
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if args.HistorySize < 1 {
+		return nil, ErrInvalidHistorySize
+	}
+
+	ft := &finalityTracker{
+		historySize:     args.HistorySize,
+		finalMetaBlocks: make([]*finalMetaBlock, 0),
+		lastFinal:       make(map[uint32]Event),
+		events:          make([]Event, 0),
+		handlers:        make([]func(event Event), 0),
+	}
+
+	// on a shard the final metablocks are the cross notarized ones while on the metachain they are the self notarized
+	if args.ShardCoordinator.SelfId() == core.MetachainShardId {
+		args.BlockTracker.RegisterSelfNotarizedHeadersHandler(ft.ReceivedFinalMetaBlocks)
+	} else {
+		args.BlockTracker.RegisterCrossNotarizedHeadersHandler(ft.ReceivedFinalMetaBlocks)
+	}
+
+	return ft, nil
+}
+
+// RegisterHandler registers a handler which will be called, in order, for each final or reverted block.
+// The handlers are called synchronously so they should not block
+func (ft *finalityTracker) RegisterHandler(handler func(event Event)) {
+	if handler == nil {
+		log.Warn("attempt to register a nil handler to a finality tracker object")
+		return
+	}
+
+	ft.mutHandlers.Lock()
+	ft.handlers = append(ft.handlers, handler)
+	ft.mutHandlers.Unlock()
+}
+
+// ReceivedFinalMetaBlocks is a registered call handler through which the finality tracker is notified about the
+// metablocks which have enough attesting blocks to be considered final
+func (ft *finalityTracker) ReceivedFinalMetaBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
+	if shardID != core.MetachainShardId {
+		return
+	}
+	if len(headers) != len(headersHashes) {
+		return
+	}
+
+	ft.mutProcess.Lock()
+	defer ft.mutProcess.Unlock()
+
+	events := make([]Event, 0)
+
+	ft.mutState.Lock()
+	for i, header := range headers {
+		metaBlock, ok := header.(*block.MetaBlock)
+		if !ok || check.IfNil(metaBlock) {
+			continue
+		}
+
+		events = append(events, ft.addFinalMetaBlock(metaBlock, headersHashes[i])...)
+	}
+	ft.mutState.Unlock()
+
+	ft.callHandlers(events)
+}
+
+func (ft *finalityTracker) addFinalMetaBlock(metaBlock *block.MetaBlock, metaBlockHash []byte) []Event {
+	events := make([]Event, 0)
+
+	numFinalMetaBlocks := len(ft.finalMetaBlocks)
+	if numFinalMetaBlocks > 0 && metaBlock.Nonce < ft.finalMetaBlocks[0].nonce {
+		return events
+	}
+
+	index := sort.Search(numFinalMetaBlocks, func(i int) bool {
+		return ft.finalMetaBlocks[i].nonce >= metaBlock.Nonce
+	})
+	if index < numFinalMetaBlocks {
+		isSameMetaBlock := ft.finalMetaBlocks[index].nonce == metaBlock.Nonce &&
+			bytes.Equal(ft.finalMetaBlocks[index].hash, metaBlockHash)
+		if isSameMetaBlock {
+			return events
+		}
+
+		log.Debug("final metablock has been replaced",
+			"nonce", metaBlock.Nonce,
+			"hash", metaBlockHash,
+		)
+		events = append(events, ft.revertFinalMetaBlocksFromIndex(index)...)
+	}
+
+	finalMeta := &finalMetaBlock{
+		nonce:       metaBlock.Nonce,
+		hash:        metaBlockHash,
+		finalEvents: make([]Event, 0, len(metaBlock.ShardInfo)+1),
+	}
+
+	for _, shardData := range metaBlock.ShardInfo {
+		event := ft.addEvent(Event{
+			Type:      BlockFinal,
+			ShardID:   shardData.ShardID,
+			Nonce:     shardData.Nonce,
+			Round:     shardData.Round,
+			Hash:      shardData.HeaderHash,
+			MetaNonce: metaBlock.Nonce,
+			MetaHash:  metaBlockHash,
+		})
+		finalMeta.finalEvents = append(finalMeta.finalEvents, event)
+	}
+
+	event := ft.addEvent(Event{
+		Type:      BlockFinal,
+		ShardID:   core.MetachainShardId,
+		Nonce:     metaBlock.Nonce,
+		Round:     metaBlock.Round,
+		Hash:      metaBlockHash,
+		MetaNonce: metaBlock.Nonce,
+		MetaHash:  metaBlockHash,
+	})
+	finalMeta.finalEvents = append(finalMeta.finalEvents, event)
+	events = append(events, finalMeta.finalEvents...)
+
+	ft.finalMetaBlocks = append(ft.finalMetaBlocks, finalMeta)
+	if len(ft.finalMetaBlocks) > ft.historySize {
+		ft.finalMetaBlocks = ft.finalMetaBlocks[len(ft.finalMetaBlocks)-ft.historySize:]
+	}
+
+	return events
+}
+
+func (ft *finalityTracker) revertFinalMetaBlocksFromIndex(index int) []Event {
+	events := make([]Event, 0)
+	revertedShards := make(map[uint32]struct{})
+
+	for i := len(ft.finalMetaBlocks) - 1; i >= index; i-- {
+		finalEvents := ft.finalMetaBlocks[i].finalEvents
+		for j := len(finalEvents) - 1; j >= 0; j-- {
+			revertedEvent := finalEvents[j]
+			revertedEvent.Type = BlockReverted
+			events = append(events, ft.addEvent(revertedEvent))
+			revertedShards[revertedEvent.ShardID] = struct{}{}
+		}
+	}
+
+	ft.finalMetaBlocks = ft.finalMetaBlocks[:index]
+
+	for shardID := range revertedShards {
+		ft.recomputeLastFinal(shardID)
+	}
+
+	return events
+}
+
+func (ft *finalityTracker) recomputeLastFinal(shardID uint32) {
+	delete(ft.lastFinal, shardID)
+
+	for i := len(ft.finalMetaBlocks) - 1; i >= 0; i-- {
+		finalEvents := ft.finalMetaBlocks[i].finalEvents
+		for j := len(finalEvents) - 1; j >= 0; j-- {
+			if finalEvents[j].ShardID == shardID {
+				ft.lastFinal[shardID] = finalEvents[j]
+				return
+			}
+		}
+	}
+}
+
+func (ft *finalityTracker) addEvent(event Event) Event {
+	event.Index = ft.nextIndex
+	ft.nextIndex++
+
+	ft.events = append(ft.events, event)
+	if len(ft.events) > ft.historySize {
+		ft.events = ft.events[len(ft.events)-ft.historySize:]
+	}
+
+	if event.Type == BlockFinal {
+		lastFinal, ok := ft.lastFinal[event.ShardID]
+		if !ok || lastFinal.Nonce <= event.Nonce {
+			ft.lastFinal[event.ShardID] = event
+		}
+	}
+
+	return event
+}
+
+func (ft *finalityTracker) callHandlers(events []Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	ft.mutHandlers.RLock()
+	handlers := make([]func(event Event), len(ft.handlers))
+	copy(handlers, ft.handlers)
+	ft.mutHandlers.RUnlock()
+
+	for _, event := range events {
+		for _, handler := range handlers {
+			handler(event)
+		}
+	}
+}
+
+// GetLastFinalBlock returns the last block announced as final for the given shard
+func (ft *finalityTracker) GetLastFinalBlock(shardID uint32) (Event, error) {
+	ft.mutState.RLock()
+	defer ft.mutState.RUnlock()
+
+	event, ok := ft.lastFinal[shardID]
+	if !ok {
+		return Event{}, ErrNoFinalBlock
+	}
+
+	return event, nil
+}
+
+// GetLastFinalBlocks returns the last blocks announced as final, sorted by shard
+func (ft *finalityTracker) GetLastFinalBlocks() []Event {
+	ft.mutState.RLock()
+	events := make([]Event, 0, len(ft.lastFinal))
+	for _, event := range ft.lastFinal {
+		events = append(events, event)
+	}
+	ft.mutState.RUnlock()
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ShardID < events[j].ShardID
+	})
+
+	return events
+}
+
+// GetEventsFromIndex returns the kept events which have an index greater or equal than the given one
+func (ft *finalityTracker) GetEventsFromIndex(index uint64) []Event {
+	ft.mutState.RLock()
+	defer ft.mutState.RUnlock()
+
+	position := sort.Search(len(ft.events), func(i int) bool {
+		return ft.events[i].Index >= index
+	})
+
+	events := make([]Event, len(ft.events)-position)
+	copy(events, ft.events[position:])
+
+	return events
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ft *finalityTracker) IsInterfaceNil() bool {
+	return ft == nil
+}
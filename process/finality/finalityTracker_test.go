@@ -0,0 +1,289 @@
+package finality_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/finality"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type notarizedHeadersHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+
+func createMockArgFinalityTracker() finality.ArgFinalityTracker {
+	return finality.ArgFinalityTracker{
+		BlockTracker:     &mock.NotarizedHeadersNotifierStub{},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		HistorySize:      10,
+	}
+}
+
+type finalityTracker interface {
+	RegisterHandler(handler func(event finality.Event))
+	GetLastFinalBlock(shardID uint32) (finality.Event, error)
+	GetLastFinalBlocks() []finality.Event
+	GetEventsFromIndex(index uint64) []finality.Event
+}
+
+func createFinalityTracker(t *testing.T, historySize int) (finalityTracker, notarizedHeadersHandler, *[]finality.Event) {
+	var receivedFinalMetaBlocks notarizedHeadersHandler
+	args := createMockArgFinalityTracker()
+	args.HistorySize = historySize
+	args.BlockTracker = &mock.NotarizedHeadersNotifierStub{
+		RegisterCrossNotarizedHeadersHandlerCalled: func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			receivedFinalMetaBlocks = handler
+		},
+	}
+
+	ft, err := finality.NewFinalityTracker(args)
+	require.Nil(t, err)
+
+	events := make([]finality.Event, 0)
+	ft.RegisterHandler(func(event finality.Event) {
+		events = append(events, event)
+	})
+
+	return ft, receivedFinalMetaBlocks, &events
+}
+
+func createMetaBlock(nonce uint64, shardHeaderHashes ...string) *block.MetaBlock {
+	shardInfo := make([]block.ShardData, 0, len(shardHeaderHashes))
+	for i, hash := range shardHeaderHashes {
+		shardInfo = append(shardInfo, block.ShardData{
+			ShardID:    uint32(i),
+			Nonce:      nonce,
+			Round:      nonce,
+			HeaderHash: []byte(hash),
+		})
+	}
+
+	return &block.MetaBlock{
+		Nonce:     nonce,
+		Round:     nonce,
+		ShardInfo: shardInfo,
+	}
+}
+
+func TestNewFinalityTracker_NilBlockTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgFinalityTracker()
+	args.BlockTracker = nil
+
+	ft, err := finality.NewFinalityTracker(args)
+	assert.True(t, check.IfNil(ft))
+	assert.Equal(t, process.ErrNilBlockTracker, err)
+}
+
+func TestNewFinalityTracker_NilShardCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgFinalityTracker()
+	args.ShardCoordinator = nil
+
+	ft, err := finality.NewFinalityTracker(args)
+	assert.True(t, check.IfNil(ft))
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestNewFinalityTracker_InvalidHistorySizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgFinalityTracker()
+	args.HistorySize = 0
+
+	ft, err := finality.NewFinalityTracker(args)
+	assert.True(t, check.IfNil(ft))
+	assert.Equal(t, finality.ErrInvalidHistorySize, err)
+}
+
+func TestNewFinalityTracker_ShouldRegisterOnCrossNotarizedHeadersForShard(t *testing.T) {
+	t.Parallel()
+
+	crossRegistered := false
+	selfRegistered := false
+	args := createMockArgFinalityTracker()
+	args.BlockTracker = &mock.NotarizedHeadersNotifierStub{
+		RegisterCrossNotarizedHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			crossRegistered = true
+		},
+		RegisterSelfNotarizedHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			selfRegistered = true
+		},
+	}
+
+	ft, err := finality.NewFinalityTracker(args)
+	assert.False(t, check.IfNil(ft))
+	assert.Nil(t, err)
+	assert.True(t, crossRegistered)
+	assert.False(t, selfRegistered)
+}
+
+func TestNewFinalityTracker_ShouldRegisterOnSelfNotarizedHeadersForMetachain(t *testing.T) {
+	t.Parallel()
+
+	crossRegistered := false
+	selfRegistered := false
+	args := createMockArgFinalityTracker()
+	args.ShardCoordinator = &mock.CoordinatorStub{
+		SelfIdCalled: func() uint32 {
+			return core.MetachainShardId
+		},
+	}
+	args.BlockTracker = &mock.NotarizedHeadersNotifierStub{
+		RegisterCrossNotarizedHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			crossRegistered = true
+		},
+		RegisterSelfNotarizedHeadersHandlerCalled: func(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+			selfRegistered = true
+		},
+	}
+
+	ft, err := finality.NewFinalityTracker(args)
+	assert.False(t, check.IfNil(ft))
+	assert.Nil(t, err)
+	assert.False(t, crossRegistered)
+	assert.True(t, selfRegistered)
+}
+
+func TestFinalityTracker_ReceivedFinalMetaBlocksShouldAnnounceNotarizedBlocks(t *testing.T) {
+	t.Parallel()
+
+	_, receivedFinalMetaBlocks, events := createFinalityTracker(t, 10)
+
+	metaBlock := createMetaBlock(5, "hdr0", "hdr1")
+	receivedFinalMetaBlocks(core.MetachainShardId, []data.HeaderHandler{metaBlock}, [][]byte{[]byte("meta5")})
+
+	require.Equal(t, 3, len(*events))
+	assert.Equal(t, finality.Event{
+		Index:     0,
+		Type:      finality.BlockFinal,
+		ShardID:   0,
+		Nonce:     5,
+		Round:     5,
+		Hash:      []byte("hdr0"),
+		MetaNonce: 5,
+		MetaHash:  []byte("meta5"),
+	}, (*events)[0])
+	assert.Equal(t, []byte("hdr1"), (*events)[1].Hash)
+	assert.Equal(t, uint32(1), (*events)[1].ShardID)
+	assert.Equal(t, core.MetachainShardId, (*events)[2].ShardID)
+	assert.Equal(t, []byte("meta5"), (*events)[2].Hash)
+	assert.Equal(t, uint64(2), (*events)[2].Index)
+}
+
+func TestFinalityTracker_ReceivedFinalMetaBlocksShouldIgnoreOtherShardsAndAlreadyAnnounced(t *testing.T) {
+	t.Parallel()
+
+	_, receivedFinalMetaBlocks, events := createFinalityTracker(t, 10)
+
+	metaBlock := createMetaBlock(5, "hdr0")
+	receivedFinalMetaBlocks(0, []data.HeaderHandler{metaBlock}, [][]byte{[]byte("meta5")})
+	assert.Equal(t, 0, len(*events))
+
+	receivedFinalMetaBlocks(core.MetachainShardId, []data.HeaderHandler{metaBlock}, [][]byte{[]byte("meta5")})
+	receivedFinalMetaBlocks(core.MetachainShardId, []data.HeaderHandler{metaBlock}, [][]byte{[]byte("meta5")})
+	assert.Equal(t, 2, len(*events))
+}
+
+func TestFinalityTracker_ReceivedFinalMetaBlocksOnForkShouldRevertAnnouncedBlocks(t *testing.T) {
+	t.Parallel()
+
+	ft, receivedFinalMetaBlocks, events := createFinalityTracker(t, 10)
+
+	receivedFinalMetaBlocks(
+		core.MetachainShardId,
+		[]data.HeaderHandler{createMetaBlock(5, "hdr0-5"), createMetaBlock(6, "hdr0-6")},
+		[][]byte{[]byte("meta5"), []byte("meta6")},
+	)
+	require.Equal(t, 4, len(*events))
+
+	lastFinal, err := ft.GetLastFinalBlock(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hdr0-6"), lastFinal.Hash)
+
+	receivedFinalMetaBlocks(
+		core.MetachainShardId,
+		[]data.HeaderHandler{createMetaBlock(6, "hdr0-6bis")},
+		[][]byte{[]byte("meta6bis")},
+	)
+	require.Equal(t, 8, len(*events))
+
+	assert.Equal(t, finality.BlockReverted, (*events)[4].Type)
+	assert.Equal(t, []byte("meta6"), (*events)[4].Hash)
+	assert.Equal(t, finality.BlockReverted, (*events)[5].Type)
+	assert.Equal(t, []byte("hdr0-6"), (*events)[5].Hash)
+	assert.Equal(t, finality.BlockFinal, (*events)[6].Type)
+	assert.Equal(t, []byte("hdr0-6bis"), (*events)[6].Hash)
+	assert.Equal(t, finality.BlockFinal, (*events)[7].Type)
+	assert.Equal(t, []byte("meta6bis"), (*events)[7].Hash)
+
+	lastFinal, _ = ft.GetLastFinalBlock(0)
+	assert.Equal(t, []byte("hdr0-6bis"), lastFinal.Hash)
+	lastFinal, _ = ft.GetLastFinalBlock(core.MetachainShardId)
+	assert.Equal(t, []byte("meta6bis"), lastFinal.Hash)
+}
+
+func TestFinalityTracker_ForkBelowLastFinalShouldRevertAndRecomputeLastFinal(t *testing.T) {
+	t.Parallel()
+
+	ft, receivedFinalMetaBlocks, _ := createFinalityTracker(t, 10)
+
+	receivedFinalMetaBlocks(
+		core.MetachainShardId,
+		[]data.HeaderHandler{createMetaBlock(5, "hdr0-5"), createMetaBlock(6, "hdr0-6"), createMetaBlock(7)},
+		[][]byte{[]byte("meta5"), []byte("meta6"), []byte("meta7")},
+	)
+	receivedFinalMetaBlocks(
+		core.MetachainShardId,
+		[]data.HeaderHandler{createMetaBlock(6)},
+		[][]byte{[]byte("meta6bis")},
+	)
+
+	lastFinal, err := ft.GetLastFinalBlock(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hdr0-5"), lastFinal.Hash)
+
+	lastFinal, err = ft.GetLastFinalBlock(core.MetachainShardId)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("meta6bis"), lastFinal.Hash)
+
+	_, err = ft.GetLastFinalBlock(1)
+	assert.Equal(t, finality.ErrNoFinalBlock, err)
+}
+
+func TestFinalityTracker_GetEventsFromIndexShouldKeepOnlyHistorySize(t *testing.T) {
+	t.Parallel()
+
+	ft, receivedFinalMetaBlocks, _ := createFinalityTracker(t, 3)
+
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		receivedFinalMetaBlocks(
+			core.MetachainShardId,
+			[]data.HeaderHandler{createMetaBlock(nonce)},
+			[][]byte{[]byte{byte(nonce)}},
+		)
+	}
+
+	events := ft.GetEventsFromIndex(0)
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, uint64(2), events[0].Index)
+	assert.Equal(t, uint64(4), events[2].Index)
+
+	events = ft.GetEventsFromIndex(4)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, uint64(5), events[0].Nonce)
+
+	events = ft.GetEventsFromIndex(5)
+	assert.Equal(t, 0, len(events))
+
+	lastFinalBlocks := ft.GetLastFinalBlocks()
+	require.Equal(t, 1, len(lastFinalBlocks))
+	assert.Equal(t, uint64(5), lastFinalBlocks[0].Nonce)
+}
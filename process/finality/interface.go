@@ -0,0 +1,12 @@
+package finality
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// NotarizedHeadersNotifier defines the block tracker subset used to get notified about the notarized headers
+type NotarizedHeadersNotifier interface {
+	RegisterCrossNotarizedHeadersHandler(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+	RegisterSelfNotarizedHeadersHandler(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+	IsInterfaceNil() bool
+}
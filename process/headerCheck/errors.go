@@ -17,3 +17,6 @@ var ErrInvalidChainID = errors.New("invalid chain ID")
 
 // ErrInvalidSoftwareVersion signals that invalid software version was provided
 var ErrInvalidSoftwareVersion = errors.New("invalid software version")
+
+// ErrInvalidLeaderIndex signals that the header was proposed by a validator which is not allowed to propose it
+var ErrInvalidLeaderIndex = errors.New("invalid leader index")
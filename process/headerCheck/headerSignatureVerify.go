@@ -1,6 +1,7 @@
 package headerCheck
 
 import (
+	"fmt"
 	"math/bits"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -26,6 +27,10 @@ type ArgsHeaderSigVerifier struct {
 	MultiSigVerifier  crypto.MultiSigVerifier
 	SingleSigVerifier crypto.SingleSigner
 	KeyGen            crypto.KeyGenerator
+	// BackupLeaderEnabled allows the headers proposed by the backup leader when the leader did not propose in time.
+	// The timing can not be checked on a header, it is enforced by the consensus group validators which reject any
+	// backup leader proposal received before the backup leader slot
+	BackupLeaderEnabled bool
 }
 
 //HeaderSigVerifier is component used to check if a header is valid
@@ -36,6 +41,8 @@ type HeaderSigVerifier struct {
 	multiSigVerifier  crypto.MultiSigVerifier
 	singleSigVerifier crypto.SingleSigner
 	keyGen            crypto.KeyGenerator
+
+	backupLeaderEnabled bool
 }
 
 // NewHeaderSigVerifier will create a new instance of HeaderSigVerifier
@@ -46,12 +53,13 @@ func NewHeaderSigVerifier(arguments *ArgsHeaderSigVerifier) (*HeaderSigVerifier,
 	}
 
 	return &HeaderSigVerifier{
-		marshalizer:       arguments.Marshalizer,
-		hasher:            arguments.Hasher,
-		nodesCoordinator:  arguments.NodesCoordinator,
-		multiSigVerifier:  arguments.MultiSigVerifier,
-		singleSigVerifier: arguments.SingleSigVerifier,
-		keyGen:            arguments.KeyGen,
+		marshalizer:         arguments.Marshalizer,
+		hasher:              arguments.Hasher,
+		nodesCoordinator:    arguments.NodesCoordinator,
+		multiSigVerifier:    arguments.MultiSigVerifier,
+		singleSigVerifier:   arguments.SingleSigVerifier,
+		keyGen:              arguments.KeyGen,
+		backupLeaderEnabled: arguments.BackupLeaderEnabled,
 	}, nil
 }
 
@@ -88,7 +96,13 @@ func (hsv *HeaderSigVerifier) VerifySignature(header data.HeaderHandler) error {
 	if len(bitmap) == 0 {
 		return process.ErrNilPubKeysBitmap
 	}
-	if bitmap[0]&1 == 0 {
+
+	leaderIndex, err := hsv.getLeaderIndex(header)
+	if err != nil {
+		return err
+	}
+	isLeaderIndexOutOfBitmap := int(leaderIndex/8) >= len(bitmap)
+	if isLeaderIndexOutOfBitmap || bitmap[leaderIndex/8]&(1<<(leaderIndex%8)) == 0 {
 		return process.ErrBlockProposerSignatureMissing
 	}
 
@@ -229,7 +243,30 @@ func (hsv *HeaderSigVerifier) verifyLeaderSignature(leaderPubKey crypto.PublicKe
 	return hsv.singleSigVerifier.Verify(leaderPubKey, headerBytes, header.GetLeaderSignature())
 }
 
+// getLeaderIndex returns the index in the consensus group of the node which proposed the header. A header proposed
+// by the backup leader is accepted only if the backup leader is enabled. Whether the leader missed its slot can not be
+// checked here: the validators sign a backup leader proposal only after the backup leader slot, with nothing received
+// from the leader, so VerifySignature enforces the condition through the signature of the backup leader and of the
+// consensus supermajority over the header
+func (hsv *HeaderSigVerifier) getLeaderIndex(header data.HeaderHandler) (uint32, error) {
+	leaderIndex := header.GetLeaderIndex()
+	if leaderIndex == 0 {
+		return leaderIndex, nil
+	}
+
+	if !hsv.backupLeaderEnabled || leaderIndex != process.BackupLeaderIndex {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidLeaderIndex, leaderIndex)
+	}
+
+	return leaderIndex, nil
+}
+
 func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.PublicKey, error) {
+	leaderIndex, err := hsv.getLeaderIndex(header)
+	if err != nil {
+		return nil, err
+	}
+
 	prevRandSeed := header.GetPrevRandSeed()
 
 	// TODO: remove if start of epoch block needs to be validated by the new epoch nodes
@@ -243,7 +280,11 @@ func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.Publi
 		return nil, err
 	}
 
-	leaderPubKeyValidator := headerConsensusGroup[0]
+	if int(leaderIndex) >= len(headerConsensusGroup) {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidLeaderIndex, leaderIndex)
+	}
+
+	leaderPubKeyValidator := headerConsensusGroup[leaderIndex]
 	return hsv.keyGen.PublicKeyFromByteArray(leaderPubKeyValidator.PubKey())
 }
 
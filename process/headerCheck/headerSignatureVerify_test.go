@@ -427,3 +427,126 @@ func TestHeaderSigVerifier_VerifySignatureOk(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, wasCalled)
 }
+
+func TestHeaderSigVerifier_VerifySignatureBackupLeaderDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		PubKeysBitmap: []byte{3},
+		LeaderIndex:   process.BackupLeaderIndex,
+	}
+
+	err := hdrSigVerifier.VerifySignature(header)
+	require.True(t, errors.Is(err, ErrInvalidLeaderIndex))
+}
+
+func TestHeaderSigVerifier_VerifySignatureBackupLeaderSigMissingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.BackupLeaderEnabled = true
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		PubKeysBitmap: []byte{1},
+		LeaderIndex:   process.BackupLeaderIndex,
+	}
+
+	err := hdrSigVerifier.VerifySignature(header)
+	require.Equal(t, process.ErrBlockProposerSignatureMissing, err)
+}
+
+func TestHeaderSigVerifier_VerifySignatureBackupLeaderOk(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	args := createHeaderSigVerifierArgs()
+	args.BackupLeaderEnabled = true
+	nodesCoordinator := &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			v1, _ := sharding.NewValidator([]byte("aaa00000000000000000000000000000"), 1, defaultChancesSelection)
+			v2, _ := sharding.NewValidator([]byte("bbb00000000000000000000000000000"), 1, defaultChancesSelection)
+			return []sharding.Validator{v1, v2}, nil
+		},
+	}
+	args.NodesCoordinator = nodesCoordinator
+
+	args.MultiSigVerifier = &mock.BelNevMock{
+		CreateMock: func(pubKeys []string, index uint16) (signer crypto.MultiSigner, err error) {
+			return &mock.BelNevMock{
+				VerifyMock: func(msg []byte, bitmap []byte) error {
+					wasCalled = true
+					return nil
+				}}, nil
+		},
+	}
+
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		PubKeysBitmap: []byte{2},
+		LeaderIndex:   process.BackupLeaderIndex,
+	}
+
+	err := hdrSigVerifier.VerifySignature(header)
+	require.Equal(t, ErrNotEnoughSignatures, err)
+
+	header.PubKeysBitmap = []byte{3}
+	err = hdrSigVerifier.VerifySignature(header)
+	require.Nil(t, err)
+	require.True(t, wasCalled)
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedAndLeaderSignatureInvalidLeaderIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createHeaderSigVerifierArgs()
+	args.BackupLeaderEnabled = true
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		LeaderIndex: process.BackupLeaderIndex + 1,
+	}
+
+	err := hdrSigVerifier.VerifyRandSeedAndLeaderSignature(header)
+	require.True(t, errors.Is(err, ErrInvalidLeaderIndex))
+}
+
+func TestHeaderSigVerifier_VerifyRandSeedAndLeaderSignatureBackupLeaderShouldUseBackupLeaderKey(t *testing.T) {
+	t.Parallel()
+
+	leaderPk := []byte("aaa00000000000000000000000000000")
+	backupLeaderPk := []byte("bbb00000000000000000000000000000")
+	args := createHeaderSigVerifierArgs()
+	args.BackupLeaderEnabled = true
+
+	var usedPk []byte
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (key crypto.PublicKey, err error) {
+			usedPk = b
+			return &mock.SingleSignPublicKey{}, nil
+		},
+	}
+	count := 0
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			count++
+			return nil
+		},
+	}
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validators []sharding.Validator, err error) {
+			v1, _ := sharding.NewValidator(leaderPk, 1, defaultChancesSelection)
+			v2, _ := sharding.NewValidator(backupLeaderPk, 1, defaultChancesSelection)
+			return []sharding.Validator{v1, v2}, nil
+		},
+	}
+	hdrSigVerifier, _ := NewHeaderSigVerifier(args)
+	header := &dataBlock.Header{
+		LeaderIndex: process.BackupLeaderIndex,
+	}
+
+	err := hdrSigVerifier.VerifyRandSeedAndLeaderSignature(header)
+	require.Nil(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, backupLeaderPk, usedPk)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersRating"
 	"github.com/ElrondNetwork/elrond-go/debug/resolver"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -26,6 +27,8 @@ type MultiDataInterceptor struct {
 	antifloodHandler           process.P2PAntifloodHandler
 	mutInterceptedDebugHandler sync.RWMutex
 	interceptedDebugHandler    process.InterceptedDebugger
+	mutPeersRatingHandler      sync.RWMutex
+	peersRatingHandler         process.PeersRatingHandler
 }
 
 // NewMultiDataInterceptor hooks a new interceptor for packed multi data
@@ -70,6 +73,7 @@ func NewMultiDataInterceptor(
 		antifloodHandler: antifloodHandler,
 	}
 	multiDataIntercept.interceptedDebugHandler = resolver.NewDisabledInterceptorResolver()
+	multiDataIntercept.peersRatingHandler = peersRating.NewDisabledPeersRatingHandler()
 
 	return multiDataIntercept, nil
 }
@@ -115,17 +119,23 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 
 	listInterceptedData := make([]process.InterceptedData, len(multiDataBuff))
 	errOriginator := mdi.antifloodHandler.IsOriginatorEligibleForTopic(message.Peer(), mdi.topic)
+	// only a batch holding data we have requested is a response of the connected peer, gossiped data does not rate it
+	isResponse := false
 
 	for index, dataBuff := range multiDataBuff {
 		var interceptedData process.InterceptedData
 		interceptedData, err = mdi.interceptedData(dataBuff, message.Peer(), fromConnectedPeer)
-		listInterceptedData[index] = interceptedData
+		isWhiteListed := mdi.whiteListRequest.IsWhiteListed(interceptedData)
+		isResponse = isResponse || isWhiteListed
 		if err != nil {
 			mdi.throttler.EndProcessing()
+			if isResponse {
+				mdi.notifyPeersRating(fromConnectedPeer, false)
+			}
 			return err
 		}
 
-		isWhiteListed := mdi.whiteListRequest.IsWhiteListed(interceptedData)
+		listInterceptedData[index] = interceptedData
 		if !isWhiteListed && errOriginator != nil {
 			mdi.throttler.EndProcessing()
 			log.Trace("got message from peer on topic only for validators", "originator",
@@ -151,6 +161,10 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		}
 	}
 
+	if isResponse {
+		mdi.notifyPeersRating(fromConnectedPeer, true)
+	}
+
 	go func() {
 		for _, interceptedData := range listInterceptedData {
 			processInterceptedData(
@@ -192,7 +206,7 @@ func (mdi *MultiDataInterceptor) interceptedData(dataBuff []byte, originator cor
 			mdi.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
 		}
 
-		return nil, err
+		return interceptedData, err
 	}
 
 	return interceptedData, nil
@@ -211,6 +225,26 @@ func (mdi *MultiDataInterceptor) SetInterceptedDebugHandler(handler process.Inte
 	return nil
 }
 
+// SetPeersRatingHandler will set the component that rates the peers based on the responses they send
+func (mdi *MultiDataInterceptor) SetPeersRatingHandler(handler process.PeersRatingHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilPeersRatingHandler
+	}
+
+	mdi.mutPeersRatingHandler.Lock()
+	mdi.peersRatingHandler = handler
+	mdi.mutPeersRatingHandler.Unlock()
+
+	return nil
+}
+
+func (mdi *MultiDataInterceptor) notifyPeersRating(fromConnectedPeer core.PeerID, isValid bool) {
+	mdi.mutPeersRatingHandler.RLock()
+	defer mdi.mutPeersRatingHandler.RUnlock()
+
+	mdi.peersRatingHandler.ResponseReceived(fromConnectedPeer, mdi.topic, isValid)
+}
+
 // RegisterHandler registers a callback function to be notified on received data
 func (mdi *MultiDataInterceptor) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	mdi.processor.RegisterHandler(handler)
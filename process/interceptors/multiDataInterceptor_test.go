@@ -646,6 +646,89 @@ func TestMultiDataInterceptor_ProcessReceivedMessageIsOriginatorNotOkButWhiteLis
 
 //------- IsInterfaceNil
 
+//------- peers rating
+
+func TestMultiDataInterceptor_SetPeersRatingHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mdi, _ := interceptors.NewMultiDataInterceptor(
+		testTopic,
+		&mock.MarshalizerMock{},
+		&mock.InterceptedDataFactoryStub{},
+		&mock.InterceptorProcessorStub{},
+		&mock.InterceptorThrottlerStub{},
+		&mock.P2PAntifloodHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+	)
+
+	err := mdi.SetPeersRatingHandler(nil)
+
+	assert.Equal(t, process.ErrNilPeersRatingHandler, err)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageShouldNotifyPeersRatingOnce(t *testing.T) {
+	t.Parallel()
+
+	testProcessReceivedMessageMultiDataNotifyPeersRating(t, nil, true, 1)
+	testProcessReceivedMessageMultiDataNotifyPeersRating(t, errors.New("invalid data"), true, 1)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageNotRequestedShouldNotNotifyPeersRating(t *testing.T) {
+	t.Parallel()
+
+	testProcessReceivedMessageMultiDataNotifyPeersRating(t, nil, false, 0)
+	testProcessReceivedMessageMultiDataNotifyPeersRating(t, errors.New("invalid data"), false, 0)
+}
+
+func testProcessReceivedMessageMultiDataNotifyPeersRating(t *testing.T, validityErr error, isRequested bool, expectedNumCalls int) {
+	buffData := [][]byte{[]byte("buff1"), []byte("buff2")}
+	marshalizer := &mock.MarshalizerMock{}
+	interceptedData := &mock.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return validityErr
+		},
+		IsForCurrentShardCalled: func() bool {
+			return true
+		},
+	}
+	mdi, _ := interceptors.NewMultiDataInterceptor(
+		testTopic,
+		marshalizer,
+		&mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+				return interceptedData, nil
+			},
+		},
+		createMockInterceptorStub(nil, nil),
+		createMockThrottler(),
+		&mock.P2PAntifloodHandlerStub{},
+		&mock.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return isRequested
+			},
+		},
+	)
+
+	numCalls := 0
+	err := mdi.SetPeersRatingHandler(&mock.PeersRatingHandlerStub{
+		ResponseReceivedCalled: func(pid core.PeerID, topic string, isValid bool) {
+			numCalls++
+			assert.Equal(t, fromConnectedPeerId, pid)
+			assert.Equal(t, testTopic, topic)
+			assert.Equal(t, validityErr == nil, isValid)
+		},
+	})
+	require.Nil(t, err)
+
+	dataField, _ := marshalizer.Marshal(&batch.Batch{Data: buffData})
+	msg := &mock.P2PMessageMock{
+		DataField: dataField,
+	}
+	_ = mdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Equal(t, expectedNumCalls, numCalls)
+}
+
 func TestMultiDataInterceptor_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/peersRating"
 	"github.com/ElrondNetwork/elrond-go/debug/resolver"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -20,6 +21,8 @@ type SingleDataInterceptor struct {
 	antifloodHandler           process.P2PAntifloodHandler
 	mutInterceptedDebugHandler sync.RWMutex
 	interceptedDebugHandler    process.InterceptedDebugger
+	mutPeersRatingHandler      sync.RWMutex
+	peersRatingHandler         process.PeersRatingHandler
 }
 
 // NewSingleDataInterceptor hooks a new interceptor for single data
@@ -59,6 +62,7 @@ func NewSingleDataInterceptor(
 		whiteListRequested: whiteListRequested,
 	}
 	singleDataIntercept.interceptedDebugHandler = resolver.NewDisabledInterceptorResolver()
+	singleDataIntercept.peersRatingHandler = peersRating.NewDisabledPeersRatingHandler()
 
 	return singleDataIntercept, nil
 }
@@ -88,7 +92,12 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 
 	receivedDebugInterceptedData(sdi.interceptedDebugHandler, interceptedData, sdi.topic)
 
+	isWhiteListed := sdi.whiteListRequested.IsWhiteListed(interceptedData)
 	err = interceptedData.CheckValidity()
+	if isWhiteListed {
+		// only the data we have requested is a response of the connected peer, gossiped data does not rate it
+		sdi.notifyPeersRating(fromConnectedPeer, err == nil)
+	}
 	if err != nil {
 		sdi.throttler.EndProcessing()
 		processDebugInterceptedData(sdi.interceptedDebugHandler, interceptedData, sdi.topic, err)
@@ -105,7 +114,6 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	}
 
 	errOriginator := sdi.antifloodHandler.IsOriginatorEligibleForTopic(message.Peer(), sdi.topic)
-	isWhiteListed := sdi.whiteListRequested.IsWhiteListed(interceptedData)
 	if !isWhiteListed && errOriginator != nil {
 		log.Trace("got message from peer on topic only for validators",
 			"originator", p2p.PeerIdToShortString(message.Peer()), "topic",
@@ -157,6 +165,26 @@ func (sdi *SingleDataInterceptor) SetInterceptedDebugHandler(handler process.Int
 	return nil
 }
 
+// SetPeersRatingHandler will set the component that rates the peers based on the responses they send
+func (sdi *SingleDataInterceptor) SetPeersRatingHandler(handler process.PeersRatingHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilPeersRatingHandler
+	}
+
+	sdi.mutPeersRatingHandler.Lock()
+	sdi.peersRatingHandler = handler
+	sdi.mutPeersRatingHandler.Unlock()
+
+	return nil
+}
+
+func (sdi *SingleDataInterceptor) notifyPeersRating(fromConnectedPeer core.PeerID, isValid bool) {
+	sdi.mutPeersRatingHandler.RLock()
+	defer sdi.mutPeersRatingHandler.RUnlock()
+
+	sdi.peersRatingHandler.ResponseReceived(fromConnectedPeer, sdi.topic, isValid)
+}
+
 // RegisterHandler registers a callback function to be notified on received data
 func (sdi *SingleDataInterceptor) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	sdi.processor.RegisterHandler(handler)
@@ -493,6 +493,84 @@ func TestSingleDataInterceptor_SetInterceptedDebugHandlerShouldWork(t *testing.T
 	assert.True(t, debugger == sdi.InterceptedDebugHandler()) //pointer testing
 }
 
+//------- peers rating
+
+func TestSingleDataInterceptor_SetPeersRatingHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sdi, _ := interceptors.NewSingleDataInterceptor(
+		testTopic,
+		&mock.InterceptedDataFactoryStub{},
+		&mock.InterceptorProcessorStub{},
+		&mock.InterceptorThrottlerStub{},
+		&mock.P2PAntifloodHandlerStub{},
+		&mock.WhiteListHandlerStub{},
+	)
+
+	err := sdi.SetPeersRatingHandler(nil)
+
+	assert.Equal(t, process.ErrNilPeersRatingHandler, err)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldNotifyPeersRating(t *testing.T) {
+	t.Parallel()
+
+	testProcessReceivedMessageNotifyPeersRating(t, nil, true, 1)
+	testProcessReceivedMessageNotifyPeersRating(t, errors.New("invalid data"), true, 1)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageNotRequestedShouldNotNotifyPeersRating(t *testing.T) {
+	t.Parallel()
+
+	testProcessReceivedMessageNotifyPeersRating(t, nil, false, 0)
+	testProcessReceivedMessageNotifyPeersRating(t, errors.New("invalid data"), false, 0)
+}
+
+func testProcessReceivedMessageNotifyPeersRating(t *testing.T, validityErr error, isRequested bool, expectedNumCalls int) {
+	interceptedData := &mock.InterceptedDataStub{
+		CheckValidityCalled: func() error {
+			return validityErr
+		},
+		IsForCurrentShardCalled: func() bool {
+			return true
+		},
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(
+		testTopic,
+		&mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+				return interceptedData, nil
+			},
+		},
+		createMockInterceptorStub(nil, nil),
+		createMockThrottler(),
+		&mock.P2PAntifloodHandlerStub{},
+		&mock.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return isRequested
+			},
+		},
+	)
+
+	numCalls := 0
+	err := sdi.SetPeersRatingHandler(&mock.PeersRatingHandlerStub{
+		ResponseReceivedCalled: func(pid core.PeerID, topic string, isValid bool) {
+			numCalls++
+			assert.Equal(t, fromConnectedPeerId, pid)
+			assert.Equal(t, testTopic, topic)
+			assert.Equal(t, validityErr == nil, isValid)
+		},
+	})
+	require.Nil(t, err)
+
+	msg := &mock.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	_ = sdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Equal(t, expectedNumCalls, numCalls)
+}
+
 //------- IsInterfaceNil
 
 func TestSingleDataInterceptor_IsInterfaceNil(t *testing.T) {
@@ -441,10 +441,17 @@ type BlockChainHookHandler interface {
 type Interceptor interface {
 	ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
 	SetInterceptedDebugHandler(handler InterceptedDebugger) error
+	SetPeersRatingHandler(handler PeersRatingHandler) error
 	RegisterHandler(handler func(topic string, hash []byte, data interface{}))
 	IsInterfaceNil() bool
 }
 
+// PeersRatingHandler defines the behavior of a component able to rate the peers based on the responses they send
+type PeersRatingHandler interface {
+	ResponseReceived(pid core.PeerID, topic string, isValid bool)
+	IsInterfaceNil() bool
+}
+
 // TopicHandler defines the functionality needed by structs to manage topics and message processors
 type TopicHandler interface {
 	HasTopic(name string) bool
@@ -581,10 +588,19 @@ type TimeCacher interface {
 type PeerBlackListCacher interface {
 	Upsert(pid core.PeerID, span time.Duration) error
 	Has(pid core.PeerID) bool
+	Remove(pid core.PeerID)
 	Sweep()
 	IsInterfaceNil() bool
 }
 
+// PeerBanHandler is a peer black list cacher that also keeps the ban reasons and persists the bans across restarts
+type PeerBanHandler interface {
+	PeerBlackListCacher
+	Ban(pid core.PeerID, duration time.Duration, reason string) error
+	Unban(pid core.PeerID) error
+	GetKnownPeers() []p2p.PeerStoreEntry
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -621,6 +637,14 @@ type GasHandler interface {
 	IsInterfaceNil() bool
 }
 
+// CommitJournalHandler defines the component that makes the writes done while committing a block crash consistent
+type CommitJournalHandler interface {
+	BeginCommit()
+	FinishCommit(nonce uint64, headerHash []byte) error
+	AbortCommit()
+	IsInterfaceNil() bool
+}
+
 // BootStorer is the interface needed by bootstrapper to read/write data in storage
 type BootStorer interface {
 	SaveLastRound(round int64) error
@@ -737,6 +761,13 @@ type EpochStartDataCreator interface {
 	IsInterfaceNil() bool
 }
 
+// EpochStartRoundsScheduleCreator defines the functionality for the metachain to create the rounds schedule at end of epoch
+type EpochStartRoundsScheduleCreator interface {
+	CreateRoundsSchedule(metaBlock *block.MetaBlock) ([]block.RoundDurationChange, error)
+	VerifyRoundsSchedule(metaBlock *block.MetaBlock) error
+	IsInterfaceNil() bool
+}
+
 // EpochStartRewardsCreator defines the functionality for the metachain to create rewards at end of epoch
 type EpochStartRewardsCreator interface {
 	CreateRewardsMiniBlocks(metaBlock *block.MetaBlock, validatorsInfo map[uint32][]*state.ValidatorInfo) (block.MiniBlockSlice, error)
@@ -792,12 +823,6 @@ type BuiltInFunctionContainer interface {
 	IsInterfaceNil() bool
 }
 
-// RoundTimeDurationHandler defines the methods to get the time duration of a round
-type RoundTimeDurationHandler interface {
-	TimeDuration() time.Duration
-	IsInterfaceNil() bool
-}
-
 // Rounder defines the actions which should be handled by a round implementation
 type Rounder interface {
 	Index() int64
@@ -869,6 +894,12 @@ type AntifloodDebugger interface {
 	IsInterfaceNil() bool
 }
 
+// AntifloodHitsRecorder defines the behavior of a component able to account, per peer, the messages stopped by the antiflood
+type AntifloodHitsRecorder interface {
+	AddAntifloodHit(pid core.PeerID, topic string)
+	IsInterfaceNil() bool
+}
+
 // MiniblockAndHash holds the info related to a miniblock and its hash
 type MiniblockAndHash struct {
 	Miniblock *block.MiniBlock
@@ -902,3 +933,19 @@ type NodesCoordinator interface {
 	GetAllLeavingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
 	IsInterfaceNil() bool
 }
+
+// SystemSCCallExecutor can execute calls issued by the protocol against the system smart contracts
+type SystemSCCallExecutor interface {
+	ExecuteSystemSmartContractCall(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error)
+	IsInterfaceNil() bool
+}
+
+// EquivocationEvidenceProcessor includes the gathered equivocation evidences in a metablock and checks the ones
+// included by other proposers
+type EquivocationEvidenceProcessor interface {
+	IncludeEvidences(metaBlock *block.MetaBlock, haveTime func() bool) error
+	ProcessEvidences(metaBlock *block.MetaBlock) error
+	RemoveIncludedEvidences(metaBlock *block.MetaBlock)
+	RestoreEvidences(metaBlock *block.MetaBlock)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// AntifloodHitsRecorderStub -
+type AntifloodHitsRecorderStub struct {
+	AddAntifloodHitCalled func(pid core.PeerID, topic string)
+}
+
+// AddAntifloodHit -
+func (ahrs *AntifloodHitsRecorderStub) AddAntifloodHit(pid core.PeerID, topic string) {
+	if ahrs.AddAntifloodHitCalled != nil {
+		ahrs.AddAntifloodHitCalled(pid, topic)
+	}
+}
+
+// IsInterfaceNil -
+func (ahrs *AntifloodHitsRecorderStub) IsInterfaceNil() bool {
+	return ahrs == nil
+}
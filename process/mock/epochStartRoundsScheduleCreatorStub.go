@@ -0,0 +1,30 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/block"
+
+// EpochStartRoundsScheduleCreatorStub -
+type EpochStartRoundsScheduleCreatorStub struct {
+	CreateRoundsScheduleCalled func(metaBlock *block.MetaBlock) ([]block.RoundDurationChange, error)
+	VerifyRoundsScheduleCalled func(metaBlock *block.MetaBlock) error
+}
+
+// CreateRoundsSchedule -
+func (e *EpochStartRoundsScheduleCreatorStub) CreateRoundsSchedule(metaBlock *block.MetaBlock) ([]block.RoundDurationChange, error) {
+	if e.CreateRoundsScheduleCalled != nil {
+		return e.CreateRoundsScheduleCalled(metaBlock)
+	}
+	return nil, nil
+}
+
+// VerifyRoundsSchedule -
+func (e *EpochStartRoundsScheduleCreatorStub) VerifyRoundsSchedule(metaBlock *block.MetaBlock) error {
+	if e.VerifyRoundsScheduleCalled != nil {
+		return e.VerifyRoundsScheduleCalled(metaBlock)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (e *EpochStartRoundsScheduleCreatorStub) IsInterfaceNil() bool {
+	return e == nil
+}
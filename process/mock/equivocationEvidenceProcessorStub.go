@@ -0,0 +1,48 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// EquivocationEvidenceProcessorStub -
+type EquivocationEvidenceProcessorStub struct {
+	IncludeEvidencesCalled        func(metaBlock *block.MetaBlock, haveTime func() bool) error
+	ProcessEvidencesCalled        func(metaBlock *block.MetaBlock) error
+	RemoveIncludedEvidencesCalled func(metaBlock *block.MetaBlock)
+	RestoreEvidencesCalled        func(metaBlock *block.MetaBlock)
+}
+
+// IncludeEvidences -
+func (eeps *EquivocationEvidenceProcessorStub) IncludeEvidences(metaBlock *block.MetaBlock, haveTime func() bool) error {
+	if eeps.IncludeEvidencesCalled != nil {
+		return eeps.IncludeEvidencesCalled(metaBlock, haveTime)
+	}
+	return nil
+}
+
+// ProcessEvidences -
+func (eeps *EquivocationEvidenceProcessorStub) ProcessEvidences(metaBlock *block.MetaBlock) error {
+	if eeps.ProcessEvidencesCalled != nil {
+		return eeps.ProcessEvidencesCalled(metaBlock)
+	}
+	return nil
+}
+
+// RemoveIncludedEvidences -
+func (eeps *EquivocationEvidenceProcessorStub) RemoveIncludedEvidences(metaBlock *block.MetaBlock) {
+	if eeps.RemoveIncludedEvidencesCalled != nil {
+		eeps.RemoveIncludedEvidencesCalled(metaBlock)
+	}
+}
+
+// RestoreEvidences -
+func (eeps *EquivocationEvidenceProcessorStub) RestoreEvidences(metaBlock *block.MetaBlock) {
+	if eeps.RestoreEvidencesCalled != nil {
+		eeps.RestoreEvidencesCalled(metaBlock)
+	}
+}
+
+// IsInterfaceNil -
+func (eeps *EquivocationEvidenceProcessorStub) IsInterfaceNil() bool {
+	return eeps == nil
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+)
+
+// EvidenceVerifierStub -
+type EvidenceVerifierStub struct {
+	VerifyCalled func(evidence *slash.EquivocationEvidence) error
+}
+
+// Verify -
+func (evs *EvidenceVerifierStub) Verify(evidence *slash.EquivocationEvidence) error {
+	if evs.VerifyCalled != nil {
+		return evs.VerifyCalled(evidence)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (evs *EvidenceVerifierStub) IsInterfaceNil() bool {
+	return evs == nil
+}
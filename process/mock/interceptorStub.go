@@ -21,6 +21,11 @@ func (is *InterceptorStub) SetInterceptedDebugHandler(_ process.InterceptedDebug
 	return nil
 }
 
+// SetPeersRatingHandler -
+func (is *InterceptorStub) SetPeersRatingHandler(_ process.PeersRatingHandler) error {
+	return nil
+}
+
 // RegisterHandler -
 func (is *InterceptorStub) RegisterHandler(_ func(topic string, hash []byte, data interface{})) {
 }
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// NotarizedHeadersNotifierStub -
+type NotarizedHeadersNotifierStub struct {
+	RegisterCrossNotarizedHeadersHandlerCalled func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+	RegisterSelfNotarizedHeadersHandlerCalled  func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+}
+
+// RegisterCrossNotarizedHeadersHandler -
+func (nhns *NotarizedHeadersNotifierStub) RegisterCrossNotarizedHeadersHandler(
+	handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte),
+) {
+	if nhns.RegisterCrossNotarizedHeadersHandlerCalled != nil {
+		nhns.RegisterCrossNotarizedHeadersHandlerCalled(handler)
+	}
+}
+
+// RegisterSelfNotarizedHeadersHandler -
+func (nhns *NotarizedHeadersNotifierStub) RegisterSelfNotarizedHeadersHandler(
+	handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte),
+) {
+	if nhns.RegisterSelfNotarizedHeadersHandlerCalled != nil {
+		nhns.RegisterSelfNotarizedHeadersHandlerCalled(handler)
+	}
+}
+
+// IsInterfaceNil -
+func (nhns *NotarizedHeadersNotifierStub) IsInterfaceNil() bool {
+	return nhns == nil
+}
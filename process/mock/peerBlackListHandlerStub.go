@@ -10,6 +10,7 @@ import (
 type PeerBlackListHandlerStub struct {
 	UpsertCalled func(pid core.PeerID, span time.Duration) error
 	HasCalled    func(pid core.PeerID) bool
+	RemoveCalled func(pid core.PeerID)
 	SweepCalled  func()
 }
 
@@ -31,6 +32,15 @@ func (pblhs *PeerBlackListHandlerStub) Has(pid core.PeerID) bool {
 	return pblhs.HasCalled(pid)
 }
 
+// Remove -
+func (pblhs *PeerBlackListHandlerStub) Remove(pid core.PeerID) {
+	if pblhs.RemoveCalled == nil {
+		return
+	}
+
+	pblhs.RemoveCalled(pid)
+}
+
 // Sweep -
 func (pblhs *PeerBlackListHandlerStub) Sweep() {
 	if pblhs.SweepCalled == nil {
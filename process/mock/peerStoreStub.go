@@ -0,0 +1,95 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// PeerStoreStub -
+type PeerStoreStub struct {
+	UpdatePeerCalled                  func(pid core.PeerID, addresses []string, peerInfo core.P2PPeerInfo)
+	BanCalled                         func(pid core.PeerID, duration time.Duration, reason string) error
+	UnbanCalled                       func(pid core.PeerID) error
+	GetPeersCalled                    func() []p2p.PeerStoreEntry
+	GetBannedPeersCalled              func() []p2p.PeerStoreEntry
+	GetKnownAddressesCalled           func(maxNumPeers int) []string
+	SetPeerHonestyScoreProviderCalled func(provider p2p.PeerHonestyScoreProvider) error
+	CloseCalled                       func() error
+}
+
+// UpdatePeer -
+func (pss *PeerStoreStub) UpdatePeer(pid core.PeerID, addresses []string, peerInfo core.P2PPeerInfo) {
+	if pss.UpdatePeerCalled != nil {
+		pss.UpdatePeerCalled(pid, addresses, peerInfo)
+	}
+}
+
+// Ban -
+func (pss *PeerStoreStub) Ban(pid core.PeerID, duration time.Duration, reason string) error {
+	if pss.BanCalled != nil {
+		return pss.BanCalled(pid, duration, reason)
+	}
+
+	return nil
+}
+
+// Unban -
+func (pss *PeerStoreStub) Unban(pid core.PeerID) error {
+	if pss.UnbanCalled != nil {
+		return pss.UnbanCalled(pid)
+	}
+
+	return nil
+}
+
+// GetPeers -
+func (pss *PeerStoreStub) GetPeers() []p2p.PeerStoreEntry {
+	if pss.GetPeersCalled != nil {
+		return pss.GetPeersCalled()
+	}
+
+	return make([]p2p.PeerStoreEntry, 0)
+}
+
+// GetBannedPeers -
+func (pss *PeerStoreStub) GetBannedPeers() []p2p.PeerStoreEntry {
+	if pss.GetBannedPeersCalled != nil {
+		return pss.GetBannedPeersCalled()
+	}
+
+	return make([]p2p.PeerStoreEntry, 0)
+}
+
+// GetKnownAddresses -
+func (pss *PeerStoreStub) GetKnownAddresses(maxNumPeers int) []string {
+	if pss.GetKnownAddressesCalled != nil {
+		return pss.GetKnownAddressesCalled(maxNumPeers)
+	}
+
+	return make([]string, 0)
+}
+
+// SetPeerHonestyScoreProvider -
+func (pss *PeerStoreStub) SetPeerHonestyScoreProvider(provider p2p.PeerHonestyScoreProvider) error {
+	if pss.SetPeerHonestyScoreProviderCalled != nil {
+		return pss.SetPeerHonestyScoreProviderCalled(provider)
+	}
+
+	return nil
+}
+
+// Close -
+func (pss *PeerStoreStub) Close() error {
+	if pss.CloseCalled != nil {
+		return pss.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (pss *PeerStoreStub) IsInterfaceNil() bool {
+	return pss == nil
+}
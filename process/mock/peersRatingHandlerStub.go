@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// PeersRatingHandlerStub -
+type PeersRatingHandlerStub struct {
+	ResponseReceivedCalled func(pid core.PeerID, topic string, isValid bool)
+}
+
+// ResponseReceived -
+func (prhs *PeersRatingHandlerStub) ResponseReceived(pid core.PeerID, topic string, isValid bool) {
+	if prhs.ResponseReceivedCalled != nil {
+		prhs.ResponseReceivedCalled(pid, topic, isValid)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prhs *PeersRatingHandlerStub) IsInterfaceNil() bool {
+	return prhs == nil
+}
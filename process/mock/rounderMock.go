@@ -3,6 +3,8 @@ package mock
 import (
 	"math"
 	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
 )
 
 // RounderMock -
@@ -53,6 +55,16 @@ func (rndm *RounderMock) RemainingTime(_ time.Time, _ time.Duration) time.Durati
 	return rndm.RoundTimeDuration
 }
 
+// TimeStampForRound -
+func (rndm *RounderMock) TimeStampForRound(round int64) time.Time {
+	return rndm.TimeStamp().Add(time.Duration(round-rndm.Index()) * rndm.TimeDuration())
+}
+
+// Schedule -
+func (rndm *RounderMock) Schedule() []consensus.RoundDuration {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rndm *RounderMock) IsInterfaceNil() bool {
 	return rndm == nil
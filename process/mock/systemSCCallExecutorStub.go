@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// SystemSCCallExecutorStub -
+type SystemSCCallExecutorStub struct {
+	ExecuteSystemSmartContractCallCalled func(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error)
+}
+
+// ExecuteSystemSmartContractCall -
+func (s *SystemSCCallExecutorStub) ExecuteSystemSmartContractCall(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error) {
+	if s.ExecuteSystemSmartContractCallCalled != nil {
+		return s.ExecuteSystemSmartContractCallCalled(scr)
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// IsInterfaceNil -
+func (s *SystemSCCallExecutorStub) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,60 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// TracerStub -
+type TracerStub struct {
+	StartSpanCalled func(name string) core.Span
+	CloseCalled     func() error
+}
+
+// StartSpan -
+func (ts *TracerStub) StartSpan(name string) core.Span {
+	if ts.StartSpanCalled != nil {
+		return ts.StartSpanCalled(name)
+	}
+
+	return &SpanStub{}
+}
+
+// Close -
+func (ts *TracerStub) Close() error {
+	if ts.CloseCalled != nil {
+		return ts.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ts *TracerStub) IsInterfaceNil() bool {
+	return ts == nil
+}
+
+// SpanStub -
+type SpanStub struct {
+	SetAttributeCalled      func(key string, value interface{})
+	SetTraceAttributeCalled func(key string, value interface{})
+	EndCalled               func()
+}
+
+// SetAttribute -
+func (ss *SpanStub) SetAttribute(key string, value interface{}) {
+	if ss.SetAttributeCalled != nil {
+		ss.SetAttributeCalled(key, value)
+	}
+}
+
+// SetTraceAttribute -
+func (ss *SpanStub) SetTraceAttribute(key string, value interface{}) {
+	if ss.SetTraceAttributeCalled != nil {
+		ss.SetTraceAttributeCalled(key, value)
+	}
+}
+
+// End -
+func (ss *SpanStub) End() {
+	if ss.EndCalled != nil {
+		ss.EndCalled()
+	}
+}
@@ -330,10 +330,17 @@ func (vs *validatorStatistics) UpdatePeerState(header data.HeaderHandler, cache
 	if err != nil {
 		return nil, err
 	}
-	leaderPK := core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[0].PubKey()))
+	leaderIndex := previousHeader.GetLeaderIndex()
+	err = vs.decreaseForSkippedLeaders(consensusGroup, leaderIndex, previousHeader.GetShardID(), consensusGroupEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderPK := core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[leaderIndex].PubKey()))
 	log.Trace("Increasing for leader", "leader", leaderPK, "round", previousHeader.GetRound())
 	err = vs.updateValidatorInfoOnSuccessfulBlock(
 		consensusGroup,
+		leaderIndex,
 		previousHeader.GetPubKeysBitmap(),
 		big.NewInt(0).Sub(previousHeader.GetAccumulatedFees(), previousHeader.GetDeveloperFees()),
 		previousHeader.GetShardID())
@@ -768,8 +775,15 @@ func (vs *validatorStatistics) updateShardDataPeerState(
 			return shardInfoErr
 		}
 
+		leaderIndex := currentHeader.GetLeaderIndex()
+		shardInfoErr = vs.decreaseForSkippedLeaders(shardConsensus, leaderIndex, h.ShardID, epoch)
+		if shardInfoErr != nil {
+			return shardInfoErr
+		}
+
 		shardInfoErr = vs.updateValidatorInfoOnSuccessfulBlock(
 			shardConsensus,
+			leaderIndex,
 			h.PubKeysBitmap,
 			big.NewInt(0).Sub(h.AccumulatedFees, h.DeveloperFees),
 			h.ShardID,
@@ -860,8 +874,48 @@ func (vs *validatorStatistics) savePeerAccountData(
 	return vs.peerAdapter.SaveAccount(peerAccount)
 }
 
+// decreaseForSkippedLeaders decreases the proposer rating of the validators which were scheduled to propose the
+// block before the one found at the leader index, as they missed their slot and a backup leader took over
+func (vs *validatorStatistics) decreaseForSkippedLeaders(
+	consensusGroup []sharding.Validator,
+	leaderIndex uint32,
+	shardID uint32,
+	epoch uint32,
+) error {
+	if int(leaderIndex) >= len(consensusGroup) {
+		return fmt.Errorf("%w: %d", process.ErrInvalidLeaderIndex, leaderIndex)
+	}
+	if epoch < vs.ratingEnableEpoch {
+		return nil
+	}
+
+	for i := uint32(0); i < leaderIndex; i++ {
+		peerAcc, err := vs.GetPeerAccount(consensusGroup[i].PubKey())
+		if err != nil {
+			return err
+		}
+
+		log.Trace("Decreasing for skipped leader", "leader", core.GetTrimmedPk(vs.pubkeyConv.Encode(consensusGroup[i].PubKey())))
+		peerAcc.DecreaseLeaderSuccessRate(1)
+		newRating := vs.rater.ComputeDecreaseProposer(
+			shardID,
+			peerAcc.GetTempRating(),
+			peerAcc.GetConsecutiveProposerMisses())
+		peerAcc.SetConsecutiveProposerMisses(peerAcc.GetConsecutiveProposerMisses() + 1)
+		peerAcc.SetTempRating(newRating)
+
+		err = vs.peerAdapter.SaveAccount(peerAcc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 	validatorList []sharding.Validator,
+	leaderIndex uint32,
 	signingBitmap []byte,
 	accumulatedFees *big.Int,
 	shardId uint32,
@@ -880,7 +934,7 @@ func (vs *validatorStatistics) updateValidatorInfoOnSuccessfulBlock(
 		peerAcc.IncreaseNumSelectedInSuccessBlocks()
 
 		newRating := peerAcc.GetRating()
-		isLeader := i == 0
+		isLeader := i == int(leaderIndex)
 		validatorSigned := (signingBitmap[i/8] & (1 << (uint16(i) % 8))) != 0
 		actionType := vs.computeValidatorActionType(isLeader, validatorSigned)
 
@@ -676,6 +676,66 @@ func TestValidatorStatisticsProcessor_UpdatePeerState_IncreasesIgnoredSignatures
 	assert.Equal(t, uint32(1), validator.IncreaseValidatorSuccessRateValue)
 }
 
+func TestValidatorStatisticsProcessor_UpdatePeerState_BackupLeaderShouldIncreaseItAndDecreaseTheLeader(t *testing.T) {
+	t.Parallel()
+
+	consensusGroup := make(map[string][]sharding.Validator)
+
+	arguments := createUpdateTestArgs(consensusGroup)
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	cache := createMockCache()
+	prevHeader, header := generateTestMetaBlockHeaders(cache)
+	prevHeader.LeaderIndex = 1
+	header.Round = prevHeader.Round + 1
+	header.Epoch = 1
+
+	v1 := mock.NewValidatorMock([]byte("pk1"))
+	v2 := mock.NewValidatorMock([]byte("pk2"))
+	v3 := mock.NewValidatorMock([]byte("pk3"))
+
+	prevHeaderConsensusKey := fmt.Sprintf(consensusGroupFormat, prevHeader.PrevRandSeed, prevHeader.Round, prevHeader.GetShardID(), prevHeader.Epoch)
+	consensusGroup[prevHeaderConsensusKey] = []sharding.Validator{v1, v2, v3}
+
+	_, err := validatorStatistics.UpdatePeerState(header, cache)
+	assert.Nil(t, err)
+
+	pa1, _ := validatorStatistics.GetPeerAccount(v1.PubKey())
+	skippedLeader := pa1.(*mock.PeerAccountHandlerMock)
+	pa2, _ := validatorStatistics.GetPeerAccount(v2.PubKey())
+	backupLeader := pa2.(*mock.PeerAccountHandlerMock)
+	pa3, _ := validatorStatistics.GetPeerAccount(v3.PubKey())
+	validator := pa3.(*mock.PeerAccountHandlerMock)
+
+	assert.Equal(t, uint32(1), skippedLeader.DecreaseLeaderSuccessRateValue)
+	assert.Equal(t, uint32(0), skippedLeader.IncreaseLeaderSuccessRateValue)
+	assert.Equal(t, uint32(1), skippedLeader.IncreaseValidatorSuccessRateValue)
+	assert.Equal(t, uint32(1), backupLeader.IncreaseLeaderSuccessRateValue)
+	assert.Equal(t, uint32(0), backupLeader.IncreaseValidatorSuccessRateValue)
+	assert.Equal(t, uint32(1), validator.IncreaseValidatorSuccessRateValue)
+}
+
+func TestValidatorStatisticsProcessor_UpdatePeerState_InvalidLeaderIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusGroup := make(map[string][]sharding.Validator)
+
+	arguments := createUpdateTestArgs(consensusGroup)
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	cache := createMockCache()
+	prevHeader, header := generateTestMetaBlockHeaders(cache)
+	prevHeader.LeaderIndex = 2
+	header.Round = prevHeader.Round + 1
+	header.Epoch = 1
+
+	prevHeaderConsensusKey := fmt.Sprintf(consensusGroupFormat, prevHeader.PrevRandSeed, prevHeader.Round, prevHeader.GetShardID(), prevHeader.Epoch)
+	consensusGroup[prevHeaderConsensusKey] = []sharding.Validator{mock.NewValidatorMock([]byte("pk1")), mock.NewValidatorMock([]byte("pk2"))}
+
+	_, err := validatorStatistics.UpdatePeerState(header, cache)
+	assert.True(t, errors.Is(err, process.ErrInvalidLeaderIndex))
+}
+
 func generateTestMetaBlockHeaders(cache map[string]data.HeaderHandler) (*block.MetaBlock, *block.MetaBlock) {
 	prevHeader := &block.MetaBlock{
 		Round:           1,
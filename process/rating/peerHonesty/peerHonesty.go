@@ -194,6 +194,25 @@ func (pph *p2pPeerHonesty) ChangeScore(pk string, topic string, units int) {
 	pph.checkBlacklistNoLock(ps)
 }
 
+// GetScore returns the sum of the topic scores of the provided public key. Unknown public keys have a 0 score
+func (pph *p2pPeerHonesty) GetScore(pk string) float64 {
+	pph.mut.RLock()
+	defer pph.mut.RUnlock()
+
+	psObj, _ := pph.cache.Peek([]byte(pk))
+	ps, ok := psObj.(*peerScore)
+	if !ok {
+		return 0
+	}
+
+	score := 0.0
+	for _, topicScore := range ps.scoresByTopic {
+		score += topicScore
+	}
+
+	return score
+}
+
 func (pph *p2pPeerHonesty) getValidPeerScoreNoLock(pk string) *peerScore {
 	key := []byte(pk)
 
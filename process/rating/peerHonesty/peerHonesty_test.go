@@ -224,6 +224,26 @@ func TestP2pPeerHonesty_DoubleChangeScoreShouldWork(t *testing.T) {
 	assert.Equal(t, float64(units+units)*cfg.UnitValue, ps.scoresByTopic[topic])
 }
 
+func TestP2pPeerHonesty_GetScoreShouldSumTopicScores(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.UnitValue = 4
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&mock.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+	)
+
+	pk := "pk"
+	assert.Equal(t, 0.0, pph.GetScore(pk))
+
+	pph.ChangeScore(pk, "topic1", 2)
+	pph.ChangeScore(pk, "topic2", -1)
+
+	assert.Equal(t, 4.0, pph.GetScore(pk))
+}
+
 func TestP2pPeerHonesty_CheckBlacklistNotBlacklisted(t *testing.T) {
 	t.Parallel()
 
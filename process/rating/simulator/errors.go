@@ -0,0 +1,12 @@
+package simulator
+
+import "errors"
+
+// ErrNilEconomicsConfig signals that a nil economics config has been provided
+var ErrNilEconomicsConfig = errors.New("nil economics config")
+
+// ErrInvalidScenario signals that the scenario is invalid
+var ErrInvalidScenario = errors.New("invalid scenario")
+
+// ErrNotEnoughEligibleValidators signals that too many validators have been jailed to form a consensus group
+var ErrNotEnoughEligibleValidators = errors.New("not enough eligible validators")
@@ -0,0 +1,128 @@
+package simulator
+
+import (
+	"fmt"
+)
+
+// Scenario holds the simulated chain and how its validators behave
+type Scenario struct {
+	General ScenarioGeneralConfig
+	Groups  []ValidatorsGroupConfig
+}
+
+// ScenarioGeneralConfig holds the simulated chain parameters, as found in nodesSetup.json and config.toml
+type ScenarioGeneralConfig struct {
+	NumEpochs               uint32
+	RoundsPerEpoch          uint64
+	RoundDurationInMs       uint64
+	NumShards               uint32
+	Metachain               bool
+	ShardConsensusGroupSize uint32
+	MetaConsensusGroupSize  uint32
+	ShardMinNodes           uint32
+	MetaMinNodes            uint32
+	Seed                    int64
+}
+
+// ValidatorsGroupConfig holds a group of validators sharing the same behaviour
+type ValidatorsGroupConfig struct {
+	Name          string
+	NumValidators uint32
+	Behaviours    []BehaviourConfig
+}
+
+// BehaviourConfig holds the percentages of the proposals and signatures missed by the validators of a group starting
+// with the given epoch. A behaviour applies until the start epoch of the next one
+type BehaviourConfig struct {
+	StartEpoch              uint32
+	MissedProposalsPercent  float64
+	MissedSignaturesPercent float64
+}
+
+func (s *Scenario) consensusGroupSize() uint32 {
+	if s.General.Metachain {
+		return s.General.MetaConsensusGroupSize
+	}
+
+	return s.General.ShardConsensusGroupSize
+}
+
+func (s *Scenario) numValidators() uint32 {
+	numValidators := uint32(0)
+	for _, group := range s.Groups {
+		numValidators += group.NumValidators
+	}
+
+	return numValidators
+}
+
+func checkScenario(scenario *Scenario) error {
+	if scenario == nil {
+		return fmt.Errorf("%w: nil scenario", ErrInvalidScenario)
+	}
+	if scenario.General.NumEpochs == 0 {
+		return fmt.Errorf("%w: NumEpochs should be greater than 0", ErrInvalidScenario)
+	}
+	if scenario.General.RoundsPerEpoch == 0 {
+		return fmt.Errorf("%w: RoundsPerEpoch should be greater than 0", ErrInvalidScenario)
+	}
+	if scenario.General.RoundDurationInMs == 0 {
+		return fmt.Errorf("%w: RoundDurationInMs should be greater than 0", ErrInvalidScenario)
+	}
+	if scenario.consensusGroupSize() == 0 {
+		return fmt.Errorf("%w: the consensus group size should be greater than 0", ErrInvalidScenario)
+	}
+	if scenario.numValidators() < scenario.consensusGroupSize() {
+		return fmt.Errorf("%w: %d validators for a consensus group size of %d",
+			ErrInvalidScenario, scenario.numValidators(), scenario.consensusGroupSize())
+	}
+
+	names := make(map[string]struct{})
+	for _, group := range scenario.Groups {
+		_, exists := names[group.Name]
+		if exists {
+			return fmt.Errorf("%w: duplicated group name %s", ErrInvalidScenario, group.Name)
+		}
+		names[group.Name] = struct{}{}
+
+		err := checkBehaviours(group)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkBehaviours(group ValidatorsGroupConfig) error {
+	for i, behaviour := range group.Behaviours {
+		if i > 0 && behaviour.StartEpoch <= group.Behaviours[i-1].StartEpoch {
+			return fmt.Errorf("%w: the behaviours of group %s should be sorted by their start epoch",
+				ErrInvalidScenario, group.Name)
+		}
+		if isPercentInvalid(behaviour.MissedProposalsPercent) || isPercentInvalid(behaviour.MissedSignaturesPercent) {
+			return fmt.Errorf("%w: the missed percentages of group %s should be between 0 and 100",
+				ErrInvalidScenario, group.Name)
+		}
+	}
+
+	return nil
+}
+
+func isPercentInvalid(percent float64) bool {
+	return percent < 0 || percent > 100
+}
+
+// behaviourInEpoch returns the behaviour of the group in the given epoch. Validators without a behaviour do not miss
+// anything
+func (group *ValidatorsGroupConfig) behaviourInEpoch(epoch uint32) BehaviourConfig {
+	behaviour := BehaviourConfig{}
+	for _, groupBehaviour := range group.Behaviours {
+		if groupBehaviour.StartEpoch > epoch {
+			break
+		}
+		behaviour = groupBehaviour
+	}
+
+	return behaviour
+}
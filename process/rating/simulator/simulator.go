@@ -0,0 +1,426 @@
+package simulator
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/rating"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const numberOfDaysInYear = 365
+const millisecondsInDay = 86400 * 1000
+const percentScale = 100.0
+
+// ArgsSimulator holds the arguments needed to create a ratings simulator
+type ArgsSimulator struct {
+	RatingsConfig   config.RatingsConfig
+	EconomicsConfig *config.EconomicsConfig
+	Scenario        *Scenario
+}
+
+// Report holds the outcome of a simulated scenario
+type Report struct {
+	StartRating  uint32
+	StartChance  uint32
+	JailedChance uint32
+	Epochs       []*EpochReport
+	JailPoints   []*JailPoint
+}
+
+// EpochReport holds the state of every validators group at the end of an epoch
+type EpochReport struct {
+	Epoch  uint32
+	Groups []*GroupReport
+}
+
+// GroupReport holds the ratings of the validators of a group which were eligible during an epoch, the chances they
+// get for the next epoch and the rate they were selected in consensus groups with during the epoch
+type GroupReport struct {
+	Name               string
+	NumEligible        int
+	NumJailed          int
+	MinRating          uint32
+	AvgRating          uint32
+	MaxRating          uint32
+	AvgChancePercent   float64
+	AvgSelectionRate   float64
+	AvgEstimatedReward *big.Int
+}
+
+// JailPoint holds the epoch at the end of which a validator got a rating too low to be selected anymore
+type JailPoint struct {
+	Group     string
+	Validator uint32
+	Epoch     uint32
+	Rating    uint32
+}
+
+type simulatedValidator struct {
+	group     *ValidatorsGroupConfig
+	index     uint32
+	behaviour BehaviourConfig
+
+	rating                     uint32
+	tempRating                 uint32
+	jailed                     bool
+	consecutiveProposerMisses  uint32
+	leaderSuccess              uint32
+	validatorSuccess           uint32
+	validatorFailure           uint32
+	validatorIgnoredSignatures uint32
+	numSelected                uint32
+	numSelectedInSuccessBlocks uint32
+}
+
+type simulator struct {
+	scenario      *Scenario
+	rater         sharding.PeerAccountListAndRatingHandler
+	economicsData *economics.EconomicsData
+	shardID       uint32
+}
+
+// NewSimulator creates a ratings simulator using the same rater as the nodes
+func NewSimulator(args ArgsSimulator) (*simulator, error) {
+	if args.EconomicsConfig == nil {
+		return nil, ErrNilEconomicsConfig
+	}
+	err := checkScenario(args.Scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	general := args.Scenario.General
+	ratingsData, err := rating.NewRatingsData(rating.RatingsDataArg{
+		Config:                   args.RatingsConfig,
+		ShardConsensusSize:       general.ShardConsensusGroupSize,
+		MetaConsensusSize:        general.MetaConsensusGroupSize,
+		ShardMinNodes:            general.ShardMinNodes,
+		MetaMinNodes:             general.MetaMinNodes,
+		RoundDurationMiliseconds: general.RoundDurationInMs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rater, err := rating.NewBlockSigningRater(ratingsData)
+	if err != nil {
+		return nil, err
+	}
+
+	economicsData, err := economics.NewEconomicsData(args.EconomicsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID := uint32(0)
+	if general.Metachain {
+		shardID = core.MetachainShardId
+	}
+
+	return &simulator{
+		scenario:      args.Scenario,
+		rater:         rater,
+		economicsData: economicsData,
+		shardID:       shardID,
+	}, nil
+}
+
+// Run simulates the scenario round by round, applying the rating changes the validator statistics processor does,
+// and returns the state of the validators groups at the end of every epoch. The same scenario always gives the same
+// report as the consensus groups are selected with a random source seeded from the scenario
+func (s *simulator) Run() (*Report, error) {
+	random := rand.New(rand.NewSource(s.scenario.General.Seed))
+	validators := s.createValidators()
+
+	report := &Report{
+		StartRating:  s.rater.GetStartRating(),
+		StartChance:  s.rater.GetChance(s.rater.GetStartRating()),
+		JailedChance: s.rater.GetChance(0),
+		Epochs:       make([]*EpochReport, 0, s.scenario.General.NumEpochs),
+		JailPoints:   make([]*JailPoint, 0),
+	}
+
+	for epoch := uint32(0); epoch < s.scenario.General.NumEpochs; epoch++ {
+		eligible := getEligibleValidators(validators)
+		if len(eligible) < int(s.scenario.consensusGroupSize()) {
+			return nil, fmt.Errorf("%w: %d eligible validators in epoch %d for a consensus group size of %d",
+				ErrNotEnoughEligibleValidators, len(eligible), epoch, s.scenario.consensusGroupSize())
+		}
+
+		for _, v := range eligible {
+			v.behaviour = v.group.behaviourInEpoch(epoch)
+		}
+
+		cumulativeWeights := s.computeCumulativeWeights(eligible)
+		for round := uint64(0); round < s.scenario.General.RoundsPerEpoch; round++ {
+			consensusGroup := s.selectConsensusGroup(random, eligible, cumulativeWeights)
+			s.processRound(random, consensusGroup)
+		}
+
+		rewardPerNodePerBlock := s.computeRewardPerNodePerBlock(epoch)
+		rewards := make(map[*simulatedValidator]*big.Int, len(eligible))
+		for _, v := range eligible {
+			rewards[v] = s.processEndOfEpoch(v, rewardPerNodePerBlock)
+		}
+
+		epochReport := s.createEpochReport(epoch, eligible, rewards)
+		report.Epochs = append(report.Epochs, epochReport)
+
+		for _, v := range eligible {
+			v.numSelected = 0
+			if s.rater.GetChance(v.rating) >= report.JailedChance {
+				continue
+			}
+
+			v.jailed = true
+			report.JailPoints = append(report.JailPoints, &JailPoint{
+				Group:     v.group.Name,
+				Validator: v.index,
+				Epoch:     epoch,
+				Rating:    v.rating,
+			})
+		}
+		epochReport.updateNumJailed(validators)
+	}
+
+	return report, nil
+}
+
+func (s *simulator) createValidators() []*simulatedValidator {
+	validators := make([]*simulatedValidator, 0, s.scenario.numValidators())
+	for i := range s.scenario.Groups {
+		group := &s.scenario.Groups[i]
+		for index := uint32(0); index < group.NumValidators; index++ {
+			validators = append(validators, &simulatedValidator{
+				group:      group,
+				index:      index,
+				rating:     s.rater.GetStartRating(),
+				tempRating: s.rater.GetStartRating(),
+			})
+		}
+	}
+
+	return validators
+}
+
+func getEligibleValidators(validators []*simulatedValidator) []*simulatedValidator {
+	eligible := make([]*simulatedValidator, 0, len(validators))
+	for _, v := range validators {
+		if !v.jailed {
+			eligible = append(eligible, v)
+		}
+	}
+
+	return eligible
+}
+
+// computeCumulativeWeights uses the chances of the validators at the start of the epoch as weights, the same way the
+// nodes coordinator does
+func (s *simulator) computeCumulativeWeights(eligible []*simulatedValidator) []uint64 {
+	minChance := s.rater.GetChance(0)
+	cumulativeWeights := make([]uint64, len(eligible))
+	totalWeight := uint64(0)
+	for i, v := range eligible {
+		weight := s.rater.GetChance(v.rating)
+		if weight < minChance {
+			weight = minChance
+		}
+		totalWeight += uint64(weight)
+		cumulativeWeights[i] = totalWeight
+	}
+
+	return cumulativeWeights
+}
+
+func (s *simulator) selectConsensusGroup(
+	random *rand.Rand,
+	eligible []*simulatedValidator,
+	cumulativeWeights []uint64,
+) []*simulatedValidator {
+	consensusGroupSize := int(s.scenario.consensusGroupSize())
+	totalWeight := cumulativeWeights[len(cumulativeWeights)-1]
+	consensusGroup := make([]*simulatedValidator, 0, consensusGroupSize)
+	selected := make(map[int]struct{}, consensusGroupSize)
+
+	for len(consensusGroup) < consensusGroupSize {
+		var index int
+		if totalWeight == 0 {
+			index = random.Intn(len(eligible))
+		} else {
+			point := uint64(random.Int63n(int64(totalWeight)))
+			index = sort.Search(len(cumulativeWeights), func(i int) bool {
+				return cumulativeWeights[i] > point
+			})
+		}
+
+		_, alreadySelected := selected[index]
+		if alreadySelected {
+			continue
+		}
+
+		selected[index] = struct{}{}
+		consensusGroup = append(consensusGroup, eligible[index])
+	}
+
+	return consensusGroup
+}
+
+// processRound applies the rating changes for a round: a missed proposal decreases the leader and the whole
+// consensus group while a proposed block increases the leader and the validators, the ones which did not sign
+// included, as they are only penalized at the end of the epoch
+func (s *simulator) processRound(random *rand.Rand, consensusGroup []*simulatedValidator) {
+	leader := consensusGroup[0]
+	for _, v := range consensusGroup {
+		v.numSelected++
+	}
+
+	if isMissed(random, leader.behaviour.MissedProposalsPercent) {
+		leader.tempRating = s.rater.ComputeDecreaseProposer(s.shardID, leader.tempRating, leader.consecutiveProposerMisses)
+		leader.consecutiveProposerMisses++
+		for _, v := range consensusGroup[1:] {
+			v.validatorFailure++
+			v.tempRating = s.rater.ComputeDecreaseValidator(s.shardID, v.tempRating)
+		}
+
+		return
+	}
+
+	leader.leaderSuccess++
+	leader.consecutiveProposerMisses = 0
+	leader.numSelectedInSuccessBlocks++
+	leader.tempRating = s.rater.ComputeIncreaseProposer(s.shardID, leader.tempRating)
+	for _, v := range consensusGroup[1:] {
+		v.numSelectedInSuccessBlocks++
+		if isMissed(random, v.behaviour.MissedSignaturesPercent) {
+			v.validatorIgnoredSignatures++
+		} else {
+			v.validatorSuccess++
+		}
+		v.tempRating = s.rater.ComputeIncreaseValidator(s.shardID, v.tempRating)
+	}
+}
+
+func isMissed(random *rand.Rand, missedPercent float64) bool {
+	return random.Float64()*percentScale < missedPercent
+}
+
+// processEndOfEpoch reverts the increases of the validators below the signed blocks threshold, estimates their
+// protocol rewards and resets their epoch counters
+func (s *simulator) processEndOfEpoch(v *simulatedValidator, rewardPerNodePerBlock *big.Int) *big.Int {
+	appearances := core.MaxUint32(1, v.validatorSuccess+v.validatorFailure+v.validatorIgnoredSignatures)
+	signedRate := float32(v.validatorSuccess) / float32(appearances)
+	if signedRate <= s.rater.GetSignedBlocksThreshold() {
+		v.tempRating = s.rater.RevertIncreaseValidator(s.shardID, v.tempRating, v.validatorFailure)
+	}
+
+	reward := big.NewInt(0)
+	// same as the rewards creator, which sends these rewards to the protocol sustainability address
+	if v.leaderSuccess > 0 || v.validatorFailure > 0 {
+		reward.Mul(rewardPerNodePerBlock, big.NewInt(int64(v.numSelectedInSuccessBlocks)))
+	}
+
+	v.rating = v.tempRating
+	v.leaderSuccess = 0
+	v.validatorSuccess = 0
+	v.validatorFailure = 0
+	v.validatorIgnoredSignatures = 0
+	v.numSelectedInSuccessBlocks = 0
+
+	return reward
+}
+
+// computeRewardPerNodePerBlock estimates the protocol rewards of a node for a block as the end of epoch economics
+// does, supposing no fees and all the blocks of all the shards produced
+func (s *simulator) computeRewardPerNodePerBlock(epoch uint32) *big.Int {
+	general := s.scenario.General
+	roundsPerDay := core.MaxUint64(1, millisecondsInDay/general.RoundDurationInMs)
+	epochStartRound := uint64(epoch) * general.RoundsPerEpoch
+	year := uint32(epochStartRound/(numberOfDaysInYear*roundsPerDay)) + 1
+	inflationRate := s.economicsData.MaxInflationRate(year)
+
+	numChains := uint64(general.NumShards + 1)
+	maxBlocksInEpoch := general.RoundsPerEpoch * numChains
+	maxBlocksInADay := roundsPerDay * numChains
+	inflationRateForEpoch := inflationRate / numberOfDaysInYear * (float64(maxBlocksInEpoch) / float64(maxBlocksInADay))
+
+	rewardPerBlock := big.NewInt(0).Div(s.economicsData.GenesisTotalSupply(), big.NewInt(0).SetUint64(maxBlocksInEpoch))
+	rewardPerBlock = core.GetPercentageOfValue(rewardPerBlock, inflationRateForEpoch)
+	protocolSustainabilityPerBlock := core.GetPercentageOfValue(rewardPerBlock, s.economicsData.ProtocolSustainabilityPercentage())
+	rewardPerBlock.Sub(rewardPerBlock, protocolSustainabilityPerBlock)
+
+	return rewardPerBlock.Div(rewardPerBlock, big.NewInt(int64(s.scenario.consensusGroupSize())))
+}
+
+func (s *simulator) createEpochReport(
+	epoch uint32,
+	eligible []*simulatedValidator,
+	rewards map[*simulatedValidator]*big.Int,
+) *EpochReport {
+	groupsReports := make(map[*ValidatorsGroupConfig]*GroupReport)
+	epochReport := &EpochReport{
+		Epoch:  epoch,
+		Groups: make([]*GroupReport, 0, len(s.scenario.Groups)),
+	}
+	for i := range s.scenario.Groups {
+		groupReport := &GroupReport{
+			Name:               s.scenario.Groups[i].Name,
+			AvgEstimatedReward: big.NewInt(0),
+		}
+		groupsReports[&s.scenario.Groups[i]] = groupReport
+		epochReport.Groups = append(epochReport.Groups, groupReport)
+	}
+
+	sumRatings := make(map[*GroupReport]uint64)
+	for _, v := range eligible {
+		groupReport := groupsReports[v.group]
+		if groupReport.NumEligible == 0 || v.rating < groupReport.MinRating {
+			groupReport.MinRating = v.rating
+		}
+		if v.rating > groupReport.MaxRating {
+			groupReport.MaxRating = v.rating
+		}
+		groupReport.NumEligible++
+		sumRatings[groupReport] += uint64(v.rating)
+		groupReport.AvgChancePercent += float64(s.rater.GetChance(v.rating))
+		groupReport.AvgSelectionRate += float64(v.numSelected) / float64(s.scenario.General.RoundsPerEpoch)
+		groupReport.AvgEstimatedReward.Add(groupReport.AvgEstimatedReward, rewards[v])
+	}
+
+	for _, groupReport := range epochReport.Groups {
+		if groupReport.NumEligible == 0 {
+			continue
+		}
+
+		numEligible := float64(groupReport.NumEligible)
+		groupReport.AvgRating = uint32(sumRatings[groupReport] / uint64(groupReport.NumEligible))
+		groupReport.AvgChancePercent /= numEligible
+		groupReport.AvgSelectionRate /= numEligible
+		groupReport.AvgEstimatedReward.Div(groupReport.AvgEstimatedReward, big.NewInt(int64(groupReport.NumEligible)))
+	}
+
+	return epochReport
+}
+
+func (er *EpochReport) updateNumJailed(validators []*simulatedValidator) {
+	numJailed := make(map[string]int)
+	for _, v := range validators {
+		if v.jailed {
+			numJailed[v.group.Name]++
+		}
+	}
+
+	for _, groupReport := range er.Groups {
+		groupReport.NumJailed = numJailed[groupReport.Name]
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *simulator) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,244 @@
+package simulator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDummyRatingsConfig() config.RatingsConfig {
+	ratingSteps := config.RatingSteps{
+		HoursToMaxRatingFromStartRating: 72,
+		ProposerValidatorImportance:     1,
+		ProposerDecreaseFactor:          -400,
+		ValidatorDecreaseFactor:         -4,
+		ConsecutiveMissedBlocksPenalty:  1.1,
+	}
+
+	return config.RatingsConfig{
+		General: config.General{
+			StartRating:           5000001,
+			MaxRating:             10000000,
+			MinRating:             1,
+			SignedBlocksThreshold: 0.01,
+			SelectionChances: []*config.SelectionChance{
+				{MaxThreshold: 0, ChancePercent: 5},
+				{MaxThreshold: 1000000, ChancePercent: 0},
+				{MaxThreshold: 5000000, ChancePercent: 19},
+				{MaxThreshold: 10000000, ChancePercent: 24},
+			},
+		},
+		ShardChain: config.ShardChain{RatingSteps: ratingSteps},
+		MetaChain:  config.MetaChain{RatingSteps: ratingSteps},
+	}
+}
+
+func createDummyEconomicsConfig() *config.EconomicsConfig {
+	return &config.EconomicsConfig{
+		GlobalSettings: config.GlobalSettings{
+			GenesisTotalSupply: "20000000000000000000000000",
+			MinimumInflation:   0,
+			YearSettings: []*config.YearSetting{
+				{Year: 1, MaximumInflation: 0.1},
+			},
+		},
+		RewardsSettings: config.RewardsSettings{
+			LeaderPercentage:                 0.1,
+			DeveloperPercentage:              0.3,
+			ProtocolSustainabilityPercentage: 0.1,
+			ProtocolSustainabilityAddress:    "erd1932eft30w753xyvme8d49qejgkjc09n5e49w4mwdjtm0neld797su0dlxp",
+		},
+		FeeSettings: config.FeeSettings{
+			MaxGasLimitPerBlock:     "1500000000",
+			MaxGasLimitPerMetaBlock: "15000000000",
+			MinGasPrice:             "1000000000",
+			MinGasLimit:             "50000",
+			GasPerDataByte:          "1500",
+			DataLimitForBaseCalc:    "10000",
+		},
+	}
+}
+
+func createDummyScenario() *Scenario {
+	return &Scenario{
+		General: ScenarioGeneralConfig{
+			NumEpochs:               4,
+			RoundsPerEpoch:          200,
+			RoundDurationInMs:       6000,
+			NumShards:               1,
+			ShardConsensusGroupSize: 5,
+			MetaConsensusGroupSize:  5,
+			ShardMinNodes:           10,
+			MetaMinNodes:            10,
+			Seed:                    7,
+		},
+		Groups: []ValidatorsGroupConfig{
+			{
+				Name:          "honest",
+				NumValidators: 8,
+			},
+			{
+				Name:          "offline",
+				NumValidators: 2,
+				Behaviours: []BehaviourConfig{
+					{StartEpoch: 1, MissedProposalsPercent: 100, MissedSignaturesPercent: 100},
+				},
+			},
+		},
+	}
+}
+
+func createMockArgsSimulator() ArgsSimulator {
+	return ArgsSimulator{
+		RatingsConfig:   createDummyRatingsConfig(),
+		EconomicsConfig: createDummyEconomicsConfig(),
+		Scenario:        createDummyScenario(),
+	}
+}
+
+func TestNewSimulator_NilEconomicsConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.EconomicsConfig = nil
+	s, err := NewSimulator(args)
+
+	assert.True(t, s.IsInterfaceNil())
+	assert.Equal(t, ErrNilEconomicsConfig, err)
+}
+
+func TestNewSimulator_InvalidScenarioShouldErr(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(scenario *Scenario){
+		"no epochs":         func(scenario *Scenario) { scenario.General.NumEpochs = 0 },
+		"no rounds":         func(scenario *Scenario) { scenario.General.RoundsPerEpoch = 0 },
+		"no round duration": func(scenario *Scenario) { scenario.General.RoundDurationInMs = 0 },
+		"not enough nodes":  func(scenario *Scenario) { scenario.General.ShardConsensusGroupSize = 11 },
+		"duplicated group":  func(scenario *Scenario) { scenario.Groups[1].Name = "honest" },
+		"invalid percent":   func(scenario *Scenario) { scenario.Groups[1].Behaviours[0].MissedSignaturesPercent = 101 },
+		"unsorted behaviours": func(scenario *Scenario) {
+			scenario.Groups[1].Behaviours = append(scenario.Groups[1].Behaviours, BehaviourConfig{StartEpoch: 1})
+		},
+	}
+
+	for name, modify := range testCases {
+		args := createMockArgsSimulator()
+		modify(args.Scenario)
+		s, err := NewSimulator(args)
+
+		assert.True(t, s.IsInterfaceNil(), name)
+		assert.True(t, errors.Is(err, ErrInvalidScenario), name)
+	}
+
+	args := createMockArgsSimulator()
+	args.Scenario = nil
+	s, err := NewSimulator(args)
+
+	assert.True(t, s.IsInterfaceNil())
+	assert.True(t, errors.Is(err, ErrInvalidScenario))
+}
+
+func TestNewSimulator_InvalidRatingsConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.RatingsConfig.General.MinRating = 0
+	s, err := NewSimulator(args)
+
+	assert.True(t, s.IsInterfaceNil())
+	assert.NotNil(t, err)
+}
+
+func TestSimulator_RunHonestValidatorsShouldIncreaseTheirRating(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.Scenario.Groups = args.Scenario.Groups[:1]
+	s, _ := NewSimulator(args)
+
+	report, err := s.Run()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(5000001), report.StartRating)
+	assert.Equal(t, uint32(24), report.StartChance)
+	assert.Equal(t, uint32(5), report.JailedChance)
+	assert.Empty(t, report.JailPoints)
+	require.Equal(t, 4, len(report.Epochs))
+
+	previousRating := report.StartRating
+	for _, epochReport := range report.Epochs {
+		groupReport := epochReport.Groups[0]
+		assert.Equal(t, 8, groupReport.NumEligible)
+		assert.Equal(t, 0, groupReport.NumJailed)
+		assert.True(t, groupReport.MinRating > previousRating)
+		assert.True(t, groupReport.MinRating <= groupReport.AvgRating && groupReport.AvgRating <= groupReport.MaxRating)
+		assert.Equal(t, float64(24), groupReport.AvgChancePercent)
+		assert.InDelta(t, 5.0/8.0, groupReport.AvgSelectionRate, 0.001)
+		assert.True(t, groupReport.AvgEstimatedReward.Sign() > 0)
+
+		previousRating = groupReport.MinRating
+	}
+}
+
+func TestSimulator_RunOfflineValidatorsShouldBeJailed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	s, _ := NewSimulator(args)
+
+	report, err := s.Run()
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(report.JailPoints))
+	for index, jailPoint := range report.JailPoints {
+		assert.Equal(t, "offline", jailPoint.Group)
+		assert.Equal(t, uint32(index), jailPoint.Validator)
+		assert.True(t, jailPoint.Epoch >= 1)
+		assert.True(t, jailPoint.Rating <= 1000000)
+	}
+
+	lastJailEpoch := report.JailPoints[1].Epoch
+	offlineGroupBeforeBehaviour := report.Epochs[0].Groups[1]
+	assert.Equal(t, 2, offlineGroupBeforeBehaviour.NumEligible)
+	assert.True(t, offlineGroupBeforeBehaviour.MinRating > report.StartRating)
+
+	offlineGroupAfterJail := report.Epochs[lastJailEpoch].Groups[1]
+	assert.Equal(t, 2, offlineGroupAfterJail.NumJailed)
+	if int(lastJailEpoch)+1 < len(report.Epochs) {
+		assert.Equal(t, 0, report.Epochs[lastJailEpoch+1].Groups[1].NumEligible)
+	}
+
+	honestGroup := report.Epochs[len(report.Epochs)-1].Groups[0]
+	assert.Equal(t, 8, honestGroup.NumEligible)
+	assert.Equal(t, 0, honestGroup.NumJailed)
+}
+
+func TestSimulator_RunShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewSimulator(createMockArgsSimulator())
+	firstReport, err := s.Run()
+	require.Nil(t, err)
+
+	secondReport, err := s.Run()
+	require.Nil(t, err)
+
+	assert.Equal(t, firstReport, secondReport)
+}
+
+func TestSimulator_RunTooManyJailedValidatorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.Scenario.General.ShardConsensusGroupSize = 9
+	s, _ := NewSimulator(args)
+
+	report, err := s.Run()
+
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, ErrNotEnoughEligibleValidators))
+}
@@ -0,0 +1,104 @@
+package slash
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm/factory"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const slashEquivocationFunction = "slashEquivocation"
+
+// ComputeOffenseKey returns the key identifying the offense proven by the evidence: the same key signing twice
+// in the same shard and round can only be slashed once
+func ComputeOffenseKey(hasher hashing.Hasher, evidence *EquivocationEvidence) []byte {
+	return computeOffenseKey(hasher, evidence.Type, evidence.PubKey, evidence.ShardID, evidence.Round)
+}
+
+func computeOffenseKey(
+	hasher hashing.Hasher,
+	equivocationType EquivocationType,
+	pubKey []byte,
+	shardID uint32,
+	round uint64,
+) []byte {
+	buff := make([]byte, 0, len(pubKey)+16)
+	buff = append(buff, pubKey...)
+	buff = append(buff, uint32ToBytes(shardID)...)
+	buff = append(buff, uint64ToBytes(round)...)
+	buff = append(buff, uint32ToBytes(uint32(equivocationType))...)
+
+	return hasher.Compute(string(buff))
+}
+
+func uint32ToBytes(value uint32) []byte {
+	buff := make([]byte, 4)
+	binary.BigEndian.PutUint32(buff, value)
+	return buff
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+	return buff
+}
+
+func unmarshalHeader(marshalizer marshal.Marshalizer, shardID uint32, buff []byte) (data.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err := marshalizer.Unmarshal(metaBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+		return metaBlock, nil
+	}
+
+	header := &block.Header{}
+	err := marshalizer.Unmarshal(header, buff)
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func computeConsensusGroup(nodesCoordinator sharding.NodesCoordinator, header data.HeaderHandler) ([]sharding.Validator, error) {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	return nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+}
+
+// getLeader returns the validator which proposed the header: the leader, or the backup leader when it took over
+func getLeader(consensusGroup []sharding.Validator, header data.HeaderHandler) (sharding.Validator, error) {
+	leaderIndex := header.GetLeaderIndex()
+	if int(leaderIndex) >= len(consensusGroup) {
+		return nil, fmt.Errorf("%w: %d", process.ErrInvalidLeaderIndex, leaderIndex)
+	}
+
+	return consensusGroup[leaderIndex], nil
+}
+
+func createSlashingSCR(pubKey []byte, offenseKey []byte) *smartContractResult.SmartContractResult {
+	txData := slashEquivocationFunction + "@" + hex.EncodeToString(pubKey) + "@" + hex.EncodeToString(offenseKey)
+
+	return &smartContractResult.SmartContractResult{
+		SndAddr:  factory.SlashingAddress,
+		RcvAddr:  factory.StakingSCAddress,
+		Value:    big.NewInt(0),
+		Data:     []byte(txData),
+		CallType: vmcommon.DirectCall,
+	}
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+type disabledEquivocationDetector struct {
+}
+
+// NewDisabledEquivocationDetector returns an equivocation detector that ignores all the received data
+func NewDisabledEquivocationDetector() *disabledEquivocationDetector {
+	return &disabledEquivocationDetector{}
+}
+
+// CheckConsensusMessage does nothing
+func (d *disabledEquivocationDetector) CheckConsensusMessage(_ *consensus.Message) {
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *disabledEquivocationDetector) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+type disabledEvidenceProcessor struct {
+}
+
+// NewDisabledEvidenceProcessor returns an evidence processor that never includes nor checks any evidence
+func NewDisabledEvidenceProcessor() *disabledEvidenceProcessor {
+	return &disabledEvidenceProcessor{}
+}
+
+// IncludeEvidences does nothing
+func (d *disabledEvidenceProcessor) IncludeEvidences(_ *block.MetaBlock, _ func() bool) error {
+	return nil
+}
+
+// ProcessEvidences returns nil
+func (d *disabledEvidenceProcessor) ProcessEvidences(_ *block.MetaBlock) error {
+	return nil
+}
+
+// RemoveIncludedEvidences does nothing
+func (d *disabledEvidenceProcessor) RemoveIncludedEvidences(_ *block.MetaBlock) {
+}
+
+// RestoreEvidences does nothing
+func (d *disabledEvidenceProcessor) RestoreEvidences(_ *block.MetaBlock) {
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *disabledEvidenceProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,303 @@
+package slash
+
+import (
+	"bytes"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+var log = logger.GetOrCreate("process/slash")
+
+type signedHeader struct {
+	headerHash []byte
+	signature  []byte
+}
+
+// ArgsEquivocationDetector holds the arguments needed to create an equivocation detector
+type ArgsEquivocationDetector struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	NodesCoordinator sharding.NodesCoordinator
+	ShardCoordinator sharding.Coordinator
+	EvidenceVerifier EvidenceVerifier
+	EvidencePool     storage.Cacher
+	Broadcaster      EvidenceBroadcaster
+	AntifloodHandler process.P2PAntifloodHandler
+	CacheSize        int
+}
+
+// equivocationDetector remembers, for each key, shard and round, the first header signed and builds an evidence
+// as soon as a different header signed with the same key for the same shard and round is seen
+type equivocationDetector struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	nodesCoordinator sharding.NodesCoordinator
+	shardCoordinator sharding.Coordinator
+	evidenceVerifier EvidenceVerifier
+	evidencePool     storage.Cacher
+	broadcaster      EvidenceBroadcaster
+	antifloodHandler process.P2PAntifloodHandler
+
+	mutSignedHeaders sync.Mutex
+	signedHeaders    storage.Cacher
+	headers          storage.Cacher
+}
+
+// NewEquivocationDetector creates a new equivocation detector
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EvidenceVerifier) {
+		return nil, process.ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, process.ErrNilCacher
+	}
+	if check.IfNil(args.Broadcaster) {
+		return nil, process.ErrNilMessenger
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, process.ErrNilAntifloodHandler
+	}
+
+	signedHeaders, err := lrucache.NewCache(args.CacheSize)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := lrucache.NewCache(args.CacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &equivocationDetector{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		nodesCoordinator: args.NodesCoordinator,
+		shardCoordinator: args.ShardCoordinator,
+		evidenceVerifier: args.EvidenceVerifier,
+		evidencePool:     args.EvidencePool,
+		broadcaster:      args.Broadcaster,
+		antifloodHandler: args.AntifloodHandler,
+		signedHeaders:    signedHeaders,
+		headers:          headers,
+	}, nil
+}
+
+// HeaderReceived is called by the header interceptors and checks the leader signature of the received header
+// against the headers already signed by the same leader in the same round
+func (ed *equivocationDetector) HeaderReceived(_ string, _ []byte, value interface{}) {
+	header, ok := value.(data.HeaderHandler)
+	if !ok || check.IfNil(header) {
+		return
+	}
+	if len(header.GetLeaderSignature()) == 0 {
+		return
+	}
+
+	consensusGroup, err := computeConsensusGroup(ed.nodesCoordinator, header)
+	if err != nil {
+		log.Trace("equivocationDetector.HeaderReceived: cannot compute consensus group", "error", err)
+		return
+	}
+	leader, err := getLeader(consensusGroup, header)
+	if err != nil {
+		log.Trace("equivocationDetector.HeaderReceived: cannot get the leader", "error", err)
+		return
+	}
+
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+	headerBytes, err := ed.marshalizer.Marshal(headerCopy)
+	if err != nil {
+		return
+	}
+
+	headerHash := ed.hasher.Compute(string(headerBytes))
+	ed.headers.Put(headerHash, headerBytes, len(headerBytes))
+	ed.checkSignedHeader(
+		LeaderEquivocation,
+		leader.PubKey(),
+		header.GetShardID(),
+		header.GetRound(),
+		headerHash,
+		header.GetLeaderSignature(),
+	)
+}
+
+// CheckConsensusMessage is called by the consensus worker for every valid consensus message: proposed headers
+// are remembered and signature shares are checked against the ones already given by the same key in the same round
+func (ed *equivocationDetector) CheckConsensusMessage(cnsMsg *consensus.Message) {
+	if cnsMsg == nil {
+		return
+	}
+
+	hasProposedHeader := len(cnsMsg.Header) > 0 &&
+		bytes.Equal(ed.hasher.Compute(string(cnsMsg.Header)), cnsMsg.BlockHeaderHash)
+	if hasProposedHeader {
+		ed.headers.Put(cnsMsg.BlockHeaderHash, cnsMsg.Header, len(cnsMsg.Header))
+	}
+
+	if len(cnsMsg.SignatureShare) == 0 || len(cnsMsg.BlockHeaderHash) == 0 || cnsMsg.RoundIndex < 0 {
+		return
+	}
+
+	ed.checkSignedHeader(
+		SignatureShareEquivocation,
+		cnsMsg.PubKey,
+		ed.shardCoordinator.SelfId(),
+		uint64(cnsMsg.RoundIndex),
+		cnsMsg.BlockHeaderHash,
+		cnsMsg.SignatureShare,
+	)
+}
+
+func (ed *equivocationDetector) checkSignedHeader(
+	equivocationType EquivocationType,
+	pubKey []byte,
+	shardID uint32,
+	round uint64,
+	headerHash []byte,
+	signature []byte,
+) {
+	offenseKey := computeOffenseKey(ed.hasher, equivocationType, pubKey, shardID, round)
+
+	ed.mutSignedHeaders.Lock()
+	value, found := ed.signedHeaders.Get(offenseKey)
+	if !found {
+		ed.signedHeaders.Put(offenseKey, &signedHeader{headerHash: headerHash, signature: signature}, 0)
+	}
+	ed.mutSignedHeaders.Unlock()
+	if !found {
+		return
+	}
+
+	first, ok := value.(*signedHeader)
+	if !ok || bytes.Equal(first.headerHash, headerHash) {
+		return
+	}
+	if ed.evidencePool.Has(offenseKey) {
+		return
+	}
+
+	firstHeader, found := ed.headers.Get(first.headerHash)
+	if !found {
+		log.Debug("equivocation detected but the first header is unknown",
+			"pk", pubKey, "shard", shardID, "round", round)
+		return
+	}
+	secondHeader, found := ed.headers.Get(headerHash)
+	if !found {
+		log.Debug("equivocation detected but the second header is unknown",
+			"pk", pubKey, "shard", shardID, "round", round)
+		return
+	}
+
+	evidence := &EquivocationEvidence{
+		Type:            equivocationType,
+		PubKey:          pubKey,
+		ShardID:         shardID,
+		Round:           round,
+		FirstHeader:     firstHeader.([]byte),
+		FirstSignature:  first.signature,
+		SecondHeader:    secondHeader.([]byte),
+		SecondSignature: signature,
+	}
+
+	ed.addEvidence(offenseKey, evidence)
+}
+
+func (ed *equivocationDetector) addEvidence(offenseKey []byte, evidence *EquivocationEvidence) {
+	err := ed.evidenceVerifier.Verify(evidence)
+	if err != nil {
+		log.Debug("equivocationDetector.addEvidence: invalid evidence", "error", err.Error())
+		return
+	}
+
+	buff, err := ed.marshalizer.Marshal(evidence)
+	if err != nil {
+		log.Debug("equivocationDetector.addEvidence: marshal", "error", err.Error())
+		return
+	}
+
+	log.Warn("equivocation detected",
+		"type", evidence.Type.String(),
+		"pk", evidence.PubKey,
+		"shard", evidence.ShardID,
+		"round", evidence.Round,
+	)
+
+	ed.evidencePool.Put(offenseKey, evidence, len(buff))
+	ed.broadcaster.Broadcast(core.EquivocationEvidenceTopic, buff)
+}
+
+// ProcessReceivedMessage verifies an equivocation evidence received from the network and adds it to the pool
+func (ed *equivocationDetector) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return process.ErrNilMessage
+	}
+	if len(message.Data()) == 0 {
+		return process.ErrNilDataToProcess
+	}
+
+	err := ed.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = ed.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, core.EquivocationEvidenceTopic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	evidence := &EquivocationEvidence{}
+	err = ed.marshalizer.Unmarshal(evidence, message.Data())
+	if err != nil {
+		return err
+	}
+
+	offenseKey := ComputeOffenseKey(ed.hasher, evidence)
+	if ed.evidencePool.Has(offenseKey) {
+		return nil
+	}
+
+	err = ed.evidenceVerifier.Verify(evidence)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("received equivocation evidence",
+		"type", evidence.Type.String(),
+		"pk", evidence.PubKey,
+		"shard", evidence.ShardID,
+		"round", evidence.Round,
+	)
+	ed.evidencePool.Put(offenseKey, evidence, len(message.Data()))
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,275 @@
+package slash_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsEquivocationDetector() slash.ArgsEquivocationDetector {
+	evidencePool, _ := lrucache.NewCache(100)
+
+	return slash.ArgsEquivocationDetector{
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &mock.HasherMock{},
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+				return []sharding.Validator{mock.NewValidatorMock(leaderPubKey)}, nil
+			},
+		},
+		ShardCoordinator: mock.NewOneShardCoordinatorMock(),
+		EvidenceVerifier: &mock.EvidenceVerifierStub{},
+		EvidencePool:     evidencePool,
+		Broadcaster: &mock.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {},
+		},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		CacheSize:        100,
+	}
+}
+
+func TestNewEquivocationDetector_NilEvidenceVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.EvidenceVerifier = nil
+	ed, err := slash.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, process.ErrNilEvidenceVerifier, err)
+}
+
+func TestNewEquivocationDetector_NilEvidencePoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.EvidencePool = nil
+	ed, err := slash.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, process.ErrNilCacher, err)
+}
+
+func TestNewEquivocationDetector_NilBroadcasterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = nil
+	ed, err := slash.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, process.ErrNilMessenger, err)
+}
+
+func TestNewEquivocationDetector_InvalidCacheSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.CacheSize = 0
+	ed, err := slash.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.NotNil(t, err)
+}
+
+func TestNewEquivocationDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ed, err := slash.NewEquivocationDetector(createMockArgsEquivocationDetector())
+
+	assert.NotNil(t, ed)
+	assert.Nil(t, err)
+}
+
+func TestEquivocationDetector_HeaderReceivedSameHeaderTwiceShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not broadcast")
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	header := &block.Header{Round: 5, LeaderSignature: []byte("signature")}
+	ed.HeaderReceived("", nil, header)
+	ed.HeaderReceived("", nil, header)
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_HeaderReceivedConflictingHeadersShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	var broadcastTopic string
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastTopic = topic
+		},
+	}
+	var verifiedEvidence *slash.EquivocationEvidence
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *slash.EquivocationEvidence) error {
+			verifiedEvidence = evidence
+			return nil
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 1"), LeaderSignature: []byte("sig 1")})
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 2"), LeaderSignature: []byte("sig 2")})
+
+	assert.Equal(t, core.EquivocationEvidenceTopic, broadcastTopic)
+	assert.Equal(t, 1, args.EvidencePool.Len())
+	assert.Equal(t, slash.LeaderEquivocation, verifiedEvidence.Type)
+	assert.Equal(t, leaderPubKey, verifiedEvidence.PubKey)
+	assert.Equal(t, uint64(5), verifiedEvidence.Round)
+	assert.Equal(t, []byte("sig 1"), verifiedEvidence.FirstSignature)
+	assert.Equal(t, []byte("sig 2"), verifiedEvidence.SecondSignature)
+}
+
+func TestEquivocationDetector_HeaderReceivedFromLeaderAndBackupLeaderShouldNotCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+			return []sharding.Validator{
+				mock.NewValidatorMock(leaderPubKey),
+				mock.NewValidatorMock([]byte("validator pub key")),
+			}, nil
+		},
+	}
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not broadcast")
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 1"), LeaderSignature: []byte("sig 1")})
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 2"), LeaderSignature: []byte("sig 2"), LeaderIndex: 1})
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 3"), LeaderSignature: []byte("sig 3"), LeaderIndex: 2})
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_HeaderReceivedInvalidEvidenceShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not broadcast")
+		},
+	}
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *slash.EquivocationEvidence) error {
+			return process.ErrInvalidEquivocationEvidence
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 1"), LeaderSignature: []byte("sig 1")})
+	ed.HeaderReceived("", nil, &block.Header{Round: 5, RootHash: []byte("root hash 2"), LeaderSignature: []byte("sig 2")})
+
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_CheckConsensusMessageConflictingSharesShouldCreateEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	numBroadcasts := 0
+	args.Broadcaster = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	hasher := &mock.HasherMock{}
+	firstHeader := []byte("first header")
+	secondHeader := []byte("second header")
+	pubKey := []byte("validator pub key")
+	ed.CheckConsensusMessage(&consensus.Message{Header: firstHeader, BlockHeaderHash: hasher.Compute(string(firstHeader)), RoundIndex: 5})
+	ed.CheckConsensusMessage(&consensus.Message{Header: secondHeader, BlockHeaderHash: hasher.Compute(string(secondHeader)), RoundIndex: 5})
+	ed.CheckConsensusMessage(&consensus.Message{
+		BlockHeaderHash: hasher.Compute(string(firstHeader)),
+		SignatureShare:  []byte("share 1"),
+		PubKey:          pubKey,
+		RoundIndex:      5,
+	})
+	assert.Equal(t, 0, numBroadcasts)
+
+	ed.CheckConsensusMessage(&consensus.Message{
+		BlockHeaderHash: hasher.Compute(string(secondHeader)),
+		SignatureShare:  []byte("share 2"),
+		PubKey:          pubKey,
+		RoundIndex:      5,
+	})
+
+	assert.Equal(t, 1, numBroadcasts)
+	assert.Equal(t, 1, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageAntifloodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsEquivocationDetector()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			return expectedErr
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	err := ed.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("data")}, "pid")
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageInvalidEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	args.EvidenceVerifier = &mock.EvidenceVerifierStub{
+		VerifyCalled: func(evidence *slash.EquivocationEvidence) error {
+			return process.ErrInvalidEquivocationEvidence
+		},
+	}
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	buff, _ := args.Marshalizer.Marshal(createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	err := ed.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, "pid")
+
+	assert.Equal(t, process.ErrInvalidEquivocationEvidence, err)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageShouldAddToPool(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEquivocationDetector()
+	ed, _ := slash.NewEquivocationDetector(args)
+
+	evidence := createEvidence(slash.LeaderEquivocation, leaderPubKey)
+	buff, _ := args.Marshalizer.Marshal(evidence)
+	err := ed.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: buff}, "pid")
+
+	assert.Nil(t, err)
+	assert.True(t, args.EvidencePool.Has(slash.ComputeOffenseKey(args.Hasher, evidence)))
+}
@@ -0,0 +1,781 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evidence.proto
+
+package slash
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EquivocationType represents the kind of conflicting data signed with the same key in the same round
+type EquivocationType int32
+
+const (
+	// InvalidEquivocation
+	InvalidEquivocation EquivocationType = 0
+	// LeaderEquivocation indicates that the same leader signed two different headers for the same round
+	LeaderEquivocation EquivocationType = 1
+	// SignatureShareEquivocation indicates that the same validator gave signature shares for two different headers in the same round
+	SignatureShareEquivocation EquivocationType = 2
+)
+
+var EquivocationType_name = map[int32]string{
+	0: "InvalidEquivocation",
+	1: "LeaderEquivocation",
+	2: "SignatureShareEquivocation",
+}
+
+var EquivocationType_value = map[string]int32{
+	"InvalidEquivocation":        0,
+	"LeaderEquivocation":         1,
+	"SignatureShareEquivocation": 2,
+}
+
+func (EquivocationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b1d6725573e3e5a, []int{0}
+}
+
+// EquivocationEvidence holds the proof that a validator signed two different headers in the same round
+type EquivocationEvidence struct {
+	Type            EquivocationType `protobuf:"varint,1,opt,name=Type,proto3,enum=proto.EquivocationType" json:"Type,omitempty"`
+	PubKey          []byte           `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardID         uint32           `protobuf:"varint,3,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round           uint64           `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	FirstHeader     []byte           `protobuf:"bytes,5,opt,name=FirstHeader,proto3" json:"FirstHeader,omitempty"`
+	FirstSignature  []byte           `protobuf:"bytes,6,opt,name=FirstSignature,proto3" json:"FirstSignature,omitempty"`
+	SecondHeader    []byte           `protobuf:"bytes,7,opt,name=SecondHeader,proto3" json:"SecondHeader,omitempty"`
+	SecondSignature []byte           `protobuf:"bytes,8,opt,name=SecondSignature,proto3" json:"SecondSignature,omitempty"`
+}
+
+func (m *EquivocationEvidence) Reset()      { *m = EquivocationEvidence{} }
+func (*EquivocationEvidence) ProtoMessage() {}
+func (*EquivocationEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b1d6725573e3e5a, []int{0}
+}
+func (m *EquivocationEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EquivocationEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationEvidence.Merge(m, src)
+}
+func (m *EquivocationEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationEvidence proto.InternalMessageInfo
+
+func (m *EquivocationEvidence) GetType() EquivocationType {
+	if m != nil {
+		return m.Type
+	}
+	return InvalidEquivocation
+}
+
+func (m *EquivocationEvidence) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *EquivocationEvidence) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *EquivocationEvidence) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *EquivocationEvidence) GetFirstHeader() []byte {
+	if m != nil {
+		return m.FirstHeader
+	}
+	return nil
+}
+
+func (m *EquivocationEvidence) GetFirstSignature() []byte {
+	if m != nil {
+		return m.FirstSignature
+	}
+	return nil
+}
+
+func (m *EquivocationEvidence) GetSecondHeader() []byte {
+	if m != nil {
+		return m.SecondHeader
+	}
+	return nil
+}
+
+func (m *EquivocationEvidence) GetSecondSignature() []byte {
+	if m != nil {
+		return m.SecondSignature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("proto.EquivocationType", EquivocationType_name, EquivocationType_value)
+	proto.RegisterType((*EquivocationEvidence)(nil), "proto.EquivocationEvidence")
+}
+
+func init() { proto.RegisterFile("evidence.proto", fileDescriptor_9b1d6725573e3e5a) }
+
+var fileDescriptor_9b1d6725573e3e5a = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0x7b, 0xb8, 0x14, 0x6e, 0xce, 0xe5, 0x22, 0x19, 0x09, 0x34, 0x2c, 0x4e, 0x1a, 0x16,
+	0xa6, 0xd1, 0x08, 0x89, 0x3e, 0x80, 0x89, 0x11, 0x23, 0xd1, 0x85, 0x29, 0xae, 0xdc, 0xf5, 0xcf,
+	0x58, 0x9a, 0x60, 0x07, 0x4b, 0x4b, 0xc2, 0xce, 0x47, 0xf0, 0x1d, 0xdc, 0xf8, 0x28, 0x2e, 0x59,
+	0xb2, 0x94, 0x61, 0xe3, 0x92, 0x47, 0x30, 0x4c, 0x51, 0x81, 0x55, 0xfb, 0xfb, 0xce, 0x6f, 0xbe,
+	0xc9, 0xcc, 0x60, 0x99, 0x8f, 0x43, 0x9f, 0x47, 0x1e, 0x6f, 0x0d, 0x63, 0x91, 0x08, 0xa6, 0xab,
+	0x4f, 0xe3, 0x38, 0x08, 0x93, 0x7e, 0xea, 0xb6, 0x3c, 0xf1, 0xd8, 0x0e, 0x44, 0x20, 0xda, 0x2a,
+	0x76, 0xd3, 0x07, 0x45, 0x0a, 0xd4, 0x5f, 0xb6, 0xaa, 0xf9, 0x9a, 0xc3, 0x6a, 0xe7, 0x29, 0x0d,
+	0xc7, 0xc2, 0x73, 0x92, 0x50, 0x44, 0x9d, 0x75, 0x29, 0x3b, 0xc2, 0xfc, 0xdd, 0x64, 0xc8, 0x0d,
+	0x30, 0xc1, 0x2a, 0x9f, 0xd4, 0x33, 0xbd, 0xb5, 0xa9, 0xae, 0xc6, 0xb6, 0x92, 0x58, 0x0d, 0x0b,
+	0xb7, 0xa9, 0x7b, 0xcd, 0x27, 0x46, 0xce, 0x04, 0xab, 0x64, 0xaf, 0x89, 0x19, 0x58, 0xec, 0xf5,
+	0x9d, 0xd8, 0xef, 0x5e, 0x18, 0x7f, 0x4c, 0xb0, 0xfe, 0xdb, 0xdf, 0xc8, 0xaa, 0xa8, 0xdb, 0x22,
+	0x8d, 0x7c, 0x23, 0x6f, 0x82, 0x95, 0xb7, 0x33, 0x60, 0x26, 0xfe, 0xbb, 0x0c, 0xe3, 0x51, 0x72,
+	0xc5, 0x1d, 0x9f, 0xc7, 0x86, 0xae, 0xca, 0x36, 0x23, 0x76, 0x80, 0x65, 0x85, 0xbd, 0x30, 0x88,
+	0x9c, 0x24, 0x8d, 0xb9, 0x51, 0x50, 0xd2, 0x4e, 0xca, 0x9a, 0x58, 0xea, 0x71, 0x4f, 0x44, 0xfe,
+	0xba, 0xaa, 0xa8, 0xac, 0xad, 0x8c, 0x59, 0xb8, 0x97, 0xf1, 0x6f, 0xd9, 0x5f, 0xa5, 0xed, 0xc6,
+	0x87, 0x1e, 0x56, 0x76, 0x4f, 0xce, 0xea, 0xb8, 0xdf, 0x8d, 0xc6, 0xce, 0x20, 0xf4, 0x37, 0x47,
+	0x15, 0x8d, 0xd5, 0x90, 0xdd, 0xa8, 0x0d, 0xb6, 0x72, 0x60, 0x84, 0x8d, 0x9f, 0xc6, 0xd5, 0x35,
+	0xf0, 0xad, 0x79, 0xee, 0xfc, 0x6c, 0x3a, 0x27, 0x6d, 0x36, 0x27, 0x6d, 0x39, 0x27, 0x78, 0x96,
+	0x04, 0x6f, 0x92, 0xe0, 0x5d, 0x12, 0x4c, 0x25, 0xc1, 0x4c, 0x12, 0x7c, 0x48, 0x82, 0x4f, 0x49,
+	0xda, 0x52, 0x12, 0xbc, 0x2c, 0x48, 0x9b, 0x2e, 0x48, 0x9b, 0x2d, 0x48, 0xbb, 0xd7, 0x47, 0x03,
+	0x67, 0xd4, 0x77, 0x0b, 0xea, 0x8d, 0x4e, 0xbf, 0x06, 0x00, 0xe5, 0x44, 0xb5, 0xdb, 0x1a, 0x02,
+	0x00, 0x00,
+}
+
+func (x EquivocationType) String() string {
+	s, ok := EquivocationType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *EquivocationEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EquivocationEvidence)
+	if !ok {
+		that2, ok := that.(EquivocationEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.FirstHeader, that1.FirstHeader) {
+		return false
+	}
+	if !bytes.Equal(this.FirstSignature, that1.FirstSignature) {
+		return false
+	}
+	if !bytes.Equal(this.SecondHeader, that1.SecondHeader) {
+		return false
+	}
+	if !bytes.Equal(this.SecondSignature, that1.SecondSignature) {
+		return false
+	}
+	return true
+}
+func (this *EquivocationEvidence) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&slash.EquivocationEvidence{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "FirstHeader: "+fmt.Sprintf("%#v", this.FirstHeader)+",\n")
+	s = append(s, "FirstSignature: "+fmt.Sprintf("%#v", this.FirstSignature)+",\n")
+	s = append(s, "SecondHeader: "+fmt.Sprintf("%#v", this.SecondHeader)+",\n")
+	s = append(s, "SecondSignature: "+fmt.Sprintf("%#v", this.SecondSignature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEvidence(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EquivocationEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondSignature) > 0 {
+		i -= len(m.SecondSignature)
+		copy(dAtA[i:], m.SecondSignature)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SecondSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SecondHeader) > 0 {
+		i -= len(m.SecondHeader)
+		copy(dAtA[i:], m.SecondHeader)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SecondHeader)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FirstSignature) > 0 {
+		i -= len(m.FirstSignature)
+		copy(dAtA[i:], m.FirstSignature)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.FirstSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FirstHeader) > 0 {
+		i -= len(m.FirstHeader)
+		copy(dAtA[i:], m.FirstHeader)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.FirstHeader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Round != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EquivocationEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovEvidence(uint64(m.Type))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovEvidence(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEvidence(uint64(m.Round))
+	}
+	l = len(m.FirstHeader)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.FirstSignature)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SecondHeader)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SecondSignature)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EquivocationEvidence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EquivocationEvidence{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`FirstHeader:` + fmt.Sprintf("%v", this.FirstHeader) + `,`,
+		`FirstSignature:` + fmt.Sprintf("%v", this.FirstSignature) + `,`,
+		`SecondHeader:` + fmt.Sprintf("%v", this.SecondHeader) + `,`,
+		`SecondSignature:` + fmt.Sprintf("%v", this.SecondSignature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEvidence(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EquivocationEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= EquivocationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstHeader = append(m.FirstHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstHeader == nil {
+				m.FirstHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstSignature = append(m.FirstSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstSignature == nil {
+				m.FirstSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondHeader = append(m.SecondHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondHeader == nil {
+				m.SecondHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondSignature = append(m.SecondSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondSignature == nil {
+				m.SecondSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
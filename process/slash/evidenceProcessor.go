@@ -0,0 +1,229 @@
+package slash
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgsEvidenceProcessor holds the arguments needed to create an evidence processor
+type ArgsEvidenceProcessor struct {
+	Marshalizer          marshal.Marshalizer
+	Hasher               hashing.Hasher
+	EvidenceVerifier     EvidenceVerifier
+	EvidencePool         storage.Cacher
+	SCCallExecutor       process.SystemSCCallExecutor
+	MaxEvidencesPerBlock uint32
+}
+
+// evidenceProcessor is used by the metachain to turn the gathered equivocation evidences into calls to the staking
+// smart contract which slash and jail the offending validators
+type evidenceProcessor struct {
+	marshalizer          marshal.Marshalizer
+	hasher               hashing.Hasher
+	evidenceVerifier     EvidenceVerifier
+	evidencePool         storage.Cacher
+	scCallExecutor       process.SystemSCCallExecutor
+	maxEvidencesPerBlock int
+}
+
+// NewEvidenceProcessor creates a new equivocation evidence processor
+func NewEvidenceProcessor(args ArgsEvidenceProcessor) (*evidenceProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.EvidenceVerifier) {
+		return nil, process.ErrNilEvidenceVerifier
+	}
+	if check.IfNil(args.EvidencePool) {
+		return nil, process.ErrNilCacher
+	}
+	if check.IfNil(args.SCCallExecutor) {
+		return nil, process.ErrNilSystemSCCallExecutor
+	}
+	if args.MaxEvidencesPerBlock == 0 {
+		return nil, fmt.Errorf("%w for MaxEvidencesPerBlock", process.ErrInvalidValue)
+	}
+
+	return &evidenceProcessor{
+		marshalizer:          args.Marshalizer,
+		hasher:               args.Hasher,
+		evidenceVerifier:     args.EvidenceVerifier,
+		evidencePool:         args.EvidencePool,
+		scCallExecutor:       args.SCCallExecutor,
+		maxEvidencesPerBlock: int(args.MaxEvidencesPerBlock),
+	}, nil
+}
+
+// IncludeEvidences executes the slashing of the pooled evidences and notarizes the successful ones in the
+// metablock peer info. Evidences that can not be slashed anymore are dropped from the pool
+func (ep *evidenceProcessor) IncludeEvidences(metaBlock *block.MetaBlock, haveTime func() bool) error {
+	if metaBlock == nil {
+		return process.ErrNilMetaBlockHeader
+	}
+
+	for _, offenseKey := range ep.evidencePool.Keys() {
+		if !haveTime() || len(metaBlock.PeerInfo) >= ep.maxEvidencesPerBlock {
+			break
+		}
+
+		value, ok := ep.evidencePool.Peek(offenseKey)
+		if !ok {
+			continue
+		}
+		evidence, ok := value.(*EquivocationEvidence)
+		if !ok {
+			ep.evidencePool.Remove(offenseKey)
+			continue
+		}
+
+		peerData, err := ep.executeEvidence(evidence)
+		if err != nil {
+			log.Debug("evidenceProcessor.IncludeEvidences: evidence dropped",
+				"offense", offenseKey,
+				"error", err.Error(),
+			)
+			ep.evidencePool.Remove(offenseKey)
+			continue
+		}
+
+		metaBlock.PeerInfo = append(metaBlock.PeerInfo, *peerData)
+	}
+
+	return nil
+}
+
+// ProcessEvidences verifies and executes the evidences notarized in the metablock peer info, checking that each one
+// slashes the announced value
+func (ep *evidenceProcessor) ProcessEvidences(metaBlock *block.MetaBlock) error {
+	if metaBlock == nil {
+		return process.ErrNilMetaBlockHeader
+	}
+	if len(metaBlock.PeerInfo) > ep.maxEvidencesPerBlock {
+		return fmt.Errorf("%w: too many evidences in block", process.ErrInvalidEquivocationEvidence)
+	}
+
+	for i := range metaBlock.PeerInfo {
+		peerData := &metaBlock.PeerInfo[i]
+		if peerData.Action != block.PeerSlashed {
+			return fmt.Errorf("%w: unexpected peer action %s", process.ErrInvalidEquivocationEvidence, peerData.Action.String())
+		}
+
+		evidence := &EquivocationEvidence{}
+		err := ep.marshalizer.Unmarshal(evidence, peerData.Evidence)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(evidence.PubKey, peerData.PublicKey) {
+			return fmt.Errorf("%w: public key mismatch", process.ErrInvalidEquivocationEvidence)
+		}
+
+		err = ep.evidenceVerifier.Verify(evidence)
+		if err != nil {
+			return err
+		}
+
+		result, err := ep.executeEvidence(evidence)
+		if err != nil {
+			return err
+		}
+
+		expectedValue := peerData.ValueChange
+		if expectedValue == nil {
+			expectedValue = big.NewInt(0)
+		}
+		if result.ValueChange.Cmp(expectedValue) != 0 {
+			return fmt.Errorf("%w: expected %s, computed %s", process.ErrEquivocationSlashMismatch,
+				expectedValue.String(), result.ValueChange.String())
+		}
+	}
+
+	return nil
+}
+
+func (ep *evidenceProcessor) executeEvidence(evidence *EquivocationEvidence) (*block.PeerData, error) {
+	evidenceBytes, err := ep.marshalizer.Marshal(evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	offenseKey := ComputeOffenseKey(ep.hasher, evidence)
+	vmOutput, err := ep.scCallExecutor.ExecuteSystemSmartContractCall(createSlashingSCR(evidence.PubKey, offenseKey))
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w: slashing returned %s: %s", process.ErrInvalidEquivocationEvidence,
+			vmOutput.ReturnCode.String(), vmOutput.ReturnMessage)
+	}
+
+	slashValue := big.NewInt(0)
+	if len(vmOutput.ReturnData) > 0 {
+		slashValue.SetBytes(vmOutput.ReturnData[0])
+	}
+
+	return &block.PeerData{
+		PublicKey:   evidence.PubKey,
+		Action:      block.PeerSlashed,
+		ValueChange: slashValue,
+		Evidence:    evidenceBytes,
+	}, nil
+}
+
+// RemoveIncludedEvidences removes from the pool the evidences notarized in the committed metablock
+func (ep *evidenceProcessor) RemoveIncludedEvidences(metaBlock *block.MetaBlock) {
+	if metaBlock == nil {
+		return
+	}
+
+	for _, evidence := range ep.includedEvidences(metaBlock) {
+		ep.evidencePool.Remove(ComputeOffenseKey(ep.hasher, evidence))
+	}
+}
+
+// RestoreEvidences puts back in the pool the evidences notarized in a reverted metablock
+func (ep *evidenceProcessor) RestoreEvidences(metaBlock *block.MetaBlock) {
+	if metaBlock == nil {
+		return
+	}
+
+	for _, evidence := range ep.includedEvidences(metaBlock) {
+		ep.evidencePool.Put(ComputeOffenseKey(ep.hasher, evidence), evidence, evidence.Size())
+	}
+}
+
+func (ep *evidenceProcessor) includedEvidences(metaBlock *block.MetaBlock) []*EquivocationEvidence {
+	evidences := make([]*EquivocationEvidence, 0, len(metaBlock.PeerInfo))
+	for _, peerData := range metaBlock.PeerInfo {
+		if peerData.Action != block.PeerSlashed {
+			continue
+		}
+
+		evidence := &EquivocationEvidence{}
+		err := ep.marshalizer.Unmarshal(evidence, peerData.Evidence)
+		if err != nil {
+			log.Debug("evidenceProcessor.includedEvidences", "error", err.Error())
+			continue
+		}
+
+		evidences = append(evidences, evidence)
+	}
+
+	return evidences
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *evidenceProcessor) IsInterfaceNil() bool {
+	return ep == nil
+}
@@ -0,0 +1,218 @@
+package slash_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func haveTimeTrue() bool {
+	return true
+}
+
+func createMockArgsEvidenceProcessor() slash.ArgsEvidenceProcessor {
+	evidencePool, _ := lrucache.NewCache(100)
+
+	return slash.ArgsEvidenceProcessor{
+		Marshalizer:      &mock.MarshalizerMock{},
+		Hasher:           &mock.HasherMock{},
+		EvidenceVerifier: &mock.EvidenceVerifierStub{},
+		EvidencePool:     evidencePool,
+		SCCallExecutor: &mock.SystemSCCallExecutorStub{
+			ExecuteSystemSmartContractCallCalled: func(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					ReturnCode: vmcommon.Ok,
+					ReturnData: [][]byte{big.NewInt(100).Bytes()},
+				}, nil
+			},
+		},
+		MaxEvidencesPerBlock: 10,
+	}
+}
+
+func addEvidenceToPool(args slash.ArgsEvidenceProcessor, evidence *slash.EquivocationEvidence) {
+	args.EvidencePool.Put(slash.ComputeOffenseKey(args.Hasher, evidence), evidence, evidence.Size())
+}
+
+func TestNewEvidenceProcessor_NilSCCallExecutorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.SCCallExecutor = nil
+	ep, err := slash.NewEvidenceProcessor(args)
+
+	assert.Nil(t, ep)
+	assert.Equal(t, process.ErrNilSystemSCCallExecutor, err)
+}
+
+func TestNewEvidenceProcessor_ZeroMaxEvidencesPerBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.MaxEvidencesPerBlock = 0
+	ep, err := slash.NewEvidenceProcessor(args)
+
+	assert.Nil(t, ep)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewEvidenceProcessor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ep, err := slash.NewEvidenceProcessor(createMockArgsEvidenceProcessor())
+
+	assert.NotNil(t, ep)
+	assert.Nil(t, err)
+}
+
+func TestEvidenceProcessor_IncludeEvidencesShouldAddPeerInfo(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	var executedSCR *smartContractResult.SmartContractResult
+	args.SCCallExecutor = &mock.SystemSCCallExecutorStub{
+		ExecuteSystemSmartContractCallCalled: func(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error) {
+			executedSCR = scr
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{big.NewInt(100).Bytes()},
+			}, nil
+		},
+	}
+	evidence := createEvidence(slash.LeaderEquivocation, leaderPubKey)
+	addEvidenceToPool(args, evidence)
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	metaBlock := &block.MetaBlock{}
+	err := ep.IncludeEvidences(metaBlock, haveTimeTrue)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, executedSCR)
+	assert.Equal(t, 1, len(metaBlock.PeerInfo))
+	assert.Equal(t, leaderPubKey, metaBlock.PeerInfo[0].PublicKey)
+	assert.Equal(t, block.PeerSlashed, metaBlock.PeerInfo[0].Action)
+	assert.Equal(t, big.NewInt(100), metaBlock.PeerInfo[0].ValueChange)
+}
+
+func TestEvidenceProcessor_IncludeEvidencesFailedSlashingShouldDropEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.SCCallExecutor = &mock.SystemSCCallExecutorStub{
+		ExecuteSystemSmartContractCallCalled: func(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	}
+	addEvidenceToPool(args, createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	metaBlock := &block.MetaBlock{}
+	err := ep.IncludeEvidences(metaBlock, haveTimeTrue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(metaBlock.PeerInfo))
+	assert.Equal(t, 0, args.EvidencePool.Len())
+}
+
+func TestEvidenceProcessor_IncludeEvidencesShouldRespectMaxEvidencesPerBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	args.MaxEvidencesPerBlock = 1
+	addEvidenceToPool(args, createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	addEvidenceToPool(args, createEvidence(slash.SignatureShareEquivocation, []byte("validator pub key")))
+	ep, _ := slash.NewEvidenceProcessor(args)
+
+	metaBlock := &block.MetaBlock{}
+	err := ep.IncludeEvidences(metaBlock, haveTimeTrue)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(metaBlock.PeerInfo))
+}
+
+func TestEvidenceProcessor_ProcessEvidencesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	addEvidenceToPool(args, createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	ep, _ := slash.NewEvidenceProcessor(args)
+	metaBlock := &block.MetaBlock{}
+	_ = ep.IncludeEvidences(metaBlock, haveTimeTrue)
+
+	err := ep.ProcessEvidences(metaBlock)
+
+	assert.Nil(t, err)
+}
+
+func TestEvidenceProcessor_ProcessEvidencesValueMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	addEvidenceToPool(args, createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	ep, _ := slash.NewEvidenceProcessor(args)
+	metaBlock := &block.MetaBlock{}
+	_ = ep.IncludeEvidences(metaBlock, haveTimeTrue)
+	metaBlock.PeerInfo[0].ValueChange = big.NewInt(200)
+
+	err := ep.ProcessEvidences(metaBlock)
+
+	assert.True(t, errors.Is(err, process.ErrEquivocationSlashMismatch))
+}
+
+func TestEvidenceProcessor_ProcessEvidencesPublicKeyMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	addEvidenceToPool(args, createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	ep, _ := slash.NewEvidenceProcessor(args)
+	metaBlock := &block.MetaBlock{}
+	_ = ep.IncludeEvidences(metaBlock, haveTimeTrue)
+	metaBlock.PeerInfo[0].PublicKey = []byte("another pub key")
+
+	err := ep.ProcessEvidences(metaBlock)
+
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceProcessor_ProcessEvidencesInvalidEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	addEvidenceToPool(args, createEvidence(slash.LeaderEquivocation, leaderPubKey))
+	ep, _ := slash.NewEvidenceProcessor(args)
+	metaBlock := &block.MetaBlock{}
+	_ = ep.IncludeEvidences(metaBlock, haveTimeTrue)
+
+	args.EvidenceVerifier.(*mock.EvidenceVerifierStub).VerifyCalled = func(evidence *slash.EquivocationEvidence) error {
+		return process.ErrInvalidEquivocationEvidence
+	}
+	err := ep.ProcessEvidences(metaBlock)
+
+	assert.Equal(t, process.ErrInvalidEquivocationEvidence, err)
+}
+
+func TestEvidenceProcessor_RemoveAndRestoreEvidences(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceProcessor()
+	evidence := createEvidence(slash.LeaderEquivocation, leaderPubKey)
+	addEvidenceToPool(args, evidence)
+	ep, _ := slash.NewEvidenceProcessor(args)
+	metaBlock := &block.MetaBlock{}
+	_ = ep.IncludeEvidences(metaBlock, haveTimeTrue)
+
+	ep.RemoveIncludedEvidences(metaBlock)
+	assert.Equal(t, 0, args.EvidencePool.Len())
+
+	ep.RestoreEvidences(metaBlock)
+	assert.True(t, args.EvidencePool.Has(slash.ComputeOffenseKey(args.Hasher, evidence)))
+}
@@ -0,0 +1,146 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. evidence.proto
+package slash
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsEvidenceVerifier holds the arguments needed to create an evidence verifier
+type ArgsEvidenceVerifier struct {
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	NodesCoordinator sharding.NodesCoordinator
+	KeyGen           crypto.KeyGenerator
+	SingleSigner     crypto.SingleSigner
+}
+
+type evidenceVerifier struct {
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	nodesCoordinator sharding.NodesCoordinator
+	keyGen           crypto.KeyGenerator
+	singleSigner     crypto.SingleSigner
+}
+
+// NewEvidenceVerifier creates a new equivocation evidence verifier
+func NewEvidenceVerifier(args ArgsEvidenceVerifier) (*evidenceVerifier, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, process.ErrNilSingleSigner
+	}
+
+	return &evidenceVerifier{
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		nodesCoordinator: args.NodesCoordinator,
+		keyGen:           args.KeyGen,
+		singleSigner:     args.SingleSigner,
+	}, nil
+}
+
+// Verify checks that both headers from the evidence are for the same shard and round, that they differ and that
+// both were signed by the accused key, which was in charge of signing them
+func (ev *evidenceVerifier) Verify(evidence *EquivocationEvidence) error {
+	if evidence == nil {
+		return process.ErrNilEquivocationEvidence
+	}
+	if evidence.Type != LeaderEquivocation && evidence.Type != SignatureShareEquivocation {
+		return fmt.Errorf("%w: unknown equivocation type %d", process.ErrInvalidEquivocationEvidence, evidence.Type)
+	}
+	if bytes.Equal(evidence.FirstHeader, evidence.SecondHeader) {
+		return fmt.Errorf("%w: the headers are identical", process.ErrInvalidEquivocationEvidence)
+	}
+
+	pubKey, err := ev.keyGen.PublicKeyFromByteArray(evidence.PubKey)
+	if err != nil {
+		return fmt.Errorf("%w: %s", process.ErrInvalidEquivocationEvidence, err.Error())
+	}
+
+	err = ev.verifySignedHeader(evidence, pubKey, evidence.FirstHeader, evidence.FirstSignature)
+	if err != nil {
+		return fmt.Errorf("%w on first header: %s", process.ErrInvalidEquivocationEvidence, err.Error())
+	}
+
+	err = ev.verifySignedHeader(evidence, pubKey, evidence.SecondHeader, evidence.SecondSignature)
+	if err != nil {
+		return fmt.Errorf("%w on second header: %s", process.ErrInvalidEquivocationEvidence, err.Error())
+	}
+
+	return nil
+}
+
+func (ev *evidenceVerifier) verifySignedHeader(
+	evidence *EquivocationEvidence,
+	pubKey crypto.PublicKey,
+	headerBytes []byte,
+	signature []byte,
+) error {
+	header, err := unmarshalHeader(ev.marshalizer, evidence.ShardID, headerBytes)
+	if err != nil {
+		return err
+	}
+	if header.GetShardID() != evidence.ShardID {
+		return fmt.Errorf("header is for shard %d", header.GetShardID())
+	}
+	if header.GetRound() != evidence.Round {
+		return fmt.Errorf("header is for round %d", header.GetRound())
+	}
+
+	consensusGroup, err := computeConsensusGroup(ev.nodesCoordinator, header)
+	if err != nil {
+		return err
+	}
+
+	signedData := headerBytes
+	switch evidence.Type {
+	case LeaderEquivocation:
+		leader, errLeader := getLeader(consensusGroup, header)
+		if errLeader != nil {
+			return errLeader
+		}
+		if !bytes.Equal(leader.PubKey(), evidence.PubKey) {
+			return fmt.Errorf("key is not the leader of the header")
+		}
+	case SignatureShareEquivocation:
+		if !isInConsensusGroup(consensusGroup, evidence.PubKey) {
+			return fmt.Errorf("key is not in the consensus group of the round")
+		}
+		signedData = ev.hasher.Compute(string(headerBytes))
+	}
+
+	return ev.singleSigner.Verify(pubKey, signedData, signature)
+}
+
+func isInConsensusGroup(consensusGroup []sharding.Validator, pubKey []byte) bool {
+	for _, validator := range consensusGroup {
+		if bytes.Equal(validator.PubKey(), pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ev *evidenceVerifier) IsInterfaceNil() bool {
+	return ev == nil
+}
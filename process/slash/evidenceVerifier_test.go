@@ -0,0 +1,218 @@
+package slash_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slash"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+)
+
+var leaderPubKey = []byte("leader pub key")
+
+func createMockArgsEvidenceVerifier() slash.ArgsEvidenceVerifier {
+	return slash.ArgsEvidenceVerifier{
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &mock.HasherMock{},
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(_ []byte, _ uint64, _ uint32, _ uint32) ([]sharding.Validator, error) {
+				return []sharding.Validator{
+					mock.NewValidatorMock(leaderPubKey),
+					mock.NewValidatorMock([]byte("validator pub key")),
+				}, nil
+			},
+		},
+		KeyGen: &mock.SingleSignKeyGenMock{
+			PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.SingleSignPublicKey{}, nil
+			},
+		},
+		SingleSigner: &mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		},
+	}
+}
+
+func createEvidence(equivocationType slash.EquivocationType, pubKey []byte) *slash.EquivocationEvidence {
+	marshalizer := &mock.MarshalizerMock{}
+	firstHeader, _ := marshalizer.Marshal(&block.Header{ShardID: 0, Round: 5, Nonce: 4, RootHash: []byte("root hash 1")})
+	secondHeader, _ := marshalizer.Marshal(&block.Header{ShardID: 0, Round: 5, Nonce: 4, RootHash: []byte("root hash 2")})
+
+	return &slash.EquivocationEvidence{
+		Type:            equivocationType,
+		PubKey:          pubKey,
+		ShardID:         0,
+		Round:           5,
+		FirstHeader:     firstHeader,
+		FirstSignature:  []byte("first signature"),
+		SecondHeader:    secondHeader,
+		SecondSignature: []byte("second signature"),
+	}
+}
+
+func TestNewEvidenceVerifier_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.NodesCoordinator = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewEvidenceVerifier_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.SingleSigner = nil
+	ev, err := slash.NewEvidenceVerifier(args)
+
+	assert.Nil(t, ev)
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewEvidenceVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ev, err := slash.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	assert.NotNil(t, ev)
+	assert.Nil(t, err)
+}
+
+func TestEvidenceVerifier_VerifyInvalidTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+	err := ev.Verify(createEvidence(slash.InvalidEquivocation, leaderPubKey))
+
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceVerifier_VerifyIdenticalHeadersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+	evidence := createEvidence(slash.LeaderEquivocation, leaderPubKey)
+	evidence.SecondHeader = evidence.FirstHeader
+
+	err := ev.Verify(evidence)
+
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceVerifier_VerifyDifferentRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+	evidence := createEvidence(slash.LeaderEquivocation, leaderPubKey)
+	evidence.Round = 6
+
+	err := ev.Verify(evidence)
+
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceVerifier_VerifyLeaderEquivocationFromNonLeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ev, _ := slash.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+	err := ev.Verify(createEvidence(slash.LeaderEquivocation, []byte("validator pub key")))
+
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceVerifier_VerifyLeaderEquivocationFromBackupLeaderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	backupLeaderPubKey := []byte("validator pub key")
+	evidence := createEvidence(slash.LeaderEquivocation, backupLeaderPubKey)
+	evidence.FirstHeader, _ = marshalizer.Marshal(&block.Header{Round: 5, Nonce: 4, RootHash: []byte("root hash 1"), LeaderIndex: 1})
+	evidence.SecondHeader, _ = marshalizer.Marshal(&block.Header{Round: 5, Nonce: 4, RootHash: []byte("root hash 2"), LeaderIndex: 1})
+	ev, _ := slash.NewEvidenceVerifier(createMockArgsEvidenceVerifier())
+
+	err := ev.Verify(evidence)
+	assert.Nil(t, err)
+
+	evidence.PubKey = leaderPubKey
+	err = ev.Verify(evidence)
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+
+	evidence.PubKey = backupLeaderPubKey
+	evidence.SecondHeader, _ = marshalizer.Marshal(&block.Header{Round: 5, Nonce: 4, RootHash: []byte("root hash 2"), LeaderIndex: 2})
+	err = ev.Verify(evidence)
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceVerifier_VerifyInvalidSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEvidenceVerifier()
+	args.SingleSigner = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if string(sig) == "second signature" {
+				return errors.New("invalid signature")
+			}
+			return nil
+		},
+	}
+	ev, _ := slash.NewEvidenceVerifier(args)
+
+	err := ev.Verify(createEvidence(slash.LeaderEquivocation, leaderPubKey))
+
+	assert.True(t, errors.Is(err, process.ErrInvalidEquivocationEvidence))
+}
+
+func TestEvidenceVerifier_VerifyLeaderEquivocationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	evidence := createEvidence(slash.LeaderEquivocation, leaderPubKey)
+	signedData := make([][]byte, 0)
+	args := createMockArgsEvidenceVerifier()
+	args.SingleSigner = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			signedData = append(signedData, msg)
+			return nil
+		},
+	}
+	ev, _ := slash.NewEvidenceVerifier(args)
+
+	err := ev.Verify(evidence)
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{evidence.FirstHeader, evidence.SecondHeader}, signedData)
+}
+
+func TestEvidenceVerifier_VerifySignatureShareEquivocationShouldVerifyHeaderHashes(t *testing.T) {
+	t.Parallel()
+
+	evidence := createEvidence(slash.SignatureShareEquivocation, []byte("validator pub key"))
+	signedData := make([][]byte, 0)
+	args := createMockArgsEvidenceVerifier()
+	args.SingleSigner = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			signedData = append(signedData, msg)
+			return nil
+		},
+	}
+	ev, _ := slash.NewEvidenceVerifier(args)
+
+	err := ev.Verify(evidence)
+
+	hasher := &mock.HasherMock{}
+	expectedSignedData := [][]byte{
+		hasher.Compute(string(evidence.FirstHeader)),
+		hasher.Compute(string(evidence.SecondHeader)),
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSignedData, signedData)
+}
@@ -0,0 +1,13 @@
+package slash
+
+// EvidenceVerifier checks that an equivocation evidence really proves a double signing
+type EvidenceVerifier interface {
+	Verify(evidence *EquivocationEvidence) error
+	IsInterfaceNil() bool
+}
+
+// EvidenceBroadcaster is able to gossip the equivocation evidences
+type EvidenceBroadcaster interface {
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
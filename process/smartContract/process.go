@@ -1265,6 +1265,64 @@ func (sc *scProcessor) ProcessSmartContractResult(scr *smartContractResult.Smart
 	return returnCode, sc.ProcessIfError(sndAcc, txHash, scr, err.Error(), scr.ReturnMessage, snapshot)
 }
 
+// ExecuteSystemSmartContractCall runs a call issued by the protocol itself, which has no sender account, against a
+// system smart contract. The state changes and the resulting smart contract results are applied only if the call
+// ended successfully, otherwise the returned VM output holds the reason of the failure.
+func (sc *scProcessor) ExecuteSystemSmartContractCall(scr *smartContractResult.SmartContractResult) (*vmcommon.VMOutput, error) {
+	if check.IfNil(scr) {
+		return nil, process.ErrNilSmartContractResult
+	}
+
+	txHash, err := core.CalculateHash(sc.marshalizer, sc.hasher, scr)
+	if err != nil {
+		return nil, err
+	}
+
+	vmInput, err := sc.createVMCallInput(scr)
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := findVMByTransaction(sc.vmContainer, scr)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput, err := vm.RunSmartContractCall(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput == nil {
+		return nil, process.ErrNilVMOutput
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		log.Debug("system smart contract call returned with error",
+			"hash", txHash,
+			"return code", vmOutput.ReturnCode.String(),
+			"return message", vmOutput.ReturnMessage,
+		)
+		return vmOutput, nil
+	}
+
+	snapshot := sc.accounts.JournalLen()
+	outPutAccounts := sortVMOutputInsideData(vmOutput)
+	results, err := sc.processSCOutputAccounts(outPutAccounts, scr, txHash)
+	if err != nil {
+		errRevert := sc.accounts.RevertToSnapshot(snapshot)
+		if errRevert != nil {
+			log.Debug("revert to snapshot", "error", errRevert.Error())
+		}
+		return nil, err
+	}
+
+	err = sc.scrForwarder.AddIntermediateTransactions(results)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
 func (sc *scProcessor) processSimpleSCR(
 	scResult *smartContractResult.SmartContractResult,
 	dstAcc state.UserAccountHandler,
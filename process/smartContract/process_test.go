@@ -2144,3 +2144,113 @@ func TestScProcessor_checkUpgradePermission(t *testing.T) {
 	err = sc.checkUpgradePermission(contract, &vmcommon.ContractCallInput{Function: "upgradeContract", VMInput: vmcommon.VMInput{CallerAddr: nil}})
 	require.Equal(t, process.ErrUpgradeNotAllowed, err)
 }
+
+func TestScProcessor_ExecuteSystemSmartContractCallNilSCRShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := NewSmartContractProcessor(createMockSmartContractProcessorArguments())
+
+	vmOutput, err := sc.ExecuteSystemSmartContractCall(nil)
+	require.Nil(t, vmOutput)
+	require.Equal(t, process.ErrNilSmartContractResult, err)
+}
+
+func TestScProcessor_ExecuteSystemSmartContractCallUserErrorShouldNotChangeState(t *testing.T) {
+	t.Parallel()
+
+	forwarded := false
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.AccountsDB = &mock.AccountsStub{
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			require.Fail(t, "should have not saved an account")
+			return nil
+		},
+	}
+	arguments.ScrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwarded = true
+			return nil
+		},
+	}
+	arguments.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+					return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+				},
+			}, nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr: []byte("snd addr"),
+		RcvAddr: []byte("000000000001234567890123456789012"),
+		Data:    []byte("function@06"),
+		Value:   big.NewInt(0),
+	}
+	vmOutput, err := sc.ExecuteSystemSmartContractCall(scr)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.UserError, vmOutput.ReturnCode)
+	require.False(t, forwarded)
+}
+
+func TestScProcessor_ExecuteSystemSmartContractCallShouldSaveStorageAndForwardResults(t *testing.T) {
+	t.Parallel()
+
+	scAddress := []byte("000000000001234567890123456789012")
+	scAccount, _ := state.NewUserAccount(scAddress)
+
+	savedAccount := false
+	forwarded := false
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.AccountsDB = &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (handler state.AccountHandler, e error) {
+			return scAccount, nil
+		},
+		SaveAccountCalled: func(accountHandler state.AccountHandler) error {
+			savedAccount = true
+			return nil
+		},
+	}
+	arguments.ScrForwarder = &mock.IntermediateTransactionHandlerMock{
+		AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+			forwarded = len(txs) == 1
+			return nil
+		},
+	}
+	arguments.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+					outAcc := &vmcommon.OutputAccount{
+						Address: scAddress,
+						StorageUpdates: map[string]*vmcommon.StorageUpdate{
+							"key": {Offset: []byte("key"), Data: []byte("value")},
+						},
+					}
+					return &vmcommon.VMOutput{
+						ReturnCode:     vmcommon.Ok,
+						OutputAccounts: map[string]*vmcommon.OutputAccount{string(scAddress): outAcc},
+					}, nil
+				},
+			}, nil
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr: []byte("snd addr"),
+		RcvAddr: scAddress,
+		Data:    []byte("function@06"),
+		Value:   big.NewInt(0),
+	}
+	vmOutput, err := sc.ExecuteSystemSmartContractCall(scr)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	require.True(t, savedAccount)
+	require.True(t, forwarded)
+
+	value, _ := scAccount.DataTrieTracker().RetrieveValue([]byte("key"))
+	require.Equal(t, []byte("value"), value)
+}
@@ -652,7 +652,12 @@ func (bfd *baseForkDetector) cleanupReceivedHeadersHigherThanNonce(nonce uint64)
 }
 
 func (bfd *baseForkDetector) computeGenesisTimeFromHeader(headerHandler data.HeaderHandler) int64 {
-	genesisTime := int64(headerHandler.GetTimeStamp() - (headerHandler.GetRound()-bfd.genesisRound)*uint64(bfd.rounder.TimeDuration().Seconds()))
+	// the time elapsed since genesis is taken from the rounds schedule as the rounds duration could have changed
+	roundTimeStamp := bfd.rounder.TimeStampForRound(int64(headerHandler.GetRound()))
+	genesisRoundTimeStamp := bfd.rounder.TimeStampForRound(int64(bfd.genesisRound))
+	elapsedTimeSinceGenesis := roundTimeStamp.Unix() - genesisRoundTimeStamp.Unix()
+
+	genesisTime := int64(headerHandler.GetTimeStamp()) - elapsedTimeSinceGenesis
 	return genesisTime
 }
 
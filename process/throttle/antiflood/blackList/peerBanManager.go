@@ -0,0 +1,113 @@
+package blackList
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.PeerBanHandler = (*peerBanManager)(nil)
+
+const defaultBanReason = "protocol violation"
+
+// ArgPeerBanManager represents the arguments for the peer ban manager
+type ArgPeerBanManager struct {
+	PeerBlackListCacher process.PeerBlackListCacher
+	PeerStore           p2p.PeerStore
+}
+
+type peerBanManager struct {
+	peerBlackListCacher process.PeerBlackListCacher
+	peerStore           p2p.PeerStore
+}
+
+// NewPeerBanManager creates a peer black list cacher that also records the bans in the peer store. The bans
+// still active in the peer store are restored in the black list cacher
+func NewPeerBanManager(arg ArgPeerBanManager) (*peerBanManager, error) {
+	if check.IfNil(arg.PeerBlackListCacher) {
+		return nil, fmt.Errorf("%w in NewPeerBanManager", process.ErrNilBlackListCacher)
+	}
+	if check.IfNil(arg.PeerStore) {
+		return nil, fmt.Errorf("%w in NewPeerBanManager", process.ErrNilPeerStore)
+	}
+
+	pbm := &peerBanManager{
+		peerBlackListCacher: arg.PeerBlackListCacher,
+		peerStore:           arg.PeerStore,
+	}
+	pbm.restoreBans()
+
+	return pbm, nil
+}
+
+func (pbm *peerBanManager) restoreBans() {
+	now := time.Now()
+	for _, entry := range pbm.peerStore.GetBannedPeers() {
+		remaining := time.Unix(entry.BanExpiry, 0).Sub(now)
+		if remaining <= 0 {
+			continue
+		}
+
+		err := pbm.peerBlackListCacher.Upsert(entry.Pid, remaining)
+		if err != nil {
+			log.Debug("peerBanManager.restoreBans", "pid", entry.Pid.Pretty(), "error", err.Error())
+			continue
+		}
+
+		log.Debug("restored peer ban", "pid", entry.Pid.Pretty(), "remaining", remaining, "reason", entry.BanReason)
+	}
+}
+
+// Upsert bans the provided peer for the given duration, using a default reason
+func (pbm *peerBanManager) Upsert(pid core.PeerID, span time.Duration) error {
+	return pbm.Ban(pid, span, defaultBanReason)
+}
+
+// Ban will add the peer in the black list cacher and will record the ban with its reason in the peer store
+func (pbm *peerBanManager) Ban(pid core.PeerID, duration time.Duration, reason string) error {
+	err := pbm.peerBlackListCacher.Upsert(pid, duration)
+	if err != nil {
+		return err
+	}
+
+	return pbm.peerStore.Ban(pid, duration, reason)
+}
+
+// Unban will remove the peer from the black list cacher and will clear its ban from the peer store
+func (pbm *peerBanManager) Unban(pid core.PeerID) error {
+	pbm.peerBlackListCacher.Remove(pid)
+
+	return pbm.peerStore.Unban(pid)
+}
+
+// Remove will remove the peer from the black list cacher and from the peer store's banned peers
+func (pbm *peerBanManager) Remove(pid core.PeerID) {
+	err := pbm.Unban(pid)
+	if err != nil {
+		log.Trace("peerBanManager.Remove", "pid", pid.Pretty(), "error", err.Error())
+	}
+}
+
+// Has returns true if the provided peer is banned
+func (pbm *peerBanManager) Has(pid core.PeerID) bool {
+	return pbm.peerBlackListCacher.Has(pid)
+}
+
+// Sweep will call the inner black list cacher method
+func (pbm *peerBanManager) Sweep() {
+	pbm.peerBlackListCacher.Sweep()
+}
+
+// GetKnownPeers returns all the peers recorded in the peer store
+func (pbm *peerBanManager) GetKnownPeers() []p2p.PeerStoreEntry {
+	return pbm.peerStore.GetPeers()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pbm *peerBanManager) IsInterfaceNil() bool {
+	return pbm == nil
+}
@@ -0,0 +1,167 @@
+package blackList
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerBanManager_NilPeerBlackListCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pbm, err := NewPeerBanManager(ArgPeerBanManager{
+		PeerStore: &mock.PeerStoreStub{},
+	})
+
+	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
+	assert.True(t, check.IfNil(pbm))
+}
+
+func TestNewPeerBanManager_NilPeerStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pbm, err := NewPeerBanManager(ArgPeerBanManager{
+		PeerBlackListCacher: &mock.PeerBlackListHandlerStub{},
+	})
+
+	assert.True(t, errors.Is(err, process.ErrNilPeerStore))
+	assert.True(t, check.IfNil(pbm))
+}
+
+func TestNewPeerBanManager_ShouldRestoreActiveBans(t *testing.T) {
+	t.Parallel()
+
+	activePid := core.PeerID("active")
+	expiredPid := core.PeerID("expired")
+	upserted := make(map[core.PeerID]time.Duration)
+	pbm, err := NewPeerBanManager(ArgPeerBanManager{
+		PeerBlackListCacher: &mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				upserted[pid] = span
+				return nil
+			},
+		},
+		PeerStore: &mock.PeerStoreStub{
+			GetBannedPeersCalled: func() []p2p.PeerStoreEntry {
+				return []p2p.PeerStoreEntry{
+					{Pid: activePid, BanExpiry: time.Now().Add(time.Hour).Unix()},
+					{Pid: expiredPid, BanExpiry: time.Now().Add(-time.Hour).Unix()},
+				}
+			},
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(pbm))
+	assert.Equal(t, 1, len(upserted))
+	assert.True(t, upserted[activePid] > time.Minute*59)
+}
+
+func TestPeerBanManager_BanShouldUpdateCacherAndStore(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	cacherUpserted := false
+	storeReason := ""
+	pbm, _ := NewPeerBanManager(ArgPeerBanManager{
+		PeerBlackListCacher: &mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(p core.PeerID, span time.Duration) error {
+				cacherUpserted = p == pid && span == time.Minute
+				return nil
+			},
+		},
+		PeerStore: &mock.PeerStoreStub{
+			BanCalled: func(p core.PeerID, duration time.Duration, reason string) error {
+				storeReason = reason
+				return nil
+			},
+		},
+	})
+
+	err := pbm.Ban(pid, time.Minute, "reason")
+	assert.Nil(t, err)
+	assert.True(t, cacherUpserted)
+	assert.Equal(t, "reason", storeReason)
+
+	err = pbm.Upsert(pid, time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultBanReason, storeReason)
+}
+
+func TestPeerBanManager_BanCacherErrorsShouldNotRecordInStore(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	pbm, _ := NewPeerBanManager(ArgPeerBanManager{
+		PeerBlackListCacher: &mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(_ core.PeerID, _ time.Duration) error {
+				return expectedErr
+			},
+		},
+		PeerStore: &mock.PeerStoreStub{
+			BanCalled: func(_ core.PeerID, _ time.Duration, _ string) error {
+				assert.Fail(t, "should have not called Ban")
+				return nil
+			},
+		},
+	})
+
+	err := pbm.Ban("pid", time.Minute, "reason")
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestPeerBanManager_UnbanShouldUpdateCacherAndStore(t *testing.T) {
+	t.Parallel()
+
+	removed := false
+	unbanned := false
+	pbm, _ := NewPeerBanManager(ArgPeerBanManager{
+		PeerBlackListCacher: &mock.PeerBlackListHandlerStub{
+			RemoveCalled: func(_ core.PeerID) {
+				removed = true
+			},
+		},
+		PeerStore: &mock.PeerStoreStub{
+			UnbanCalled: func(_ core.PeerID) error {
+				unbanned = true
+				return nil
+			},
+		},
+	})
+
+	err := pbm.Unban("pid")
+	assert.Nil(t, err)
+	assert.True(t, removed)
+	assert.True(t, unbanned)
+}
+
+func TestPeerBlackListWithReason_UpsertShouldBanWithReason(t *testing.T) {
+	t.Parallel()
+
+	storeReason := ""
+	pbm, _ := NewPeerBanManager(ArgPeerBanManager{
+		PeerBlackListCacher: &mock.PeerBlackListHandlerStub{},
+		PeerStore: &mock.PeerStoreStub{
+			BanCalled: func(_ core.PeerID, _ time.Duration, reason string) error {
+				storeReason = reason
+				return nil
+			},
+		},
+	})
+
+	pblr, err := NewPeerBlackListWithReason(nil, "flooding")
+	assert.True(t, errors.Is(err, process.ErrNilPeerBanHandler))
+	assert.True(t, check.IfNil(pblr))
+
+	pblr, _ = NewPeerBlackListWithReason(pbm, "flooding")
+	err = pblr.Upsert("pid", time.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, "flooding", storeReason)
+}
@@ -0,0 +1,39 @@
+package blackList
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.PeerBlackListCacher = (*peerBlackListWithReason)(nil)
+
+type peerBlackListWithReason struct {
+	process.PeerBanHandler
+	reason string
+}
+
+// NewPeerBlackListWithReason creates a peer black list cacher that bans the upserted peers with the provided reason
+func NewPeerBlackListWithReason(peerBanHandler process.PeerBanHandler, reason string) (*peerBlackListWithReason, error) {
+	if check.IfNil(peerBanHandler) {
+		return nil, fmt.Errorf("%w in NewPeerBlackListWithReason", process.ErrNilPeerBanHandler)
+	}
+
+	return &peerBlackListWithReason{
+		PeerBanHandler: peerBanHandler,
+		reason:         reason,
+	}, nil
+}
+
+// Upsert bans the provided peer for the given duration, using the configured reason
+func (pblr *peerBlackListWithReason) Upsert(pid core.PeerID, span time.Duration) error {
+	return pblr.Ban(pid, span, pblr.reason)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pblr *peerBlackListWithReason) IsInterfaceNil() bool {
+	return pblr == nil
+}
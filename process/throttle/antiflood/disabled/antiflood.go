@@ -56,6 +56,15 @@ func (af *AntiFlood) SetDebugger(_ process.AntifloodDebugger) error {
 	return nil
 }
 
+// SetAntifloodHitsRecorder returns nil
+func (af *AntiFlood) SetAntifloodHitsRecorder(_ process.AntifloodHitsRecorder) error {
+	return nil
+}
+
+// SetTrustedPeers does nothing
+func (af *AntiFlood) SetTrustedPeers(_ []core.PeerID) {
+}
+
 // BlacklistPeer does nothing
 func (af *AntiFlood) BlacklistPeer(_ core.PeerID, _ string, _ time.Duration) {
 }
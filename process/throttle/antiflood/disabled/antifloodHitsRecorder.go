@@ -0,0 +1,16 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// AntifloodHitsRecorder is a disabled instance of the antiflood hits recorder
+type AntifloodHitsRecorder struct {
+}
+
+// AddAntifloodHit does nothing
+func (ahr *AntifloodHitsRecorder) AddAntifloodHit(_ core.PeerID, _ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ahr *AntifloodHitsRecorder) IsInterfaceNil() bool {
+	return ahr == nil
+}
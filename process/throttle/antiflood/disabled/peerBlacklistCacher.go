@@ -28,6 +28,10 @@ func (pbc *PeerBlacklistCacher) Has(_ core.PeerID) bool {
 	return false
 }
 
+// Remove does nothing
+func (pbc *PeerBlacklistCacher) Remove(_ core.PeerID) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (pbc *PeerBlacklistCacher) IsInterfaceNil() bool {
 	return pbc == nil
@@ -34,24 +34,54 @@ func NewP2PAntiFloodAndBlackList(
 	config config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
-) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
+	peerStore p2p.PeerStore,
+) (process.P2PAntifloodHandler, process.PeerBanHandler, process.TimeCacher, error) {
 	if check.IfNil(statusHandler) {
 		return nil, nil, nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(peerStore) {
+		return nil, nil, nil, p2p.ErrNilPeerStore
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid)
+		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid, peerStore)
+	}
+
+	// manual bans issued over REST or CLI are enforced even if the antiflood is disabled
+	peerTimeCache, err := timecache.NewPeerTimeCache(timecache.NewTimeCache(defaultSpan))
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	return &disabled.AntiFlood{}, &disabled.PeerBlacklistCacher{}, &disabled.TimeCache{}, nil
+	peerBanManager, err := blackList.NewPeerBanManager(blackList.ArgPeerBanManager{
+		PeerBlackListCacher: peerTimeCache,
+		PeerStore:           peerStore,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	publicKeysCache := &disabled.TimeCache{}
+	startSweepingTimeCaches(peerBanManager, publicKeysCache)
+
+	return &disabled.AntiFlood{}, peerBanManager, publicKeysCache, nil
 }
 
 func initP2PAntiFloodAndBlackList(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
-) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
+	peerStore p2p.PeerStore,
+) (process.P2PAntifloodHandler, process.PeerBanHandler, process.TimeCacher, error) {
 	cache := timecache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
+	peerTimeCache, err := timecache.NewPeerTimeCache(cache)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	p2pPeerBlackList, err := blackList.NewPeerBanManager(blackList.ArgPeerBanManager{
+		PeerBlackListCacher: peerTimeCache,
+		PeerStore:           peerStore,
+	})
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -162,7 +192,7 @@ func createFloodPreventer(
 	antifloodCacheConfig config.CacheConfig,
 	statusHandler core.AppStatusHandler,
 	quotaIdentifier string,
-	blackListHandler process.PeerBlackListCacher,
+	peerBanHandler process.PeerBanHandler,
 	selfPid core.PeerID,
 ) (process.FloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
@@ -171,6 +201,14 @@ func createFloodPreventer(
 		return nil, err
 	}
 
+	blackListHandler, err := blackList.NewPeerBlackListWithReason(
+		peerBanHandler,
+		fmt.Sprintf("flooding detected by the %s antiflood", quotaIdentifier),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	blackListProcessor, err := blackList.NewP2PBlackListProcessor(
 		blackListCache,
 		blackListHandler,
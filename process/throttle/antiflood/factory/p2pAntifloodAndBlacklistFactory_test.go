@@ -2,11 +2,13 @@ package factory
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/peerStore"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,14 +19,14 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.Config{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid, peerStore.NewDisabledPeerStore())
 	assert.Nil(t, af)
 	assert.Nil(t, pids)
 	assert.Nil(t, pks)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
-func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
+func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementationsButEnforceBans(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.Config{
@@ -33,18 +35,30 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 		},
 	}
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, peerStore.NewDisabledPeerStore())
 	assert.NotNil(t, af)
 	assert.NotNil(t, pids)
 	assert.NotNil(t, pks)
 	assert.Nil(t, err)
 
 	_, ok1 := af.(*disabled.AntiFlood)
-	_, ok2 := pids.(*disabled.PeerBlacklistCacher)
 	_, ok3 := pks.(*disabled.TimeCache)
 	assert.True(t, ok1)
-	assert.True(t, ok2)
 	assert.True(t, ok3)
+
+	_ = pids.Upsert(currentPid, time.Minute)
+	assert.True(t, pids.Has(currentPid))
+}
+
+func TestNewP2PAntiFloodAndBlackList_NilPeerStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, &mock.AppStatusHandlerMock{}, currentPid, nil)
+	assert.Nil(t, af)
+	assert.Nil(t, pids)
+	assert.Nil(t, pks)
+	assert.Equal(t, p2p.ErrNilPeerStore, err)
 }
 
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *testing.T) {
@@ -68,7 +82,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	}
 
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, peerStore.NewDisabledPeerStore())
 	assert.Nil(t, err)
 	assert.NotNil(t, af)
 	assert.NotNil(t, pids)
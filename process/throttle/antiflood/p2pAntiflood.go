@@ -24,9 +24,12 @@ type p2pAntiflood struct {
 	topicPreventer      process.TopicFloodPreventer
 	mutDebugger         sync.RWMutex
 	debugger            process.AntifloodDebugger
+	hitsRecorder        process.AntifloodHitsRecorder
 	peerValidatorMapper process.PeerValidatorMapper
 	mapTopicsFromAll    map[string]struct{}
 	mutTopicCheck       sync.RWMutex
+	mutTrustedPeers     sync.RWMutex
+	trustedPeers        map[core.PeerID]struct{}
 }
 
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
@@ -52,8 +55,10 @@ func NewP2PAntiflood(
 		floodPreventers:     floodPreventers,
 		topicPreventer:      topicFloodPreventer,
 		debugger:            &disabled.AntifloodDebugger{},
+		hitsRecorder:        &disabled.AntifloodHitsRecorder{},
 		mapTopicsFromAll:    make(map[string]struct{}),
 		peerValidatorMapper: &disabled.PeerValidatorMapper{},
+		trustedPeers:        make(map[core.PeerID]struct{}),
 	}, nil
 }
 
@@ -62,6 +67,9 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 	if message == nil {
 		return p2p.ErrNilMessage
 	}
+	if af.isTrusted(fromConnectedPeer) {
+		return af.checkOriginatorNotBlacklisted(message)
+	}
 
 	var lastErrFound error
 	for _, fp := range af.floodPreventers {
@@ -84,6 +92,10 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 		return lastErrFound
 	}
 
+	return af.checkOriginatorNotBlacklisted(message)
+}
+
+func (af *p2pAntiflood) checkOriginatorNotBlacklisted(message p2p.MessageP2P) error {
 	originatorIsBlacklisted := af.blacklistHandler.Has(message.Peer())
 	if originatorIsBlacklisted {
 		af.recordDebugEvent(message.Peer(), message.Topics(), 1, uint64(len(message.Data())), message.SeqNo(), true)
@@ -134,6 +146,27 @@ func (af *p2pAntiflood) SetPeerValidatorMapper(validatorMapper process.PeerValid
 	return nil
 }
 
+// SetTrustedPeers sets the peers that are exempted from the antiflood checks and can not be blacklisted
+func (af *p2pAntiflood) SetTrustedPeers(pids []core.PeerID) {
+	trustedPeers := make(map[core.PeerID]struct{}, len(pids))
+	for _, pid := range pids {
+		trustedPeers[pid] = struct{}{}
+	}
+
+	af.mutTrustedPeers.Lock()
+	af.trustedPeers = trustedPeers
+	af.mutTrustedPeers.Unlock()
+}
+
+func (af *p2pAntiflood) isTrusted(pid core.PeerID) bool {
+	af.mutTrustedPeers.RLock()
+	defer af.mutTrustedPeers.RUnlock()
+
+	_, found := af.trustedPeers[pid]
+
+	return found
+}
+
 func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topics []string, numRejected uint32, sizeRejected uint64, sequence []byte, isBlacklisted bool) {
 	if len(topics) == 0 {
 		topics = []string{unidentifiedTopic}
@@ -143,6 +176,7 @@ func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topics []string, numRe
 	defer af.mutDebugger.RUnlock()
 
 	af.debugger.AddData(pid, topics[0], numRejected, sizeRejected, sequence, isBlacklisted)
+	af.hitsRecorder.AddAntifloodHit(pid, topics[0])
 }
 
 func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
@@ -181,6 +215,10 @@ func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p
 
 // CanProcessMessagesOnTopic signals if a p2p message can be processed or not for a given topic
 func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+	if af.isTrusted(peer) {
+		return nil
+	}
+
 	err := af.topicPreventer.IncreaseLoad(peer, topic, numMessages)
 	if err != nil {
 		log.Trace("topicFloodPreventer.Accumulate peer",
@@ -230,8 +268,29 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 	return nil
 }
 
+// SetAntifloodHitsRecorder sets the component that accounts, per peer, the messages stopped by the antiflood
+func (af *p2pAntiflood) SetAntifloodHitsRecorder(recorder process.AntifloodHitsRecorder) error {
+	if check.IfNil(recorder) {
+		return process.ErrNilAntifloodHitsRecorder
+	}
+
+	af.mutDebugger.Lock()
+	af.hitsRecorder = recorder
+	af.mutDebugger.Unlock()
+
+	return nil
+}
+
 // BlacklistPeer will add a peer to the black list
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
+	if af.isTrusted(peer) {
+		log.Debug("will not blacklist trusted peer",
+			"pid", peer.Pretty(),
+			"reason", reason,
+		)
+		return
+	}
+
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
 
 	err := af.blacklistHandler.Upsert(peer, duration)
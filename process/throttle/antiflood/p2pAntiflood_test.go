@@ -269,6 +269,67 @@ func TestP2pAntiflood_CanProcessMessagesOriginatorIsBlacklistedShouldErr(t *test
 	assert.True(t, errors.Is(err, process.ErrOriginatorIsBlacklisted))
 }
 
+func TestP2pAntiflood_CanProcessMessageTrustedPeerShouldNotCallFloodPreventers(t *testing.T) {
+	t.Parallel()
+
+	trustedPeer := core.PeerID("trusted")
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{
+			HasCalled: func(pid core.PeerID) bool {
+				return false
+			},
+		},
+		&mock.TopicAntiFloodStub{
+			IncreaseLoadCalled: func(pid core.PeerID, topic string, numMessages uint32) error {
+				assert.Fail(t, "should have not called topic IncreaseLoad")
+				return nil
+			},
+		},
+		&mock.FloodPreventerStub{
+			IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+				assert.Fail(t, "should have not called IncreaseLoad")
+				return nil
+			},
+		},
+	)
+	afm.SetTrustedPeers([]core.PeerID{trustedPeer})
+	message := &mock.P2PMessageMock{
+		DataField: []byte("data"),
+		PeerField: core.PeerID("originator"),
+	}
+
+	err := afm.CanProcessMessage(message, trustedPeer)
+	assert.Nil(t, err)
+
+	err = afm.CanProcessMessagesOnTopic(trustedPeer, "topic", 1, 1, nil)
+	assert.Nil(t, err)
+}
+
+func TestP2pAntiflood_CanProcessMessageTrustedPeerBlacklistedOriginatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	trustedPeer := core.PeerID("trusted")
+	originator := core.PeerID("originator")
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{
+			HasCalled: func(pid core.PeerID) bool {
+				return pid == originator
+			},
+		},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+	afm.SetTrustedPeers([]core.PeerID{trustedPeer})
+	message := &mock.P2PMessageMock{
+		DataField: []byte("data"),
+		PeerField: originator,
+	}
+
+	err := afm.CanProcessMessage(message, trustedPeer)
+
+	assert.True(t, errors.Is(err, process.ErrOriginatorIsBlacklisted))
+}
+
 func TestP2pAntiflood_ResetForTopicSetMaxMessagesShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -355,6 +416,45 @@ func TestP2pAntiflood_SetDebuggerShouldWork(t *testing.T) {
 	assert.True(t, afm.Debugger() == debugger)
 }
 
+func TestP2pAntiflood_SetAntifloodHitsRecorderNilRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetAntifloodHitsRecorder(nil)
+	assert.Equal(t, process.ErrNilAntifloodHitsRecorder, err)
+}
+
+func TestP2pAntiflood_AntifloodHitShouldBeRecorded(t *testing.T) {
+	t.Parallel()
+
+	identifierCall := core.PeerID("id")
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			IncreaseLoadCalled: func(pid core.PeerID, topic string, numMessages uint32) error {
+				return process.ErrSystemBusy
+			},
+		},
+		&mock.FloodPreventerStub{},
+	)
+	recordedHits := make(map[core.PeerID]string)
+	err := afm.SetAntifloodHitsRecorder(&mock.AntifloodHitsRecorderStub{
+		AddAntifloodHitCalled: func(pid core.PeerID, topic string) {
+			recordedHits[pid] = topic
+		},
+	})
+	assert.Nil(t, err)
+
+	_ = afm.CanProcessMessagesOnTopic(identifierCall, "topic", 1, 0, nil)
+
+	assert.Equal(t, map[core.PeerID]string{identifierCall: "topic"}, recordedHits)
+}
+
 func TestP2pAntiflood_Close(t *testing.T) {
 	t.Parallel()
 
@@ -421,6 +521,25 @@ func TestP2pAntiflood_BlacklistPeerShouldWork(t *testing.T) {
 	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
 }
 
+func TestP2pAntiflood_BlacklistPeerTrustedPeerShouldNotBlacklist(t *testing.T) {
+	t.Parallel()
+
+	trustedPeer := core.PeerID("trusted")
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				assert.Fail(t, "should have not blacklisted a trusted peer")
+				return nil
+			},
+		},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+	afm.SetTrustedPeers([]core.PeerID{trustedPeer})
+
+	afm.BlacklistPeer(trustedPeer, "reason", time.Second)
+}
+
 func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	t.Parallel()
 
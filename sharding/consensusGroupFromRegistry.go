@@ -0,0 +1,115 @@
+package sharding
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+// ArgsConsensusGroupFromRegistry holds the arguments needed to recompute a consensus group from a nodes
+// coordinator registry
+type ArgsConsensusGroupFromRegistry struct {
+	Registry           *NodesCoordinatorRegistry
+	Helper             NodesCoordinatorHelper
+	Hasher             hashing.Hasher
+	ConsensusGroupSize int
+	Randomness         []byte
+	Round              uint64
+	ShardID            uint32
+	Epoch              uint32
+}
+
+// ComputeConsensusGroupFromRegistry recomputes the consensus group of the given round from the eligible validators
+// saved in the registry for the given epoch. The randomness is the one used by the consensus in that round, which is
+// the random seed of the shard's last block committed before the round. The first validator of the group is the leader
+func ComputeConsensusGroupFromRegistry(args ArgsConsensusGroupFromRegistry) ([]Validator, error) {
+	if args.Registry == nil {
+		return nil, ErrNilNodesCoordinatorRegistry
+	}
+	if args.Helper == nil {
+		return nil, ErrNilNodesCoordinatorHelper
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.ConsensusGroupSize < 1 {
+		return nil, ErrInvalidConsensusGroupSize
+	}
+	if len(args.Randomness) == 0 {
+		return nil, ErrNilRandomness
+	}
+
+	epochValidators, ok := args.Registry.EpochsConfig[fmt.Sprint(args.Epoch)]
+	if !ok {
+		return nil, fmt.Errorf("%w epoch=%v", ErrEpochNodesConfigDoesNotExist, args.Epoch)
+	}
+
+	nodesConfig, err := epochValidatorsToEpochNodesConfig(epochValidators)
+	if err != nil {
+		return nil, err
+	}
+
+	nbShards := uint32(len(nodesConfig.eligibleMap))
+	if nbShards < 2 {
+		return nil, ErrInvalidNumberOfShards
+	}
+	if args.ShardID >= nbShards-1 && args.ShardID != core.MetachainShardId {
+		return nil, ErrInvalidShardId
+	}
+
+	eligibleList := nodesConfig.eligibleMap[args.ShardID]
+	weights, err := args.Helper.ValidatorsWeights(eligibleList)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := NewSelectorExpandedList(weights, args.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectValidators(
+		selector,
+		roundRandomness(args.Randomness, args.Round),
+		uint32(args.ConsensusGroupSize),
+		eligibleList,
+	)
+}
+
+// ComputeHistoricalConsensusGroup computes the consensus group of a past round. If the nodes configuration of the
+// epoch is no longer held in memory, it is rebuilt from the registry saved in the boot storage under the given key
+func (ihgs *indexHashedNodesCoordinator) ComputeHistoricalConsensusGroup(
+	registryKey []byte,
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]Validator, error) {
+	validators, err := ihgs.ComputeConsensusGroup(randomness, round, shardID, epoch)
+	if !errors.Is(err, ErrEpochNodesConfigDoesNotExist) {
+		return validators, err
+	}
+
+	if len(registryKey) == 0 {
+		return nil, fmt.Errorf("%w for epoch %d", ErrEmptyRegistryKey, epoch)
+	}
+
+	registry, err := LoadNodesCoordinatorRegistry(ihgs.bootStorer, registryKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the registry for epoch %d", err, epoch)
+	}
+
+	return ComputeConsensusGroupFromRegistry(ArgsConsensusGroupFromRegistry{
+		Registry:           registry,
+		Helper:             ihgs.nodesCoordinatorHelper,
+		Hasher:             ihgs.hasher,
+		ConsensusGroupSize: ihgs.ConsensusGroupSize(shardID),
+		Randomness:         randomness,
+		Round:              round,
+		ShardID:            shardID,
+		Epoch:              epoch,
+	})
+}
@@ -0,0 +1,133 @@
+package sharding
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/sharding/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsConsensusGroupFromRegistry(ihgs *indexHashedNodesCoordinator) ArgsConsensusGroupFromRegistry {
+	return ArgsConsensusGroupFromRegistry{
+		Registry:           ihgs.NodesCoordinatorToRegistry(),
+		Helper:             ihgs,
+		Hasher:             &mock.HasherMock{},
+		ConsensusGroupSize: 3,
+		Randomness:         []byte("randomness"),
+		Round:              37,
+		ShardID:            0,
+		Epoch:              0,
+	}
+}
+
+func createNodesCoordinatorForHistoricalGroups(t *testing.T) *indexHashedNodesCoordinator {
+	arguments := createArguments()
+	arguments.ShardConsensusGroupSize = 3
+	arguments.MetaConsensusGroupSize = 3
+	ihgs, err := NewIndexHashedNodesCoordinator(arguments)
+	require.Nil(t, err)
+
+	return ihgs
+}
+
+func TestComputeConsensusGroupFromRegistry_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ihgs := createNodesCoordinatorForHistoricalGroups(t)
+
+	testCases := map[error]func(args *ArgsConsensusGroupFromRegistry){
+		ErrNilNodesCoordinatorRegistry: func(args *ArgsConsensusGroupFromRegistry) { args.Registry = nil },
+		ErrNilNodesCoordinatorHelper:   func(args *ArgsConsensusGroupFromRegistry) { args.Helper = nil },
+		ErrNilHasher:                   func(args *ArgsConsensusGroupFromRegistry) { args.Hasher = nil },
+		ErrInvalidConsensusGroupSize:   func(args *ArgsConsensusGroupFromRegistry) { args.ConsensusGroupSize = 0 },
+		ErrNilRandomness:               func(args *ArgsConsensusGroupFromRegistry) { args.Randomness = nil },
+		ErrInvalidShardId:              func(args *ArgsConsensusGroupFromRegistry) { args.ShardID = 1 },
+	}
+
+	for expectedErr, modify := range testCases {
+		args := createMockArgsConsensusGroupFromRegistry(ihgs)
+		modify(&args)
+		validators, err := ComputeConsensusGroupFromRegistry(args)
+
+		assert.Nil(t, validators)
+		assert.Equal(t, expectedErr, err)
+	}
+}
+
+func TestComputeConsensusGroupFromRegistry_MissingEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ihgs := createNodesCoordinatorForHistoricalGroups(t)
+	args := createMockArgsConsensusGroupFromRegistry(ihgs)
+	args.Epoch = 5
+	validators, err := ComputeConsensusGroupFromRegistry(args)
+
+	assert.Nil(t, validators)
+	assert.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+}
+
+func TestComputeConsensusGroupFromRegistry_ShouldMatchTheNodesCoordinatorSelection(t *testing.T) {
+	t.Parallel()
+
+	ihgs := createNodesCoordinatorForHistoricalGroups(t)
+
+	for _, shardID := range []uint32{0, core.MetachainShardId} {
+		for round := uint64(1); round < 20; round++ {
+			args := createMockArgsConsensusGroupFromRegistry(ihgs)
+			args.Round = round
+			args.ShardID = shardID
+
+			expected, err := ihgs.ComputeConsensusGroup(args.Randomness, round, shardID, 0)
+			require.Nil(t, err)
+
+			validators, err := ComputeConsensusGroupFromRegistry(args)
+			require.Nil(t, err)
+			assert.Equal(t, 3, len(validators))
+			assert.True(t, sameValidators(expected, validators))
+		}
+	}
+}
+
+func TestIndexHashedNodesCoordinator_ComputeHistoricalConsensusGroupEpochInMemory(t *testing.T) {
+	t.Parallel()
+
+	ihgs := createNodesCoordinatorForHistoricalGroups(t)
+	randomness := []byte("randomness")
+
+	expected, err := ihgs.ComputeConsensusGroup(randomness, 10, 0, 0)
+	require.Nil(t, err)
+
+	validators, err := ihgs.ComputeHistoricalConsensusGroup(nil, randomness, 10, 0, 0)
+	require.Nil(t, err)
+	assert.True(t, sameValidators(expected, validators))
+}
+
+func TestIndexHashedNodesCoordinator_ComputeHistoricalConsensusGroupShouldLoadTheRegistry(t *testing.T) {
+	t.Parallel()
+
+	ihgs := createNodesCoordinatorForHistoricalGroups(t)
+	randomness := []byte("randomness")
+	key := []byte("epoch start randomness")
+
+	expected, err := ihgs.ComputeConsensusGroup(randomness, 10, core.MetachainShardId, 0)
+	require.Nil(t, err)
+
+	err = ihgs.saveState(key)
+	require.Nil(t, err)
+	delete(ihgs.nodesConfig, 0)
+
+	validators, err := ihgs.ComputeHistoricalConsensusGroup(key, randomness, 10, core.MetachainShardId, 0)
+	require.Nil(t, err)
+	assert.True(t, sameValidators(expected, validators))
+
+	validators, err = ihgs.ComputeHistoricalConsensusGroup(nil, randomness, 10, core.MetachainShardId, 0)
+	assert.Nil(t, validators)
+	assert.True(t, errors.Is(err, ErrEmptyRegistryKey))
+
+	validators, err = ihgs.ComputeHistoricalConsensusGroup([]byte("missing key"), randomness, 10, core.MetachainShardId, 0)
+	assert.Nil(t, validators)
+	assert.NotNil(t, err)
+}
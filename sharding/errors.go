@@ -123,3 +123,12 @@ var ErrNilEndOfProcessingHandler = errors.New("nil end of processing handler")
 
 // ErrNilOrEmptyDestinationForDistribute signals that a nil or empty value was provided for destination of distributedNodes
 var ErrNilOrEmptyDestinationForDistribute = errors.New("nil or empty destination list for distributeNodes")
+
+// ErrNilNodesCoordinatorRegistry signals that a nil nodes coordinator registry has been provided
+var ErrNilNodesCoordinatorRegistry = errors.New("nil nodes coordinator registry")
+
+// ErrNilNodesCoordinatorHelper signals that a nil nodes coordinator helper has been provided
+var ErrNilNodesCoordinatorHelper = errors.New("nil nodes coordinator helper")
+
+// ErrEmptyRegistryKey signals that an empty nodes coordinator registry key has been provided
+var ErrEmptyRegistryKey = errors.New("empty nodes coordinator registry key")
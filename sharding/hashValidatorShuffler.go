@@ -95,10 +95,10 @@ func (rhs *randHashShuffler) UpdateParams(
 //      6.  The shuffled out nodes are distributed among the existing shards into waiting lists.
 //          We may have three situations:
 //          a)  In case (shuffled out nodes + new nodes) > (nbShards * perShardHysteresis + minNodesPerShard) then
-//              we need to prepare for a split event, so a higher percentage of nodes need to be directed to the shard
-//              that will be split.
+//              the shards are split: the new shards take part of the nodes of the shard they are created from and
+//              the shards left without enough nodes are refilled from the other shards waiting lists
 //          b)  In case (shuffled out nodes + new nodes) < (nbShards * perShardHysteresis) then we can immediately
-//              execute the shard merge
+//              execute the shard merge: the nodes of the removed shard join the shard taking over its addresses
 //          c)  No change in the number of shards then nothing extra needs to be done
 func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNodes, error) {
 	eligibleAfterReshard := copyValidatorMap(args.Eligible)
@@ -122,11 +122,29 @@ func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNo
 	nodesMeta := rhs.nodesMeta
 	rhs.mutShufflerParams.RUnlock()
 
+	var err error
+	nbShards := args.NbShards
+	newNodes := args.NewNodes
 	if canSplit {
-		eligibleAfterReshard, waitingAfterReshard = rhs.splitShards(args.Eligible, args.Waiting, newNbShards)
+		eligibleAfterReshard, waitingAfterReshard, newNodes, err = rhs.splitShards(
+			args.Eligible,
+			args.Waiting,
+			args.NewNodes,
+			args.NbShards,
+			newNbShards,
+			args.Rand,
+		)
+		if err != nil {
+			return nil, err
+		}
+		nbShards = newNbShards
 	}
 	if canMerge {
-		eligibleAfterReshard, waitingAfterReshard = rhs.mergeShards(args.Eligible, args.Waiting, newNbShards)
+		eligibleAfterReshard, waitingAfterReshard, err = rhs.mergeShards(args.Eligible, args.Waiting, args.NbShards, newNbShards)
+		if err != nil {
+			return nil, err
+		}
+		nbShards = newNbShards
 	}
 
 	return shuffleNodes(shuffleNodesArg{
@@ -134,11 +152,11 @@ func (rhs *randHashShuffler) UpdateNodeLists(args ArgsUpdateNodes) (*ResUpdateNo
 		waiting:           waitingAfterReshard,
 		unstakeLeaving:    args.UnStakeLeaving,
 		additionalLeaving: args.AdditionalLeaving,
-		newNodes:          args.NewNodes,
+		newNodes:          newNodes,
 		randomness:        args.Rand,
 		nodesMeta:         nodesMeta,
 		nodesPerShard:     nodesPerShard,
-		nbShards:          args.NbShards,
+		nbShards:          nbShards,
 		distributor:       rhs.validatorDistributor,
 	})
 }
@@ -357,7 +375,7 @@ func (rhs *randHashShuffler) computeNewShards(
 		return nbShardsNew
 	}
 
-	if nodesNewEpoch < nodesForMerge {
+	if nodesNewEpoch < nodesForMerge && nbShards > 1 {
 		return nbShardsNew - 1
 	}
 
@@ -482,29 +500,183 @@ func removeValidatorFromListKeepOrder(validatorList []Validator, index int) []Va
 	return append(validatorList[:index], validatorList[index+1:]...)
 }
 
-// splitShards prepares for the shards split, or if already prepared does the split returning the resulting
-// shards configuration for eligible and waiting lists
+// splitShards divides the eligible and waiting lists of the shards being split between them and the newly created
+// shards, returning the resulting shards configuration for eligible and waiting lists and the new nodes which were
+// not needed to refill the shards. The new shards are created from the shard which currently holds their addresses,
+// so that the split shards only need to hand over a part of their state
 func (rhs *randHashShuffler) splitShards(
 	eligible map[uint32][]Validator,
 	waiting map[uint32][]Validator,
-	_ uint32,
-) (map[uint32][]Validator, map[uint32][]Validator) {
-	log.Error(ErrNotImplemented.Error())
+	newNodes []Validator,
+	nbShards uint32,
+	newNbShards uint32,
+	randomness []byte,
+) (map[uint32][]Validator, map[uint32][]Validator, []Validator, error) {
+	newEligible := copyValidatorMap(eligible)
+	newWaiting := copyValidatorMap(waiting)
+
+	newShardsPerParent := make(map[uint32][]uint32)
+	for shardID := nbShards; shardID < newNbShards; shardID++ {
+		parentShardID, err := ComputeShardIDAfterReshard(shardID, nbShards)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		newShardsPerParent[parentShardID] = append(newShardsPerParent[parentShardID], shardID)
+	}
+
+	parentShardIDs := make([]uint32, 0, len(newShardsPerParent))
+	for parentShardID := range newShardsPerParent {
+		parentShardIDs = append(parentShardIDs, parentShardID)
+	}
+	sort.Slice(parentShardIDs, func(i, j int) bool {
+		return parentShardIDs[i] < parentShardIDs[j]
+	})
+
+	for _, parentShardID := range parentShardIDs {
+		destShardIDs := append([]uint32{parentShardID}, newShardsPerParent[parentShardID]...)
+		splitShardList(newEligible, parentShardID, destShardIDs, randomness)
+		splitShardList(newWaiting, parentShardID, destShardIDs, randomness)
+	}
+
+	rhs.mutShufflerParams.RLock()
+	nodesPerShard := rhs.nodesShard
+	nodesMeta := rhs.nodesMeta
+	rhs.mutShufflerParams.RUnlock()
+
+	remainingNewNodes := shuffleList(newNodes, randomness)
+	remainingNewNodes = balanceShards(newEligible, newWaiting, remainingNewNodes, newNbShards, nodesPerShard, nodesMeta)
+
+	log.Debug("shards split", "previous number of shards", nbShards, "new number of shards", newNbShards)
+
+	return newEligible, newWaiting, remainingNewNodes, nil
+}
+
+// splitShardList shuffles the list of the parent shard and distributes it between the parent and its new shards
+func splitShardList(
+	validatorsMap map[uint32][]Validator,
+	parentShardID uint32,
+	destShardIDs []uint32,
+	randomness []byte,
+) {
+	shuffledValidators := shuffleList(validatorsMap[parentShardID], randomness)
+	for _, shardID := range destShardIDs {
+		validatorsMap[shardID] = make([]Validator, 0)
+	}
+
+	numDestShards := len(destShardIDs)
+	for i, validator := range shuffledValidators {
+		shardID := destShardIDs[i%numDestShards]
+		validatorsMap[shardID] = append(validatorsMap[shardID], validator)
+	}
+}
 
-	// TODO: do the split
-	return copyValidatorMap(eligible), copyValidatorMap(waiting)
+// balanceShards refills the waiting lists of the shards which can not sustain themselves after a split, first with
+// new nodes and then with nodes from the shards holding more than the required number of nodes, waiting nodes being
+// moved before eligible ones. Returns the new nodes which were not used
+func balanceShards(
+	eligible map[uint32][]Validator,
+	waiting map[uint32][]Validator,
+	newNodes []Validator,
+	nbShards uint32,
+	nodesPerShard uint32,
+	nodesMeta uint32,
+) []Validator {
+	numNodes := func(shardID uint32) uint32 {
+		return uint32(len(eligible[shardID]) + len(waiting[shardID]))
+	}
+	requiredNodes := func(shardID uint32) uint32 {
+		if shardID == core.MetachainShardId {
+			return nodesMeta
+		}
+		return nodesPerShard
+	}
+
+	donorShardIDs := sortKeys(eligible)
+	for shardID := uint32(0); shardID < nbShards; shardID++ {
+		for numNodes(shardID) < nodesPerShard {
+			if len(newNodes) > 0 {
+				waiting[shardID] = append(waiting[shardID], newNodes[0])
+				newNodes = newNodes[1:]
+				continue
+			}
+
+			donorShardID, found := findDonorShard(donorShardIDs, numNodes, requiredNodes)
+			if !found {
+				log.Warn("not enough nodes to balance the shards after split",
+					"shard", shardID, "num nodes", numNodes(shardID), "nodes per shard", nodesPerShard)
+				return newNodes
+			}
+
+			var movedValidator Validator
+			if len(waiting[donorShardID]) > 0 {
+				lastIndex := len(waiting[donorShardID]) - 1
+				movedValidator = waiting[donorShardID][lastIndex]
+				waiting[donorShardID] = waiting[donorShardID][:lastIndex]
+			} else {
+				lastIndex := len(eligible[donorShardID]) - 1
+				movedValidator = eligible[donorShardID][lastIndex]
+				eligible[donorShardID] = eligible[donorShardID][:lastIndex]
+			}
+
+			waiting[shardID] = append(waiting[shardID], movedValidator)
+		}
+	}
+
+	return newNodes
 }
 
-// mergeShards merges the required shards, returning the resulting shards configuration for eligible and waiting lists
+// findDonorShard returns the shard holding the most nodes above its required number of nodes
+func findDonorShard(
+	shardIDs []uint32,
+	numNodes func(shardID uint32) uint32,
+	requiredNodes func(shardID uint32) uint32,
+) (uint32, bool) {
+	donorShardID := uint32(0)
+	maxSurplus := uint32(0)
+	for _, shardID := range shardIDs {
+		nodesInShard := numNodes(shardID)
+		if nodesInShard <= requiredNodes(shardID) {
+			continue
+		}
+
+		surplus := nodesInShard - requiredNodes(shardID)
+		if surplus > maxSurplus {
+			maxSurplus = surplus
+			donorShardID = shardID
+		}
+	}
+
+	return donorShardID, maxSurplus > 0
+}
+
+// mergeShards merges the required shards, returning the resulting shards configuration for eligible and waiting lists.
+// The nodes of a removed shard join the shard which will hold its addresses, the eligible surplus being shuffled out
+// afterwards as for any other shard
 func (rhs *randHashShuffler) mergeShards(
 	eligible map[uint32][]Validator,
 	waiting map[uint32][]Validator,
-	_ uint32,
-) (map[uint32][]Validator, map[uint32][]Validator) {
-	log.Error(ErrNotImplemented.Error())
+	nbShards uint32,
+	newNbShards uint32,
+) (map[uint32][]Validator, map[uint32][]Validator, error) {
+	newEligible := copyValidatorMap(eligible)
+	newWaiting := copyValidatorMap(waiting)
+
+	for shardID := newNbShards; shardID < nbShards; shardID++ {
+		destShardID, err := ComputeShardIDAfterReshard(shardID, newNbShards)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		newEligible[destShardID] = append(newEligible[destShardID], newEligible[shardID]...)
+		newWaiting[destShardID] = append(newWaiting[destShardID], newWaiting[shardID]...)
+		delete(newEligible, shardID)
+		delete(newWaiting, shardID)
+	}
+
+	log.Debug("shards merged", "previous number of shards", nbShards, "new number of shards", newNbShards)
 
-	// TODO: do the merge
-	return copyValidatorMap(eligible), copyValidatorMap(waiting)
+	return newEligible, newWaiting, nil
 }
 
 // copyValidatorMap creates a copy for the Validators map, creating copies for each of the lists for each shard
@@ -2423,3 +2423,133 @@ func BenchmarkRandHashShuffler_RemoveWithoutReslice(b *testing.B) {
 	runtime.ReadMemStats(&m2)
 	fmt.Println(fmt.Sprintf("Used %d MB", (m2.HeapAlloc-m.HeapAlloc)/1024/1024))
 }
+
+func countValidators(validatorsMap map[uint32][]Validator) int {
+	numValidators := 0
+	for _, validators := range validatorsMap {
+		numValidators += len(validators)
+	}
+
+	return numValidators
+}
+
+func TestRandHashShuffler_splitShardsShouldCreateNewShardsFromParent(t *testing.T) {
+	t.Parallel()
+
+	shuffler := createHashShufflerInter()
+	nbShards := uint32(2)
+	eligible := generateValidatorMap(eligiblePerShard, nbShards)
+	waiting := generateValidatorMap(eligiblePerShard, nbShards)
+
+	newEligible, newWaiting, newNodes, err := shuffler.splitShards(eligible, waiting, nil, nbShards, nbShards+1, []byte("randomness"))
+	require.Nil(t, err)
+
+	assert.Equal(t, 0, len(newNodes))
+	assert.Equal(t, int(nbShards)+2, len(newEligible))
+	assert.Equal(t, countValidators(eligible)+countValidators(waiting), countValidators(newEligible)+countValidators(newWaiting))
+	for shardID := uint32(0); shardID <= nbShards; shardID++ {
+		assert.True(t, len(newEligible[shardID])+len(newWaiting[shardID]) >= eligiblePerShard)
+	}
+
+	// shard 2 is created from shard 0, shard 1 is left untouched
+	assert.Equal(t, eligible[1], newEligible[1])
+	assert.True(t, contains(newEligible[2], append(eligible[0], waiting[0]...)))
+}
+
+func TestRandHashShuffler_splitShardsShouldRefillShardsFromNewNodesThenOtherShards(t *testing.T) {
+	t.Parallel()
+
+	shuffler := createHashShufflerInter()
+	nbShards := uint32(2)
+	eligible := generateValidatorMap(eligiblePerShard, nbShards)
+	waiting := generateValidatorMap(0, nbShards)
+	waiting[1] = generateValidatorList(eligiblePerShard)
+	newNodes := generateValidatorList(eligiblePerShard / 2)
+
+	newEligible, newWaiting, remainingNewNodes, err := shuffler.splitShards(
+		eligible,
+		waiting,
+		newNodes,
+		nbShards,
+		nbShards+1,
+		[]byte("randomness"),
+	)
+	require.Nil(t, err)
+
+	assert.Equal(t, 0, len(remainingNewNodes))
+	assert.Equal(t, eligiblePerShard, len(newEligible[0])+len(newWaiting[0]))
+	assert.Equal(t, eligiblePerShard, len(newEligible[2])+len(newWaiting[2]))
+	// shard 1 gave half of its waiting list to the new shard
+	assert.Equal(t, eligiblePerShard+eligiblePerShard/2, len(newEligible[1])+len(newWaiting[1]))
+	assert.Equal(t, eligible[1], newEligible[1])
+}
+
+func TestRandHashShuffler_mergeShardsShouldMoveNodesOfRemovedShard(t *testing.T) {
+	t.Parallel()
+
+	shuffler := createHashShufflerInter()
+	nbShards := uint32(3)
+	eligible := generateValidatorMap(eligiblePerShard, nbShards)
+	waiting := generateValidatorMap(waitingPerShard, nbShards)
+
+	newEligible, newWaiting, err := shuffler.mergeShards(eligible, waiting, nbShards, nbShards-1)
+	require.Nil(t, err)
+
+	assert.Equal(t, int(nbShards), len(newEligible))
+	_, found := newEligible[2]
+	assert.False(t, found)
+	assert.Equal(t, 2*eligiblePerShard, len(newEligible[0]))
+	assert.Equal(t, 2*waitingPerShard, len(newWaiting[0]))
+	assert.True(t, contains(eligible[2], newEligible[0]))
+	assert.Equal(t, eligible[1], newEligible[1])
+}
+
+func TestRandHashShuffler_UpdateNodeListsWithSplit(t *testing.T) {
+	t.Parallel()
+
+	shuffler := NewHashValidatorsShuffler(eligiblePerShard, eligiblePerShard, 0, true, true)
+	nbShards := uint32(2)
+	eligible := generateValidatorMap(eligiblePerShard, nbShards)
+	waiting := generateValidatorMap(eligiblePerShard/2, nbShards)
+
+	res, err := shuffler.UpdateNodeLists(ArgsUpdateNodes{
+		Eligible: eligible,
+		Waiting:  waiting,
+		NewNodes: generateValidatorList(eligiblePerShard),
+		Rand:     []byte("randomness"),
+		NbShards: nbShards,
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, 5, len(res.Eligible))
+	for shardID, validators := range res.Eligible {
+		assert.Equal(t, eligiblePerShard, len(validators), "shard %d", shardID)
+	}
+	assert.Equal(t, countValidators(eligible)+countValidators(waiting)+eligiblePerShard,
+		countValidators(res.Eligible)+countValidators(res.Waiting))
+}
+
+func TestRandHashShuffler_UpdateNodeListsWithMerge(t *testing.T) {
+	t.Parallel()
+
+	shuffler := NewHashValidatorsShuffler(eligiblePerShard, eligiblePerShard, 0, true, true)
+	nbShards := uint32(3)
+	eligible := generateValidatorMap(eligiblePerShard, nbShards)
+	waiting := generateValidatorMap(0, nbShards)
+
+	res, err := shuffler.UpdateNodeLists(ArgsUpdateNodes{
+		Eligible:       eligible,
+		Waiting:        waiting,
+		UnStakeLeaving: eligible[2][:1],
+		Rand:           []byte("randomness"),
+		NbShards:       nbShards,
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, int(nbShards), len(res.Eligible))
+	for shardID, validators := range res.Eligible {
+		assert.Equal(t, eligiblePerShard, len(validators), "shard %d", shardID)
+	}
+	assert.Equal(t, 1, len(res.Leaving))
+	assert.Equal(t, countValidators(eligible)-1, countValidators(res.Eligible)+countValidators(res.Waiting))
+}
@@ -20,6 +20,7 @@ import (
 
 var _ NodesCoordinator = (*indexHashedNodesCoordinator)(nil)
 var _ PublicKeysSelector = (*indexHashedNodesCoordinator)(nil)
+var _ HistoricalConsensusGroupComputer = (*indexHashedNodesCoordinator)(nil)
 
 const (
 	keyFormat               = "%s_%v_%v_%v"
@@ -304,7 +305,7 @@ func (ihgs *indexHashedNodesCoordinator) ComputeConsensusGroup(
 	}
 
 	consensusSize := ihgs.ConsensusGroupSize(shardID)
-	randomness = []byte(fmt.Sprintf("%d-%s", round, randomness))
+	randomness = roundRandomness(randomness, round)
 
 	log.Debug("computeValidatorsGroup",
 		"randomness", randomness,
@@ -582,12 +583,20 @@ func (ihgs *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 		log.Error("saving nodes coordinator config failed", "error", err.Error())
 	}
 
+	newNbShards := uint32(len(resUpdateNodes.Eligible) - 1)
+	if newNbShards != newNodesConfig.nbShards {
+		log.Info("number of shards changed",
+			"epoch", newEpoch,
+			"previous number of shards", newNodesConfig.nbShards,
+			"new number of shards", newNbShards)
+	}
+
 	displayNodesConfiguration(
 		resUpdateNodes.Eligible,
 		resUpdateNodes.Waiting,
 		leavingNodesMap,
 		stillRemainingNodesMap,
-		newNodesConfig.nbShards)
+		newNbShards)
 
 	ihgs.mutSavedStateKey.Lock()
 	ihgs.savedStateKey = randomness
@@ -1036,6 +1045,11 @@ func computeActuallyLeaving(
 	}
 }
 
+// roundRandomness returns the randomness used to select the consensus group of the given round
+func roundRandomness(randomness []byte, round uint64) []byte {
+	return []byte(fmt.Sprintf("%d-%s", round, randomness))
+}
+
 func selectValidators(
 	selector RandomSelector,
 	randomness []byte,
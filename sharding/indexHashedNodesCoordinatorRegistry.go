@@ -6,6 +6,8 @@ import (
 	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // SerializableValidator holds the minimal data required for marshalling and un-marshalling a validator
@@ -40,20 +42,10 @@ func (ihgs *indexHashedNodesCoordinatorWithRater) LoadState(key []byte) error {
 }
 
 func (ihgs *indexHashedNodesCoordinator) baseLoadState(key []byte) error {
-	ncInternalkey := append([]byte(core.NodesCoordinatorRegistryKeyPrefix), key...)
-
-	log.Debug("getting nodes coordinator config", "key", ncInternalkey)
-
 	ihgs.loadingFromDisk.Store(true)
 	defer ihgs.loadingFromDisk.Store(false)
 
-	data, err := ihgs.bootStorer.Get(ncInternalkey)
-	if err != nil {
-		return err
-	}
-
-	config := &NodesCoordinatorRegistry{}
-	err = json.Unmarshal(data, config)
+	config, err := LoadNodesCoordinatorRegistry(ihgs.bootStorer, key)
 	if err != nil {
 		return err
 	}
@@ -78,6 +70,29 @@ func (ihgs *indexHashedNodesCoordinator) baseLoadState(key []byte) error {
 	return nil
 }
 
+// LoadNodesCoordinatorRegistry reads the nodes coordinator registry saved in the boot storage under the given key
+func LoadNodesCoordinatorRegistry(bootStorer storage.Storer, key []byte) (*NodesCoordinatorRegistry, error) {
+	if check.IfNil(bootStorer) {
+		return nil, ErrNilBootStorer
+	}
+	ncInternalkey := append([]byte(core.NodesCoordinatorRegistryKeyPrefix), key...)
+
+	log.Debug("getting nodes coordinator config", "key", ncInternalkey)
+
+	data, err := bootStorer.Get(ncInternalkey)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := &NodesCoordinatorRegistry{}
+	err = json.Unmarshal(data, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
 func displayNodesConfigInfo(config map[uint32]*epochNodesConfig) {
 	for epoch, cfg := range config {
 		log.Debug("restored config for",
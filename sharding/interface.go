@@ -41,6 +41,13 @@ type NodesCoordinator interface {
 	IsInterfaceNil() bool
 }
 
+// HistoricalConsensusGroupComputer defines a component able to compute the consensus group of a past round, loading
+// the epoch nodes configuration from the registry saved under the provided key when it is no longer held in memory
+type HistoricalConsensusGroupComputer interface {
+	ComputeHistoricalConsensusGroup(registryKey []byte, randomness []byte, round uint64, shardID uint32, epoch uint32) ([]Validator, error)
+	IsInterfaceNil() bool
+}
+
 // EpochStartEventNotifier provides Register and Unregister functionality for the end of epoch events
 type EpochStartEventNotifier interface {
 	RegisterHandler(handler epochStart.ActionHandler)
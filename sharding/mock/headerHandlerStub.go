@@ -129,6 +129,11 @@ func (hhs *HeaderHandlerStub) GetLeaderSignature() []byte {
 	panic("implement me")
 }
 
+// GetLeaderIndex -
+func (hhs *HeaderHandlerStub) GetLeaderIndex() uint32 {
+	return 0
+}
+
 // GetChainID -
 func (hhs *HeaderHandlerStub) GetChainID() []byte {
 	panic("implement me")
@@ -199,6 +204,11 @@ func (hhs *HeaderHandlerStub) SetLeaderSignature(_ []byte) {
 	panic("implement me")
 }
 
+// SetLeaderIndex -
+func (hhs *HeaderHandlerStub) SetLeaderIndex(_ uint32) {
+	panic("implement me")
+}
+
 // SetChainID -
 func (hhs *HeaderHandlerStub) SetChainID(_ []byte) {
 	panic("implement me")
@@ -2,6 +2,7 @@ package sharding
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -9,6 +10,8 @@ import (
 
 var _ Coordinator = (*multiShardCoordinator)(nil)
 
+const reshardAddressLength = 32
+
 // multiShardCoordinator struct defines the functionality for handling transaction dispatching to
 // the corresponding shards. The number of shards is currently passed as a constructor
 // parameter and later it should be calculated by this structure
@@ -101,6 +104,21 @@ func (msc *multiShardCoordinator) CommunicationIdentifier(destShardID uint32) st
 	return core.CommunicationIdentifierBetweenShards(msc.selfId, destShardID)
 }
 
+// ComputeShardIDAfterReshard returns the shard which, in a network having the provided number of shards, holds the
+// addresses ending in the bits of the given shard ID. On a split it gives the shard a new shard is created from and
+// on a merge it gives the shard a removed shard is merged into
+func ComputeShardIDAfterReshard(shardID uint32, numberOfShards uint32) (uint32, error) {
+	coordinator, err := NewMultiShardCoordinator(numberOfShards, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	address := bytes.Repeat([]byte{0xFF}, reshardAddressLength)
+	binary.BigEndian.PutUint32(address[reshardAddressLength-4:], shardID)
+
+	return coordinator.ComputeIdFromBytes(address), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (msc *multiShardCoordinator) IsInterfaceNil() bool {
 	return msc == nil
@@ -205,3 +205,35 @@ func TestMultiShardCoordinator_CommunicationIdentifier(t *testing.T) {
 	shard, _ := NewMultiShardCoordinator(2, selfId)
 	assert.Equal(t, fmt.Sprintf("_%d_%d", selfId, destId), shard.CommunicationIdentifier(destId))
 }
+
+func TestComputeShardIDAfterReshard_InvalidNumberOfShardsShouldErr(t *testing.T) {
+	shardID, err := ComputeShardIDAfterReshard(2, 0)
+
+	assert.Equal(t, ErrInvalidNumberOfShards, err)
+	assert.Equal(t, uint32(0), shardID)
+}
+
+func TestComputeShardIDAfterReshard_ShouldMatchAddressMapping(t *testing.T) {
+	for _, numShards := range []uint32{2, 3, 4, 5} {
+		newCoordinator, _ := NewMultiShardCoordinator(numShards+1, 0)
+		oldCoordinator, _ := NewMultiShardCoordinator(numShards, 0)
+
+		// every address of the newly created shard was held by its parent shard
+		parentShardID, err := ComputeShardIDAfterReshard(numShards, numShards)
+		assert.Nil(t, err)
+		for i := uint32(0); i < 1000; i++ {
+			address := getAddressFromUint32(i)
+			if newCoordinator.ComputeId(address) == numShards {
+				assert.Equal(t, parentShardID, oldCoordinator.ComputeId(address))
+			}
+		}
+	}
+}
+
+func TestComputeShardIDAfterReshard_MergeShouldReturnExistingShard(t *testing.T) {
+	destShardID, _ := ComputeShardIDAfterReshard(2, 2)
+	assert.Equal(t, uint32(0), destShardID)
+
+	destShardID, _ = ComputeShardIDAfterReshard(3, 3)
+	assert.Equal(t, uint32(1), destShardID)
+}
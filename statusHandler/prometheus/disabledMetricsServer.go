@@ -0,0 +1,24 @@
+package prometheus
+
+type disabledMetricsServer struct {
+}
+
+// NewDisabledMetricsServer returns a metrics server that does not serve anything
+func NewDisabledMetricsServer() *disabledMetricsServer {
+	return &disabledMetricsServer{}
+}
+
+// Start does nothing and returns nil
+func (dms *disabledMetricsServer) Start() error {
+	return nil
+}
+
+// Close does nothing and returns nil
+func (dms *disabledMetricsServer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dms *disabledMetricsServer) IsInterfaceNil() bool {
+	return dms == nil
+}
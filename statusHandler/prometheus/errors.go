@@ -0,0 +1,12 @@
+package prometheus
+
+import "errors"
+
+// ErrInvalidBuckets signals that invalid histogram buckets have been provided
+var ErrInvalidBuckets = errors.New("invalid histogram buckets")
+
+// ErrNilHTTPHandler signals that a nil HTTP handler has been provided
+var ErrNilHTTPHandler = errors.New("nil HTTP handler")
+
+// ErrInvalidPort signals that an invalid port has been provided
+var ErrInvalidPort = errors.New("invalid port")
@@ -0,0 +1,8 @@
+package prometheus
+
+// MetricsServer defines the behavior of the component that exposes the metrics to the prometheus scrapers
+type MetricsServer interface {
+	Start() error
+	Close() error
+	IsInterfaceNil() bool
+}
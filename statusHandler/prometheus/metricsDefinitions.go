@@ -0,0 +1,105 @@
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+type metricType string
+
+const (
+	counterType   metricType = "counter"
+	gaugeType     metricType = "gauge"
+	histogramType metricType = "histogram"
+)
+
+type metricDefinition struct {
+	help       string
+	metricType metricType
+	buckets    []float64
+}
+
+// defaultDefinition is used for all the numeric metrics that are not explicitly defined, as the app status handlers
+// mostly report the current value of a metric
+var defaultDefinition = metricDefinition{
+	metricType: gaugeType,
+}
+
+func createMetricsDefinitions(cfg config.PrometheusConfig) (map[string]metricDefinition, error) {
+	histogramsBuckets := map[string][]float64{
+		"BlockProcessingBucketsInMs":  cfg.BlockProcessingBucketsInMs,
+		"SubroundDurationBucketsInMs": cfg.SubroundDurationBucketsInMs,
+		"StorageLatencyBucketsInUs":   cfg.StorageLatencyBucketsInUs,
+	}
+	for name, buckets := range histogramsBuckets {
+		err := checkBuckets(buckets)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", err, name)
+		}
+	}
+
+	return map[string]metricDefinition{
+		core.MetricBlockProcessingTime: {
+			help:       "The time, in milliseconds, needed to process a block",
+			metricType: histogramType,
+			buckets:    cfg.BlockProcessingBucketsInMs,
+		},
+		core.MetricConsensusSubroundDuration: {
+			help:       "The time, in milliseconds, spent in a consensus subround",
+			metricType: histogramType,
+			buckets:    cfg.SubroundDurationBucketsInMs,
+		},
+		core.MetricStorageOperationLatency: {
+			help:       "The time, in microseconds, needed by a storage operation",
+			metricType: histogramType,
+			buckets:    cfg.StorageLatencyBucketsInUs,
+		},
+		core.MetricTxPoolNumTxs: {
+			help:       "The number of transactions held in a transactions pool cache",
+			metricType: gaugeType,
+		},
+		core.MetricP2PTopicNumReceivedBytes: {
+			help:       "The number of bytes received on a p2p topic",
+			metricType: counterType,
+		},
+		core.MetricP2PTopicNumSentBytes: {
+			help:       "The number of bytes sent directly to peers on a p2p topic",
+			metricType: counterType,
+		},
+		core.MetricP2PNumBytesSavedByCompression: {
+			help:       "The number of bytes saved by compressing the sent p2p payloads",
+			metricType: counterType,
+		},
+		core.MetricTrieSyncNumSyncedNodes: {
+			help:       "The number of trie nodes synced so far",
+			metricType: counterType,
+		},
+		core.MetricTrieSyncNumReceivedBytes: {
+			help:       "The number of bytes received while syncing tries",
+			metricType: counterType,
+		},
+		core.MetricTrieSyncNumPendingNodes: {
+			help:       "The number of trie nodes still awaited from the network",
+			metricType: gaugeType,
+		},
+		core.MetricNumTimesInForkChoice: {
+			help:       "The number of times the node was in fork choice",
+			metricType: counterType,
+		},
+	}, nil
+}
+
+func checkBuckets(buckets []float64) error {
+	if len(buckets) == 0 {
+		return fmt.Errorf("%w, at least one bucket should be provided", ErrInvalidBuckets)
+	}
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			return fmt.Errorf("%w, the buckets should be sorted in increasing order", ErrInvalidBuckets)
+		}
+	}
+
+	return nil
+}
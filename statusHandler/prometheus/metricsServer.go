@@ -0,0 +1,83 @@
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("statusHandler/prometheus")
+
+const metricsPath = "/metrics"
+
+const closeTimeout = 5 * time.Second
+
+const defaultInterfaceAddress = "localhost"
+
+// metricsServer is the HTTP server that exposes the metrics on a separate port, so they can be scraped without
+// opening the node's REST API
+type metricsServer struct {
+	server *http.Server
+}
+
+// NewMetricsServer creates a new metrics server that will serve the provided handler on the /metrics path. The server
+// listens on localhost if no interface address is provided
+func NewMetricsServer(interfaceAddress string, port uint32, handler http.Handler) (*metricsServer, error) {
+	if handler == nil {
+		return nil, ErrNilHTTPHandler
+	}
+	if port == 0 || port > 65535 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidPort, port)
+	}
+
+	if len(interfaceAddress) == 0 {
+		interfaceAddress = defaultInterfaceAddress
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, handler)
+
+	return &metricsServer{
+		server: &http.Server{
+			Addr:    net.JoinHostPort(interfaceAddress, fmt.Sprint(port)),
+			Handler: mux,
+		},
+	}, nil
+}
+
+// Start binds the listen address and starts serving the metrics on a new go routine. It returns an error if the
+// address can not be bound, so the node does not start with its metrics silently unavailable
+func (ms *metricsServer) Start() error {
+	listener, err := net.Listen("tcp", ms.server.Addr)
+	if err != nil {
+		return fmt.Errorf("%w while starting the prometheus metrics server on %s", err, ms.server.Addr)
+	}
+
+	log.Info("starting the prometheus metrics server", "address", listener.Addr().String()+metricsPath)
+
+	go func() {
+		errServe := ms.server.Serve(listener)
+		if errServe != nil && errServe != http.ErrServerClosed {
+			log.Error("prometheus metrics server stopped", "error", errServe.Error())
+		}
+	}()
+
+	return nil
+}
+
+// Close gracefully stops the metrics server
+func (ms *metricsServer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	return ms.server.Shutdown(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ms *metricsServer) IsInterfaceNil() bool {
+	return ms == nil
+}
@@ -0,0 +1,84 @@
+package prometheus_test
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetricsServer_NilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ms, err := prometheus.NewMetricsServer("", 9464, nil)
+
+	assert.True(t, check.IfNil(ms))
+	assert.Equal(t, prometheus.ErrNilHTTPHandler, err)
+}
+
+func TestNewMetricsServer_InvalidPortShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ms, err := prometheus.NewMetricsServer("", 0, http.NotFoundHandler())
+	assert.True(t, check.IfNil(ms))
+	assert.True(t, errors.Is(err, prometheus.ErrInvalidPort))
+
+	ms, err = prometheus.NewMetricsServer("", 65536, http.NotFoundHandler())
+	assert.True(t, check.IfNil(ms))
+	assert.True(t, errors.Is(err, prometheus.ErrInvalidPort))
+}
+
+func TestNewMetricsServer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ms, err := prometheus.NewMetricsServer("", 9464, http.NotFoundHandler())
+
+	assert.False(t, check.IfNil(ms))
+	assert.Nil(t, err)
+	assert.Nil(t, ms.Close())
+}
+
+func TestMetricsServer_StartShouldServeOnLocalhost(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.Nil(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	ms, _ := prometheus.NewMetricsServer("", uint32(port), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	err = ms.Start()
+	require.Nil(t, err)
+	defer func() {
+		_ = ms.Close()
+	}()
+
+	resp, err := http.Get(fmt.Sprintf("http://localhost:%d/metrics", port))
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestMetricsServer_StartOnBoundAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	ms, _ := prometheus.NewMetricsServer("localhost", uint32(port), http.NotFoundHandler())
+	err = ms.Start()
+
+	assert.NotNil(t, err)
+}
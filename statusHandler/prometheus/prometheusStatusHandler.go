@@ -0,0 +1,354 @@
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+var _ core.AppStatusHandler = (*prometheusStatusHandler)(nil)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+const (
+	shardLabel = "shard"
+	epochLabel = "epoch"
+	leLabel    = "le"
+)
+
+var metricNameRegex = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+type histogram struct {
+	bucketsCounts []uint64
+	sum           float64
+	count         uint64
+}
+
+type series struct {
+	value     float64
+	histogram *histogram
+}
+
+type metricFamily struct {
+	definition metricDefinition
+	series     map[string]*series
+}
+
+// prometheusStatusHandler is an app status handler that keeps the numeric metrics in a registry able to output them
+// in the prometheus text format. The counters and the histograms are defined in the metrics definitions, all the
+// other numeric metrics are exposed as gauges. Every series is labeled with the shard and the epoch of the node
+type prometheusStatusHandler struct {
+	definitions map[string]metricDefinition
+
+	mut      sync.RWMutex
+	families map[string]*metricFamily
+	shardID  string
+	epoch    string
+}
+
+// NewPrometheusStatusHandler creates a new app status handler that exposes the metrics in the prometheus format
+func NewPrometheusStatusHandler(cfg config.PrometheusConfig) (*prometheusStatusHandler, error) {
+	definitions, err := createMetricsDefinitions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &prometheusStatusHandler{
+		definitions: definitions,
+		families:    make(map[string]*metricFamily),
+	}, nil
+}
+
+// Increment increments the counter or the gauge identified by the provided key
+func (psh *prometheusStatusHandler) Increment(key string) {
+	psh.add(key, 1)
+}
+
+// AddUint64 adds the provided value to the counter or the gauge identified by the provided key
+func (psh *prometheusStatusHandler) AddUint64(key string, val uint64) {
+	psh.add(key, float64(val))
+}
+
+// Decrement decrements the gauge identified by the provided key
+func (psh *prometheusStatusHandler) Decrement(key string) {
+	psh.add(key, -1)
+}
+
+// SetInt64Value sets the value of the metric identified by the provided key. The histograms will observe the value
+func (psh *prometheusStatusHandler) SetInt64Value(key string, value int64) {
+	psh.set(key, float64(value))
+}
+
+// SetUInt64Value sets the value of the metric identified by the provided key. The histograms will observe the value
+func (psh *prometheusStatusHandler) SetUInt64Value(key string, value uint64) {
+	switch key {
+	case core.MetricShardId:
+		psh.setConstLabel(&psh.shardID, value)
+	case core.MetricEpochNumber:
+		psh.setConstLabel(&psh.epoch, value)
+	}
+
+	psh.set(key, float64(value))
+}
+
+// SetStringValue does nothing as prometheus only handles numeric values
+func (psh *prometheusStatusHandler) SetStringValue(_ string, _ string) {
+}
+
+// Close does nothing
+func (psh *prometheusStatusHandler) Close() {
+}
+
+func (psh *prometheusStatusHandler) setConstLabel(label *string, value uint64) {
+	psh.mut.Lock()
+	*label = strconv.FormatUint(value, 10)
+	psh.mut.Unlock()
+}
+
+func (psh *prometheusStatusHandler) add(key string, delta float64) {
+	psh.mut.Lock()
+	defer psh.mut.Unlock()
+
+	family, s, ok := psh.getOrCreateSeries(key)
+	if !ok {
+		return
+	}
+
+	switch family.definition.metricType {
+	case histogramType:
+		return
+	case counterType:
+		if delta < 0 {
+			return
+		}
+	}
+
+	s.value += delta
+}
+
+func (psh *prometheusStatusHandler) set(key string, value float64) {
+	psh.mut.Lock()
+	defer psh.mut.Unlock()
+
+	family, s, ok := psh.getOrCreateSeries(key)
+	if !ok {
+		return
+	}
+
+	if family.definition.metricType == histogramType {
+		s.histogram.observe(value, family.definition.buckets)
+		return
+	}
+
+	s.value = value
+}
+
+func (psh *prometheusStatusHandler) getOrCreateSeries(key string) (*metricFamily, *series, bool) {
+	name, labels, ok := splitMetricKey(key)
+	if !ok {
+		return nil, nil, false
+	}
+
+	family, found := psh.families[name]
+	if !found {
+		definition, isDefined := psh.definitions[name]
+		if !isDefined {
+			definition = defaultDefinition
+		}
+
+		family = &metricFamily{
+			definition: definition,
+			series:     make(map[string]*series),
+		}
+		psh.families[name] = family
+	}
+
+	s, found := family.series[labels]
+	if !found {
+		s = &series{}
+		if family.definition.metricType == histogramType {
+			s.histogram = &histogram{
+				bucketsCounts: make([]uint64, len(family.definition.buckets)),
+			}
+		}
+		family.series[labels] = s
+	}
+
+	return family, s, true
+}
+
+// splitMetricKey splits a key in the form name{label1="value1",label2="value2"} in the metric name and its labels
+func splitMetricKey(key string) (string, string, bool) {
+	name := key
+	labels := ""
+
+	idx := strings.IndexByte(key, '{')
+	if idx >= 0 {
+		if !strings.HasSuffix(key, "}") {
+			return "", "", false
+		}
+
+		name = key[:idx]
+		labels = key[idx+1 : len(key)-1]
+	}
+
+	return name, labels, metricNameRegex.MatchString(name)
+}
+
+func (h *histogram) observe(value float64, buckets []float64) {
+	for i, upperBound := range buckets {
+		if value <= upperBound {
+			h.bucketsCounts[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// Metrics returns all the metrics in the prometheus text format
+func (psh *prometheusStatusHandler) Metrics() string {
+	builder := &strings.Builder{}
+	_ = psh.writeMetrics(builder)
+
+	return builder.String()
+}
+
+// ServeHTTP writes all the metrics in the prometheus text format
+func (psh *prometheusStatusHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	err := psh.writeMetrics(w)
+	if err != nil {
+		log.Debug("prometheusStatusHandler.ServeHTTP", "error", err.Error())
+	}
+}
+
+func (psh *prometheusStatusHandler) writeMetrics(w io.Writer) error {
+	psh.mut.RLock()
+	defer psh.mut.RUnlock()
+
+	constLabels := psh.createConstLabels()
+
+	names := make([]string, 0, len(psh.families))
+	for name := range psh.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err := writeFamily(w, name, psh.families[name], constLabels)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (psh *prometheusStatusHandler) createConstLabels() string {
+	labels := make([]string, 0, 2)
+	if len(psh.shardID) > 0 {
+		labels = append(labels, fmt.Sprintf("%s=\"%s\"", shardLabel, psh.shardID))
+	}
+	if len(psh.epoch) > 0 {
+		labels = append(labels, fmt.Sprintf("%s=\"%s\"", epochLabel, psh.epoch))
+	}
+
+	return strings.Join(labels, ",")
+}
+
+func writeFamily(w io.Writer, name string, family *metricFamily, constLabels string) error {
+	if len(family.definition.help) > 0 {
+		_, err := fmt.Fprintf(w, "# HELP %s %s\n", name, family.definition.help)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "# TYPE %s %s\n", name, family.definition.metricType)
+	if err != nil {
+		return err
+	}
+
+	seriesLabels := make([]string, 0, len(family.series))
+	for labels := range family.series {
+		seriesLabels = append(seriesLabels, labels)
+	}
+	sort.Strings(seriesLabels)
+
+	for _, labels := range seriesLabels {
+		s := family.series[labels]
+		allLabels := joinLabels(constLabels, labels)
+		if family.definition.metricType != histogramType {
+			err = writeSample(w, name, allLabels, s.value)
+		} else {
+			err = writeHistogram(w, name, allLabels, family.definition.buckets, s.histogram)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeHistogram(w io.Writer, name string, labels string, buckets []float64, h *histogram) error {
+	for i, upperBound := range buckets {
+		bucketLabels := joinLabels(labels, fmt.Sprintf("%s=\"%s\"", leLabel, formatValue(upperBound)))
+		err := writeSample(w, name+"_bucket", bucketLabels, float64(h.bucketsCounts[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	infBucketLabels := joinLabels(labels, fmt.Sprintf("%s=\"+Inf\"", leLabel))
+	err := writeSample(w, name+"_bucket", infBucketLabels, float64(h.count))
+	if err != nil {
+		return err
+	}
+
+	err = writeSample(w, name+"_sum", labels, h.sum)
+	if err != nil {
+		return err
+	}
+
+	return writeSample(w, name+"_count", labels, float64(h.count))
+}
+
+func writeSample(w io.Writer, name string, labels string, value float64) error {
+	var err error
+	if len(labels) == 0 {
+		_, err = fmt.Fprintf(w, "%s %s\n", name, formatValue(value))
+	} else {
+		_, err = fmt.Fprintf(w, "%s{%s} %s\n", name, labels, formatValue(value))
+	}
+
+	return err
+}
+
+func joinLabels(first string, second string) string {
+	if len(first) == 0 {
+		return second
+	}
+	if len(second) == 0 {
+		return first
+	}
+
+	return first + "," + second
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (psh *prometheusStatusHandler) IsInterfaceNil() bool {
+	return psh == nil
+}
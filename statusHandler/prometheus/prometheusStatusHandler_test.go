@@ -0,0 +1,137 @@
+package prometheus_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPrometheusConfig() config.PrometheusConfig {
+	return config.PrometheusConfig{
+		Enabled:                     true,
+		Port:                        9464,
+		BlockProcessingBucketsInMs:  []float64{10, 100, 1000},
+		SubroundDurationBucketsInMs: []float64{5, 50},
+		StorageLatencyBucketsInUs:   []float64{100, 1000},
+		MeasureStorageLatency:       true,
+	}
+}
+
+func TestNewPrometheusStatusHandler_InvalidBucketsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createPrometheusConfig()
+	cfg.SubroundDurationBucketsInMs = nil
+	psh, err := prometheus.NewPrometheusStatusHandler(cfg)
+	assert.True(t, check.IfNil(psh))
+	assert.True(t, errors.Is(err, prometheus.ErrInvalidBuckets))
+
+	cfg = createPrometheusConfig()
+	cfg.BlockProcessingBucketsInMs = []float64{10, 10}
+	psh, err = prometheus.NewPrometheusStatusHandler(cfg)
+	assert.True(t, check.IfNil(psh))
+	assert.True(t, errors.Is(err, prometheus.ErrInvalidBuckets))
+}
+
+func TestNewPrometheusStatusHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	psh, err := prometheus.NewPrometheusStatusHandler(createPrometheusConfig())
+
+	assert.False(t, check.IfNil(psh))
+	assert.Nil(t, err)
+	assert.Equal(t, "", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_GaugesShouldBeLabeledWithShardAndEpoch(t *testing.T) {
+	t.Parallel()
+
+	psh, _ := prometheus.NewPrometheusStatusHandler(createPrometheusConfig())
+	psh.SetUInt64Value(core.MetricShardId, 1)
+	psh.SetUInt64Value(core.MetricEpochNumber, 7)
+	psh.SetInt64Value(core.MetricWithLabels(core.MetricTxPoolNumTxs, core.MetricLabelPool, "transactions"), 42)
+	psh.Increment(core.MetricNumConnectedPeers)
+	psh.Increment(core.MetricNumConnectedPeers)
+	psh.Decrement(core.MetricNumConnectedPeers)
+	psh.SetStringValue(core.MetricNodeType, "validator")
+
+	metrics := psh.Metrics()
+
+	assert.Contains(t, metrics, "# TYPE erd_tx_pool_num_txs gauge\n")
+	assert.Contains(t, metrics, `erd_tx_pool_num_txs{shard="1",epoch="7",pool="transactions"} 42`+"\n")
+	assert.Contains(t, metrics, `erd_num_connected_peers{shard="1",epoch="7"} 1`+"\n")
+	assert.Contains(t, metrics, `erd_shard_id{shard="1",epoch="7"} 1`+"\n")
+	assert.NotContains(t, metrics, core.MetricNodeType)
+}
+
+func TestPrometheusStatusHandler_CountersShouldNotDecrease(t *testing.T) {
+	t.Parallel()
+
+	psh, _ := prometheus.NewPrometheusStatusHandler(createPrometheusConfig())
+	key := core.MetricWithLabels(core.MetricP2PTopicNumReceivedBytes, core.MetricLabelTopic, "transactions")
+	psh.AddUint64(key, 10)
+	psh.Increment(key)
+	psh.Decrement(key)
+
+	metrics := psh.Metrics()
+
+	assert.Contains(t, metrics, "# HELP erd_p2p_topic_num_received_bytes ")
+	assert.Contains(t, metrics, "# TYPE erd_p2p_topic_num_received_bytes counter\n")
+	assert.Contains(t, metrics, `erd_p2p_topic_num_received_bytes{topic="transactions"} 11`+"\n")
+}
+
+func TestPrometheusStatusHandler_HistogramsShouldObserveTheSetValues(t *testing.T) {
+	t.Parallel()
+
+	psh, _ := prometheus.NewPrometheusStatusHandler(createPrometheusConfig())
+	psh.SetUInt64Value(core.MetricBlockProcessingTime, 5)
+	psh.SetUInt64Value(core.MetricBlockProcessingTime, 50)
+	psh.SetUInt64Value(core.MetricBlockProcessingTime, 5000)
+	psh.Increment(core.MetricBlockProcessingTime)
+
+	metrics := psh.Metrics()
+
+	expectedLines := []string{
+		"# TYPE erd_block_processing_time_ms histogram",
+		`erd_block_processing_time_ms_bucket{le="10"} 1`,
+		`erd_block_processing_time_ms_bucket{le="100"} 2`,
+		`erd_block_processing_time_ms_bucket{le="1000"} 2`,
+		`erd_block_processing_time_ms_bucket{le="+Inf"} 3`,
+		"erd_block_processing_time_ms_sum 5055",
+		"erd_block_processing_time_ms_count 3",
+	}
+	assert.Contains(t, metrics, strings.Join(expectedLines, "\n")+"\n")
+}
+
+func TestPrometheusStatusHandler_InvalidKeysShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	psh, _ := prometheus.NewPrometheusStatusHandler(createPrometheusConfig())
+	psh.SetUInt64Value("invalid metric", 1)
+	psh.SetUInt64Value(`erd_metric{label="value"`, 1)
+
+	assert.Equal(t, "", psh.Metrics())
+}
+
+func TestPrometheusStatusHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	psh, _ := prometheus.NewPrometheusStatusHandler(createPrometheusConfig())
+	psh.SetUInt64Value(core.MetricNonce, 37)
+
+	resp := httptest.NewRecorder()
+	psh.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.HasPrefix(resp.Header().Get("Content-Type"), "text/plain; version=0.0.4"))
+	assert.Equal(t, "# TYPE erd_nonce gauge\nerd_nonce 37\n", resp.Body.String())
+}
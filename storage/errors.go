@@ -120,3 +120,6 @@ var ErrNegativeSizeInBytes = errors.New("negative size in bytes")
 
 // ErrNilTimeCache signals that a nil time cache has been provided
 var ErrNilTimeCache = errors.New("nil time cache")
+
+// ErrEmptyUnitRetentionIdentifier signals that a storage unit retention policy was provided without an identifier
+var ErrEmptyUnitRetentionIdentifier = errors.New("empty storage unit retention identifier")
@@ -3,6 +3,7 @@ package factory
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -19,6 +20,7 @@ var log = logger.GetOrCreate("storage/factory")
 const (
 	minimumNumberOfActivePersisters = 1
 	minimumNumberOfEpochsToKeep     = 2
+	bytesInMegabyte                 = 1024 * 1024
 )
 
 // StorageServiceFactory handles the creation of storage services for both meta and shards
@@ -47,6 +49,10 @@ func NewStorageServiceFactory(
 	if config.StoragePruning.NumActivePersisters < minimumNumberOfActivePersisters {
 		return nil, storage.ErrInvalidNumberOfActivePersisters
 	}
+	err := checkUnitsRetention(config.StoragePruning)
+	if err != nil {
+		return nil, err
+	}
 	if check.IfNil(shardCoordinator) {
 		return nil, storage.ErrNilShardCoordinator
 	}
@@ -66,6 +72,23 @@ func NewStorageServiceFactory(
 	}, nil
 }
 
+func checkUnitsRetention(pruningConfig config.StoragePruningConfig) error {
+	for _, unitRetention := range pruningConfig.UnitsRetention {
+		if len(unitRetention.Identifier) == 0 {
+			return storage.ErrEmptyUnitRetentionIdentifier
+		}
+		if unitRetention.KeepForever || unitRetention.NumEpochsToKeep == 0 {
+			continue
+		}
+		if unitRetention.NumEpochsToKeep < minimumNumberOfEpochsToKeep ||
+			unitRetention.NumEpochsToKeep < pruningConfig.NumActivePersisters {
+			return fmt.Errorf("%w for unit %s", storage.ErrInvalidNumberOfEpochsToSave, unitRetention.Identifier)
+		}
+	}
+
+	return nil
+}
+
 // CreateForShard will return the storage service which contains all storers needed for a shard
 func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService, error) {
 	var headerUnit *pruning.PruningStorer
@@ -439,6 +462,22 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 	numOfEpochsToKeep := uint32(psf.generalConfig.StoragePruning.NumEpochsToKeep)
 	numOfActivePersisters := uint32(psf.generalConfig.StoragePruning.NumActivePersisters)
 	pruningEnabled := psf.generalConfig.StoragePruning.Enabled
+	maxDiskSizeInMB := psf.generalConfig.StoragePruning.MaxDiskSizeInMB
+	compactionInterval := time.Duration(psf.generalConfig.StoragePruning.CompactionIntervalInSec) * time.Second
+
+	unitRetention, found := psf.getUnitRetention(storageConfig.DB.FilePath)
+	if found {
+		if unitRetention.NumEpochsToKeep > 0 {
+			numOfEpochsToKeep = uint32(unitRetention.NumEpochsToKeep)
+		}
+		if unitRetention.MaxDiskSizeInMB > 0 {
+			maxDiskSizeInMB = unitRetention.MaxDiskSizeInMB
+		}
+		if unitRetention.KeepForever {
+			cleanOldEpochsData = false
+			maxDiskSizeInMB = 0
+		}
+	}
 	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath := filepath.Join(psf.pathManager.PathForEpoch(shardId, psf.currentEpoch, storageConfig.DB.FilePath))
 	args := &pruning.StorerArgs{
@@ -456,7 +495,19 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		NumOfActivePersisters: numOfActivePersisters,
 		Notifier:              psf.epochStartNotifier,
 		MaxBatchSize:          storageConfig.DB.MaxBatchSize,
+		MaxDiskSizeInBytes:    maxDiskSizeInMB * bytesInMegabyte,
+		CompactionInterval:    compactionInterval,
 	}
 
 	return args
 }
+
+func (psf *StorageServiceFactory) getUnitRetention(identifier string) (config.UnitRetentionConfig, bool) {
+	for _, unitRetention := range psf.generalConfig.StoragePruning.UnitsRetention {
+		if unitRetention.Identifier == identifier {
+			return unitRetention, true
+		}
+	}
+
+	return config.UnitRetentionConfig{}, false
+}
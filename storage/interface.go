@@ -30,6 +30,11 @@ type Persister interface {
 	IsInterfaceNil() bool
 }
 
+// Compactor defines a persister that is able to reclaim the disk space used by removed or overwritten entries
+type Compactor interface {
+	Compact() error
+}
+
 // Batcher allows to batch the data first then write the batch to the persister in one go
 type Batcher interface {
 	// Put inserts one entry - key, value pair - into the batch
@@ -208,6 +213,7 @@ type SizedLRUCacheHandler interface {
 type TimeCacher interface {
 	Upsert(key string, span time.Duration) error
 	Has(key string) bool
+	Remove(key string)
 	Sweep()
 	IsInterfaceNil() bool
 }
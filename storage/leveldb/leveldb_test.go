@@ -318,3 +318,27 @@ func TestDB_PutGetLargeValue(t *testing.T) {
 
 	assert.Equal(t, buffLargeValue, recovered)
 }
+
+func TestDB_CompactShouldKeepTheStoredData(t *testing.T) {
+	ldb := createLevelDb(t, 10, 1, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	removedKey := []byte("removed key")
+	keptKey := []byte("kept key")
+	val := []byte("value")
+	_ = ldb.Put(removedKey, val)
+	_ = ldb.Put(keptKey, val)
+	_ = ldb.Remove(removedKey)
+
+	err := ldb.Compact()
+	assert.Nil(t, err)
+
+	_, err = ldb.Get(removedKey)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	valRecovered, err := ldb.Get(keptKey)
+	assert.Nil(t, err)
+	assert.Equal(t, val, valRecovered)
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// CompactablePersisterStub -
+type CompactablePersisterStub struct {
+	storage.Persister
+	CompactCalled func() error
+}
+
+// Compact -
+func (cps *CompactablePersisterStub) Compact() error {
+	if cps.CompactCalled != nil {
+		return cps.CompactCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (cps *CompactablePersisterStub) IsInterfaceNil() bool {
+	return cps == nil
+}
@@ -6,6 +6,7 @@ import "time"
 type TimeCacheStub struct {
 	UpsertCalled func(key string, span time.Duration) error
 	HasCalled    func(key string) bool
+	RemoveCalled func(key string)
 	SweepCalled  func()
 }
 
@@ -27,6 +28,13 @@ func (tcs *TimeCacheStub) Has(key string) bool {
 	return false
 }
 
+// Remove -
+func (tcs *TimeCacheStub) Remove(key string) {
+	if tcs.RemoveCalled != nil {
+		tcs.RemoveCalled(key)
+	}
+}
+
 // Sweep -
 func (tcs *TimeCacheStub) Sweep() {
 	if tcs.SweepCalled != nil {
@@ -9,9 +9,27 @@ import (
 var log = logger.GetOrCreate("storage")
 
 var cummulatedSizeInBytes atomic.Counter
+var cummulatedReclaimedSizeInBytes atomic.Counter
 
 // Question for review: keep this or remove it (helps us to compute planned memory at runtime)?
 func MonitorNewCache(tag string, sizeInBytes uint64) {
 	cummulatedSizeInBytes.Add(int64(sizeInBytes))
 	log.Debug("MonitorNewCache", "name", tag, "capacity", core.ConvertBytes(sizeInBytes), "cummulated", core.ConvertBytes(cummulatedSizeInBytes.GetUint64()))
 }
+
+// MonitorReclaimedSpace records the disk space reclaimed by a storage unit, either by compacting its databases
+// or by removing the databases of old epochs
+func MonitorReclaimedSpace(tag string, reason string, sizeInBytes uint64) {
+	cummulatedReclaimedSizeInBytes.Add(int64(sizeInBytes))
+	log.Debug("MonitorReclaimedSpace",
+		"name", tag,
+		"reason", reason,
+		"reclaimed", core.ConvertBytes(sizeInBytes),
+		"cummulated", core.ConvertBytes(GetReclaimedSpaceInBytes()),
+	)
+}
+
+// GetReclaimedSpaceInBytes returns the total disk space reclaimed by the storage units since the node started
+func GetReclaimedSpaceInBytes() uint64 {
+	return uint64(cummulatedReclaimedSizeInBytes.Get())
+}
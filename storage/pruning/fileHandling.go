@@ -52,3 +52,23 @@ func isDirectoryEmpty(name string) bool {
 	_, err = f.Readdirnames(1) // Or f.Readdir(1)
 	return err == io.EOF
 }
+
+// getDirectorySize returns the total size of the files found in the provided directory and its subdirectories
+func getDirectorySize(path string) uint64 {
+	size := uint64(0)
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		log.Debug("pruning db - directory size", "path", path, "error", err.Error())
+	}
+
+	return size
+}
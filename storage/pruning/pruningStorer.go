@@ -1,6 +1,7 @@
 package pruning
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -71,6 +72,8 @@ type PruningStorer struct {
 	epochForPutOperation  uint32
 	cleanOldEpochsData    bool
 	pruningEnabled        bool
+	maxDiskSizeInBytes    uint64
+	cancelCompaction      func()
 }
 
 // NewPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -153,6 +156,8 @@ func initPruningStorer(
 		dbPath:                args.DbPath,
 		numOfEpochsToKeep:     args.NumOfEpochsToKeep,
 		numOfActivePersisters: args.NumOfActivePersisters,
+		maxDiskSizeInBytes:    args.MaxDiskSizeInBytes,
+		cancelCompaction:      func() {},
 	}
 
 	if args.BloomFilterConf.Size != 0 { // if size is 0, that means an empty config was used so bloom filter will be nil
@@ -166,6 +171,12 @@ func initPruningStorer(
 
 	pdb.registerHandler(args.Notifier)
 
+	if args.CompactionInterval > 0 {
+		var ctx context.Context
+		ctx, pdb.cancelCompaction = context.WithCancel(context.Background())
+		go pdb.compactionLoop(ctx, args.CompactionInterval)
+	}
+
 	return pdb, nil
 }
 
@@ -293,6 +304,8 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 
 // Close will close PruningStorer
 func (ps *PruningStorer) Close() error {
+	ps.cancelCompaction()
+
 	closedSuccessfully := true
 	for _, persister := range ps.activePersisters {
 		err := persister.persister.Close()
@@ -686,6 +699,13 @@ func (ps *PruningStorer) changeEpoch(header data.HeaderHandler) error {
 		log.Warn("closing and destroying old persister", "error", err.Error())
 		return err
 	}
+
+	err = ps.destroyPersistersExceedingDiskSize()
+	if err != nil {
+		log.Warn("destroying persisters exceeding the disk size", "error", err.Error())
+		return err
+	}
+
 	return nil
 }
 
@@ -850,11 +870,13 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	}
 
 	for _, p := range persistersToDestroy {
+		sizeInBytes := getDirectorySize(p.path)
 		err := p.persister.DestroyClosed()
 		if err != nil {
 			return err
 		}
 		removeDirectoryIfEmpty(p.path)
+		storage.MonitorReclaimedSpace(ps.identifier, "epochs retention", sizeInBytes)
 	}
 
 	return nil
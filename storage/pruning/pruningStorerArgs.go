@@ -1,6 +1,8 @@
 package pruning
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -21,4 +23,6 @@ type StorerArgs struct {
 	MaxBatchSize          int
 	PruningEnabled        bool
 	CleanOldEpochsData    bool
+	MaxDiskSizeInBytes    uint64
+	CompactionInterval    time.Duration
 }
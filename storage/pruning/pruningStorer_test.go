@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -887,3 +890,142 @@ func TestDirectories(t *testing.T) {
 
 	_ = os.RemoveAll("user-directory")
 }
+
+func createPruningStorerWithDataInEpochs(
+	t *testing.T,
+	dir string,
+	maxDiskSizeInBytes uint64,
+	numEpochs uint32,
+) (*pruning.PruningStorer, map[uint32][]byte, []byte) {
+	args := getDefaultArgsSerialDB()
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return filepath.Join(dir, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+	}}
+	args.NumOfEpochsToKeep = 10
+	args.NumOfActivePersisters = 2
+	args.MaxDiskSizeInBytes = maxDiskSizeInBytes
+	ps, _ := pruning.NewPruningStorer(args)
+
+	value := make([]byte, 1024)
+	keysByEpoch := make(map[uint32][]byte)
+	for epoch := uint32(0); epoch < numEpochs; epoch++ {
+		if epoch > 0 {
+			err := ps.ChangeEpochSimple(epoch)
+			require.Nil(t, err)
+		}
+		ps.SetEpochForPutOperation(epoch)
+
+		for i := 0; i < 40; i++ {
+			key := []byte(fmt.Sprintf("key_%d_%d", epoch, i))
+			err := ps.Put(key, value)
+			require.Nil(t, err)
+			keysByEpoch[epoch] = key
+		}
+	}
+	err := ps.ChangeEpochSimple(numEpochs)
+	require.Nil(t, err)
+	ps.ClearCache()
+
+	return ps, keysByEpoch, value
+}
+
+func TestPruningStorer_ChangeEpochShouldRemoveOldestPersistersExceedingDiskSize(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "pruning_disk_size")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	ps, keysByEpoch, value := createPruningStorerWithDataInEpochs(t, dir, 100*1024, 4)
+
+	_, err := os.Stat(filepath.Join(dir, "Epoch_0"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = ps.GetFromEpoch(keysByEpoch[0], 0)
+	assert.NotNil(t, err)
+
+	res, err := ps.GetFromEpoch(keysByEpoch[3], 3)
+	assert.Nil(t, err)
+	assert.Equal(t, value, res)
+
+	_ = ps.Close()
+}
+
+func TestPruningStorer_ChangeEpochWithoutMaxDiskSizeShouldKeepPersisters(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "pruning_disk_size")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	ps, keysByEpoch, value := createPruningStorerWithDataInEpochs(t, dir, 0, 4)
+
+	_, err := os.Stat(filepath.Join(dir, "Epoch_0"))
+	assert.Nil(t, err)
+	res, err := ps.GetFromEpoch(keysByEpoch[0], 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, res)
+
+	_ = ps.Close()
+}
+
+func TestPruningStorer_CompactionShouldBeCalledPeriodically(t *testing.T) {
+	t.Parallel()
+
+	numCompactCalls := int32(0)
+	args := getDefaultArgs()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return &mock.CompactablePersisterStub{
+				Persister: memorydb.New(),
+				CompactCalled: func() error {
+					atomic.AddInt32(&numCompactCalls, 1)
+					return nil
+				},
+			}, nil
+		},
+	}
+	args.CompactionInterval = time.Millisecond * 10
+	ps, _ := pruning.NewPruningStorer(args)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.True(t, atomic.LoadInt32(&numCompactCalls) > 0)
+
+	_ = ps.Close()
+	time.Sleep(time.Millisecond * 20)
+	numCallsAfterClose := atomic.LoadInt32(&numCompactCalls)
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, numCallsAfterClose, atomic.LoadInt32(&numCompactCalls))
+}
+
+func TestPruningStorer_CompactActivePersistersShouldSkipPersistersWithoutCompaction(t *testing.T) {
+	t.Parallel()
+
+	compactErr := errors.New("compaction error")
+	numCompactCalls := 0
+	args := getDefaultArgs()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			if path == "Epoch_1" {
+				return memorydb.New(), nil
+			}
+
+			return &mock.CompactablePersisterStub{
+				Persister: memorydb.New(),
+				CompactCalled: func() error {
+					numCompactCalls++
+					return compactErr
+				},
+			}, nil
+		},
+	}
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return fmt.Sprintf("Epoch_%d", epoch)
+	}}
+	ps, _ := pruning.NewPruningStorer(args)
+	_ = ps.ChangeEpochSimple(1)
+
+	ps.CompactActivePersisters()
+	assert.Equal(t, 1, numCompactCalls)
+}
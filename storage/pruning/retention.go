@@ -0,0 +1,120 @@
+package pruning
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// destroyPersistersExceedingDiskSize removes the oldest closed persisters while the disk size occupied by all the
+// persisters of this unit is greater than the configured maximum. The active persisters are never removed
+func (ps *PruningStorer) destroyPersistersExceedingDiskSize() error {
+	if ps.maxDiskSizeInBytes == 0 {
+		return nil
+	}
+
+	ps.lock.RLock()
+	persistersMapByEpoch := make(map[uint32]*persisterData, len(ps.persistersMapByEpoch))
+	for epoch, pd := range ps.persistersMapByEpoch {
+		persistersMapByEpoch[epoch] = pd
+	}
+	activeEpochs := make(map[uint32]struct{}, len(ps.activePersisters))
+	for _, pd := range ps.activePersisters {
+		activeEpochs[pd.epoch] = struct{}{}
+	}
+	ps.lock.RUnlock()
+
+	epochs := make([]uint32, 0, len(persistersMapByEpoch))
+	sizesByEpoch := make(map[uint32]uint64, len(persistersMapByEpoch))
+	totalSizeInBytes := uint64(0)
+	for epoch, pd := range persistersMapByEpoch {
+		epochs = append(epochs, epoch)
+		sizesByEpoch[epoch] = getDirectorySize(pd.path)
+		totalSizeInBytes += sizesByEpoch[epoch]
+	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	persistersToDestroy := make([]*persisterData, 0)
+	ps.lock.Lock()
+	for _, epoch := range epochs {
+		if totalSizeInBytes <= ps.maxDiskSizeInBytes {
+			break
+		}
+
+		_, isActive := activeEpochs[epoch]
+		pd := persistersMapByEpoch[epoch]
+		if isActive || !pd.getIsClosed() {
+			break
+		}
+
+		delete(ps.persistersMapByEpoch, epoch)
+		totalSizeInBytes -= sizesByEpoch[epoch]
+		persistersToDestroy = append(persistersToDestroy, pd)
+	}
+	ps.lock.Unlock()
+
+	if totalSizeInBytes > ps.maxDiskSizeInBytes {
+		log.Debug("PruningStorer - disk size exceeded by the active persisters",
+			"identifier", ps.identifier,
+			"size", core.ConvertBytes(totalSizeInBytes),
+			"max size", core.ConvertBytes(ps.maxDiskSizeInBytes))
+	}
+
+	for _, p := range persistersToDestroy {
+		err := p.persister.DestroyClosed()
+		if err != nil {
+			return err
+		}
+		removeDirectoryIfEmpty(p.path)
+		storage.MonitorReclaimedSpace(ps.identifier, "disk size retention", sizesByEpoch[p.epoch])
+	}
+
+	return nil
+}
+
+func (ps *PruningStorer) compactionLoop(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("PruningStorer - closing the compaction loop", "identifier", ps.identifier)
+			return
+		case <-time.After(interval):
+			ps.compactActivePersisters()
+		}
+	}
+}
+
+// compactActivePersisters compacts the active persisters that support compaction and reports the reclaimed space
+func (ps *PruningStorer) compactActivePersisters() {
+	ps.lock.RLock()
+	activePersisters := make([]*persisterData, len(ps.activePersisters))
+	copy(activePersisters, ps.activePersisters)
+	ps.lock.RUnlock()
+
+	for _, pd := range activePersisters {
+		compactor, ok := pd.persister.(storage.Compactor)
+		if !ok || pd.getIsClosed() {
+			continue
+		}
+
+		sizeBefore := getDirectorySize(pd.path)
+		err := compactor.Compact()
+		if err != nil {
+			log.Debug("PruningStorer - compaction",
+				"identifier", ps.identifier,
+				"epoch", pd.epoch,
+				"error", err.Error())
+			continue
+		}
+
+		sizeAfter := getDirectorySize(pd.path)
+		if sizeAfter < sizeBefore {
+			storage.MonitorReclaimedSpace(ps.identifier, "compaction", sizeBefore-sizeAfter)
+		}
+	}
+}
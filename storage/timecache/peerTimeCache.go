@@ -40,6 +40,11 @@ func (ptc *peerTimeCache) Has(pid core.PeerID) bool {
 	return ptc.timeCache.Has(string(pid))
 }
 
+// Remove will call the inner time cache method with the provided pid as string
+func (ptc *peerTimeCache) Remove(pid core.PeerID) {
+	ptc.timeCache.Remove(string(pid))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ptc *peerTimeCache) IsInterfaceNil() bool {
 	return ptc == nil
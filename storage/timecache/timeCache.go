@@ -111,6 +111,13 @@ func (tc *TimeCache) Has(key string) bool {
 	return ok
 }
 
+// Remove will remove the key from the time cache, if it exists
+func (tc *TimeCache) Remove(key string) {
+	tc.mut.Lock()
+	delete(tc.data, key)
+	tc.mut.Unlock()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tc *TimeCache) IsInterfaceNil() bool {
 	return tc == nil
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/throttler"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/syncer"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -30,6 +31,7 @@ type ArgsNewAccountsDBSyncersContainerFactory struct {
 	TrieStorageManager   data.StorageManager
 	WaitTime             time.Duration
 	MaxTrieLevelInMemory uint
+	AppStatusHandler     core.AppStatusHandler
 }
 
 type accountDBSyncersContainerFactory struct {
@@ -42,6 +44,7 @@ type accountDBSyncersContainerFactory struct {
 	waitTime             time.Duration
 	trieStorageManager   data.StorageManager
 	maxTrieLevelinMemory uint
+	trieSyncStatistics   trie.SyncStatisticsHandler
 }
 
 const minWaitTime = time.Second
@@ -69,6 +72,14 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 	if args.WaitTime < minWaitTime {
 		return nil, fmt.Errorf("%w, minWaitTime is %d", update.ErrInvalidWaitTime, minWaitTime)
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, update.ErrNilAppStatusHandler
+	}
+
+	trieSyncStatistics, err := trie.NewSyncStatistics(args.AppStatusHandler)
+	if err != nil {
+		return nil, err
+	}
 
 	t := &accountDBSyncersContainerFactory{
 		shardCoordinator:     args.ShardCoordinator,
@@ -78,6 +89,7 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 		marshalizer:          args.Marshalizer,
 		trieStorageManager:   args.TrieStorageManager,
 		waitTime:             args.WaitTime,
+		trieSyncStatistics:   trieSyncStatistics,
 		maxTrieLevelinMemory: args.MaxTrieLevelInMemory,
 	}
 
@@ -123,6 +135,7 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			WaitTime:             a.waitTime,
 			Cacher:               a.trieCacher,
 			MaxTrieLevelInMemory: a.maxTrieLevelinMemory,
+			TrieSyncStatistics:   a.trieSyncStatistics,
 		},
 		ShardId:   shardId,
 		Throttler: thr,
@@ -146,6 +159,7 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			WaitTime:             a.waitTime,
 			Cacher:               a.trieCacher,
 			MaxTrieLevelInMemory: a.maxTrieLevelinMemory,
+			TrieSyncStatistics:   a.trieSyncStatistics,
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
@@ -71,6 +71,7 @@ type ArgsExporter struct {
 	ChainID                  []byte
 	Rounder                  process.Rounder
 	GenesisNodesSetupHandler update.GenesisNodesSetupHandler
+	AppStatusHandler         core.AppStatusHandler
 }
 
 type exportHandlerFactory struct {
@@ -113,6 +114,7 @@ type exportHandlerFactory struct {
 	chainID                  []byte
 	rounder                  process.Rounder
 	genesisNodesSetupHandler update.GenesisNodesSetupHandler
+	appStatusHandler         core.AppStatusHandler
 }
 
 // NewExportHandlerFactory creates an exporter factory
@@ -207,6 +209,9 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 	if check.IfNil(args.GenesisNodesSetupHandler) {
 		return nil, update.ErrNilGenesisNodesSetupHandler
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, update.ErrNilAppStatusHandler
+	}
 
 	e := &exportHandlerFactory{
 		txSignMarshalizer:        args.TxSignMarshalizer,
@@ -246,6 +251,7 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		chainID:                  args.ChainID,
 		rounder:                  args.Rounder,
 		genesisNodesSetupHandler: args.GenesisNodesSetupHandler,
+		appStatusHandler:         args.AppStatusHandler,
 	}
 
 	return e, nil
@@ -331,6 +337,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		TrieStorageManager:   dataTriesContainerFactory.TrieStorageManager(),
 		WaitTime:             time.Minute,
 		MaxTrieLevelInMemory: e.maxTrieLevelInMemory,
+		AppStatusHandler:     e.appStatusHandler,
 	}
 	accountsDBSyncerFactory, err := NewAccountsDBSContainerFactory(argsAccountsSyncers)
 	if err != nil {
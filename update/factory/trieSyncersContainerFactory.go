@@ -20,13 +20,15 @@ type ArgsNewTrieSyncersContainerFactory struct {
 	RequestHandler    update.RequestHandler
 	DataTrieContainer state.TriesHolder
 	ShardCoordinator  sharding.Coordinator
+	AppStatusHandler  core.AppStatusHandler
 }
 
 type trieSyncersContainerFactory struct {
-	shardCoordinator sharding.Coordinator
-	trieCacher       storage.Cacher
-	trieContainer    state.TriesHolder
-	requestHandler   update.RequestHandler
+	shardCoordinator   sharding.Coordinator
+	trieCacher         storage.Cacher
+	trieContainer      state.TriesHolder
+	requestHandler     update.RequestHandler
+	trieSyncStatistics trie.SyncStatisticsHandler
 }
 
 // NewTrieSyncersContainerFactory creates a factory for trie syncers container
@@ -46,12 +48,21 @@ func NewTrieSyncersContainerFactory(args ArgsNewTrieSyncersContainerFactory) (*t
 	if check.IfNil(args.TrieCacher) {
 		return nil, update.ErrNilCacher
 	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, update.ErrNilAppStatusHandler
+	}
+
+	trieSyncStatistics, err := trie.NewSyncStatistics(args.AppStatusHandler)
+	if err != nil {
+		return nil, err
+	}
 
 	t := &trieSyncersContainerFactory{
-		shardCoordinator: args.ShardCoordinator,
-		trieCacher:       args.TrieCacher,
-		requestHandler:   args.RequestHandler,
-		trieContainer:    args.DataTrieContainer,
+		shardCoordinator:   args.ShardCoordinator,
+		trieCacher:         args.TrieCacher,
+		requestHandler:     args.RequestHandler,
+		trieContainer:      args.DataTrieContainer,
+		trieSyncStatistics: trieSyncStatistics,
 	}
 
 	return t, nil
@@ -93,7 +104,15 @@ func (t *trieSyncersContainerFactory) createOneTrieSyncer(
 		return update.ErrNilDataTrieContainer
 	}
 
-	trieSyncer, err := trie.NewTrieSyncer(t.requestHandler, t.trieCacher, dataTrie, shId, trieTopicFromAccountType(accType))
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:     t.requestHandler,
+		InterceptedNodes:   t.trieCacher,
+		Trie:               dataTrie,
+		ShardId:            shId,
+		Topic:              trieTopicFromAccountType(accType),
+		TrieSyncStatistics: t.trieSyncStatistics,
+	}
+	trieSyncer, err := trie.NewTrieSyncer(arg)
 	if err != nil {
 		return err
 	}
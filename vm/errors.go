@@ -77,6 +77,9 @@ var ErrNilArgumentsParser = errors.New("nil arguments parser")
 // ErrOnExecutionAtStakingSC signals that there was an error at staking sc call
 var ErrOnExecutionAtStakingSC = errors.New("execution error at staking sc")
 
+// ErrOnExecutionAtAuctionSC signals that there was an error at auction sc call
+var ErrOnExecutionAtAuctionSC = errors.New("execution error at auction sc")
+
 // ErrNilAuctionSmartContractAddress signals that auction smart contract address is nil
 var ErrNilAuctionSmartContractAddress = errors.New("nil auction smart contract address")
 
@@ -86,6 +89,9 @@ var ErrInvalidStakingAccessAddress = errors.New("invalid staking access address"
 // ErrInvalidJailAccessAddress signals that invalid jailing access address was provided
 var ErrInvalidJailAccessAddress = errors.New("invalid jailing access address")
 
+// ErrInvalidSlashingAccessAddress signals that invalid slashing access address was provided
+var ErrInvalidSlashingAccessAddress = errors.New("invalid slashing access address")
+
 // ErrNotEnoughGas signals that there is not enough gas for execution
 var ErrNotEnoughGas = errors.New("not enough gas")
 
@@ -98,6 +104,9 @@ var ErrInvalidMinNumberOfNodes = errors.New("invalid min number of nodes")
 // ErrInvalidBaseIssuingCost signals that invalid base issuing cost has been provided
 var ErrInvalidBaseIssuingCost = errors.New("invalid base issuing cost")
 
+// ErrInvalidRoundDurationBounds signals that invalid round duration bounds have been provided
+var ErrInvalidRoundDurationBounds = errors.New("invalid round duration bounds")
+
 // ErrNilHasher signals that an operation has been attempted to or with a nil hasher implementation
 var ErrNilHasher = errors.New("nil Hasher")
 
@@ -149,6 +158,9 @@ var ErrNegativeBleedPercentagePerRound = errors.New("negative bleed percentage p
 // ErrNegativeMaximumPercentageToBleed signals that negative maximum percentage to bleed has been provided
 var ErrNegativeMaximumPercentageToBleed = errors.New("negative maximum percentage to bleed")
 
+// ErrInvalidEquivocationSlashPercentage signals that an equivocation slash percentage outside [0, 1] has been provided
+var ErrInvalidEquivocationSlashPercentage = errors.New("invalid equivocation slash percentage")
+
 // ErrInvalidMaxNumberOfNodes signals that invalid number of max number of nodes has been provided
 var ErrInvalidMaxNumberOfNodes = errors.New("invalid number of max number of nodes")
 
@@ -116,13 +116,14 @@ func (scf *systemSCFactory) createGasConfig(gasMap map[string]map[string]uint64)
 
 func (scf *systemSCFactory) createStakingContract() (vm.SystemSmartContract, error) {
 	argsStaking := systemSmartContracts.ArgsNewStakingSmartContract{
-		MinNumNodes:       uint64(scf.nodesConfigProvider.MinNumberOfNodes()),
-		StakingSCConfig:   scf.systemSCConfig.StakingSystemSCConfig,
-		Eei:               scf.systemEI,
-		StakingAccessAddr: AuctionSCAddress,
-		JailAccessAddr:    JailingAddress,
-		GasCost:           scf.gasCost,
-		Marshalizer:       scf.marshalizer,
+		MinNumNodes:        uint64(scf.nodesConfigProvider.MinNumberOfNodes()),
+		StakingSCConfig:    scf.systemSCConfig.StakingSystemSCConfig,
+		Eei:                scf.systemEI,
+		StakingAccessAddr:  AuctionSCAddress,
+		JailAccessAddr:     JailingAddress,
+		SlashingAccessAddr: SlashingAddress,
+		GasCost:            scf.gasCost,
+		Marshalizer:        scf.marshalizer,
 	}
 	staking, err := systemSmartContracts.NewStakingSmartContract(argsStaking)
 	return staking, err
@@ -29,11 +29,13 @@ func createMockNewSystemScFactoryArgs() ArgsNewSystemSCFactory {
 				OwnerAddress:    "aaaaaa",
 			},
 			GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
-				ProposalCost:     "500",
-				NumNodes:         100,
-				MinQuorum:        50,
-				MinPassThreshold: 50,
-				MinVetoThreshold: 50,
+				ProposalCost:         "500",
+				NumNodes:             100,
+				MinQuorum:            50,
+				MinPassThreshold:     50,
+				MinVetoThreshold:     50,
+				MinRoundDurationInMs: 4000,
+				MaxRoundDurationInMs: 10000,
 			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
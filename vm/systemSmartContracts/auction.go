@@ -18,6 +18,7 @@ import (
 )
 
 const minArgsLenToChangeValidatorKey = 4
+const keyOwnerPrefix = "keyOwner_"
 
 var zero = big.NewInt(0)
 
@@ -143,6 +144,8 @@ func (s *stakingAuctionSC) Execute(args *vmcommon.ContractCallInput) vmcommon.Re
 	//	return s.changeValidatorKeys(args)
 	case "unJail":
 		return s.unJail(args)
+	case "slashStake":
+		return s.slashStake(args)
 	}
 
 	s.eei.AddReturnMessage("invalid method to call")
@@ -327,6 +330,8 @@ func (s *stakingAuctionSC) replaceBLSKey(registrationData *AuctionData, oldBlsKe
 		if bytes.Equal(registeredKey, oldBlsKey) {
 			foundOldKey = true
 			registrationData.BlsPubKeys[i] = newBlsKey
+			s.eei.SetStorage(keyOwnerKey(newBlsKey), s.eei.GetStorage(keyOwnerKey(oldBlsKey)))
+			s.eei.SetStorage(keyOwnerKey(oldBlsKey), nil)
 			break
 		}
 	}
@@ -528,6 +533,7 @@ func (s *stakingAuctionSC) registerBLSKeys(
 		}
 
 		registrationData.BlsPubKeys = append(registrationData.BlsPubKeys, blsKey)
+		s.eei.SetStorage(keyOwnerKey(blsKey), pubKey)
 	}
 
 	return blsKeys, nil
@@ -1006,6 +1012,10 @@ func (s *stakingAuctionSC) unBond(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
+	for _, blsKey := range unBondedKeys {
+		s.eei.SetStorage(keyOwnerKey(blsKey), nil)
+	}
+
 	zero := big.NewInt(0)
 	if registrationData.LockedStake.Cmp(zero) == 0 && registrationData.TotalStakeValue.Cmp(zero) == 0 {
 		s.eei.SetStorage(args.CallerAddr, nil)
@@ -1034,6 +1044,57 @@ func (s *stakingAuctionSC) deleteUnBondedKeys(registrationData *AuctionData, unB
 	}
 }
 
+// slashStake removes the value slashed from a BLS key by the staking contract from the stake of the key's owner.
+// The staking contract moves the slashed value out of this contract, so it can not be unBonded or claimed.
+func (s *stakingAuctionSC) slashStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.stakingSCAddress) {
+		s.eei.AddReturnMessage("slashStake function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", 2, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	blsKey := args.Arguments[0]
+	ownerAddress := s.eei.GetStorage(keyOwnerKey(blsKey))
+	if len(ownerAddress) == 0 {
+		s.eei.AddReturnMessage("owner not found for key " + hex.EncodeToString(blsKey))
+		return vmcommon.UserError
+	}
+
+	registrationData, err := s.getOrCreateRegistrationData(ownerAddress)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot get or create registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	slashValue := big.NewInt(0).SetBytes(args.Arguments[1])
+	if registrationData.LockedStake.Cmp(slashValue) < 0 {
+		s.eei.AddReturnMessage("contract error on slashStake function, lockedStake < slash value")
+		return vmcommon.UserError
+	}
+
+	registrationData.LockedStake.Sub(registrationData.LockedStake, slashValue)
+	registrationData.TotalStakeValue.Sub(registrationData.TotalStakeValue, slashValue)
+	if registrationData.TotalStakeValue.Cmp(zero) < 0 {
+		s.eei.AddReturnMessage("contract error on slashStake function, total stake < 0")
+		return vmcommon.UserError
+	}
+
+	err = s.saveRegistrationData(ownerAddress, registrationData)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func keyOwnerKey(blsKey []byte) []byte {
+	return append([]byte(keyOwnerPrefix), blsKey...)
+}
+
 func (s *stakingAuctionSC) claim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		s.eei.AddReturnMessage("transaction value must be zero")
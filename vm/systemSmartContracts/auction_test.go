@@ -901,6 +901,97 @@ func TestStakingAuctionSC_ExecuteStakeUnStakeUnBondBlsPubKeyAndRestake(t *testin
 	assert.True(t, stakedData.Staked)
 }
 
+func TestStakingAuctionSC_ExecuteUnBondAfterSlashEquivocationShouldRefundTheRemainingStake(t *testing.T) {
+	t.Parallel()
+
+	stakerAddress := []byte("stakerAddress")
+	stakerPubKey := []byte("stakerPubKey")
+	nodePrice := big.NewInt(10000000)
+	nonce := uint64(1)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return nonce
+		},
+	}
+	args := createMockArgumentsForAuction()
+
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), atArgParser, &mock.AccountsStub{})
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = nodePrice.String()
+	argsStaking.StakingSCConfig.UnBondPeriod = 1000
+	argsStaking.StakingSCConfig.AuctionEnableNonce = 100000000
+	argsStaking.StakingSCConfig.EquivocationSlashPercentage = 0.1
+	argsStaking.Eei = eei
+	stakingSC, _ := NewStakingSmartContract(argsStaking)
+
+	args.StakingSCConfig = argsStaking.StakingSCConfig
+	args.Eei = eei
+	sc, _ := NewStakingAuctionSmartContract(args)
+
+	eei.SetSCAddress([]byte("addr"))
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		if bytes.Equal(key, args.AuctionSCAddress) {
+			return sc, nil
+		}
+		return stakingSC, nil
+	}})
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = stakerAddress
+	arguments.RecipientAddr = args.AuctionSCAddress
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), stakerPubKey, []byte("signed")}
+	arguments.CallValue = nodePrice
+	eei.SetSCAddress(args.AuctionSCAddress)
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	arguments.CallerAddr = []byte("anotherCaller")
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), []byte("anotherKey"), []byte("signed")}
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	slashArguments := CreateVmContractCallInput()
+	slashArguments.Function = "slashEquivocation"
+	slashArguments.CallerAddr = argsStaking.SlashingAccessAddr
+	slashArguments.RecipientAddr = args.StakingSCAddress
+	slashArguments.Arguments = [][]byte{stakerPubKey, []byte("offense")}
+	eei.SetSCAddress(args.StakingSCAddress)
+	retCode = stakingSC.Execute(slashArguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	unJailArguments := CreateVmContractCallInput()
+	unJailArguments.Function = "unJail"
+	unJailArguments.CallerAddr = args.AuctionSCAddress
+	unJailArguments.Arguments = [][]byte{stakerPubKey}
+	retCode = stakingSC.Execute(unJailArguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	nonce += 1
+	arguments.CallerAddr = stakerAddress
+	arguments.Function = "unStake"
+	arguments.Arguments = [][]byte{stakerPubKey}
+	arguments.CallValue = big.NewInt(0)
+	eei.SetSCAddress(args.AuctionSCAddress)
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	nonce += args.StakingSCConfig.UnBondPeriod + 1
+	arguments.Function = "unBond"
+	retCode = sc.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, big.NewInt(9000000), eei.GetBalance(stakerAddress))
+	assert.Equal(t, big.NewInt(1000000), eei.GetBalance(args.StakingSCAddress))
+	assert.Equal(t, big.NewInt(-10000000), eei.GetBalance(args.AuctionSCAddress))
+
+	eei.SetSCAddress(args.AuctionSCAddress)
+	assert.Equal(t, 0, len(eei.GetStorage(stakerAddress)))
+	assert.Equal(t, 0, len(eei.GetStorage(keyOwnerKey(stakerPubKey))))
+}
+
 func TestStakingAuctionSC_ExecuteUnBound(t *testing.T) {
 	t.Parallel()
 
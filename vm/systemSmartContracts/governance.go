@@ -3,6 +3,7 @@ package systemSmartContracts
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -16,6 +17,8 @@ import (
 )
 
 const governanceConfigKey = "governanceConfig"
+const roundDurationKey = "roundDuration"
+const roundDurationPrefix = "roundDurationProposal"
 const hardForkPrefix = "hardFork"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
@@ -66,6 +69,10 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	if !ok || baseProposalCost.Cmp(big.NewInt(0)) < 0 {
 		return nil, vm.ErrInvalidBaseIssuingCost
 	}
+	if args.GovernanceConfig.MinRoundDurationInMs == 0 ||
+		args.GovernanceConfig.MinRoundDurationInMs > args.GovernanceConfig.MaxRoundDurationInMs {
+		return nil, vm.ErrInvalidRoundDurationBounds
+	}
 
 	return &governanceContract{
 		eei:                 args.Eei,
@@ -92,6 +99,10 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.init(args)
 	}
 
+	if args.Function == "getRoundDuration" {
+		return g.getRoundDuration(args)
+	}
+
 	if g.disabled {
 		g.eei.AddReturnMessage("Governance SC disabled")
 		return vmcommon.UserError
@@ -112,6 +123,10 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.revokeVotePower(args)
 	case "changeConfig":
 		return g.changeConfig(args)
+	case "roundDurationProposal":
+		return g.roundDurationProposal(args)
+	case "changeRoundDuration":
+		return g.changeRoundDuration(args)
 	case "closeProposal":
 		return g.closeProposal(args)
 	}
@@ -194,6 +209,160 @@ func (g *governanceContract) changeConfig(args *vmcommon.ContractCallInput) vmco
 	return vmcommon.Ok
 }
 
+// roundDurationProposal opens a proposal to change the round duration, in milliseconds. The round duration is
+// changed through changeRoundDuration only if the proposal is voted
+func (g *governanceContract) roundDurationProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(g.baseProposalCost) != 0 {
+		g.eei.AddReturnMessage("invalid proposal cost, expected " + g.baseProposalCost.String())
+		return vmcommon.OutOfFunds
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 4 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 4")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !g.isWhiteListed(args.CallerAddr) {
+		g.eei.AddReturnMessage("called address is not whiteListed")
+		return vmcommon.UserError
+	}
+	roundDuration, err := g.roundDurationFromArgument(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	gitHubCommit := args.Arguments[1]
+	if len(gitHubCommit) != githubCommitLength {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", githubCommitLength))
+		return vmcommon.UserError
+	}
+	if g.proposalExists(gitHubCommit) {
+		g.eei.AddReturnMessage("proposal already exists")
+		return vmcommon.UserError
+	}
+
+	startVoteNonce, endVoteNonce, err := g.startEndNonceFromArguments(args.Arguments[2], args.Arguments[3])
+	if err != nil {
+		g.eei.AddReturnMessage("invalid start/end vote nonce" + err.Error())
+		return vmcommon.UserError
+	}
+
+	key := append([]byte(roundDurationPrefix), gitHubCommit...)
+	g.eei.SetStorage(key, big.NewInt(0).SetUint64(roundDuration).Bytes())
+
+	generalProposal := &GeneralProposal{
+		IssuerAddress:  args.CallerAddr,
+		GitHubCommit:   gitHubCommit,
+		StartVoteNonce: startVoteNonce,
+		EndVoteNonce:   endVoteNonce,
+		Yes:            0,
+		No:             0,
+		Veto:           0,
+		DontCare:       0,
+		Voted:          false,
+		TopReference:   key,
+		Voters:         make([][]byte, 0),
+	}
+	err = g.saveGeneralProposal(gitHubCommit, generalProposal)
+	if err != nil {
+		log.Warn("saveGeneralProposal", "err", err)
+		g.eei.AddReturnMessage("saveGeneralProposal" + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// changeRoundDuration saves the round duration of a closed and voted round duration proposal, which the metachain
+// adds to the rounds schedule at the next epoch start. A proposal can change the round duration only once
+func (g *governanceContract) changeRoundDuration(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("changeRoundDuration can be called only without callValue")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		g.eei.AddReturnMessage("changeRoundDuration needs 1 argument")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.CloseProposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	gitHubCommit := args.Arguments[0]
+	key := append([]byte(roundDurationPrefix), gitHubCommit...)
+	roundDurationBytes := g.eei.GetStorage(key)
+	if len(roundDurationBytes) == 0 {
+		g.eei.AddReturnMessage("no pending round duration proposal with this reference")
+		return vmcommon.UserError
+	}
+
+	generalProposal, err := g.getGeneralProposal(gitHubCommit)
+	if err != nil {
+		g.eei.AddReturnMessage("getGeneralProposal error " + err.Error())
+		return vmcommon.UserError
+	}
+	if !generalProposal.Closed || !generalProposal.Voted {
+		g.eei.AddReturnMessage("round duration proposal was not voted")
+		return vmcommon.UserError
+	}
+
+	roundDuration := big.NewInt(0).SetBytes(roundDurationBytes)
+	if !g.isRoundDurationInBounds(roundDuration) {
+		g.eei.AddReturnMessage(g.roundDurationBoundsMessage())
+		return vmcommon.UserError
+	}
+
+	g.eei.SetStorage([]byte(roundDurationKey), roundDuration.Bytes())
+	g.eei.SetStorage(key, nil)
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) roundDurationFromArgument(arg []byte) (uint64, error) {
+	roundDuration, ok := big.NewInt(0).SetString(string(arg), conversionBase)
+	if !ok {
+		return 0, errors.New("round duration argument is incorrectly formatted")
+	}
+	if !g.isRoundDurationInBounds(roundDuration) {
+		return 0, errors.New(g.roundDurationBoundsMessage())
+	}
+
+	return roundDuration.Uint64(), nil
+}
+
+func (g *governanceContract) isRoundDurationInBounds(roundDuration *big.Int) bool {
+	return roundDuration.IsUint64() &&
+		roundDuration.Uint64() >= g.governanceConfig.MinRoundDurationInMs &&
+		roundDuration.Uint64() <= g.governanceConfig.MaxRoundDurationInMs
+}
+
+func (g *governanceContract) roundDurationBoundsMessage() string {
+	return fmt.Sprintf("round duration must be between %d and %d",
+		g.governanceConfig.MinRoundDurationInMs, g.governanceConfig.MaxRoundDurationInMs)
+}
+
+// getRoundDuration returns the round duration voted through changeRoundDuration, empty if none was voted. It can be
+// called even if the contract is disabled, as the metachain reads it at every epoch start
+func (g *governanceContract) getRoundDuration(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("getRoundDuration can be called only without callValue")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 0 {
+		g.eei.AddReturnMessage("getRoundDuration does not take arguments")
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(g.eei.GetStorage([]byte(roundDurationKey)))
+
+	return vmcommon.Ok
+}
+
 func (g *governanceContract) getConfig() (*GovernanceConfig, error) {
 	marshaledData := g.eei.GetStorage([]byte(governanceConfigKey))
 	scConfig := &GovernanceConfig{}
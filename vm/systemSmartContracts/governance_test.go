@@ -23,11 +23,13 @@ func createMockGovernanceArgs() ArgsNewGovernanceContract {
 		Eei:     &mock.SystemEIStub{},
 		GasCost: vm.GasCost{},
 		GovernanceConfig: config.GovernanceSystemSCConfig{
-			NumNodes:         3,
-			MinPassThreshold: 1,
-			MinQuorum:        2,
-			MinVetoThreshold: 2,
-			ProposalCost:     "100",
+			NumNodes:             3,
+			MinPassThreshold:     1,
+			MinQuorum:            2,
+			MinVetoThreshold:     2,
+			ProposalCost:         "100",
+			MinRoundDurationInMs: 4000,
+			MaxRoundDurationInMs: 10000,
 		},
 		ESDTSCAddress:       nil,
 		Marshalizer:         &mock.MarshalizerMock{},
@@ -93,6 +95,24 @@ func TestNewGovernanceContract_ZeroBaseProposerCostShouldErr(t *testing.T) {
 	require.Equal(t, vm.ErrInvalidBaseIssuingCost, err)
 }
 
+func TestNewGovernanceContract_InvalidRoundDurationBoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.GovernanceConfig.MinRoundDurationInMs = 0
+
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, gsc)
+	require.Equal(t, vm.ErrInvalidRoundDurationBounds, err)
+
+	args = createMockGovernanceArgs()
+	args.GovernanceConfig.MinRoundDurationInMs = args.GovernanceConfig.MaxRoundDurationInMs + 1
+
+	gsc, err = NewGovernanceContract(args)
+	require.Nil(t, gsc)
+	require.Equal(t, vm.ErrInvalidRoundDurationBounds, err)
+}
+
 func TestGovernanceContract_ExecuteNilVMInputShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -174,6 +194,143 @@ func TestGovernanceContract_ExecuteChangeConfigShouldWork(t *testing.T) {
 	require.Equal(t, vmcommon.Ok, retCode)
 }
 
+func TestGovernanceContract_ExecuteRoundDurationProposalOutOfBoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	callerAddr := []byte("addr1")
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			generalProposal := &GeneralProposal{
+				Voted: true,
+			}
+			generalProposalBytes, _ := json.Marshal(generalProposal)
+			return generalProposalBytes
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			require.Fail(t, "should have not saved the round duration proposal")
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	for _, roundDuration := range []string{"3999", "10001", "-5000", "five seconds"} {
+		callInput := createVMInput(big.NewInt(100), "roundDurationProposal", callerAddr, []byte("addr2"))
+		callInput.Arguments = [][]byte{
+			[]byte(roundDuration),
+			[]byte("0123456789012345678901234567890123456789"),
+			[]byte("100"),
+			[]byte("1000"),
+		}
+
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+	}
+}
+
+func TestGovernanceContract_ExecuteChangeRoundDurationWithoutProposalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.Eei = &mock.SystemEIStub{
+		SetStorageCalled: func(key []byte, value []byte) {
+			require.Fail(t, "should have not saved the round duration")
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	callInput := createVMInput(big.NewInt(0), "changeRoundDuration", []byte("addr1"), []byte("addr2"))
+	callInput.Arguments = [][]byte{[]byte("0123456789012345678901234567890123456789")}
+
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_ExecuteChangeRoundDurationNotVotedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gsc, blockChainHook, wlAddr := createGovernanceWithTwoValidators(t)
+	recipientAddr := []byte("recipientAddress")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	startNonce := uint64(100)
+	stopNonce := uint64(1000)
+
+	openRoundDurationProposal(t, gsc, wlAddr, recipientAddr, gitHubCommit, "5000", startNonce, stopNonce)
+
+	// the round duration can not be changed while the proposal is still open
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	voteProposal(t, gsc, []byte("vala1"), gitHubCommit, recipientAddr, "yes")
+	retCode := changeRoundDuration(gsc, gitHubCommit, recipientAddr)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	// nor if the proposal did not pass
+	voteProposal(t, gsc, []byte("vala2"), gitHubCommit, recipientAddr, "no")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return stopNonce + 1
+	}
+	closeProposal(t, gsc, wlAddr, gitHubCommit, recipientAddr)
+	retCode = changeRoundDuration(gsc, gitHubCommit, recipientAddr)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	require.Equal(t, 0, len(gsc.eei.GetStorage([]byte(roundDurationKey))))
+}
+
+func TestGovernanceContract_ExecuteChangeRoundDurationVotedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gsc, blockChainHook, wlAddr := createGovernanceWithTwoValidators(t)
+	recipientAddr := []byte("recipientAddress")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	startNonce := uint64(100)
+	stopNonce := uint64(1000)
+
+	openRoundDurationProposal(t, gsc, wlAddr, recipientAddr, gitHubCommit, "5000", startNonce, stopNonce)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return startNonce + 1
+	}
+	voteProposal(t, gsc, []byte("vala1"), gitHubCommit, recipientAddr, "yes")
+	voteProposal(t, gsc, []byte("vala2"), gitHubCommit, recipientAddr, "yes")
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return stopNonce + 1
+	}
+	closeProposal(t, gsc, wlAddr, gitHubCommit, recipientAddr)
+
+	// anyone can apply the result of the vote, but only once
+	retCode := changeRoundDuration(gsc, gitHubCommit, recipientAddr)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, big.NewInt(5000).Bytes(), gsc.eei.GetStorage([]byte(roundDurationKey)))
+
+	retCode = changeRoundDuration(gsc, gitHubCommit, recipientAddr)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_ExecuteGetRoundDurationShouldWorkEvenIfDisabled(t *testing.T) {
+	t.Parallel()
+
+	var finishedValue []byte
+	args := createMockGovernanceArgs()
+	args.GovernanceConfig.Disabled = true
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			require.Equal(t, []byte(roundDurationKey), key)
+			return big.NewInt(5000).Bytes()
+		},
+		FinishCalled: func(value []byte) {
+			finishedValue = value
+		},
+	}
+	gsc, _ := NewGovernanceContract(args)
+
+	callInput := createVMInput(big.NewInt(0), "getRoundDuration", []byte("addr1"), []byte("addr2"))
+
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, big.NewInt(5000).Bytes(), finishedValue)
+}
+
 func TestGovernanceContract_ExecuteWhiteListProposalInvalidValueShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -659,3 +816,65 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+// createGovernanceWithTwoValidators returns an initialized governance contract with a whitelisted address and two
+// validators, vala1 and vala2, each with one staked node
+func createGovernanceWithTwoValidators(t *testing.T) (*governanceContract, *mock.BlockChainHookStub, []byte) {
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), atArgParser, &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	nodeData := &StakedData{Staked: true}
+	stakedDataBytes, _ := json.Marshal(nodeData)
+	for _, validator := range []string{"vala1", "vala2"} {
+		blsKey := []byte("blsKey" + validator)
+		auctionData := &AuctionData{
+			NumRegistered: 1,
+			BlsPubKeys:    [][]byte{blsKey},
+		}
+		auctionDataBytes, _ := json.Marshal(auctionData)
+		eei.SetStorageForAddress(args.AuctionSCAddress, []byte(validator), auctionDataBytes)
+		eei.SetStorageForAddress(args.StakingSCAddress, blsKey, stakedDataBytes)
+	}
+
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	wlAddr := []byte("genesisAddr")
+	whiteListAddrAtGenesis(t, gsc, wlAddr, recipientAddr)
+
+	return gsc, blockChainHook, wlAddr
+}
+
+func openRoundDurationProposal(
+	t *testing.T,
+	g *governanceContract,
+	WLAddr, recipientAddr, gitHubCommit []byte,
+	roundDuration string,
+	startNonce, stopNonce uint64,
+) {
+	callInput := createVMInput(big.NewInt(100), "roundDurationProposal", WLAddr, recipientAddr)
+	callInput.Arguments = [][]byte{
+		[]byte(roundDuration),
+		gitHubCommit,
+		[]byte(fmt.Sprintf("%d", startNonce)),
+		[]byte(fmt.Sprintf("%d", stopNonce)),
+	}
+	retCode := g.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+}
+
+func changeRoundDuration(g *governanceContract, gitHubCommit, recipientAddr []byte) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), "changeRoundDuration", []byte("anyAddress"), recipientAddr)
+	callInput.Arguments = [][]byte{gitHubCommit}
+
+	return g.Execute(callInput)
+}
@@ -23,6 +23,8 @@ const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
 const waitingListHeadKey = "waitingList"
 const waitingElementPrefix = "w_"
+const equivocationPrefix = "equivocation_"
+const slashedStakePrefix = "slashed_"
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -30,9 +32,11 @@ type stakingSC struct {
 	unBondPeriod             uint64
 	stakeAccessAddr          []byte
 	jailAccessAddr           []byte
+	slashingAccessAddr       []byte
 	numRoundsWithoutBleed    uint64
 	bleedPercentagePerRound  float64
 	maximumPercentageToBleed float64
+	equivocationSlashPercent float64
 	gasCost                  vm.GasCost
 	minNumNodes              uint64
 	maxNumNodes              uint64
@@ -41,13 +45,14 @@ type stakingSC struct {
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
 type ArgsNewStakingSmartContract struct {
-	StakingSCConfig   config.StakingSystemSCConfig
-	MinNumNodes       uint64
-	Eei               vm.SystemEI
-	StakingAccessAddr []byte
-	JailAccessAddr    []byte
-	GasCost           vm.GasCost
-	Marshalizer       marshal.Marshalizer
+	StakingSCConfig    config.StakingSystemSCConfig
+	MinNumNodes        uint64
+	Eei                vm.SystemEI
+	StakingAccessAddr  []byte
+	JailAccessAddr     []byte
+	SlashingAccessAddr []byte
+	GasCost            vm.GasCost
+	Marshalizer        marshal.Marshalizer
 }
 
 // NewStakingSmartContract creates a staking smart contract
@@ -63,6 +68,9 @@ func NewStakingSmartContract(
 	if len(args.JailAccessAddr) < 1 {
 		return nil, vm.ErrInvalidJailAccessAddress
 	}
+	if len(args.SlashingAccessAddr) < 1 {
+		return nil, vm.ErrInvalidSlashingAccessAddress
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, vm.ErrNilMarshalizer
 	}
@@ -75,6 +83,9 @@ func NewStakingSmartContract(
 	if args.StakingSCConfig.MaximumPercentageToBleed < 0 {
 		return nil, vm.ErrNegativeMaximumPercentageToBleed
 	}
+	if args.StakingSCConfig.EquivocationSlashPercentage < 0 || args.StakingSCConfig.EquivocationSlashPercentage > 1 {
+		return nil, vm.ErrInvalidEquivocationSlashPercentage
+	}
 	if args.MinNumNodes > args.StakingSCConfig.MaxNumberOfNodesForStake {
 		return nil, vm.ErrInvalidMaxNumberOfNodes
 	}
@@ -84,9 +95,11 @@ func NewStakingSmartContract(
 		unBondPeriod:             args.StakingSCConfig.UnBondPeriod,
 		stakeAccessAddr:          args.StakingAccessAddr,
 		jailAccessAddr:           args.JailAccessAddr,
+		slashingAccessAddr:       args.SlashingAccessAddr,
 		numRoundsWithoutBleed:    args.StakingSCConfig.NumRoundsWithoutBleed,
 		bleedPercentagePerRound:  args.StakingSCConfig.BleedPercentagePerRound,
 		maximumPercentageToBleed: args.StakingSCConfig.MaximumPercentageToBleed,
+		equivocationSlashPercent: args.StakingSCConfig.EquivocationSlashPercentage,
 		gasCost:                  args.GasCost,
 		minNumNodes:              args.MinNumNodes,
 		maxNumNodes:              args.StakingSCConfig.MaxNumberOfNodesForStake,
@@ -120,6 +133,8 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.unBond(args)
 	case "slash":
 		return r.slash(args)
+	case "slashEquivocation":
+		return r.slashEquivocation(args)
 	case "get":
 		return r.get(args)
 	case "isStaked":
@@ -535,6 +550,7 @@ func (r *stakingSC) stake(args *vmcommon.ContractCallInput, onlyRegister bool) v
 	}
 
 	if !onlyRegister {
+		stakeValue = r.getStakeValueAfterSlashing(args.Arguments[0], stakeValue)
 		if registrationData.StakeValue.Cmp(stakeValue) < 0 {
 			registrationData.StakeValue.Set(stakeValue)
 		}
@@ -670,7 +686,7 @@ func (r *stakingSC) moveFirstFromWaitingToStakedIfNeeded(blsKey []byte) error {
 	nodeData.Staked = true
 	nodeData.RegisterNonce = r.eei.BlockChainHook().CurrentNonce()
 	nodeData.StakedNonce = r.eei.BlockChainHook().CurrentNonce()
-	stakeValue := r.getStakeValueForCurrentEpoch()
+	stakeValue := r.getStakeValueAfterSlashing(elementInList.BLSPublicKey, r.getStakeValueForCurrentEpoch())
 	if nodeData.StakeValue.Cmp(stakeValue) < 0 {
 		nodeData.StakeValue.Set(stakeValue)
 	}
@@ -720,6 +736,7 @@ func (r *stakingSC) unBond(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 		}
 
 		r.eei.SetStorage(args.Arguments[0], nil)
+		r.eei.SetStorage(slashedStakeKey(args.Arguments[0]), nil)
 		r.eei.Finish(registrationData.StakeValue.Bytes())
 		r.eei.Finish(big.NewInt(0).SetUint64(uint64(registrationData.UnStakedEpoch)).Bytes())
 
@@ -741,6 +758,7 @@ func (r *stakingSC) unBond(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	}
 
 	r.eei.SetStorage(args.Arguments[0], nil)
+	r.eei.SetStorage(slashedStakeKey(args.Arguments[0]), nil)
 	r.eei.Finish(registrationData.StakeValue.Bytes())
 	r.eei.Finish(big.NewInt(0).SetUint64(uint64(registrationData.UnStakedEpoch)).Bytes())
 
@@ -793,6 +811,107 @@ func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 	return vmcommon.Ok
 }
 
+// slashEquivocation burns a percentage of the stake of a validator that signed two conflicting blocks
+// and jails it. The second argument identifies the offense so the same proof can not be used twice.
+// The slashed value is also taken out of the owner's stake held by the auction contract and moved
+// to this contract, which can not spend it.
+func (r *stakingSC) slashEquivocation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, r.slashingAccessAddr) {
+		r.eei.AddReturnMessage("slashEquivocation function called by not the slashing address")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		retMessage := fmt.Sprintf("slashEquivocation function called with wrong number of arguments: expected %d, got %d", 2, len(args.Arguments))
+		r.eei.AddReturnMessage(retMessage)
+		return vmcommon.UserError
+	}
+
+	blsKey := args.Arguments[0]
+	offenseKey := append([]byte(equivocationPrefix), args.Arguments[1]...)
+	if len(r.eei.GetStorage(offenseKey)) > 0 {
+		r.eei.AddReturnMessage("equivocation already slashed")
+		return vmcommon.UserError
+	}
+
+	registrationData, err := r.getOrCreateRegisteredData(blsKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(registrationData.RewardAddress) == 0 {
+		r.eei.AddReturnMessage("cannot slash a key that is not registered")
+		return vmcommon.UserError
+	}
+
+	slashValue := getPercentageOfValue(registrationData.StakeValue, r.equivocationSlashPercent)
+	registrationData.StakeValue = big.NewInt(0).Sub(registrationData.StakeValue, slashValue)
+
+	err = r.slashOwnerStake(args.RecipientAddr, blsKey, slashValue)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot slash the owner stake: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	if registrationData.UnJailedNonce <= registrationData.JailedNonce {
+		r.addToJailedNodes()
+	}
+	registrationData.JailedRound = r.eei.BlockChainHook().CurrentRound()
+	registrationData.JailedNonce = r.eei.BlockChainHook().CurrentNonce()
+
+	err = r.saveStakingData(blsKey, registrationData)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	r.eei.SetStorage(offenseKey, []byte(slashValue.String()))
+	r.eei.Finish(slashValue.Bytes())
+
+	return vmcommon.Ok
+}
+
+func (r *stakingSC) slashOwnerStake(stakingSCAddress []byte, blsKey []byte, slashValue *big.Int) error {
+	if slashValue.Cmp(zero) == 0 {
+		return nil
+	}
+
+	txData := "slashStake@" + hex.EncodeToString(blsKey) + "@" + hex.EncodeToString(slashValue.Bytes())
+	vmOutput, err := r.eei.ExecuteOnDestContext(r.stakeAccessAddr, stakingSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w: %s", vm.ErrOnExecutionAtAuctionSC, vmOutput.ReturnMessage)
+	}
+
+	err = r.eei.Transfer(stakingSCAddress, r.stakeAccessAddr, slashValue, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	slashedKey := slashedStakeKey(blsKey)
+	slashedStake := big.NewInt(0).SetBytes(r.eei.GetStorage(slashedKey))
+	slashedStake.Add(slashedStake, slashValue)
+	r.eei.SetStorage(slashedKey, slashedStake.Bytes())
+
+	return nil
+}
+
+// getStakeValueAfterSlashing returns the stake value a key has to hold, lowered by what was already slashed from it,
+// so that staking the key again does not restore its slashed stake
+func (r *stakingSC) getStakeValueAfterSlashing(blsKey []byte, stakeValue *big.Int) *big.Int {
+	slashedStake := big.NewInt(0).SetBytes(r.eei.GetStorage(slashedStakeKey(blsKey)))
+	if slashedStake.Cmp(stakeValue) >= 0 {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Sub(stakeValue, slashedStake)
+}
+
+func slashedStakeKey(blsKey []byte) []byte {
+	return append([]byte(slashedStakePrefix), blsKey...)
+}
+
 func (r *stakingSC) isStaked(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) < 1 {
 		r.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected min %d, got %d", 1, 0))
@@ -15,17 +15,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockStakingScArguments() ArgsNewStakingSmartContract {
 	return ArgsNewStakingSmartContract{
-		Eei:               &mock.SystemEIStub{},
-		StakingAccessAddr: []byte("auction"),
-		JailAccessAddr:    []byte("jail"),
-		MinNumNodes:       1,
-		Marshalizer:       &mock.MarshalizerMock{},
+		Eei:                &mock.SystemEIStub{},
+		StakingAccessAddr:  []byte("auction"),
+		JailAccessAddr:     []byte("jail"),
+		SlashingAccessAddr: []byte("slashing"),
+		MinNumNodes:        1,
+		Marshalizer:        &mock.MarshalizerMock{},
 		StakingSCConfig: config.StakingSystemSCConfig{
 			GenesisNodePrice:                     "100",
 			MinStakeValue:                        "1",
@@ -103,6 +105,28 @@ func TestNewStakingSmartContract_NilJailAccessAddrEIShouldErr(t *testing.T) {
 	assert.Equal(t, vm.ErrInvalidJailAccessAddress, err)
 }
 
+func TestNewStakingSmartContract_NilSlashingAccessAddrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.SlashingAccessAddr = nil
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrInvalidSlashingAccessAddress, err)
+}
+
+func TestNewStakingSmartContract_InvalidEquivocationSlashPercentageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.EquivocationSlashPercentage = 1.5
+	stakingSmartContract, err := NewStakingSmartContract(args)
+
+	assert.Nil(t, stakingSmartContract)
+	assert.Equal(t, vm.ErrInvalidEquivocationSlashPercentage, err)
+}
+
 func TestNewStakingSmartContract_NegativeStakeValueShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1142,6 +1166,133 @@ func TestStakingSc_StakeJailAndUnJail(t *testing.T) {
 	require.Equal(t, big.NewInt(999), registrationData.StakeValue)
 }
 
+func TestStakingSc_SlashEquivocation(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 10
+	}
+
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	slashingAccessAddr := []byte("slashingAccessAddr")
+	slashedStake := big.NewInt(0)
+	auctionSC := &mock.SystemSCStub{
+		ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			assert.Equal(t, "slashStake", args.Function)
+			slashedStake.Add(slashedStake, big.NewInt(0).SetBytes(args.Arguments[1]))
+			return vmcommon.Ok
+		},
+	}
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return auctionSC, nil
+	}})
+
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.MinStakeValue = "100"
+	args.StakingSCConfig.EquivocationSlashPercentage = 0.1
+	args.StakingAccessAddr = stakingAccessAddress
+	args.SlashingAccessAddr = slashingAccessAddr
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	stakerPubKey := []byte("stakerPublicKey")
+	offense := []byte("offense")
+
+	setStakeValueCurrentEpoch(t, stakingSmartContract, stakingAccessAddress, big.NewInt(1000), vmcommon.Ok)
+
+	// wrong caller
+	doSlashEquivocation(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, offense, vmcommon.UserError)
+	// key not registered
+	doSlashEquivocation(t, stakingSmartContract, slashingAccessAddr, stakerPubKey, offense, vmcommon.UserError)
+
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+	doSlashEquivocation(t, stakingSmartContract, slashingAccessAddr, stakerPubKey, offense, vmcommon.Ok)
+	// the same offense can not be slashed twice
+	doSlashEquivocation(t, stakingSmartContract, slashingAccessAddr, stakerPubKey, offense, vmcommon.UserError)
+
+	var registrationData StakedData
+	data := stakingSmartContract.eei.GetStorage(stakerPubKey)
+	_ = json.Unmarshal(data, &registrationData)
+	assert.Equal(t, big.NewInt(900), registrationData.StakeValue)
+	assert.Equal(t, uint64(10), registrationData.JailedNonce)
+	assert.Equal(t, big.NewInt(100), slashedStake)
+	assert.Equal(t, big.NewInt(-100), eei.GetBalance(stakingAccessAddress))
+
+	// a jailed validator can not unStake
+	doUnStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey, vmcommon.UserError)
+
+	// staking the key again does not restore the slashed stake
+	doStake(t, stakingSmartContract, stakingAccessAddress, []byte("otherStakerAddr"), []byte("otherStakerPublicKey"))
+	doUnJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.Ok)
+	doUnStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey, vmcommon.Ok)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+
+	data = stakingSmartContract.eei.GetStorage(stakerPubKey)
+	_ = json.Unmarshal(data, &registrationData)
+	assert.True(t, registrationData.Staked)
+	assert.Equal(t, big.NewInt(900), registrationData.StakeValue)
+}
+
+func TestStakingSc_SlashEquivocationAuctionErrorShouldNotSlash(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	blockChainHook.GetStorageDataCalled = func(accountsAddress []byte, index []byte) (i []byte, e error) {
+		return nil, nil
+	}
+
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{})
+	eei.SetSCAddress([]byte("addr"))
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return &mock.SystemSCStub{
+			ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+				return vmcommon.UserError
+			},
+		}, nil
+	}})
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	slashingAccessAddr := []byte("slashingAccessAddr")
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.MinStakeValue = "100"
+	args.StakingSCConfig.EquivocationSlashPercentage = 0.1
+	args.StakingAccessAddr = stakingAccessAddress
+	args.SlashingAccessAddr = slashingAccessAddr
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	stakerPubKey := []byte("stakerPublicKey")
+	offense := []byte("offense")
+
+	setStakeValueCurrentEpoch(t, stakingSmartContract, stakingAccessAddress, big.NewInt(1000), vmcommon.Ok)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+	doSlashEquivocation(t, stakingSmartContract, slashingAccessAddr, stakerPubKey, offense, vmcommon.UserError)
+
+	var registrationData StakedData
+	data := stakingSmartContract.eei.GetStorage(stakerPubKey)
+	_ = json.Unmarshal(data, &registrationData)
+	assert.Equal(t, big.NewInt(1000), registrationData.StakeValue)
+}
+
+func doSlashEquivocation(t *testing.T, sc *stakingSC, callerAddr, stakerPubKey, offense []byte, expectedCode vmcommon.ReturnCode) {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slashEquivocation"
+	arguments.CallerAddr = callerAddr
+	arguments.Arguments = [][]byte{stakerPubKey, offense}
+
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, expectedCode, retCode)
+}
+
 func doUnJail(t *testing.T, sc *stakingSC, callerAddr, addrToUnJail []byte, expectedCode vmcommon.ReturnCode) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "unJail"